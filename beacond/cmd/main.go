@@ -38,14 +38,6 @@ func run() error {
 		return err
 	}
 
-	// TODO: This is hood as fuck needs to be improved
-	// but for now we ball to get CI unblocked.
-	chainSpec := os.Getenv("CHAIN_SPEC")
-	loadedSpec := spec.TestnetChainSpec()
-	if chainSpec == "devnet" {
-		loadedSpec = spec.DevnetChainSpec()
-	}
-
 	// Build the node using the node-core.
 	nb := nodebuilder.New(
 		// Set the Name to the Default.
@@ -57,8 +49,9 @@ func run() error {
 		// Set the DepInject Configuration to the Default.
 		nodebuilder.WithDepInjectConfig[types.NodeI](
 			nodebuilder.DefaultDepInjectConfig()),
-		// Set the ChainSpec to the Default.
-		nodebuilder.WithChainSpec[types.NodeI](loadedSpec),
+		// Set the ChainSpec to the Default. Another chain spec can be
+		// selected with --beacon-kit.chain-spec or CHAIN_SPEC.
+		nodebuilder.WithChainSpec[types.NodeI](spec.TestnetChainSpec()),
 		// Set the Runtime Components to the Default.
 		nodebuilder.WithComponents[types.NodeI](
 			components.DefaultComponentsWithStandardTypes(),
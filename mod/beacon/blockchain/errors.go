@@ -35,4 +35,9 @@ var (
 	ErrNilBlk = errors.New("nil beacon block")
 	// ErrDataNotAvailable.
 	ErrDataNotAvailable = errors.New("data not available")
+	// ErrExecutionClientNotReady is returned when the execution client does
+	// not support the active fork.
+	ErrExecutionClientNotReady = errors.New(
+		"execution client not ready for the active fork",
+	)
 )
@@ -25,7 +25,9 @@ import (
 	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // sendPostBlockFCU sends a forkchoice update to the execution client.
@@ -85,8 +87,7 @@ func (s *Service[
 				uint64(blk.GetBody().GetExecutionPayload().GetTimestamp()+1),
 			)),
 			prevBlockRoot,
-			lph.GetBlockHash(),
-			lph.GetParentHash(),
+			s.fcs.StateFor(lph.GetBlockHash()),
 		); err == nil {
 			return
 		}
@@ -99,17 +100,14 @@ func (s *Service[
 				"failed to send forkchoice update with attributes",
 				"error", err,
 			)
+		s.reorgToLatestValid(ctx, err, blk.GetSlot())
 	} else {
 		// If we are not building blocks, or we failed to build a block
 		// we can just send the forkchoice update without attributes.
 		_, _, err = s.ee.NotifyForkchoiceUpdate(
 			ctx,
 			engineprimitives.BuildForkchoiceUpdateRequest(
-				&engineprimitives.ForkchoiceStateV1{
-					HeadBlockHash:      lph.GetBlockHash(),
-					SafeBlockHash:      lph.GetParentHash(),
-					FinalizedBlockHash: lph.GetParentHash(),
-				},
+				s.fcs.StateFor(lph.GetBlockHash()),
 				nil,
 				s.cs.ActiveForkVersionForSlot(blk.GetSlot()),
 			),
@@ -119,6 +117,47 @@ func (s *Service[
 				"failed to send forkchoice update without attributes",
 				"error", err,
 			)
+			s.reorgToLatestValid(ctx, err, blk.GetSlot())
 		}
 	}
 }
+
+// reorgToLatestValid moves the head of the execution client back to the
+// latest valid hash given for an INVALID head, if err carries one.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	DepositStoreT,
+]) reorgToLatestValid(
+	ctx context.Context,
+	err error,
+	slot math.Slot,
+) {
+	latestValidHash, ok := engineerrors.LatestValidHash(err)
+	if !ok {
+		return
+	}
+
+	s.logger.Warn(
+		"moving execution head back to the latest valid hash",
+		"latest_valid_hash", latestValidHash,
+	)
+	s.fcs.Reorg(latestValidHash)
+	if _, _, err = s.ee.NotifyForkchoiceUpdate(
+		ctx,
+		engineprimitives.BuildForkchoiceUpdateRequest(
+			s.fcs.State(),
+			nil,
+			s.cs.ActiveForkVersionForSlot(slot),
+		),
+	); err != nil {
+		s.logger.Error(
+			"failed to move execution head back to the latest valid hash",
+			"error", err,
+		)
+	}
+}
@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// forkReadinessEpochs is the number of epochs before a scheduled fork from
+// which the readiness of the execution client for it is checked, once per
+// epoch. The warnings escalate to errors in the last quarter of them.
+const forkReadinessEpochs math.Epoch = 256
+
+// forkReadiness tracks the readiness of the execution client for the forks
+// of the chain.
+type forkReadiness struct {
+	mu sync.Mutex
+	// checked is the epoch the readiness for the next fork was last
+	// checked at.
+	checked *math.Epoch
+	// ready holds the versions of the forks the execution client was found
+	// ready for.
+	ready map[uint32]struct{}
+}
+
+// newForkReadiness returns a forkReadiness with no fork checked.
+func newForkReadiness() *forkReadiness {
+	return &forkReadiness{ready: make(map[uint32]struct{})}
+}
+
+// isReady returns whether the execution client was found ready for the
+// fork of forkVersion.
+func (r *forkReadiness) isReady(forkVersion uint32) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.ready[forkVersion]
+	return ok
+}
+
+// markReady records that the execution client is ready for the fork of
+// forkVersion.
+func (r *forkReadiness) markReady(forkVersion uint32) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ready[forkVersion] = struct{}{}
+}
+
+// markChecked records that the readiness for the next fork is checked at
+// epoch, and returns false if it already was.
+func (r *forkReadiness) markChecked(epoch math.Epoch) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.checked != nil && *r.checked == epoch {
+		return false
+	}
+	r.checked = &epoch
+	return true
+}
+
+// checkForkReadiness checks that the execution client advertises the engine
+// API methods of the forks around the slot of a block. In the epochs before
+// a scheduled fork, a missing method is warned about. In the first epoch
+// of a fork, it is an error: the execution client cannot process the
+// payloads of the fork, and the node would fall out of consensus.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	DepositStoreT,
+]) checkForkReadiness(ctx context.Context, slot math.Slot) error {
+	var (
+		epoch    = s.cs.SlotToEpoch(slot)
+		schedule = s.cs.ForkSchedule()
+	)
+
+	// At the boundary, the fork must be supported.
+	if fork := schedule.ForkAtEpoch(epoch); fork.Epoch == epoch &&
+		!s.forkReadiness.isReady(fork.Version) {
+		if err := s.ee.CheckForkReadiness(ctx, fork.Version); err != nil {
+			s.logger.Error(
+				"execution client is not ready for the active fork, "+
+					"upgrade it to a release supporting the fork and restart it",
+				"fork", version.Name(fork.Version),
+				"fork_epoch", fork.Epoch,
+				"error", err,
+			)
+			return errors.Wrapf(
+				ErrExecutionClientNotReady,
+				"fork %s active from epoch %d, upgrade the execution client "+
+					"to a release supporting it: %v",
+				version.Name(fork.Version), fork.Epoch, err,
+			)
+		}
+		s.forkReadiness.markReady(fork.Version)
+	}
+
+	// Ahead of the next fork, its support is warned about.
+	next, ok := schedule.NextFork(epoch)
+	if !ok || next.Epoch-epoch > forkReadinessEpochs ||
+		s.forkReadiness.isReady(next.Version) ||
+		!s.forkReadiness.markChecked(epoch) {
+		return nil
+	}
+	remaining := next.Epoch - epoch
+	if err := s.ee.CheckForkReadiness(ctx, next.Version); err != nil {
+		logFn := s.logger.Warn
+		if remaining <= forkReadinessEpochs/4 {
+			logFn = s.logger.Error
+		}
+		logFn(
+			"execution client is not ready for the next fork, "+
+				"upgrade it to a release supporting the fork before it",
+			"fork", version.Name(next.Version),
+			"fork_epoch", next.Epoch,
+			"epochs_remaining", remaining,
+			"error", err,
+		)
+		return nil
+	}
+	s.forkReadiness.markReady(next.Version)
+	s.logger.Info(
+		"execution client is ready for the next fork",
+		"fork", version.Name(next.Version),
+		"fork_epoch", next.Epoch,
+	)
+	return nil
+}
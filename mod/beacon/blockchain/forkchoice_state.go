@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"sync"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// ForkchoiceState tracks the execution block hashes of the forkchoice
+// states sent to the execution client, and is the single source of them:
+//   - the head is the payload of the latest block processed, or of a
+//     proposal accepted since, optimistically, before it is finalized by
+//     consensus;
+//   - the safe block is the payload of the latest block processed;
+//   - the finalized block is the payload of the block processed before it,
+//     which is committed once the safe block is.
+//
+// The safe and finalized hashes are zero until known, which the execution
+// client reads as no change. It is safe for concurrent use.
+type ForkchoiceState struct {
+	mu        sync.RWMutex
+	head      common.ExecutionHash
+	safe      common.ExecutionHash
+	finalized common.ExecutionHash
+}
+
+// NewForkchoiceState returns a ForkchoiceState with no block tracked.
+func NewForkchoiceState() *ForkchoiceState {
+	return &ForkchoiceState{}
+}
+
+// Init sets the head, safe and finalized hashes to blockHash, the payload
+// of the latest committed block, if no block is tracked yet.
+func (f *ForkchoiceState) Init(blockHash common.ExecutionHash) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.head != (common.ExecutionHash{}) {
+		return
+	}
+	f.head, f.safe, f.finalized = blockHash, blockHash, blockHash
+}
+
+// ProposalAccepted sets the head to blockHash, the payload of a proposal
+// accepted but not processed yet.
+func (f *ForkchoiceState) ProposalAccepted(blockHash common.ExecutionHash) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.head = blockHash
+}
+
+// BlockProcessed sets the head and safe hashes to blockHash, the payload of
+// a block processed, and finalizes the previous safe block.
+func (f *ForkchoiceState) BlockProcessed(blockHash common.ExecutionHash) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.safe != blockHash {
+		f.finalized = f.safe
+	}
+	f.head, f.safe = blockHash, blockHash
+}
+
+// Reorg moves the head and safe hashes back to latestValidHash, the latest
+// valid hash given by the execution client for an INVALID head. The
+// finalized hash, committed by consensus, is kept.
+func (f *ForkchoiceState) Reorg(latestValidHash common.ExecutionHash) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.head, f.safe = latestValidHash, latestValidHash
+}
+
+// State returns the forkchoice state of the tracked hashes.
+func (f *ForkchoiceState) State() *engineprimitives.ForkchoiceStateV1 {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.stateFor(f.head)
+}
+
+// StateFor returns the forkchoice state with head as head block, for a
+// payload to be built on it, and the tracked safe and finalized hashes.
+func (f *ForkchoiceState) StateFor(
+	head common.ExecutionHash,
+) *engineprimitives.ForkchoiceStateV1 {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.stateFor(head)
+}
+
+// stateFor returns the forkchoice state with head as head block. The lock
+// must be held.
+func (f *ForkchoiceState) stateFor(
+	head common.ExecutionHash,
+) *engineprimitives.ForkchoiceStateV1 {
+	return &engineprimitives.ForkchoiceStateV1{
+		HeadBlockHash:      head,
+		SafeBlockHash:      f.safe,
+		FinalizedBlockHash: f.finalized,
+	}
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkchoiceState(t *testing.T) {
+	var (
+		a = common.ExecutionHash{0x0a}
+		b = common.ExecutionHash{0x0b}
+		c = common.ExecutionHash{0x0c}
+		d = common.ExecutionHash{0x0d}
+	)
+	fcs := blockchain.NewForkchoiceState()
+	requireState := func(head, safe, finalized common.ExecutionHash) {
+		t.Helper()
+		require.Equal(t, &engineprimitives.ForkchoiceStateV1{
+			HeadBlockHash:      head,
+			SafeBlockHash:      safe,
+			FinalizedBlockHash: finalized,
+		}, fcs.State())
+	}
+
+	// At startup, all three are the latest committed payload.
+	fcs.Init(a)
+	requireState(a, a, a)
+	fcs.Init(b)
+	requireState(a, a, a)
+
+	// An accepted proposal only moves the head.
+	fcs.ProposalAccepted(b)
+	requireState(b, a, a)
+
+	// Once processed, it is safe, and its parent finalized.
+	fcs.BlockProcessed(b)
+	requireState(b, b, a)
+	fcs.ProposalAccepted(c)
+	requireState(c, b, a)
+	fcs.BlockProcessed(c)
+	requireState(c, c, b)
+
+	// Processing the same block again does not finalize it.
+	fcs.BlockProcessed(c)
+	requireState(c, c, b)
+
+	// A payload built on the safe block keeps the tracked hashes.
+	fcs.ProposalAccepted(d)
+	require.Equal(t, &engineprimitives.ForkchoiceStateV1{
+		HeadBlockHash:      c,
+		SafeBlockHash:      c,
+		FinalizedBlockHash: b,
+	}, fcs.StateFor(c))
+
+	// An INVALID head moves the head and safe hashes back to the latest
+	// valid hash.
+	fcs.Reorg(b)
+	requireState(b, b, b)
+	fcs.BlockProcessed(d)
+	requireState(d, d, b)
+}
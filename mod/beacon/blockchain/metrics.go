@@ -23,6 +23,7 @@ package blockchain
 import (
 	"time"
 
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -60,6 +61,12 @@ func (cm *chainMetrics) measureBlobProcessingDuration(start time.Time) {
 	)
 }
 
+// markUnexpectedProposer increments the counter for the number of incoming
+// blocks not from the proposer sampled from the state.
+func (cm *chainMetrics) markUnexpectedProposer() {
+	cm.sink.IncrementCounter("beacon_kit.blockchain.unexpected_proposer")
+}
+
 // markRebuildPayloadForRejectedBlockSuccess increments the counter for the
 // number of times
 // the validator successfully rebuilt the payload for a rejected block.
@@ -85,7 +92,7 @@ func (cm *chainMetrics) markRebuildPayloadForRejectedBlockFailure(
 		"slot",
 		string(slot.String()),
 		"error",
-		err.Error(),
+		engineerrors.MetricLabel(err),
 	)
 }
 
@@ -112,7 +119,7 @@ func (cm *chainMetrics) markOptimisticPayloadBuildFailure(
 		"slot",
 		string(slot.String()),
 		"error",
-		err.Error(),
+		engineerrors.MetricLabel(err),
 	)
 }
 
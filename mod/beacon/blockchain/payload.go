@@ -51,10 +51,20 @@ func (s *Service[
 		return
 	}
 
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		s.logger.Error(
+			"failed to get latest execution payload for force startup head",
+			"error", err,
+		)
+		return
+	}
+	s.fcs.Init(lph.GetBlockHash())
+
 	// TODO: Verify if the slot number is correct here, I believe in current
 	// form
 	// it should be +1'd. Not a big deal until hardforks are in play though.
-	if err = s.lb.SendForceHeadFCU(ctx, st, slot+1); err != nil {
+	if err = s.lb.SendForceHeadFCU(ctx, s.fcs.State(), slot+1); err != nil {
 		s.logger.Error(
 			"failed to send force head FCU",
 			"error", err,
@@ -161,11 +171,7 @@ func (s *Service[
 		// We set the parent root to the previous block root.
 		prevBlockRoot,
 		// We set the head of our chain to previous finalized block.
-		lph.GetBlockHash(),
-		// We can say that the payload from the previous block is *finalized*,
-		// TODO: This is making an assumption about the consensus rules
-		// and possibly should be made more explicit later on.
-		lph.GetBlockHash(),
+		s.fcs.StateFor(lph.GetBlockHash()),
 	); err != nil {
 		s.metrics.markRebuildPayloadForRejectedBlockFailure(slot, err)
 		return err
@@ -249,13 +255,8 @@ func (s *Service[
 		// The previous block root is simply the root of the block we just
 		// processed.
 		blkRoot,
-		// We set the head of our chain to the block we just processed.
-		payload.GetBlockHash(),
-		// We can say that the payload from the previous block is *finalized*,
-		// This is safe to do since this block was accepted and the thus the
-		// parent hash was deemed valid by the state transition function we
-		// just processed.
-		payload.GetParentHash(),
+		// We set the head of our chain to the block we just accepted.
+		s.fcs.StateFor(payload.GetBlockHash()),
 	); err != nil {
 		s.metrics.markOptimisticPayloadBuildFailure(slot, err)
 		return err
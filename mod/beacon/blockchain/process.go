@@ -73,6 +73,47 @@ func (s *Service[
 	ctx context.Context,
 	blk BeaconBlockT,
 	sidecars BlobSidecarsT,
+) ([]*transition.ValidatorUpdate, error) {
+	return s.processBlockAndBlobs(ctx, blk, sidecars, newFinalizeContext)
+}
+
+// ReplayBlockAndBlobs processes a beacon block that is already finalized,
+// while the node catches up with the chain. Its signatures are not verified
+// and its payload is not sent to the execution client, which syncs it from
+// the forkchoice updates instead.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	DepositStoreT,
+]) ReplayBlockAndBlobs(
+	ctx context.Context,
+	blk BeaconBlockT,
+	sidecars BlobSidecarsT,
+) ([]*transition.ValidatorUpdate, error) {
+	return s.processBlockAndBlobs(
+		ctx, blk, sidecars, transition.NewReplayContext,
+	)
+}
+
+// processBlockAndBlobs processes the block, in the transition context
+// returned by newContext, and its blob sidecars.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	DepositStoreT,
+]) processBlockAndBlobs(
+	ctx context.Context,
+	blk BeaconBlockT,
+	sidecars BlobSidecarsT,
+	newContext func(context.Context) *transition.Context,
 ) ([]*transition.ValidatorUpdate, error) {
 	var (
 		g, gCtx    = errgroup.WithContext(ctx)
@@ -85,15 +126,15 @@ func (s *Service[
 		return nil, ErrNilBlk
 	}
 
+	// The execution client must support the fork of the block.
+	if err := s.checkForkReadiness(ctx, blk.GetSlot()); err != nil {
+		return nil, err
+	}
+
 	// Launch a goroutine to process the incoming beacon block.
 	g.Go(func() error {
 		var err error
-		// We set `OptimisticEngine` to true since this is called during
-		// FinalizeBlock. We want to assume the payload is valid. If it
-		// ends up not being valid later, the node will simply AppHash,
-		// which is completely fine. This means we were syncing from a
-		// bad peer, and we would likely AppHash anyways.
-		valUpdates, err = s.processBeaconBlock(gCtx, st, blk)
+		valUpdates, err = s.processBeaconBlock(newContext(gCtx), st, blk)
 		return err
 	})
 
@@ -116,11 +157,16 @@ func (s *Service[
 		return nil, ErrDataNotAvailable
 	}
 
+	s.fcs.BlockProcessed(blk.GetBody().GetExecutionPayload().GetBlockHash())
+
 	// emit new block event
 	s.blockFeed.Send(
 		// TODO: decouple from feed package.
 		feed.NewEvent(ctx, events.BeaconBlockFinalized, (blk)),
 	)
+	if err := s.sendFinalizedEvent(ctx, st, blk); err != nil {
+		return nil, err
+	}
 
 	// If required, we want to forkchoice at the end of post
 	// block processing.
@@ -128,12 +174,46 @@ func (s *Service[
 	// We won't send a fcu if the block is bad, should be addressed
 	// via ticker later.
 
-	go s.sendPostBlockFCU(ctx, st, blk)
+	s.goInflight(func() { s.sendPostBlockFCU(ctx, st, blk) })
 
 	return valUpdates, nil
 }
 
-// ProcessBeaconBlock processes the beacon block.
+// sendFinalizedEvent publishes the BeaconBlockFinalized event of blk, with
+// the state st it resulted in, to the finalized block feed.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	DepositStoreT,
+]) sendFinalizedEvent(
+	ctx context.Context, st BeaconStateT, blk BeaconBlockT,
+) error {
+	root, err := blk.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return err
+	}
+	s.finalizedFeed.Send(feed.NewEvent(
+		ctx, events.BeaconBlockFinalized,
+		&events.BeaconBlockFinalizedEvent[BeaconBlockT]{
+			Root:             root,
+			Slot:             blk.GetSlot(),
+			Block:            blk,
+			Eth1DepositIndex: depositIndex,
+		},
+	))
+	return nil
+}
+
+// processBeaconBlock runs the state transition of the beacon block in the
+// transition context ctx.
 func (s *Service[
 	AvailabilityStoreT,
 	BeaconBlockT,
@@ -143,35 +223,43 @@ func (s *Service[
 	DepositT,
 	DepositStoreT,
 ]) processBeaconBlock(
-	ctx context.Context,
+	ctx *transition.Context,
 	st BeaconStateT,
 	blk BeaconBlockT,
 ) ([]*transition.ValidatorUpdate, error) {
 	startTime := time.Now()
 	defer s.metrics.measureStateTransitionDuration(startTime)
-	valUpdates, err := s.sp.Transition(
-		&transition.Context{
-			Context:          ctx,
-			OptimisticEngine: true,
-			// When we are NOT synced to the tip, process proposal
-			// does NOT get called and thus we must ensure that
-			// NewPayload is called to get the execution
-			// client the payload.
-			//
-			// When we are synced to the tip, we can skip the
-			// NewPayload call since we already gave our execution client
-			// the payload in process proposal.
-			//
-			// In both cases the payload was already accepted by a majority
-			// of validators in their process proposal call and thus
-			// the "verification aspect" of this NewPayload call is
-			// actually irrelevant at this point.
-			SkipPayloadVerification: false,
-		},
-		st,
-		blk,
-	)
-	return valUpdates, err
+	return s.sp.Transition(ctx, st, blk)
+}
+
+// newFinalizeContext returns the transition context of a block processed
+// during FinalizeBlock.
+func newFinalizeContext(ctx context.Context) *transition.Context {
+	return &transition.Context{
+		Context: ctx,
+		// We set `OptimisticEngine` to true since this is called during
+		// FinalizeBlock. We want to assume the payload is valid. If it
+		// ends up not being valid later, the node will simply AppHash,
+		// which is completely fine. This means we were syncing from a
+		// bad peer, and we would likely AppHash anyways.
+		OptimisticEngine: true,
+		// When we are NOT synced to the tip, process proposal
+		// does NOT get called and thus we must ensure that
+		// NewPayload is called to get the execution
+		// client the payload, unless the block is replayed with
+		// ReplayBlockAndBlobs and the execution client syncs it from the
+		// forkchoice updates.
+		//
+		// When we are synced to the tip, we can skip the
+		// NewPayload call since we already gave our execution client
+		// the payload in process proposal.
+		//
+		// In both cases the payload was already accepted by a majority
+		// of validators in their process proposal call and thus
+		// the "verification aspect" of this NewPayload call is
+		// actually irrelevant at this point.
+		SkipPayloadVerification: false,
+	}
 }
 
 // ProcessBlobSidecars processes the blob sidecars.
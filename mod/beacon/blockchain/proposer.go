@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// proposerCacheSlots is the number of slots behind the latest one the
+// proposers are cached for.
+const proposerCacheSlots = 64
+
+// proposerCache caches the proposers of the recent slots. The proposer of a
+// slot only depends on the state at its epoch, so it is computed once for
+// all the proposals of the slot.
+type proposerCache struct {
+	mu        sync.Mutex
+	proposers map[math.Slot]math.ValidatorIndex
+}
+
+// newProposerCache returns an empty proposer cache.
+func newProposerCache() *proposerCache {
+	return &proposerCache{
+		proposers: make(map[math.Slot]math.ValidatorIndex),
+	}
+}
+
+// get returns the cached proposer of slot.
+func (c *proposerCache) get(slot math.Slot) (math.ValidatorIndex, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	index, ok := c.proposers[slot]
+	return index, ok
+}
+
+// add caches the proposer of slot, evicting the proposers of the slots
+// more than proposerCacheSlots behind it.
+func (c *proposerCache) add(slot math.Slot, index math.ValidatorIndex) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.proposers[slot] = index
+	for cached := range c.proposers {
+		if cached+proposerCacheSlots < slot {
+			delete(c.proposers, cached)
+		}
+	}
+}
+
+// proposerIndex returns the proposer of slot, as computed by the state
+// processor from st. If st is at an earlier epoch, the proposer is computed
+// from a copy of st processed up to slot.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	DepositStoreT,
+]) proposerIndex(
+	st BeaconStateT,
+	slot math.Slot,
+) (math.ValidatorIndex, error) {
+	if index, ok := s.proposers.get(slot); ok {
+		return index, nil
+	}
+
+	stateSlot, err := st.GetSlot()
+	if err != nil {
+		return 0, err
+	}
+	if s.cs.SlotToEpoch(stateSlot) < s.cs.SlotToEpoch(slot) {
+		st = st.Copy()
+		if _, err = s.sp.ProcessSlots(st, slot); err != nil {
+			return 0, err
+		}
+	}
+
+	index, err := s.sp.GetBeaconProposerIndex(st, slot)
+	if err != nil {
+		return 0, err
+	}
+	s.proposers.add(slot, index)
+	return index, nil
+}
+
+// verifyProposer checks the proposer of an incoming block against the one
+// sampled from the state. The proposers are selected by CometBFT, not by the
+// beacon chain, so a different proposer is only reported and the block is
+// not rejected for it.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	DepositStoreT,
+]) verifyProposer(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) {
+	expected, err := s.proposerIndex(st, blk.GetSlot())
+	if err != nil {
+		s.logger.Error(
+			"failed to compute the proposer of the incoming block",
+			"slot", blk.GetSlot(),
+			"error", err,
+		)
+		return
+	}
+	if proposer := blk.GetProposerIndex(); proposer != expected {
+		s.logger.Warn(
+			"incoming block is not from the expected proposer",
+			"slot", blk.GetSlot(),
+			"proposer_index", proposer,
+			"expected_proposer_index", expected,
+		)
+		s.metrics.markUnexpectedProposer()
+	}
+}
@@ -102,6 +102,8 @@ func (s *Service[
 		"state_root", blk.GetStateRoot(),
 	)
 
+	s.verifyProposer(preState, blk)
+
 	// We purposefully make a copy of the BeaconState in orer
 	// to avoid modifying the underlying state, for the event in which
 	// we have to rebuild a payload for this slot again, if we do not agree
@@ -121,7 +123,9 @@ func (s *Service[
 		)
 
 		if s.shouldBuildOptimisticPayloads() {
-			go s.handleRebuildPayloadForRejectedBlock(ctx, preState)
+			s.goInflight(func() {
+				s.handleRebuildPayloadForRejectedBlock(ctx, preState)
+			})
 		}
 
 		return err
@@ -132,9 +136,12 @@ func (s *Service[
 		"state_root",
 		blk.GetStateRoot(),
 	)
+	s.fcs.ProposalAccepted(blk.GetBody().GetExecutionPayload().GetBlockHash())
 
 	if s.shouldBuildOptimisticPayloads() {
-		go s.handleOptimisticPayloadBuild(ctx, postState, blk)
+		s.goInflight(func() {
+			s.handleOptimisticPayloadBuild(ctx, postState, blk)
+		})
 	}
 
 	return nil
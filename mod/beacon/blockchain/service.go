@@ -73,13 +73,25 @@ type Service[
 	]
 	// metrics is the metrics for the service.
 	metrics *chainMetrics
+	// proposers caches the proposers of the recent slots.
+	proposers *proposerCache
 	// blockFeed is the event feed for new blocks.
 	blockFeed EventFeed[*feed.Event[BeaconBlockT]]
+	// finalizedFeed is the event feed for finalized blocks.
+	finalizedFeed FinalizedBlockFeed[BeaconBlockT]
 	// optimisticPayloadBuilds is a flag used when the optimistic payload
 	// builder is enabled.
 	optimisticPayloadBuilds bool
 	// forceStartupSyncOnce is used to force a sync of the startup head.
 	forceStartupSyncOnce *sync.Once
+	// inflight tracks the forkchoice updates and payload builds sent to the
+	// execution client in the background.
+	inflight *sync.WaitGroup
+	// forkReadiness tracks the readiness of the execution client for the
+	// forks of the chain.
+	forkReadiness *forkReadiness
+	// fcs tracks the forkchoice state sent to the execution client.
+	fcs *ForkchoiceState
 }
 
 // NewService creates a new validator service.
@@ -106,6 +118,7 @@ func NewService[
 	cs primitives.ChainSpec,
 	ee ExecutionEngine,
 	lb LocalBuilder[BeaconStateT],
+	fcs *ForkchoiceState,
 	bp BlobProcessor[
 		AvailabilityStoreT,
 		BeaconBlockBodyT,
@@ -117,6 +130,7 @@ func NewService[
 	],
 	ts TelemetrySink,
 	blockFeed EventFeed[*feed.Event[BeaconBlockT]],
+	finalizedFeed FinalizedBlockFeed[BeaconBlockT],
 	optimisticPayloadBuilds bool,
 ) *Service[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
@@ -134,9 +148,14 @@ func NewService[
 		bp:                      bp,
 		sp:                      sp,
 		metrics:                 newChainMetrics(ts),
+		proposers:               newProposerCache(),
 		blockFeed:               blockFeed,
+		finalizedFeed:           finalizedFeed,
 		optimisticPayloadBuilds: optimisticPayloadBuilds,
 		forceStartupSyncOnce:    new(sync.Once),
+		inflight:                new(sync.WaitGroup),
+		forkReadiness:           newForkReadiness(),
+		fcs:                     fcs,
 	}
 }
 
@@ -167,6 +186,49 @@ func (s *Service[
 	return nil
 }
 
+// Stop waits for the forkchoice updates and payload builds in flight, so
+// that the execution client is not left with a dangling payload build.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositStoreT,
+	DepositT,
+]) Stop(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.inflight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// goInflight runs fn in the background, tracking it so that Stop waits for
+// it to return.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositStoreT,
+	DepositT,
+]) goInflight(fn func()) {
+	s.inflight.Add(1)
+	go func() {
+		defer s.inflight.Done()
+		fn()
+	}()
+}
+
 func (s *Service[
 	AvailabilityStoreT,
 	BeaconBlockT,
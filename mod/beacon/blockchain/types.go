@@ -29,6 +29,8 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
@@ -113,6 +115,10 @@ type DepositStore[DepositT any] interface {
 
 // ExecutionEngine is the interface for the execution engine.
 type ExecutionEngine interface {
+	// CheckForkReadiness returns an error if the execution client does not
+	// advertise the engine API methods required by the fork of
+	// forkVersion.
+	CheckForkReadiness(ctx context.Context, forkVersion uint32) error
 	// GetPayload returns the payload and blobs bundle for the given slot.
 	GetPayload(
 		ctx context.Context,
@@ -140,6 +146,12 @@ type EventFeed[EventT any] interface {
 	Send(event EventT) int
 }
 
+// FinalizedBlockFeed is the interface for sending the events of finalized
+// blocks.
+type FinalizedBlockFeed[BeaconBlockT any] interface {
+	EventFeed[*feed.Event[*events.BeaconBlockFinalizedEvent[BeaconBlockT]]]
+}
+
 // LocalBuilder is the interface for the builder service.
 type LocalBuilder[BeaconStateT any] interface {
 	// Enabled returns true if the local builder is enabled.
@@ -151,12 +163,13 @@ type LocalBuilder[BeaconStateT any] interface {
 		slot math.Slot,
 		timestamp uint64,
 		parentBlockRoot primitives.Root,
-		headEth1BlockHash common.ExecutionHash,
-		finalEth1BlockHash common.ExecutionHash,
+		fcs *engineprimitives.ForkchoiceStateV1,
 	) (*engineprimitives.PayloadID, error)
+	// SendForceHeadFCU sends the forkchoice state fcs to the execution
+	// client, without payload attributes.
 	SendForceHeadFCU(
 		ctx context.Context,
-		st BeaconStateT,
+		fcs *engineprimitives.ForkchoiceStateV1,
 		slot math.Slot,
 	) error
 }
@@ -189,6 +202,9 @@ type StateProcessor[
 		BeaconStateT,
 		BeaconBlockT,
 	) ([]*transition.ValidatorUpdate, error)
+	// GetBeaconProposerIndex returns the proposer of a slot, sampled from
+	// a state at its epoch.
+	GetBeaconProposerIndex(BeaconStateT, math.Slot) (math.ValidatorIndex, error)
 }
 
 // StorageBackend defines an interface for accessing various storage components
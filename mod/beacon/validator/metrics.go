@@ -23,6 +23,7 @@ package validator
 import (
 	"time"
 
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -71,6 +72,6 @@ func (cm *validatorMetrics) failedToRetrievePayload(
 		"slot",
 		string(slot.String()),
 		"error",
-		err.Error(),
+		engineerrors.MetricLabel(err),
 	)
 }
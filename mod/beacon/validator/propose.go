@@ -163,6 +163,7 @@ func (s *Service[
 	s.logger.Info(
 		"beacon block successfully built 🛠️ ",
 		"slot", requestedSlot,
+		"fork", version.Name(blk.Version()),
 		"state_root", blk.GetStateRoot(),
 		"duration", time.Since(startTime).String(),
 	)
@@ -179,21 +180,23 @@ func (s *Service[
 ) (BeaconBlockT, error) {
 	var blk BeaconBlockT
 	// Create a new block.
-	parentBlockRoot, err := st.GetBlockRootAtIndex(
-		uint64(requestedSlot-1) % s.chainSpec.SlotsPerHistoricalRoot(),
+	parentBlockRoot, err := st.GetBlockRootAtSlot(
+		requestedSlot-1, requestedSlot,
 	)
 
 	if err != nil {
 		return blk, errors.Newf(
-			"failed to get block root at index: %w",
+			"failed to get block root at slot: %w",
 			err,
 		)
 	}
 
 	// Get the proposer index for the slot.
-	proposerIndex, err := st.ValidatorIndexByPubkey(
-		s.signer.PublicKey(),
-	)
+	pubkey, err := s.signer.PublicKey()
+	if err != nil {
+		return blk, errors.Newf("failed to get signer pubkey: %w", err)
+	}
+	proposerIndex, err := st.ValidatorIndexByPubkey(pubkey)
 	if err != nil {
 		return blk, errors.Newf(
 			"failed to get validator by pubkey: %w",
@@ -236,6 +239,9 @@ func (s *Service[
 	if err != nil {
 		return crypto.BLSSignature{}, err
 	}
+	if signer, ok := s.signer.(SlotSigner); ok {
+		return signer.SignForSlot(slot, signingRoot[:])
+	}
 	return s.signer.Sign(signingRoot[:])
 }
 
@@ -289,8 +295,7 @@ func (s *Service[
 				uint64((lph.GetTimestamp()+1)),
 			),
 			blk.GetParentBlockRoot(),
-			lph.GetBlockHash(),
-			lph.GetParentHash(),
+			s.fcs.StateFor(lph.GetBlockHash()),
 		)
 	}
 	return envelope, nil
@@ -85,6 +85,8 @@ type Service[
 	// remotePayloadBuilders represents a list of remote block builders, these
 	// builders are connected to other execution clients via the EngineAPI.
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, *types.ExecutionPayload]
+	// fcs provides the forkchoice states to request payloads with.
+	fcs ForkchoiceStateProvider
 	// metrics is a metrics collector.
 	metrics *validatorMetrics
 }
@@ -124,6 +126,7 @@ func NewService[
 	],
 	localPayloadBuilder PayloadBuilder[BeaconStateT, *types.ExecutionPayload],
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, *types.ExecutionPayload],
+	fcs ForkchoiceStateProvider,
 	ts TelemetrySink,
 ) *Service[
 	BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
@@ -143,6 +146,7 @@ func NewService[
 		blobFactory:           blobFactory,
 		localPayloadBuilder:   localPayloadBuilder,
 		remotePayloadBuilders: remotePayloadBuilders,
+		fcs:                   fcs,
 		metrics:               newValidatorMetrics(ts),
 	}
 }
@@ -104,6 +104,9 @@ type BeaconState[
 	Copy() BeaconStateT
 	// GetBlockRootAtIndex returns the block root at the given index.
 	GetBlockRootAtIndex(uint64) (primitives.Root, error)
+	// GetBlockRootAtSlot returns the block root at the given slot, as seen
+	// from a state at currentSlot.
+	GetBlockRootAtSlot(slot, currentSlot math.Slot) (primitives.Root, error)
 	// GetLatestExecutionPayloadHeader returns the latest execution payload
 	// header.
 	GetLatestExecutionPayloadHeader() (
@@ -191,8 +194,7 @@ type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
 		slot math.Slot,
 		timestamp uint64,
 		parentBlockRoot primitives.Root,
-		headEth1BlockHash common.ExecutionHash,
-		finalEth1BlockHash common.ExecutionHash,
+		fcs *engineprimitives.ForkchoiceStateV1,
 	) (*engineprimitives.PayloadID, error)
 	// RequestPayloadSync requests a payload for the given slot and
 	// blocks until the payload is delivered.
@@ -202,17 +204,33 @@ type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
 		slot math.Slot,
 		timestamp uint64,
 		parentBlockRoot primitives.Root,
-		headEth1BlockHash common.ExecutionHash,
-		finalEth1BlockHash common.ExecutionHash,
+		fcs *engineprimitives.ForkchoiceStateV1,
 	) (engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload], error)
 	// SendForceHeadFCU sends a force head FCU to the execution client.
 	SendForceHeadFCU(
 		ctx context.Context,
-		st BeaconStateT,
+		fcs *engineprimitives.ForkchoiceStateV1,
 		slot math.Slot,
 	) error
 }
 
+// ForkchoiceStateProvider provides the forkchoice states sent to the
+// execution client.
+type ForkchoiceStateProvider interface {
+	// StateFor returns the forkchoice state with head as head block.
+	StateFor(head common.ExecutionHash) *engineprimitives.ForkchoiceStateV1
+}
+
+// SlotSigner is a signer that refuses to sign messages for a slot that could
+// get the validator slashed.
+type SlotSigner interface {
+	// SignForSlot signs the signing root of a message made for the block at
+	// the given slot.
+	SignForSlot(
+		slot math.Slot, signingRoot []byte,
+	) (crypto.BLSSignature, error)
+}
+
 // StateProcessor defines the interface for processing the state.
 type StateProcessor[
 	BeaconBlockT any,
@@ -76,8 +76,8 @@ func CollectGenesisDepositsCmd() *cobra.Command {
 				*types.ExecutionPayloadHeaderDeneb,
 			]{}
 
-			if err = json.Unmarshal(
-				appGenesisState["beacon"], genesisInfo,
+			if err = genesisInfo.UnmarshalJSONStrict(
+				appGenesisState["beacon"],
 			); err != nil {
 				return errors.Wrap(err, "failed to unmarshal beacon genesis")
 			}
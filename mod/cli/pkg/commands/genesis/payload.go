@@ -90,8 +90,8 @@ func AddExecutionPayloadCmd() *cobra.Command {
 				*types.Deposit,
 				*types.ExecutionPayloadHeaderDeneb,
 			]{}
-			if err = json.Unmarshal(
-				appGenesisState["beacon"], genesisInfo,
+			if err = genesisInfo.UnmarshalJSONStrict(
+				appGenesisState["beacon"],
 			); err != nil {
 				return errors.Wrap(err, "failed to unmarshal beacon state")
 			}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keystore
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer/keystore"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagPasswordFile = "password-file"
+	FlagOverwrite    = "overwrite"
+)
+
+// NewImportKeystoreCommand creates a new command for importing an EIP-2335
+// keystore as the key of the BLS signer.
+func NewImportKeystoreCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-keystore [keystore-file]",
+		Short: "Imports an EIP-2335 keystore as the validator signing key",
+		Long: `This command decrypts an EIP-2335 keystore to check its password
+and copies it into the config directory of the node. The password is read
+from the file given by --password-file, or prompted for if it is not set.
+Set the signer type to "keystore" in app.toml to sign with the imported key.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, ok := cmd.Context().
+				Value(client.ClientContextKey).(*client.Context)
+			if !ok {
+				return ErrNoClientCtx
+			}
+			passwordFile, err := cmd.Flags().GetString(FlagPasswordFile)
+			if err != nil {
+				return err
+			}
+			overwrite, err := cmd.Flags().GetBool(FlagOverwrite)
+			if err != nil {
+				return err
+			}
+
+			return importKeystore(
+				cmd, args[0], passwordFile,
+				filepath.Join(clientCtx.HomeDir, signer.DefaultKeystorePath),
+				overwrite,
+			)
+		},
+	}
+
+	cmd.Flags().String(
+		FlagPasswordFile, "", "File holding the password of the keystore",
+	)
+	cmd.Flags().Bool(
+		FlagOverwrite, false, "Replace a previously imported keystore",
+	)
+	return cmd
+}
+
+// importKeystore checks that the keystore at path decrypts to a valid key
+// and copies it to outputPath.
+func importKeystore(
+	cmd *cobra.Command,
+	path, passwordFile, outputPath string,
+	overwrite bool,
+) error {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	ks, err := keystore.Parse(bz)
+	if err != nil {
+		return err
+	}
+	password, err := keystore.ReadPassword(passwordFile)
+	if err != nil {
+		return err
+	}
+	s, err := signer.SignerFromKeystore(ks, password)
+	if err != nil {
+		return err
+	}
+	pubkey, err := s.PublicKey()
+	if err != nil {
+		return err
+	}
+
+	if _, err = os.Stat(outputPath); err == nil && !overwrite {
+		return errors.Wrapf(ErrKeystoreExists, "%s", outputPath)
+	} else if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(outputPath), 0o700); err != nil {
+		return err
+	}
+	if err = os.WriteFile(outputPath, bz, 0o600); err != nil {
+		return err
+	}
+
+	cmd.Printf(
+		"Imported keystore of %s to: %s\n"+
+			"Set type = \"keystore\" in [beacon-kit.signer] to sign with it\n",
+		pubkey.String(), outputPath,
+	)
+	return nil
+}
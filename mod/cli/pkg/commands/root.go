@@ -27,8 +27,11 @@ import (
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/deposit"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/genesis"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/jwt"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/keystore"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/slashing"
 	beaconconfig "github.com/berachain/beacon-kit/mod/node-core/pkg/config"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/pruning"
 	"github.com/cosmos/cosmos-sdk/client/snapshot"
@@ -41,11 +44,13 @@ import (
 )
 
 // DefaultRootCommandSetup sets up the default commands for the root command.
+// The start command opens the application database with dbOpener.
 func DefaultRootCommandSetup[T servertypes.Application](
 	rootCmd *cobra.Command,
 	mm *module.Manager,
 	newApp servertypes.AppCreator[T],
 	chainSpec primitives.ChainSpec,
+	dbOpener func(rootDir string, backend dbm.BackendType) (dbm.DB, error),
 ) {
 	// Add the ToS Flag to the root command.
 	beaconconfig.AddToSFlag(rootCmd)
@@ -53,8 +58,13 @@ func DefaultRootCommandSetup[T servertypes.Application](
 	// Setup the custom start command options.
 	startCmdOptions := server.StartCmdOptions[T]{
 		AddFlags: beaconconfig.AddBeaconKitFlags,
+		DBOpener: dbOpener,
 	}
 
+	// Extend the sdk keys commands with the import of validator keystores.
+	keysCmd := keys.Commands()
+	keysCmd.AddCommand(keystore.NewImportKeystoreCommand())
+
 	// Add all the commands to the root command.
 	rootCmd.AddCommand(
 		// `comet`
@@ -72,11 +82,13 @@ func DefaultRootCommandSetup[T servertypes.Application](
 		// `jwt`
 		jwt.Commands(),
 		// `keys`
-		keys.Commands(),
+		keysCmd,
 		// `prune`
 		pruning.Cmd(newApp),
 		// `rollback`
 		server.NewRollbackCmd(newApp),
+		// `slashing-protection`
+		slashing.Commands(),
 		// `snapshots`
 		snapshot.Cmd(newApp),
 		// `start`
@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashing
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer/slashing"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+const FlagGenesisValidatorsRoot = "genesis-validators-root"
+
+// Commands creates a new command for managing the slashing protection store.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "slashing-protection",
+		Short:                      "Slashing protection subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewExportCommand(),
+		NewImportCommand(),
+	)
+
+	return cmd
+}
+
+// NewExportCommand creates a new command for exporting the slashing
+// protection store.
+func NewExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [output-file]",
+		Short: "Exports the slashing protection history",
+		Long: `This command writes the slashing protection history of the node
+to a file in the EIP-3076 interchange format, to be imported into another node
+before it starts signing with the same key.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, genesisRoot, err := openStore(cmd)
+			if err != nil {
+				return err
+			}
+			bz, err := store.Export(genesisRoot)
+			if err != nil {
+				return err
+			}
+			if err = os.WriteFile(args[0], bz, 0o600); err != nil {
+				return err
+			}
+			cmd.Printf("Exported slashing protection to: %s\n", args[0])
+			return nil
+		},
+	}
+	addGenesisRootFlag(cmd)
+	return cmd
+}
+
+// NewImportCommand creates a new command for importing into the slashing
+// protection store.
+func NewImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [interchange-file]",
+		Short: "Imports a slashing protection history",
+		Long: `This command merges a slashing protection history in the EIP-3076
+interchange format into the slashing protection store of the node. The node
+must not be running.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, genesisRoot, err := openStore(cmd)
+			if err != nil {
+				return err
+			}
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			if err = store.Import(bz, genesisRoot); err != nil {
+				return err
+			}
+			cmd.Printf("Imported slashing protection from: %s\n", args[0])
+			return nil
+		},
+	}
+	addGenesisRootFlag(cmd)
+	return cmd
+}
+
+// addGenesisRootFlag adds the required genesis validators root flag to the
+// command.
+func addGenesisRootFlag(cmd *cobra.Command) {
+	cmd.Flags().String(
+		FlagGenesisValidatorsRoot, "",
+		"Genesis validators root of the chain, in hex",
+	)
+	//nolint:errcheck // the flag is defined above.
+	cmd.MarkFlagRequired(FlagGenesisValidatorsRoot)
+}
+
+// openStore opens the slashing protection store in the home directory and
+// returns it along with the genesis validators root given to the command.
+func openStore(cmd *cobra.Command) (*slashing.Store, bytes.B32, error) {
+	var genesisRoot bytes.B32
+	clientCtx, ok := cmd.Context().
+		Value(client.ClientContextKey).(*client.Context)
+	if !ok {
+		return nil, genesisRoot, ErrNoClientCtx
+	}
+	root, err := cmd.Flags().GetString(FlagGenesisValidatorsRoot)
+	if err != nil {
+		return nil, genesisRoot, err
+	}
+	if err = genesisRoot.UnmarshalText([]byte(root)); err != nil {
+		return nil, genesisRoot, err
+	}
+
+	store, err := slashing.Open(filepath.Join(
+		clientCtx.HomeDir, signer.DefaultSlashingProtectionPath,
+	))
+	return store, genesisRoot, err
+}
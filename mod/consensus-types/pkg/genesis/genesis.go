@@ -21,7 +21,9 @@
 package genesis
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"math/big"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
@@ -52,6 +54,47 @@ type Genesis[
 	ExecutionPayloadHeader ExecutonPayloadHeaderT `json:"execution_payload_header"`
 }
 
+// genesisJSON is the JSON of a Genesis, with its execution payload header
+// left to be decoded on its own.
+type genesisJSON[DepositT any] struct {
+	ForkVersion            primitives.Version `json:"fork_version"`
+	Deposits               []DepositT         `json:"deposits"`
+	ExecutionPayloadHeader json.RawMessage    `json:"execution_payload_header"`
+}
+
+// UnmarshalJSONStrict unmarshals the JSON of the genesis, rejecting the keys
+// that are not fields of it, of its deposits, or of its execution payload
+// header if it can be decoded strictly, so that a misspelled key is not
+// silently left to its zero value.
+func (g *Genesis[DepositT, ExecutonPayloadHeaderT]) UnmarshalJSONStrict(
+	bz []byte,
+) error {
+	var raw genesisJSON[DepositT]
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&raw); err != nil {
+		return err
+	}
+	g.ForkVersion = raw.ForkVersion
+	g.Deposits = raw.Deposits
+
+	if raw.ExecutionPayloadHeader == nil {
+		return nil
+	}
+	if err := json.Unmarshal(
+		raw.ExecutionPayloadHeader, &g.ExecutionPayloadHeader,
+	); err != nil {
+		return err
+	}
+	header, ok := any(g.ExecutionPayloadHeader).(interface {
+		UnmarshalJSONStrict(bz []byte) error
+	})
+	if !ok || string(raw.ExecutionPayloadHeader) == "null" {
+		return nil
+	}
+	return header.UnmarshalJSONStrict(raw.ExecutionPayloadHeader)
+}
+
 // DefaultGenesis returns a the default genesis.
 func DefaultGenesisDeneb() *Genesis[
 	*types.Deposit, *types.ExecutionPayloadHeaderDeneb,
@@ -21,9 +21,12 @@
 package genesis_test
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/genesis"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -69,3 +72,35 @@ func TestDefaultGenesisExecutionPayloadHeaderDeneb(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, header)
 }
+
+func TestGenesis_UnmarshalJSONStrict(t *testing.T) {
+	bz, err := json.Marshal(genesis.DefaultGenesisDeneb())
+	require.NoError(t, err)
+
+	var g genesis.Genesis[*types.Deposit, *types.ExecutionPayloadHeaderDeneb]
+	require.NoError(t, g.UnmarshalJSONStrict(bz))
+	require.Equal(t, genesis.DefaultGenesisDeneb(), &g)
+
+	tests := []struct {
+		name     string
+		from, to string
+	}{
+		{name: "Genesis", from: "deposits", to: "deposit"},
+		{
+			name: "Execution payload header",
+			from: "excessBlobGas",
+			to:   "excess_blob_gas",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var g genesis.Genesis[
+				*types.Deposit, *types.ExecutionPayloadHeaderDeneb,
+			]
+			err := g.UnmarshalJSONStrict([]byte(strings.Replace(
+				string(bz), `"`+tt.from+`"`, `"`+tt.to+`"`, 1,
+			)))
+			require.ErrorContains(t, err, tt.to)
+		})
+	}
+}
@@ -21,7 +21,6 @@
 package state
 
 import (
-	"fmt"
 	"reflect"
 
 	deneb "github.com/berachain/beacon-kit/mod/consensus-types/pkg/state/deneb"
@@ -112,6 +111,71 @@ func (st *BeaconState[
 			},
 		}, nil
 	default:
-		return nil, fmt.Errorf("unsupported version %d", forkVersion)
+		return nil, version.ErrForkNotSupported{
+			Version:   forkVersion,
+			Subsystem: "beacon state",
+		}
 	}
 }
+
+// FieldRoot is the hash tree root of a top-level field of the state.
+type FieldRoot struct {
+	// Name is the name of the field.
+	Name string
+	// Root is the hash tree root of the field.
+	Root primitives.Root
+}
+
+// denebFields are the names of the fields of the Deneb state, in the order
+// they are merkleized in.
+//
+//nolint:gochecknoglobals // read-only.
+var denebFields = []string{
+	"genesisValidatorsRoot",
+	"slot",
+	"fork",
+	"latestBlockHeader",
+	"blockRoots",
+	"stateRoots",
+	"eth1Data",
+	"eth1DepositIndex",
+	"latestExecutionPayloadHeader",
+	"validators",
+	"balances",
+	"randaoMixes",
+	"nextWithdrawalIndex",
+	"nextWithdrawalValidatorIndex",
+	"slashings",
+	"totalSlashing",
+}
+
+// FieldRoots returns the hash tree roots of the top-level fields of the
+// state, in the order they are merkleized in. They are the leaves of the
+// tree of the hash tree root of the state.
+func (st *BeaconState[
+	BeaconBlockHeaderT,
+	ExecutionPayloadHeaderT,
+	Eth1DataT,
+	ForkT,
+	ValidatorT,
+]) FieldRoots() ([]FieldRoot, error) {
+	tree, err := st.GetTree()
+	if err != nil {
+		return nil, err
+	}
+
+	// The fields are the leaves of a tree of depth 4, starting at the
+	// generalized index 16.
+	roots := make([]FieldRoot, len(denebFields))
+	for i, name := range denebFields {
+		node, nErr := tree.Get(len(denebFields) + i)
+		if nErr != nil {
+			return nil, nErr
+		}
+		roots[i] = FieldRoot{
+			Name: name,
+			Root: primitives.Root(node.Hash()),
+		}
+	}
+	return roots, nil
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/state"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+type beaconState = state.BeaconState[
+	*types.BeaconBlockHeader,
+	*types.ExecutionPayloadHeader,
+	*types.Eth1Data,
+	*types.Fork,
+	*types.Validator,
+]
+
+// newBeaconState returns a Deneb state of two validators with the given
+// balances.
+func newBeaconState(t *testing.T, balances ...uint64) *beaconState {
+	t.Helper()
+	st, err := new(beaconState).New(
+		version.Deneb,
+		primitives.Root{0x01},
+		math.Slot(10),
+		&types.Fork{},
+		&types.BeaconBlockHeader{},
+		make([]primitives.Root, 8),
+		make([]primitives.Root, 8),
+		&types.Eth1Data{},
+		2,
+		&types.ExecutionPayloadHeader{
+			InnerExecutionPayloadHeader: &types.ExecutionPayloadHeaderDeneb{
+				LogsBloom: make([]byte, 256),
+			},
+		},
+		[]*types.Validator{{}, {}},
+		balances,
+		make([]primitives.Bytes32, 8),
+		0,
+		0,
+		make([]uint64, 8),
+		0,
+	)
+	require.NoError(t, err)
+	return st
+}
+
+func TestBeaconState_FieldRoots(t *testing.T) {
+	st := newBeaconState(t, 32e9, 32e9)
+	roots, err := st.FieldRoots()
+	require.NoError(t, err)
+	require.Len(t, roots, 16)
+
+	// The field roots are the leaves of the hash tree root of the state.
+	chunks := make([][]byte, len(roots))
+	for i, root := range roots {
+		chunks[i] = root.Root[:]
+	}
+	tree, err := ssz.TreeFromChunks(chunks)
+	require.NoError(t, err)
+	expected, err := st.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, expected[:], tree.Hash())
+
+	// Only the root of the corrupted field differs.
+	corrupted, err := newBeaconState(t, 32e9, 31e9).FieldRoots()
+	require.NoError(t, err)
+	var differing []string
+	for i, root := range roots {
+		require.Equal(t, root.Name, corrupted[i].Name)
+		if root.Root != corrupted[i].Root {
+			differing = append(differing, root.Name)
+		}
+	}
+	require.Equal(t, []string{"balances"}, differing)
+}
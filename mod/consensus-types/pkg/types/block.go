@@ -34,13 +34,10 @@ type BeaconBlock struct {
 
 // Empty creates an empty beacon block.
 func (w *BeaconBlock) Empty(forkVersion uint32) *BeaconBlock {
-	switch forkVersion {
-	case version.Deneb:
-		return &BeaconBlock{
-			RawBeaconBlock: (*BeaconBlockDeneb)(nil),
-		}
-	default:
-		panic("fork version not supported")
+	return &BeaconBlock{
+		RawBeaconBlock: mustTypesOf(forkVersion).NewBeaconBlock(
+			BeaconBlockHeaderBase{},
+		),
 	}
 }
 
@@ -51,28 +48,18 @@ func (w *BeaconBlock) NewWithVersion(
 	parentBlockRoot common.Root,
 	forkVersion uint32,
 ) (*BeaconBlock, error) {
-	var (
-		block RawBeaconBlock[*BeaconBlockBody]
-		base  = BeaconBlockHeaderBase{
+	types, err := typesOf(forkVersion)
+	if err != nil {
+		return &BeaconBlock{}, err
+	}
+
+	return &BeaconBlock{
+		RawBeaconBlock: types.NewBeaconBlock(BeaconBlockHeaderBase{
 			Slot:            slot.Unwrap(),
 			ProposerIndex:   proposerIndex.Unwrap(),
 			ParentBlockRoot: parentBlockRoot,
 			StateRoot:       bytes.B32{},
-		}
-	)
-
-	switch forkVersion {
-	case version.Deneb:
-		block = &BeaconBlockDeneb{
-			BeaconBlockHeaderBase: base,
-			Body:                  &BeaconBlockBodyDeneb{},
-		}
-	default:
-		return &BeaconBlock{}, ErrForkVersionNotSupported
-	}
-
-	return &BeaconBlock{
-		RawBeaconBlock: block,
+		}),
 	}, nil
 }
 
@@ -82,14 +69,13 @@ func (w *BeaconBlock) NewFromSSZ(
 	forkVersion uint32,
 ) (*BeaconBlock, error) {
 	var block = new(BeaconBlock)
-	switch forkVersion {
-	case version.Deneb:
-		block.RawBeaconBlock = &BeaconBlockDeneb{}
-	default:
-		return block, ErrForkVersionNotSupported
+	types, err := typesOf(forkVersion)
+	if err != nil {
+		return block, err
 	}
 
-	if err := block.UnmarshalSSZ(bz); err != nil {
+	block.RawBeaconBlock = types.NewBeaconBlock(BeaconBlockHeaderBase{})
+	if err = block.UnmarshalSSZ(bz); err != nil {
 		return block, err
 	}
 	return block, nil
@@ -105,7 +91,7 @@ func (w *BeaconBlock) IsNil() bool {
 // BeaconBlockDeneb represents a block in the beacon chain during
 // the Deneb fork.
 //
-//go:generate go run github.com/ferranbt/fastssz/sszgen --path block.go -objs BeaconBlockDeneb -include ../../../primitives/pkg/common,../../../primitives/pkg/crypto,../../../primitives/pkg/math,..,./header.go,./withdrawal_credentials.go,../../../engine-primitives/pkg/engine-primitives/withdrawal.go,./deposit.go,./payload.go,./deposit.go,../../../primitives/pkg/eip4844,../../../primitives/pkg/bytes,./eth1data.go,../../../primitives/pkg/math,../../../primitives/pkg/common,./body.go,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output block.ssz.go
+//go:generate go run github.com/ferranbt/fastssz/sszgen --path block.go -objs BeaconBlockDeneb,BeaconBlockElectra -include ../../../primitives/pkg/common,../../../primitives/pkg/crypto,../../../primitives/pkg/math,..,./header.go,./withdrawal_credentials.go,../../../engine-primitives/pkg/engine-primitives/withdrawal.go,./deposit.go,./payload.go,./deposit.go,../../../primitives/pkg/eip4844,../../../primitives/pkg/bytes,./eth1data.go,../../../primitives/pkg/math,../../../primitives/pkg/common,./body.go,./proposer_slashing.go,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output block.ssz.go
 type BeaconBlockDeneb struct {
 	// BeaconBlockHeaderBase is the base of the BeaconBlockDeneb.
 	BeaconBlockHeaderBase
@@ -151,3 +137,51 @@ func (b BeaconBlockDeneb) GetHeader() *BeaconBlockHeader {
 		BodyRoot: bodyRoot,
 	}
 }
+
+// BeaconBlockElectra represents a block in the beacon chain during the
+// Electra fork.
+type BeaconBlockElectra struct {
+	// BeaconBlockHeaderBase is the base of the BeaconBlockElectra.
+	BeaconBlockHeaderBase
+	// Body is the body of the BeaconBlockElectra, containing the block's
+	// operations.
+	Body *BeaconBlockBodyElectra
+}
+
+// Version identifies the version of the BeaconBlockElectra.
+func (b *BeaconBlockElectra) Version() uint32 {
+	return version.Electra
+}
+
+// IsNil checks if the BeaconBlockElectra instance is nil.
+func (b *BeaconBlockElectra) IsNil() bool {
+	return b == nil
+}
+
+// SetStateRoot sets the state root of the BeaconBlockElectra.
+func (b *BeaconBlockElectra) SetStateRoot(root common.Root) {
+	b.StateRoot = root
+}
+
+// GetBody retrieves the body of the BeaconBlockElectra.
+func (b *BeaconBlockElectra) GetBody() *BeaconBlockBody {
+	return &BeaconBlockBody{RawBeaconBlockBody: b.Body}
+}
+
+// GetHeader builds a BeaconBlockHeader from the BeaconBlockElectra.
+func (b BeaconBlockElectra) GetHeader() *BeaconBlockHeader {
+	bodyRoot, err := b.GetBody().HashTreeRoot()
+	if err != nil {
+		return nil
+	}
+
+	return &BeaconBlockHeader{
+		BeaconBlockHeaderBase: BeaconBlockHeaderBase{
+			Slot:            b.Slot,
+			ProposerIndex:   b.ProposerIndex,
+			ParentBlockRoot: b.ParentBlockRoot,
+			StateRoot:       b.StateRoot,
+		},
+		BodyRoot: bodyRoot,
+	}
+}
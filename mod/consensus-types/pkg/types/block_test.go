@@ -94,6 +94,38 @@ func TestBeaconBlockFromSSZ(t *testing.T) {
 	require.Equal(t, originalBlock, block)
 }
 
+func TestBeaconBlockElectraFromSSZ(t *testing.T) {
+	deneb := generateValidBeaconBlockDeneb()
+	deneb.Body.Deposits = []*types.Deposit{}
+	originalBlock := &types.BeaconBlockElectra{
+		BeaconBlockHeaderBase: deneb.BeaconBlockHeaderBase,
+		Body: &types.BeaconBlockBodyElectra{
+			BeaconBlockBodyBase: deneb.Body.BeaconBlockBodyBase,
+			ProposerSlashings: []*types.ProposerSlashing{
+				generateProposerSlashing(),
+			},
+			ExecutionPayload:   deneb.Body.ExecutionPayload,
+			BlobKzgCommitments: deneb.Body.BlobKzgCommitments,
+		},
+	}
+	require.Equal(t, version.Electra, originalBlock.Version())
+
+	sszBlock, err := originalBlock.MarshalSSZ()
+	require.NoError(t, err)
+
+	wrappedBlock, err := (&types.BeaconBlock{}).NewFromSSZ(
+		sszBlock, version.Electra,
+	)
+	require.NoError(t, err)
+	block, ok := wrappedBlock.RawBeaconBlock.(*types.BeaconBlockElectra)
+	require.True(t, ok)
+	require.Equal(t, originalBlock, block)
+
+	// The Deneb encoding has no proposer slashings.
+	_, err = (&types.BeaconBlock{}).NewFromSSZ(sszBlock, version.Deneb)
+	require.Error(t, err)
+}
+
 func TestBeaconBlockFromSSZForkVersionNotSupported(t *testing.T) {
 	wrappedBlock := &types.BeaconBlock{}
 	_, err := wrappedBlock.NewFromSSZ([]byte{}, 1)
@@ -33,7 +33,6 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 const (
@@ -47,6 +46,18 @@ const (
 	// KZGMerkleIndexDeneb is the merkle index of BlobKzgCommitments' root
 	// in the merkle tree built from the block body.
 	KZGMerkleIndexDeneb = 26
+
+	// BodyLengthElectra is the number of fields in the
+	// BeaconBlockBodyElectra struct.
+	BodyLengthElectra uint64 = 7
+
+	// KZGPositionElectra is the position of BlobKzgCommitments in the
+	// block body.
+	KZGPositionElectra = BodyLengthElectra - 1
+
+	// KZGMerkleIndexElectra is the merkle index of BlobKzgCommitments' root
+	// in the merkle tree built from the block body.
+	KZGMerkleIndexElectra = 28
 )
 
 type BeaconBlockBody struct {
@@ -55,19 +66,8 @@ type BeaconBlockBody struct {
 
 // RawBeaconBlockBody is an interface for the different beacon block body.
 func (b *BeaconBlockBody) Empty(forkVersion uint32) *BeaconBlockBody {
-	switch forkVersion {
-	case version.Deneb:
-		return &BeaconBlockBody{RawBeaconBlockBody: &BeaconBlockBodyDeneb{
-			BeaconBlockBodyBase: BeaconBlockBodyBase{},
-			ExecutionPayload: &ExecutableDataDeneb{
-				//nolint:mnd // todo fix.
-				LogsBloom: make([]byte, 256),
-				//nolint:mnd // todo fix.
-				ExtraData: make([]byte, 32),
-			},
-		}}
-	default:
-		panic("unsupported fork version")
+	return &BeaconBlockBody{
+		RawBeaconBlockBody: mustTypesOf(forkVersion).NewBeaconBlockBody(),
 	}
 }
 
@@ -78,12 +78,8 @@ func BlockBodyKZGOffset(
 	slot math.Slot,
 	cs common.ChainSpec,
 ) uint64 {
-	switch cs.ActiveForkVersionForSlot(slot) {
-	case version.Deneb:
-		return KZGMerkleIndexDeneb * cs.MaxBlobCommitmentsPerBlock()
-	default:
-		panic("unsupported fork version")
-	}
+	return mustTypesOf(cs.ActiveForkVersionForSlot(slot)).KZGMerkleIndex *
+		cs.MaxBlobCommitmentsPerBlock()
 }
 
 // BeaconBlockBodyBase represents the base body of a beacon block that is
@@ -96,7 +92,7 @@ type BeaconBlockBodyBase struct {
 	// Graffiti is for a fun message or meme.
 	Graffiti [32]byte `ssz-size:"32"`
 	// Deposits is the list of deposits included in the body.
-	Deposits []*Deposit `              ssz-max:"16"`
+	Deposits []*Deposit `ssz-max:"16"`
 }
 
 // GetRandaoReveal returns the RandaoReveal of the Body.
@@ -114,9 +110,8 @@ func (b *BeaconBlockBodyBase) GetEth1Data() *Eth1Data {
 	return b.Eth1Data
 }
 
-// SetBlobKzgCommitments sets the BlobKzgCommitments of the
-// BeaconBlockBodyDeneb.
-func (b *BeaconBlockBodyDeneb) SetEth1Data(eth1Data *Eth1Data) {
+// SetEth1Data sets the Eth1Data of the Body.
+func (b *BeaconBlockBodyBase) SetEth1Data(eth1Data *Eth1Data) {
 	b.Eth1Data = eth1Data
 }
 
@@ -138,7 +133,7 @@ func (b *BeaconBlockBodyBase) SetDeposits(deposits []*Deposit) {
 // BeaconBlockBodyDeneb represents the body of a beacon block in the Deneb
 // chain.
 //
-//go:generate go run github.com/ferranbt/fastssz/sszgen --path ./body.go -objs BeaconBlockBodyDeneb -include ../../../primitives/pkg/crypto,./payload.go,../../../primitives/pkg/eip4844,../../../primitives/pkg/bytes,./eth1data.go,../../../primitives/pkg/math,../../../primitives/pkg/common,./deposit.go,../../../engine-primitives/pkg/engine-primitives/withdrawal.go,./withdrawal_credentials.go,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output body.ssz.go
+//go:generate go run github.com/ferranbt/fastssz/sszgen --path ./body.go -objs BeaconBlockBodyDeneb,BeaconBlockBodyElectra -include ../../../primitives/pkg/crypto,./payload.go,../../../primitives/pkg/eip4844,../../../primitives/pkg/bytes,./eth1data.go,../../../primitives/pkg/math,../../../primitives/pkg/common,./deposit.go,./proposer_slashing.go,./header.go,../../../engine-primitives/pkg/engine-primitives/withdrawal.go,./withdrawal_credentials.go,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output body.ssz.go
 type BeaconBlockBodyDeneb struct {
 	BeaconBlockBodyBase
 	// ExecutionPayload is the execution payload of the body.
@@ -152,6 +147,25 @@ func (b *BeaconBlockBodyDeneb) IsNil() bool {
 	return b == nil
 }
 
+// GetProposerSlashings returns nil, proposer slashings are only included
+// in the bodies from Electra on.
+func (b *BeaconBlockBodyDeneb) GetProposerSlashings() []*ProposerSlashing {
+	return nil
+}
+
+// SetProposerSlashings returns ErrForkVersionNotSupported if proposer
+// slashings are set, they cannot be included in a Deneb body.
+func (b *BeaconBlockBodyDeneb) SetProposerSlashings(
+	proposerSlashings []*ProposerSlashing,
+) error {
+	if len(proposerSlashings) > 0 {
+		return errors.Wrap(
+			ErrForkVersionNotSupported, "proposer slashings in deneb body",
+		)
+	}
+	return nil
+}
+
 // GetExecutionPayload returns the ExecutionPayload of the Body.
 func (
 	b *BeaconBlockBodyDeneb,
@@ -222,3 +236,114 @@ func (b *BeaconBlockBodyDeneb) GetTopLevelRoots() ([][32]byte, error) {
 func (b *BeaconBlockBodyDeneb) Length() uint64 {
 	return BodyLengthDeneb
 }
+
+// BeaconBlockBodyElectra represents the body of a beacon block in the
+// Electra chain. It is the body of Deneb with the proposer slashings, the
+// execution payload is that of Deneb.
+type BeaconBlockBodyElectra struct {
+	BeaconBlockBodyBase
+	// ProposerSlashings is the list of proposer slashings included in the
+	// body.
+	ProposerSlashings []*ProposerSlashing `ssz-max:"16"`
+	// ExecutionPayload is the execution payload of the body.
+	ExecutionPayload *ExecutableDataDeneb
+	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
+	BlobKzgCommitments []eip4844.KZGCommitment `ssz-size:"?,48" ssz-max:"16"`
+}
+
+// IsNil checks if the BeaconBlockBodyElectra is nil.
+func (b *BeaconBlockBodyElectra) IsNil() bool {
+	return b == nil
+}
+
+// GetProposerSlashings returns the ProposerSlashings of the
+// BeaconBlockBodyElectra.
+func (b *BeaconBlockBodyElectra) GetProposerSlashings() []*ProposerSlashing {
+	return b.ProposerSlashings
+}
+
+// SetProposerSlashings sets the ProposerSlashings of the
+// BeaconBlockBodyElectra.
+func (b *BeaconBlockBodyElectra) SetProposerSlashings(
+	proposerSlashings []*ProposerSlashing,
+) error {
+	b.ProposerSlashings = proposerSlashings
+	return nil
+}
+
+// GetExecutionPayload returns the ExecutionPayload of the Body.
+func (
+	b *BeaconBlockBodyElectra,
+) GetExecutionPayload() *ExecutionPayload {
+	return &ExecutionPayload{InnerExecutionPayload: b.ExecutionPayload}
+}
+
+// SetExecutionData sets the ExecutionData of the BeaconBlockBodyElectra.
+func (b *BeaconBlockBodyElectra) SetExecutionData(
+	executionData *ExecutionPayload,
+) error {
+	var ok bool
+	b.ExecutionPayload, ok = executionData.
+		InnerExecutionPayload.(*ExecutableDataDeneb)
+	if !ok {
+		return errors.New("invalid execution data type")
+	}
+	return nil
+}
+
+// GetBlobKzgCommitments returns the BlobKzgCommitments of the Body.
+func (
+	b *BeaconBlockBodyElectra,
+) GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash] {
+	return b.BlobKzgCommitments
+}
+
+// SetBlobKzgCommitments sets the BlobKzgCommitments of the
+// BeaconBlockBodyElectra.
+func (b *BeaconBlockBodyElectra) SetBlobKzgCommitments(
+	commitments eip4844.KZGCommitments[common.ExecutionHash],
+) {
+	b.BlobKzgCommitments = commitments
+}
+
+// GetTopLevelRoots returns the top-level roots of the
+// BeaconBlockBodyElectra.
+func (b *BeaconBlockBodyElectra) GetTopLevelRoots() ([][32]byte, error) {
+	layer := make([][32]byte, BodyLengthElectra)
+	var err error
+	randao := b.GetRandaoReveal()
+	layer[0], err = ssz.MerkleizeByteSlice[math.U64, [32]byte](randao[:])
+	if err != nil {
+		return nil, err
+	}
+
+	layer[1], err = b.Eth1Data.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	layer[2] = b.GetGraffiti()
+
+	layer[3], err = Deposits(b.GetDeposits()).HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	layer[4], err = ProposerSlashings(b.GetProposerSlashings()).HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	layer[5], err = b.GetExecutionPayload().HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	// KZG commitments is not needed
+	return layer, nil
+}
+
+// Length returns the number of fields in the BeaconBlockBodyElectra struct.
+func (b *BeaconBlockBodyElectra) Length() uint64 {
+	return BodyLengthElectra
+}
@@ -133,3 +133,50 @@ func TestBeaconBlockBodyDeneb_GetTopLevelRoots(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, roots)
 }
+
+func TestBeaconBlockBodyDeneb_SetProposerSlashings(t *testing.T) {
+	body := types.BeaconBlockBodyDeneb{}
+	require.NoError(t, body.SetProposerSlashings(nil))
+	require.ErrorIs(t,
+		body.SetProposerSlashings([]*types.ProposerSlashing{
+			generateProposerSlashing(),
+		}),
+		types.ErrForkVersionNotSupported,
+	)
+	require.Nil(t, body.GetProposerSlashings())
+}
+
+func TestBeaconBlockBodyElectra_GetTopLevelRoots(t *testing.T) {
+	deneb := generateBeaconBlockBodyDeneb()
+	body := types.BeaconBlockBodyElectra{
+		BeaconBlockBodyBase: deneb.BeaconBlockBodyBase,
+		ProposerSlashings: []*types.ProposerSlashing{
+			generateProposerSlashing(),
+		},
+		ExecutionPayload:   deneb.ExecutionPayload,
+		BlobKzgCommitments: []eip4844.KZGCommitment{},
+	}
+	require.NoError(t, body.SetProposerSlashings(body.ProposerSlashings))
+	require.Len(t, body.GetProposerSlashings(), 1)
+
+	// The top level roots are the leaves of the tree of the body, the KZG
+	// commitments aside.
+	roots, err := body.GetTopLevelRoots()
+	require.NoError(t, err)
+	require.Len(t, roots, int(body.Length()))
+	tree, err := body.GetTree()
+	require.NoError(t, err)
+	for i := range types.KZGPositionElectra {
+		//#nosec:G115 // the body has a few fields.
+		leaf, err := tree.Get(int(8 + i))
+		require.NoError(t, err)
+		require.Equal(t, leaf.Hash(), roots[i][:], "field %d", i)
+	}
+
+	// The root differs from that of the Deneb body of the same fields.
+	root, err := body.HashTreeRoot()
+	require.NoError(t, err)
+	denebRoot, err := deneb.HashTreeRoot()
+	require.NoError(t, err)
+	require.NotEqual(t, denebRoot, root)
+}
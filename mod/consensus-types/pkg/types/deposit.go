@@ -21,6 +21,7 @@
 package types
 
 import (
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
@@ -106,6 +107,49 @@ func (d *Deposit) VerifySignature(
 	)
 }
 
+// VerifySignatures verifies the signatures of all the deposits with a single
+// call to batchVerificationFn, which reports the index of the first invalid
+// signature.
+func (d Deposits) VerifySignatures(
+	forkData *ForkData,
+	domainType common.DomainType,
+	batchVerificationFn func(
+		pubkeys [][48]byte, msgs [][]byte, sigs [][96]byte,
+	) error,
+) error {
+	if len(d) == 0 {
+		return nil
+	}
+
+	domain, err := forkData.ComputeDomain(domainType)
+	if err != nil {
+		return err
+	}
+
+	pubkeys := make([][48]byte, len(d))
+	msgs := make([][]byte, len(d))
+	sigs := make([][96]byte, len(d))
+	for i, deposit := range d {
+		var signingRoot common.Root
+		signingRoot, err = ssz.ComputeSigningRoot(&DepositMessage{
+			Pubkey:      deposit.Pubkey,
+			Credentials: deposit.Credentials,
+			Amount:      deposit.Amount,
+		}, domain)
+		if err != nil {
+			return err
+		}
+		pubkeys[i] = deposit.Pubkey
+		msgs[i] = signingRoot[:]
+		sigs[i] = deposit.Signature
+	}
+
+	if err = batchVerificationFn(pubkeys, msgs, sigs); err != nil {
+		return errors.Join(err, ErrDepositMessage)
+	}
+	return nil
+}
+
 // GetAmount returns the deposit amount in gwei.
 func (d *Deposit) GetAmount() math.Gwei {
 	return d.Amount
@@ -52,23 +52,20 @@ func CreateAndSignDepositMessage(
 	credentials WithdrawalCredentials,
 	amount math.Gwei,
 ) (*DepositMessage, crypto.BLSSignature, error) {
-	domain, err := forkData.ComputeDomain(domainType)
+	pubkey, err := signer.PublicKey()
 	if err != nil {
 		return nil, crypto.BLSSignature{}, err
 	}
 
 	depositMessage := &DepositMessage{
-		Pubkey:      signer.PublicKey(),
+		Pubkey:      pubkey,
 		Credentials: credentials,
 		Amount:      amount,
 	}
 
-	signingRoot, err := ssz.ComputeSigningRoot(depositMessage, domain)
-	if err != nil {
-		return nil, crypto.BLSSignature{}, err
-	}
-
-	signature, err := signer.Sign(signingRoot[:])
+	signature, _, err := SignWithForkData(
+		signer, depositMessage, forkData, domainType,
+	)
 	if err != nil {
 		return nil, crypto.BLSSignature{}, err
 	}
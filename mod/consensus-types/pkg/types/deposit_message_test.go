@@ -45,7 +45,7 @@ func TestCreateAndSignDepositMessage(t *testing.T) {
 	}
 
 	mocksSigner := &mocks.BLSSigner{}
-	mocksSigner.On("PublicKey").Return(crypto.BLSPubkey{})
+	mocksSigner.On("PublicKey").Return(crypto.BLSPubkey{}, nil)
 	mocksSigner.On("Sign", mock.Anything).Return(crypto.BLSSignature{}, nil)
 
 	credentials := types.WithdrawalCredentials{}
@@ -60,6 +60,21 @@ func TestCreateAndSignDepositMessage(t *testing.T) {
 	require.NotNil(t, signature)
 }
 
+func TestCreateAndSignDepositMessage_PublicKeyError(t *testing.T) {
+	errPubkey := errors.New("pubkey unavailable")
+	mocksSigner := mocks.NewBLSSigner(t)
+	mocksSigner.EXPECT().PublicKey().Return(crypto.BLSPubkey{}, errPubkey)
+
+	_, _, err := types.CreateAndSignDepositMessage(
+		&types.ForkData{},
+		common.DomainType{0x01, 0x00, 0x00, 0x00},
+		mocksSigner,
+		types.WithdrawalCredentials{},
+		math.Gwei(32),
+	)
+	require.ErrorIs(t, err, errPubkey)
+}
+
 func TestNewDepositMessage(t *testing.T) {
 	pubKey := crypto.BLSPubkey{}
 	credentials := types.WithdrawalCredentials{}
@@ -141,6 +141,53 @@ func TestDeposit_VerifySignature(t *testing.T) {
 	require.NoError(t, errVerify)
 }
 
+func TestDeposits_VerifySignatures(t *testing.T) {
+	forkData := &types.ForkData{
+		CurrentVersion:        common.Version{0x00, 0x00, 0x00, 0x04},
+		GenesisValidatorsRoot: common.Root{0x00, 0x00, 0x00, 0x00},
+	}
+	domainType := common.DomainType{0x01, 0x00, 0x00, 0x00}
+	deposits := types.Deposits{
+		generateValidDeposit(),
+		types.NewDeposit(
+			crypto.BLSPubkey{0x01}, types.WithdrawalCredentials{0x02},
+			math.Gwei(64), crypto.BLSSignature{0x03}, 2,
+		),
+	}
+
+	// The batch must hold the same messages as the single verifications.
+	var msgs [][]byte
+	for _, deposit := range deposits {
+		require.NoError(t, deposit.VerifySignature(
+			forkData, domainType,
+			func(_ crypto.BLSPubkey, msg []byte, _ crypto.BLSSignature) error {
+				msgs = append(msgs, msg)
+				return nil
+			},
+		))
+	}
+
+	err := deposits.VerifySignatures(forkData, domainType, func(
+		pubkeys [][48]byte, batchMsgs [][]byte, sigs [][96]byte,
+	) error {
+		require.Equal(t, msgs, batchMsgs)
+		for i, deposit := range deposits {
+			require.Equal(t, [48]byte(deposit.Pubkey), pubkeys[i])
+			require.Equal(t, [96]byte(deposit.Signature), sigs[i])
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	err = deposits.VerifySignatures(forkData, domainType, func(
+		[][48]byte, [][]byte, [][96]byte,
+	) error {
+		return crypto.ErrInvalidSignature
+	})
+	require.ErrorIs(t, err, crypto.ErrInvalidSignature)
+	require.ErrorIs(t, err, types.ErrDepositMessage)
+}
+
 func TestDeposit_Getters(t *testing.T) {
 	deposit := generateValidDeposit()
 
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// ENRForkID as defined in the Ethereum 2.0 networking specification, the
+// fork of a node and the next fork it is ready for:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/p2p-interface.md#eth2-field
+//
+//go:generate go run github.com/ferranbt/fastssz/sszgen -path enr_fork_id.go -objs ENRForkID -include ../../../primitives/pkg/bytes,../../../primitives/pkg/math,../../../primitives/pkg/common -output enr_fork_id.ssz.go
+//nolint:lll
+type ENRForkID struct {
+	// ForkDigest is the digest of the current fork.
+	ForkDigest common.ForkDigest `json:"fork_digest"       ssz-size:"4"`
+	// NextForkVersion is the version of the next fork, the current one if
+	// no fork is scheduled.
+	NextForkVersion common.Version `json:"next_fork_version" ssz-size:"4"`
+	// NextForkEpoch is the epoch of the next fork, the far future epoch if
+	// no fork is scheduled.
+	NextForkEpoch math.Epoch `json:"next_fork_epoch"`
+}
+
+// NewENRForkID returns the ENRForkID at epoch of the chain of chainSpec,
+// whose genesis validators root is genesisValidatorsRoot.
+func NewENRForkID(
+	chainSpec common.ChainSpec,
+	epoch math.Epoch,
+	genesisValidatorsRoot common.Root,
+) (*ENRForkID, error) {
+	schedule := chainSpec.ForkSchedule()
+	currentVersion := version.FromUint32[common.Version](
+		schedule.ForkAtEpoch(epoch).Version,
+	)
+	digest, err := NewForkData(
+		currentVersion, genesisValidatorsRoot,
+	).ComputeForkDigest()
+	if err != nil {
+		return nil, err
+	}
+
+	id := &ENRForkID{
+		ForkDigest:      digest,
+		NextForkVersion: currentVersion,
+		NextForkEpoch:   math.Epoch(constants.FarFutureEpoch),
+	}
+	if next, ok := schedule.NextFork(epoch); ok {
+		id.NextForkVersion = version.FromUint32[common.Version](next.Version)
+		id.NextForkEpoch = next.Epoch
+	}
+	return id, nil
+}
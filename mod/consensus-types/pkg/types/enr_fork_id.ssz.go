@@ -0,0 +1,83 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: df04aa2e379e260174d49e5cb5680438508ab4a5ba680703ade89da7130588db
+// Version: 0.1.3
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the ENRForkID object
+func (e *ENRForkID) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
+
+// MarshalSSZTo ssz marshals the ENRForkID object to a target array
+func (e *ENRForkID) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'ForkDigest'
+	dst = append(dst, e.ForkDigest[:]...)
+
+	// Field (1) 'NextForkVersion'
+	dst = append(dst, e.NextForkVersion[:]...)
+
+	// Field (2) 'NextForkEpoch'
+	dst = ssz.MarshalUint64(dst, uint64(e.NextForkEpoch))
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ENRForkID object
+func (e *ENRForkID) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'ForkDigest'
+	copy(e.ForkDigest[:], buf[0:4])
+
+	// Field (1) 'NextForkVersion'
+	copy(e.NextForkVersion[:], buf[4:8])
+
+	// Field (2) 'NextForkEpoch'
+	e.NextForkEpoch = math.Epoch(ssz.UnmarshallUint64(buf[8:16]))
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ENRForkID object
+func (e *ENRForkID) SizeSSZ() (size int) {
+	size = 16
+	return
+}
+
+// HashTreeRoot ssz hashes the ENRForkID object
+func (e *ENRForkID) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
+}
+
+// HashTreeRootWith ssz hashes the ENRForkID object with a hasher
+func (e *ENRForkID) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ForkDigest'
+	hh.PutBytes(e.ForkDigest[:])
+
+	// Field (1) 'NextForkVersion'
+	hh.PutBytes(e.NextForkVersion[:])
+
+	// Field (2) 'NextForkEpoch'
+	hh.PutUint64(uint64(e.NextForkEpoch))
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ENRForkID object
+func (e *ENRForkID) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(e)
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewENRForkID(t *testing.T) {
+	genesisValidatorsRoot := common.Root{0x01}
+	denebVersion := version.FromUint32[common.Version](version.Deneb)
+	electraVersion := version.FromUint32[common.Version](version.Electra)
+	denebDigest, err := types.NewForkData(
+		denebVersion, genesisValidatorsRoot,
+	).ComputeForkDigest()
+	require.NoError(t, err)
+	electraDigest, err := types.NewForkData(
+		electraVersion, genesisValidatorsRoot,
+	).ComputeForkDigest()
+	require.NoError(t, err)
+
+	cs := chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+	]{
+		ForkSchedule: chain.ForkSchedule[math.Epoch]{
+			{Name: "deneb", Version: version.Deneb, Epoch: 0},
+			{Name: "electra", Version: version.Electra, Epoch: 10},
+		},
+	})
+
+	tests := []struct {
+		name  string
+		epoch math.Epoch
+		want  *types.ENRForkID
+	}{
+		{
+			name:  "before next fork",
+			epoch: 9,
+			want: &types.ENRForkID{
+				ForkDigest:      denebDigest,
+				NextForkVersion: electraVersion,
+				NextForkEpoch:   10,
+			},
+		},
+		{
+			name:  "at last fork",
+			epoch: 10,
+			want: &types.ENRForkID{
+				ForkDigest:      electraDigest,
+				NextForkVersion: electraVersion,
+				NextForkEpoch:   math.Epoch(constants.FarFutureEpoch),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := types.NewENRForkID(cs, tt.epoch, genesisValidatorsRoot)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, id)
+		})
+	}
+}
+
+func TestENRForkID_Serialization(t *testing.T) {
+	original := &types.ENRForkID{
+		ForkDigest:      common.ForkDigest{0x6a, 0x95, 0xa1, 0xa9},
+		NextForkVersion: common.Version{0x05, 0x00, 0x00, 0x00},
+		NextForkEpoch:   math.Epoch(constants.FarFutureEpoch),
+	}
+	require.Equal(t, 16, original.SizeSSZ())
+
+	data, err := original.MarshalSSZ()
+	require.NoError(t, err)
+	var unmarshalled types.ENRForkID
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, original, &unmarshalled)
+
+	data, err = json.Marshal(original)
+	require.NoError(t, err)
+	unmarshalled = types.ENRForkID{}
+	require.NoError(t, json.Unmarshal(data, &unmarshalled))
+	require.Equal(t, original, &unmarshalled)
+}
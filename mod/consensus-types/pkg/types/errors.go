@@ -20,7 +20,10 @@
 
 package types
 
-import "github.com/berachain/beacon-kit/mod/errors"
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
 
 var (
 	// ErrDepositMessage is an error for when the deposit signature doesn't
@@ -33,6 +36,10 @@ var (
 	)
 
 	// ErrForkVersionNotSupported is an error for when the fork
-	// version is not supported.
-	ErrForkVersionNotSupported = errors.New("fork version not supported")
+	// version is not supported. It matches any version.ErrForkNotSupported.
+	ErrForkVersionNotSupported error = version.ErrForkNotSupported{}
+
+	// ErrUnknownFields is an error for when a JSON object decoded strictly
+	// has keys that are not fields of its type.
+	ErrUnknownFields = errors.New("unknown JSON fields")
 )
@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/stretchr/testify/require"
 )
 
@@ -124,3 +125,31 @@ func TestNew(t *testing.T) {
 	require.Equal(t, currentVersion, newForkData.CurrentVersion)
 	require.Equal(t, genesisValidatorsRoot, newForkData.GenesisValidatorsRoot)
 }
+
+func TestForkData_ComputeForkDigest(t *testing.T) {
+	// Mainnet genesis validators root and fork digests.
+	genesisValidatorsRoot := common.Root(common.HexToHash(
+		"0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95",
+	))
+	tests := []struct {
+		name    string
+		version uint32
+		digest  common.ForkDigest
+	}{
+		{"phase0", version.Phase0, common.ForkDigest{0xb5, 0x30, 0x3f, 0x2a}},
+		{"altair", version.Altair, common.ForkDigest{0xaf, 0xca, 0xab, 0xa0}},
+		{"bellatrix", version.Bellatrix, common.ForkDigest{0x4a, 0x26, 0xc5, 0x8b}},
+		{"capella", version.Capella, common.ForkDigest{0xbb, 0xa4, 0xda, 0x96}},
+		{"deneb", version.Deneb, common.ForkDigest{0x6a, 0x95, 0xa1, 0xa9}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			digest, err := types.NewForkData(
+				version.FromUint32[common.Version](tt.version),
+				genesisValidatorsRoot,
+			).ComputeForkDigest()
+			require.NoError(t, err)
+			require.Equal(t, tt.digest, digest)
+		})
+	}
+}
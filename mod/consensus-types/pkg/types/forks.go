@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// ForkTypes are the constructors of the consensus types of a fork. The
+// types of a fork are created by version once registered with
+// RegisterForkTypes.
+type ForkTypes struct {
+	// NewBeaconBlock returns a beacon block of the fork with the header
+	// base and an empty body.
+	NewBeaconBlock func(
+		base BeaconBlockHeaderBase,
+	) RawBeaconBlock[*BeaconBlockBody]
+	// NewBeaconBlockBody returns an empty beacon block body of the fork.
+	NewBeaconBlockBody func() RawBeaconBlockBody
+	// NewExecutionPayload returns an empty execution payload of the fork.
+	NewExecutionPayload func() InnerExecutionPayload
+	// NewExecutionPayloadHeader returns an empty execution payload header of
+	// the fork, that of its genesis.
+	NewExecutionPayloadHeader func() InnerExecutionPayloadHeader
+	// ExecutionPayloadHeaderOf returns the header of an execution payload of
+	// the fork, given the roots of its transactions and withdrawals.
+	ExecutionPayloadHeaderOf func(
+		payload InnerExecutionPayload,
+		txsRoot, withdrawalsRoot primitives.Root,
+	) InnerExecutionPayloadHeader
+	// KZGMerkleIndex is the merkle index of the root of the KZG commitments
+	// in the merkle tree of the beacon block body of the fork.
+	KZGMerkleIndex uint64
+}
+
+// forkTypes holds the consensus types of the forks, those of Deneb and
+// Electra being registered by default.
+//
+//nolint:gochecknoglobals // forks register their types at init.
+var forkTypes = func() *version.Registry[ForkTypes] {
+	r := version.NewRegistry[ForkTypes]("consensus types")
+	r.MustRegister(version.Deneb, denebTypes())
+	r.MustRegister(version.Electra, electraTypes())
+	return r
+}()
+
+// RegisterForkTypes registers the consensus types of the fork of
+// forkVersion.
+func RegisterForkTypes(forkVersion uint32, types ForkTypes) error {
+	return forkTypes.Register(forkVersion, types)
+}
+
+// typesOf returns the consensus types of the fork of forkVersion, or
+// version.ErrForkNotSupported if they are not registered.
+func typesOf(forkVersion uint32) (ForkTypes, error) {
+	return forkTypes.Get(forkVersion)
+}
+
+// mustTypesOf returns the consensus types of the fork of forkVersion, and
+// panics if they are not registered.
+func mustTypesOf(forkVersion uint32) ForkTypes {
+	types, err := typesOf(forkVersion)
+	if err != nil {
+		panic(err)
+	}
+	return types
+}
+
+// denebTypes returns the consensus types of Deneb.
+func denebTypes() ForkTypes {
+	return ForkTypes{
+		NewBeaconBlock: func(
+			base BeaconBlockHeaderBase,
+		) RawBeaconBlock[*BeaconBlockBody] {
+			return &BeaconBlockDeneb{
+				BeaconBlockHeaderBase: base,
+				Body:                  &BeaconBlockBodyDeneb{},
+			}
+		},
+		NewBeaconBlockBody: func() RawBeaconBlockBody {
+			return &BeaconBlockBodyDeneb{
+				BeaconBlockBodyBase: BeaconBlockBodyBase{},
+				ExecutionPayload: &ExecutableDataDeneb{
+					//nolint:mnd // todo fix.
+					LogsBloom: make([]byte, 256),
+					//nolint:mnd // todo fix.
+					ExtraData: make([]byte, 32),
+				},
+			}
+		},
+		NewExecutionPayload: func() InnerExecutionPayload {
+			return &ExecutableDataDeneb{}
+		},
+		NewExecutionPayloadHeader: func() InnerExecutionPayloadHeader {
+			return &ExecutionPayloadHeaderDeneb{}
+		},
+		ExecutionPayloadHeaderOf: func(
+			e InnerExecutionPayload,
+			txsRoot, withdrawalsRoot primitives.Root,
+		) InnerExecutionPayloadHeader {
+			return &ExecutionPayloadHeaderDeneb{
+				ParentHash:       e.GetParentHash(),
+				FeeRecipient:     e.GetFeeRecipient(),
+				StateRoot:        e.GetStateRoot(),
+				ReceiptsRoot:     e.GetReceiptsRoot(),
+				LogsBloom:        e.GetLogsBloom(),
+				Random:           e.GetPrevRandao(),
+				Number:           e.GetNumber(),
+				GasLimit:         e.GetGasLimit(),
+				GasUsed:          e.GetGasUsed(),
+				Timestamp:        e.GetTimestamp(),
+				ExtraData:        e.GetExtraData(),
+				BaseFeePerGas:    e.GetBaseFeePerGas(),
+				BlockHash:        e.GetBlockHash(),
+				TransactionsRoot: txsRoot,
+				WithdrawalsRoot:  withdrawalsRoot,
+				BlobGasUsed:      e.GetBlobGasUsed(),
+				ExcessBlobGas:    e.GetExcessBlobGas(),
+			}
+		},
+		KZGMerkleIndex: KZGMerkleIndexDeneb,
+	}
+}
+
+// electraTypes returns the consensus types of Electra, whose block body
+// includes the proposer slashings. The execution payload is that of Deneb.
+func electraTypes() ForkTypes {
+	types := denebTypes()
+	types.NewBeaconBlock = func(
+		base BeaconBlockHeaderBase,
+	) RawBeaconBlock[*BeaconBlockBody] {
+		return &BeaconBlockElectra{
+			BeaconBlockHeaderBase: base,
+			Body:                  &BeaconBlockBodyElectra{},
+		}
+	}
+	types.NewBeaconBlockBody = func() RawBeaconBlockBody {
+		return &BeaconBlockBodyElectra{
+			BeaconBlockBodyBase: BeaconBlockBodyBase{},
+			ExecutionPayload: &ExecutableDataDeneb{
+				//nolint:mnd // todo fix.
+				LogsBloom: make([]byte, 256),
+				//nolint:mnd // todo fix.
+				ExtraData: make([]byte, 32),
+			},
+		}
+	}
+	types.KZGMerkleIndex = KZGMerkleIndexElectra
+	return types
+}
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+// futureFork is the version of a fork not supported by default.
+const futureFork = version.Electra + 1
+
+// The types of the future fork are those of Deneb under another version.
+type (
+	futureBlock   struct{ *types.BeaconBlockDeneb }
+	futurePayload struct{ *types.ExecutableDataDeneb }
+	futureHeader  struct {
+		*types.ExecutionPayloadHeaderDeneb
+	}
+)
+
+func (futureBlock) Version() uint32 { return futureFork }
+
+func (futurePayload) Version() uint32 { return futureFork }
+
+func (futureHeader) Version() uint32 { return futureFork }
+
+//nolint:gochecknoglobals // the types are registered once per process.
+var registerFutureFork sync.Once
+
+// registerFutureTypes registers the types of the future fork.
+func registerFutureTypes(t *testing.T) {
+	t.Helper()
+	registerFutureFork.Do(func() {
+		require.NoError(t, types.RegisterForkTypes(futureFork, types.ForkTypes{
+			NewBeaconBlock: func(
+				base types.BeaconBlockHeaderBase,
+			) types.RawBeaconBlock[*types.BeaconBlockBody] {
+				return futureBlock{&types.BeaconBlockDeneb{
+					BeaconBlockHeaderBase: base,
+					Body:                  &types.BeaconBlockBodyDeneb{},
+				}}
+			},
+			NewBeaconBlockBody: func() types.RawBeaconBlockBody {
+				return &types.BeaconBlockBodyDeneb{}
+			},
+			NewExecutionPayload: func() types.InnerExecutionPayload {
+				return futurePayload{&types.ExecutableDataDeneb{}}
+			},
+			NewExecutionPayloadHeader: func() types.InnerExecutionPayloadHeader {
+				return futureHeader{&types.ExecutionPayloadHeaderDeneb{}}
+			},
+			ExecutionPayloadHeaderOf: func(
+				payload types.InnerExecutionPayload,
+				txsRoot, withdrawalsRoot primitives.Root,
+			) types.InnerExecutionPayloadHeader {
+				return futureHeader{&types.ExecutionPayloadHeaderDeneb{
+					BlockHash:        payload.GetBlockHash(),
+					TransactionsRoot: txsRoot,
+					WithdrawalsRoot:  withdrawalsRoot,
+				}}
+			},
+			KZGMerkleIndex: types.KZGMerkleIndexDeneb,
+		}))
+	})
+}
+
+func TestRegisterForkTypes(t *testing.T) {
+	registerFutureTypes(t)
+	require.ErrorIs(t,
+		types.RegisterForkTypes(version.Deneb, types.ForkTypes{}),
+		version.ErrForkAlreadyRegistered,
+	)
+
+	blk, err := (&types.BeaconBlock{}).NewWithVersion(
+		math.Slot(1), 0, common.Root{0x01}, futureFork,
+	)
+	require.NoError(t, err)
+	require.Equal(t, futureFork, blk.Version())
+	require.Equal(t, math.Slot(1), blk.GetSlot())
+	require.Equal(t,
+		futureFork, (&types.BeaconBlock{}).Empty(futureFork).Version(),
+	)
+
+	payload := (&types.ExecutionPayload{}).Empty(futureFork)
+	require.Equal(t, futureFork, payload.Version())
+	header, err := (&types.ExecutionPayload{
+		InnerExecutionPayload: futurePayload{&types.ExecutableDataDeneb{
+			BlockHash:    common.ExecutionHash{0x02},
+			LogsBloom:    make([]byte, 256),
+			Transactions: [][]byte{},
+		}},
+	}).ToHeader()
+	require.NoError(t, err)
+	require.Equal(t, futureFork, header.Version())
+	require.Equal(t, common.ExecutionHash{0x02}, header.GetBlockHash())
+	require.Equal(t,
+		futureFork,
+		(&types.ExecutionPayloadHeader{}).Empty(futureFork).Version(),
+	)
+}
+
+func TestForkTypes_NotSupported(t *testing.T) {
+	const unknownFork = futureFork + 1
+	_, err := (&types.BeaconBlock{}).NewWithVersion(
+		math.Slot(1), 0, common.Root{}, unknownFork,
+	)
+	require.Equal(t, version.ErrForkNotSupported{
+		Version:   unknownFork,
+		Subsystem: "consensus types",
+	}, err)
+	require.ErrorIs(t, err, types.ErrForkVersionNotSupported)
+	require.Panics(t, func() {
+		(&types.ExecutionPayload{}).Empty(unknownFork)
+	})
+}
@@ -41,6 +41,7 @@ type RawBeaconBlockBody interface {
 
 // WriteOnlyBeaconBlockBody is the interface for a write-only beacon block body.
 type WriteOnlyBeaconBlockBody interface {
+	SetProposerSlashings([]*ProposerSlashing) error
 	SetDeposits([]*Deposit)
 	SetEth1Data(*Eth1Data)
 	SetExecutionData(*ExecutionPayload) error
@@ -55,6 +56,7 @@ type ReadOnlyBeaconBlockBody interface {
 	IsNil() bool
 
 	// Execution returns the execution data of the block.
+	GetProposerSlashings() []*ProposerSlashing
 	GetDeposits() []*Deposit
 	GetEth1Data() *Eth1Data
 	GetGraffiti() bytes.B32
@@ -22,9 +22,9 @@ package types
 
 import (
 	"context"
+	"encoding/json"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
-	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -48,14 +48,9 @@ type InnerExecutionPayload interface {
 
 // Empty returns an empty ExecutionPayload for the given fork version.
 func (e *ExecutionPayload) Empty(forkVersion uint32) *ExecutionPayload {
-	e = new(ExecutionPayload)
-	switch forkVersion {
-	case version.Deneb:
-		e.InnerExecutionPayload = &ExecutableDataDeneb{}
-	default:
-		panic("unknown fork version")
+	return &ExecutionPayload{
+		InnerExecutionPayload: mustTypesOf(forkVersion).NewExecutionPayload(),
 	}
-	return e
 }
 
 // ToHeader converts the ExecutionPayload to an ExecutionPayloadHeader.
@@ -88,32 +83,15 @@ func (e *ExecutionPayload) ToHeader() (*ExecutionPayloadHeader, error) {
 		return nil, err
 	}
 
-	switch e.Version() {
-	case version.Deneb:
-		return &ExecutionPayloadHeader{
-			InnerExecutionPayloadHeader: &ExecutionPayloadHeaderDeneb{
-				ParentHash:       e.GetParentHash(),
-				FeeRecipient:     e.GetFeeRecipient(),
-				StateRoot:        e.GetStateRoot(),
-				ReceiptsRoot:     e.GetReceiptsRoot(),
-				LogsBloom:        e.GetLogsBloom(),
-				Random:           e.GetPrevRandao(),
-				Number:           e.GetNumber(),
-				GasLimit:         e.GetGasLimit(),
-				GasUsed:          e.GetGasUsed(),
-				Timestamp:        e.GetTimestamp(),
-				ExtraData:        e.GetExtraData(),
-				BaseFeePerGas:    e.GetBaseFeePerGas(),
-				BlockHash:        e.GetBlockHash(),
-				TransactionsRoot: txsRoot,
-				WithdrawalsRoot:  withdrawalsRoot,
-				BlobGasUsed:      e.GetBlobGasUsed(),
-				ExcessBlobGas:    e.GetExcessBlobGas(),
-			},
-		}, nil
-	default:
-		return nil, errors.New("unknown fork version")
+	types, err := typesOf(e.Version())
+	if err != nil {
+		return nil, err
 	}
+	return &ExecutionPayloadHeader{
+		InnerExecutionPayloadHeader: types.ExecutionPayloadHeaderOf(
+			e.InnerExecutionPayload, txsRoot, withdrawalsRoot,
+		),
+	}, nil
 }
 
 // ExecutableDataDeneb is the execution payload for Deneb.
@@ -136,9 +114,9 @@ type ExecutableDataDeneb struct {
 	BaseFeePerGas math.Wei                       `json:"baseFeePerGas" ssz-size:"32"  gencodec:"required"`
 	BlockHash     common.ExecutionHash           `json:"blockHash"     ssz-size:"32"  gencodec:"required"`
 	Transactions  [][]byte                       `json:"transactions"  ssz-size:"?,?" gencodec:"required" ssz-max:"1048576,1073741824"`
-	Withdrawals   []*engineprimitives.Withdrawal `json:"withdrawals"                                      ssz-max:"16"`
-	BlobGasUsed   math.U64                       `json:"blobGasUsed"`
-	ExcessBlobGas math.U64                       `json:"excessBlobGas"`
+	Withdrawals   []*engineprimitives.Withdrawal `json:"withdrawals"                  gencodec:"required" ssz-max:"16"`
+	BlobGasUsed   math.U64                       `json:"blobGasUsed"                  gencodec:"required"`
+	ExcessBlobGas math.U64                       `json:"excessBlobGas"                gencodec:"required"`
 }
 
 // JSON type overrides for ExecutableDataDeneb.
@@ -247,3 +225,49 @@ func (d *ExecutableDataDeneb) GetBlobGasUsed() math.U64 {
 func (d *ExecutableDataDeneb) GetExcessBlobGas() math.U64 {
 	return d.ExcessBlobGas
 }
+
+// forkFieldDefaults are the JSON defaults of the fields of an
+// ExecutableDataDeneb introduced by the forks after Bellatrix, for the
+// blocks that predate them: no withdrawals and no blob gas.
+//
+//nolint:gochecknoglobals // constant.
+var forkFieldDefaults = map[string]json.RawMessage{
+	"withdrawals":   json.RawMessage(`[]`),
+	"blobGasUsed":   json.RawMessage(`"0x0"`),
+	"excessBlobGas": json.RawMessage(`"0x0"`),
+}
+
+// UnmarshalJSONLenient unmarshals the JSON of a payload that may predate
+// the forks of some of its fields, as returned by an execution client
+// outside of the engine API. The fields introduced after Bellatrix are set
+// to their defaults when null or absent, the others are required as by
+// UnmarshalJSON, which must be used for the engine API.
+func (d *ExecutableDataDeneb) UnmarshalJSONLenient(input []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(input, &fields); err != nil {
+		return err
+	}
+	if fields == nil {
+		return d.UnmarshalJSON(input)
+	}
+	for name, value := range forkFieldDefaults {
+		if raw, ok := fields[name]; !ok || string(raw) == "null" {
+			fields[name] = value
+		}
+	}
+	bz, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	return d.UnmarshalJSON(bz)
+}
+
+// UnmarshalJSONStrict unmarshals the JSON of the ExecutableDataDeneb as
+// UnmarshalJSON does, and rejects the keys that are not fields of it, which
+// would otherwise be ignored.
+func (d *ExecutableDataDeneb) UnmarshalJSONStrict(input []byte) error {
+	if err := checkKnownFields(input, d); err != nil {
+		return err
+	}
+	return d.UnmarshalJSON(input)
+}
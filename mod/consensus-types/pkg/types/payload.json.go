@@ -31,9 +31,9 @@ func (e ExecutableDataDeneb) MarshalJSON() ([]byte, error) {
 		BaseFeePerGas math.U256L                     `json:"baseFeePerGas" ssz-size:"32"  gencodec:"required"`
 		BlockHash     common.Hash                    `json:"blockHash"     ssz-size:"32"  gencodec:"required"`
 		Transactions  []bytes.Bytes                  `json:"transactions"  ssz-size:"?,?" gencodec:"required" ssz-max:"1048576,1073741824"`
-		Withdrawals   []*engineprimitives.Withdrawal `json:"withdrawals"                                      ssz-max:"16"`
-		BlobGasUsed   math.U64                       `json:"blobGasUsed"`
-		ExcessBlobGas math.U64                       `json:"excessBlobGas"`
+		Withdrawals   []*engineprimitives.Withdrawal `json:"withdrawals"                  gencodec:"required" ssz-max:"16"`
+		BlobGasUsed   math.U64                       `json:"blobGasUsed"                  gencodec:"required"`
+		ExcessBlobGas math.U64                       `json:"excessBlobGas"                gencodec:"required"`
 	}
 	var enc ExecutableDataDeneb
 	enc.ParentHash = e.ParentHash
@@ -78,9 +78,9 @@ func (e *ExecutableDataDeneb) UnmarshalJSON(input []byte) error {
 		BaseFeePerGas *math.U256L                    `json:"baseFeePerGas" ssz-size:"32"  gencodec:"required"`
 		BlockHash     *common.Hash                   `json:"blockHash"     ssz-size:"32"  gencodec:"required"`
 		Transactions  []bytes.Bytes                  `json:"transactions"  ssz-size:"?,?" gencodec:"required" ssz-max:"1048576,1073741824"`
-		Withdrawals   []*engineprimitives.Withdrawal `json:"withdrawals"                                      ssz-max:"16"`
-		BlobGasUsed   *math.U64                      `json:"blobGasUsed"`
-		ExcessBlobGas *math.U64                      `json:"excessBlobGas"`
+		Withdrawals   []*engineprimitives.Withdrawal `json:"withdrawals"                  gencodec:"required" ssz-max:"16"`
+		BlobGasUsed   *math.U64                      `json:"blobGasUsed"                  gencodec:"required"`
+		ExcessBlobGas *math.U64                      `json:"excessBlobGas"                gencodec:"required"`
 	}
 	var dec ExecutableDataDeneb
 	if err := json.Unmarshal(input, &dec); err != nil {
@@ -145,14 +145,17 @@ func (e *ExecutableDataDeneb) UnmarshalJSON(input []byte) error {
 	for k, v := range dec.Transactions {
 		e.Transactions[k] = v
 	}
-	if dec.Withdrawals != nil {
-		e.Withdrawals = dec.Withdrawals
+	if dec.Withdrawals == nil {
+		return errors.New("missing required field 'withdrawals' for ExecutableDataDeneb")
 	}
-	if dec.BlobGasUsed != nil {
-		e.BlobGasUsed = *dec.BlobGasUsed
+	e.Withdrawals = dec.Withdrawals
+	if dec.BlobGasUsed == nil {
+		return errors.New("missing required field 'blobGasUsed' for ExecutableDataDeneb")
 	}
-	if dec.ExcessBlobGas != nil {
-		e.ExcessBlobGas = *dec.ExcessBlobGas
+	e.BlobGasUsed = *dec.BlobGasUsed
+	if dec.ExcessBlobGas == nil {
+		return errors.New("missing required field 'excessBlobGas' for ExecutableDataDeneb")
 	}
+	e.ExcessBlobGas = *dec.ExcessBlobGas
 	return nil
 }
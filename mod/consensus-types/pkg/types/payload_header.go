@@ -47,16 +47,11 @@ type InnerExecutionPayloadHeader interface {
 func (e *ExecutionPayloadHeader) Empty(
 	forkVersion uint32,
 ) *ExecutionPayloadHeader {
-	e = new(ExecutionPayloadHeader)
-	switch forkVersion {
-	case version.Deneb:
-		e.InnerExecutionPayloadHeader = &ExecutionPayloadHeaderDeneb{}
-	default:
-		panic(
-			"unknown fork version, cannot create empty ExecutionPayloadHeader",
-		)
+	return &ExecutionPayloadHeader{
+		InnerExecutionPayloadHeader: mustTypesOf(
+			forkVersion,
+		).NewExecutionPayloadHeader(),
 	}
-	return e
 }
 
 // NewFromSSZ returns a new ExecutionPayloadHeader from the given SSZ bytes.
@@ -214,3 +209,13 @@ func (d *ExecutionPayloadHeaderDeneb) GetBlobGasUsed() math.U64 {
 func (d *ExecutionPayloadHeaderDeneb) GetExcessBlobGas() math.U64 {
 	return d.ExcessBlobGas
 }
+
+// UnmarshalJSONStrict unmarshals the JSON of the ExecutionPayloadHeaderDeneb
+// as UnmarshalJSON does, and rejects the keys that are not fields of it,
+// which would otherwise be ignored.
+func (d *ExecutionPayloadHeaderDeneb) UnmarshalJSONStrict(input []byte) error {
+	if err := checkKnownFields(input, d); err != nil {
+		return err
+	}
+	return d.UnmarshalJSON(input)
+}
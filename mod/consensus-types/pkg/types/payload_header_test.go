@@ -258,3 +258,27 @@ func TestExecutablePayloadHeaderDeneb_UnmarshalJSON_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestExecutionPayloadHeaderDeneb_UnmarshalJSONStrict(t *testing.T) {
+	original := generateExecutionPayloadHeaderDeneb()
+	original.BlobGasUsed = 0x20000
+	bz, err := original.MarshalJSON()
+	require.NoError(t, err)
+
+	var header types.ExecutionPayloadHeaderDeneb
+	require.NoError(t, header.UnmarshalJSONStrict(bz))
+	require.Equal(t, original, &header)
+
+	// The misspelled key is ignored by the default decoding, leaving the
+	// field to its zero value.
+	bz = renameJSONField(t, bz, "blobGasUsed", "blob_gas_used")
+	header = types.ExecutionPayloadHeaderDeneb{}
+	require.NoError(t, header.UnmarshalJSON(bz))
+	require.Zero(t, header.BlobGasUsed)
+
+	err = new(types.ExecutionPayloadHeaderDeneb).UnmarshalJSONStrict(bz)
+	require.ErrorIs(t, err, types.ErrUnknownFields)
+	require.ErrorContains(
+		t, err, "'blob_gas_used' for ExecutionPayloadHeaderDeneb",
+	)
+}
@@ -22,6 +22,8 @@ package types_test
 
 import (
 	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
@@ -273,6 +275,21 @@ func TestExecutableDataDeneb_UnmarshalJSON_Error(t *testing.T) {
 			removeField:   "transactions",
 			expectedError: "missing required field 'transactions' for ExecutableDataDeneb",
 		},
+		{
+			name:          "missing required field 'withdrawals'",
+			removeField:   "withdrawals",
+			expectedError: "missing required field 'withdrawals' for ExecutableDataDeneb",
+		},
+		{
+			name:          "missing required field 'blobGasUsed'",
+			removeField:   "blobGasUsed",
+			expectedError: "missing required field 'blobGasUsed' for ExecutableDataDeneb",
+		},
+		{
+			name:          "missing required field 'excessBlobGas'",
+			removeField:   "excessBlobGas",
+			expectedError: "missing required field 'excessBlobGas' for ExecutableDataDeneb",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -294,3 +311,99 @@ func TestExecutableDataDeneb_UnmarshalJSON_Error(t *testing.T) {
 		})
 	}
 }
+
+// readGethPayload returns the JSON of an execution payload encoded by geth,
+// from testdata, with the given fields removed.
+func readGethPayload(t *testing.T, name string, remove ...string) []byte {
+	t.Helper()
+	bz, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	if len(remove) == 0 {
+		return bz
+	}
+
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(bz, &fields))
+	for _, field := range remove {
+		delete(fields, field)
+	}
+	bz, err = json.Marshal(fields)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestExecutableDataDeneb_UnmarshalJSONLenient(t *testing.T) {
+	forkFields := []string{"withdrawals", "blobGasUsed", "excessBlobGas"}
+
+	t.Run("Cancun payload", func(t *testing.T) {
+		bz := readGethPayload(t, "geth_payload_cancun.json")
+
+		var strict, lenient types.ExecutableDataDeneb
+		require.NoError(t, strict.UnmarshalJSON(bz))
+		require.NoError(t, lenient.UnmarshalJSONLenient(bz))
+		require.Equal(t, strict, lenient)
+		require.Len(t, lenient.Withdrawals, 1)
+		require.Equal(t, math.U64(41), lenient.Withdrawals[0].Index)
+		require.Equal(t, math.U64(0x20000), lenient.BlobGasUsed)
+	})
+
+	// Geth encodes the fields of the forks a block predates as null, other
+	// execution clients omit them.
+	for name, remove := range map[string][]string{
+		"null fork fields":   nil,
+		"absent fork fields": forkFields,
+	} {
+		t.Run(name, func(t *testing.T) {
+			bz := readGethPayload(t, "geth_payload_pre_shanghai.json", remove...)
+
+			var strict types.ExecutableDataDeneb
+			require.ErrorContains(t, strict.UnmarshalJSON(bz),
+				"missing required field 'withdrawals'")
+
+			var lenient types.ExecutableDataDeneb
+			require.NoError(t, lenient.UnmarshalJSONLenient(bz))
+			require.Equal(t, math.U64(1234), lenient.Number)
+			require.Len(t, lenient.Transactions, 1)
+			require.Equal(t, []*engineprimitives.Withdrawal{}, lenient.Withdrawals)
+			require.Zero(t, lenient.BlobGasUsed)
+			require.Zero(t, lenient.ExcessBlobGas)
+		})
+	}
+
+	t.Run("Missing required field", func(t *testing.T) {
+		bz := readGethPayload(
+			t, "geth_payload_pre_shanghai.json", "blockHash",
+		)
+		var payload types.ExecutableDataDeneb
+		require.ErrorContains(t, payload.UnmarshalJSONLenient(bz),
+			"missing required field 'blockHash'")
+	})
+}
+
+// renameJSONField returns the JSON object bz with the key from renamed to.
+func renameJSONField(t *testing.T, bz []byte, from, to string) []byte {
+	t.Helper()
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(bz, &fields))
+	fields[to] = fields[from]
+	delete(fields, from)
+	bz, err := json.Marshal(fields)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestExecutableDataDeneb_UnmarshalJSONStrict(t *testing.T) {
+	original := generateExecutableDataDeneb()
+	original.BlobGasUsed = 0x20000
+	bz, err := original.MarshalJSON()
+	require.NoError(t, err)
+
+	var payload types.ExecutableDataDeneb
+	require.NoError(t, payload.UnmarshalJSONStrict(bz))
+	require.Equal(t, original, &payload)
+
+	bz = renameJSONField(t, bz, "blobGasUsed", "blob_gas_used")
+	err = new(types.ExecutableDataDeneb).UnmarshalJSONStrict(bz)
+	require.ErrorIs(t, err, types.ErrUnknownFields)
+	require.ErrorContains(t, err, "'blob_gas_used' for ExecutableDataDeneb")
+}
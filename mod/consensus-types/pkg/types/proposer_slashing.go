@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+)
+
+// SignedBeaconBlockHeader as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#signedbeaconblockheader
+//
+//go:generate go run github.com/ferranbt/fastssz/sszgen --path ./proposer_slashing.go -objs SignedBeaconBlockHeader,ProposerSlashing -include ./header.go,../../../primitives/pkg/common,../../../primitives/pkg/math,../../../primitives/pkg/bytes,../../../primitives/pkg/crypto,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output proposer_slashing.ssz.go
+//nolint:lll
+type SignedBeaconBlockHeader struct {
+	// Header is the signed header.
+	Header *BeaconBlockHeader
+	// Signature is the signature of the proposer over the header.
+	Signature crypto.BLSSignature `ssz-size:"96"`
+}
+
+// ProposerSlashing as defined in the Ethereum 2.0 specification, the proof
+// that a proposer signed two different headers for the same slot.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#proposerslashing
+//
+//nolint:lll
+type ProposerSlashing struct {
+	// SignedHeader1 is the first of the headers.
+	SignedHeader1 *SignedBeaconBlockHeader
+	// SignedHeader2 is the second of the headers.
+	SignedHeader2 *SignedBeaconBlockHeader
+}
+
+// GetHeaders returns the two headers of the ProposerSlashing.
+func (p *ProposerSlashing) GetHeaders() (
+	*BeaconBlockHeader, *BeaconBlockHeader,
+) {
+	return p.SignedHeader1.Header, p.SignedHeader2.Header
+}
+
+// GetSignatures returns the signatures of the two headers of the
+// ProposerSlashing.
+func (p *ProposerSlashing) GetSignatures() (
+	crypto.BLSSignature, crypto.BLSSignature,
+) {
+	return p.SignedHeader1.Signature, p.SignedHeader2.Signature
+}
+
+// ProposerSlashings is a typealias for a list of ProposerSlashings.
+type ProposerSlashings []*ProposerSlashing
+
+// HashTreeRoot returns the hash tree root of the ProposerSlashings list.
+func (p ProposerSlashings) HashTreeRoot() (common.Root, error) {
+	return ssz.MerkleizeListComposite[any, math.U64](
+		p, constants.MaxProposerSlashingsPerBlock,
+	)
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/stretchr/testify/require"
+)
+
+func generateProposerSlashing() *types.ProposerSlashing {
+	return &types.ProposerSlashing{
+		SignedHeader1: &types.SignedBeaconBlockHeader{
+			Header: types.NewBeaconBlockHeader(
+				10, 3, common.Root{1}, common.Root{2}, common.Root{3},
+			),
+			Signature: crypto.BLSSignature{4},
+		},
+		SignedHeader2: &types.SignedBeaconBlockHeader{
+			Header: types.NewBeaconBlockHeader(
+				10, 3, common.Root{1}, common.Root{2}, common.Root{5},
+			),
+			Signature: crypto.BLSSignature{6},
+		},
+	}
+}
+
+func TestProposerSlashing_MarshalUnmarshalSSZ(t *testing.T) {
+	original := generateProposerSlashing()
+	data, err := original.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, original.SizeSSZ())
+
+	var unmarshalled types.ProposerSlashing
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, original, &unmarshalled)
+}
+
+func TestProposerSlashing_UnmarshalSSZ_ErrSize(t *testing.T) {
+	var unmarshalled types.ProposerSlashing
+	require.Error(t, unmarshalled.UnmarshalSSZ(make([]byte, 10)))
+}
+
+func TestProposerSlashing_Getters(t *testing.T) {
+	ps := generateProposerSlashing()
+
+	header1, header2 := ps.GetHeaders()
+	require.Equal(t, ps.SignedHeader1.Header, header1)
+	require.Equal(t, ps.SignedHeader2.Header, header2)
+
+	signature1, signature2 := ps.GetSignatures()
+	require.Equal(t, crypto.BLSSignature{4}, signature1)
+	require.Equal(t, crypto.BLSSignature{6}, signature2)
+}
+
+func TestProposerSlashings_HashTreeRoot(t *testing.T) {
+	empty, err := types.ProposerSlashings{}.HashTreeRoot()
+	require.NoError(t, err)
+
+	root, err := types.ProposerSlashings{
+		generateProposerSlashing(),
+	}.HashTreeRoot()
+	require.NoError(t, err)
+	require.NotEqual(t, empty, root)
+}
+
+func TestBeaconBlockBodyElectra_ProposerSlashingsSSZ(t *testing.T) {
+	deneb := generateBeaconBlockBodyDeneb()
+	body := types.BeaconBlockBodyElectra{
+		BeaconBlockBodyBase: deneb.BeaconBlockBodyBase,
+		ProposerSlashings: []*types.ProposerSlashing{
+			generateProposerSlashing(),
+		},
+		ExecutionPayload:   deneb.ExecutionPayload,
+		BlobKzgCommitments: []eip4844.KZGCommitment{{1}},
+	}
+
+	data, err := body.MarshalSSZ()
+	require.NoError(t, err)
+
+	var unmarshalled types.BeaconBlockBodyElectra
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, body.ProposerSlashings, unmarshalled.ProposerSlashings)
+
+	// The top level roots match the fields of the body.
+	roots, err := body.GetTopLevelRoots()
+	require.NoError(t, err)
+	require.Len(t, roots, int(types.BodyLengthElectra))
+	slashingsRoot, err := types.ProposerSlashings(
+		body.ProposerSlashings,
+	).HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, [32]byte(slashingsRoot), roots[4])
+}
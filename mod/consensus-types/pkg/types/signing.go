@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+)
+
+// DomainSigner is a signer that is told the domain of the signing roots it
+// signs, e.g. to audit them.
+type DomainSigner interface {
+	// SignInDomain signs a signing root computed in the given domain.
+	SignInDomain(
+		domain common.Domain, signingRoot []byte,
+	) (crypto.BLSSignature, error)
+}
+
+// SignObject signs the signing root of obj in the given domain. The signing
+// root is returned alongside the signature for logging. Signers implementing
+// DomainSigner are told the domain.
+func SignObject(
+	signer crypto.BLSSigner,
+	obj interface{ HashTreeRoot() ([32]byte, error) },
+	domain common.Domain,
+) (crypto.BLSSignature, common.Root, error) {
+	signingRoot, err := ssz.ComputeSigningRoot(obj, domain)
+	if err != nil {
+		return crypto.BLSSignature{}, common.Root{}, err
+	}
+
+	var signature crypto.BLSSignature
+	if ds, ok := signer.(DomainSigner); ok {
+		signature, err = ds.SignInDomain(domain, signingRoot[:])
+	} else {
+		signature, err = signer.Sign(signingRoot[:])
+	}
+	if err != nil {
+		return crypto.BLSSignature{}, common.Root{}, err
+	}
+	return signature, signingRoot, nil
+}
+
+// SignWithForkData signs the signing root of obj in the domain of the given
+// type computed from forkData.
+func SignWithForkData(
+	signer crypto.BLSSigner,
+	obj interface{ HashTreeRoot() ([32]byte, error) },
+	forkData *ForkData,
+	domainType common.DomainType,
+) (crypto.BLSSignature, common.Root, error) {
+	domain, err := forkData.ComputeDomain(domainType)
+	if err != nil {
+		return crypto.BLSSignature{}, common.Root{}, err
+	}
+	return SignObject(signer, obj, domain)
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignWithForkData(t *testing.T) {
+	forkData := types.NewForkData(
+		common.Version{0x00, 0x00, 0x00, 0x04}, common.Root{0x01},
+	)
+	domainType := common.DomainType{0x03, 0x00, 0x00, 0x00}
+	obj := &types.DepositMessage{
+		Pubkey:      crypto.BLSPubkey{0x02},
+		Credentials: types.WithdrawalCredentials{0x01},
+		Amount:      math.Gwei(32e9),
+	}
+
+	// Compose the signing root manually.
+	domain, err := forkData.ComputeDomain(domainType)
+	require.NoError(t, err)
+	wantRoot, err := ssz.ComputeSigningRoot(obj, domain)
+	require.NoError(t, err)
+	wantSignature := crypto.BLSSignature{0x04}
+
+	signer := mocks.NewBLSSigner(t)
+	signer.EXPECT().Sign(wantRoot[:]).Return(wantSignature, nil).Twice()
+
+	signature, root, err := types.SignWithForkData(
+		signer, obj, forkData, domainType,
+	)
+	require.NoError(t, err)
+	require.Equal(t, wantSignature, signature)
+	require.Equal(t, wantRoot, root)
+
+	signature, root, err = types.SignObject(signer, obj, domain)
+	require.NoError(t, err)
+	require.Equal(t, wantSignature, signature)
+	require.Equal(t, wantRoot, root)
+}
+
+// domainSigner records the domain it is told.
+type domainSigner struct {
+	*mocks.BLSSigner
+	domain common.Domain
+}
+
+func (s *domainSigner) SignInDomain(
+	domain common.Domain, signingRoot []byte,
+) (crypto.BLSSignature, error) {
+	s.domain = domain
+	return s.Sign(signingRoot)
+}
+
+func TestSignObject_DomainSigner(t *testing.T) {
+	domain := common.Domain{0x01, 0x02}
+	m := mocks.NewBLSSigner(t)
+	m.EXPECT().Sign(mock.Anything).Return(crypto.BLSSignature{0x01}, nil)
+	signer := &domainSigner{BLSSigner: m}
+
+	signature, _, err := types.SignObject(
+		signer, &types.DepositMessage{}, domain,
+	)
+	require.NoError(t, err)
+	require.Equal(t, crypto.BLSSignature{0x01}, signature)
+	require.Equal(t, domain, signer.domain)
+}
+
+func TestSignObject_SignError(t *testing.T) {
+	errSign := errors.New("signing failed")
+	signer := mocks.NewBLSSigner(t)
+	signer.EXPECT().Sign(mock.Anything).Return(crypto.BLSSignature{}, errSign)
+
+	_, _, err := types.SignObject(
+		signer, &types.DepositMessage{}, common.Domain{},
+	)
+	require.ErrorIs(t, err, errSign)
+}
@@ -194,6 +194,117 @@ func TestValidator_IsActive(t *testing.T) {
 	}
 }
 
+func TestValidator_Status(t *testing.T) {
+	farFuture := math.Epoch(constants.FarFutureEpoch)
+	tests := []struct {
+		name      string
+		epoch     math.Epoch
+		validator *types.Validator
+		want      string
+	}{
+		{
+			name:  "pending initialized",
+			epoch: 1,
+			validator: &types.Validator{
+				ActivationEligibilityEpoch: farFuture,
+				ActivationEpoch:            farFuture,
+				ExitEpoch:                  farFuture,
+				WithdrawableEpoch:          farFuture,
+			},
+			want: types.ValidatorStatusPendingInitialized,
+		},
+		{
+			name:  "pending queued",
+			epoch: 1,
+			validator: &types.Validator{
+				ActivationEligibilityEpoch: 1,
+				ActivationEpoch:            farFuture,
+				ExitEpoch:                  farFuture,
+				WithdrawableEpoch:          farFuture,
+			},
+			want: types.ValidatorStatusPendingQueued,
+		},
+		{
+			name:  "active ongoing",
+			epoch: 5,
+			validator: &types.Validator{
+				ActivationEpoch:   5,
+				ExitEpoch:         farFuture,
+				WithdrawableEpoch: farFuture,
+			},
+			want: types.ValidatorStatusActiveOngoing,
+		},
+		{
+			name:  "active exiting",
+			epoch: 5,
+			validator: &types.Validator{
+				ActivationEpoch:   5,
+				ExitEpoch:         10,
+				WithdrawableEpoch: 20,
+			},
+			want: types.ValidatorStatusActiveExiting,
+		},
+		{
+			name:  "active slashed",
+			epoch: 5,
+			validator: &types.Validator{
+				Slashed:           true,
+				ActivationEpoch:   5,
+				ExitEpoch:         10,
+				WithdrawableEpoch: 20,
+			},
+			want: types.ValidatorStatusActiveSlashed,
+		},
+		{
+			name:  "exited unslashed",
+			epoch: 10,
+			validator: &types.Validator{
+				ActivationEpoch:   5,
+				ExitEpoch:         10,
+				WithdrawableEpoch: 20,
+			},
+			want: types.ValidatorStatusExitedUnslashed,
+		},
+		{
+			name:  "exited slashed",
+			epoch: 10,
+			validator: &types.Validator{
+				Slashed:           true,
+				ActivationEpoch:   5,
+				ExitEpoch:         10,
+				WithdrawableEpoch: 20,
+			},
+			want: types.ValidatorStatusExitedSlashed,
+		},
+		{
+			name:  "withdrawal possible",
+			epoch: 20,
+			validator: &types.Validator{
+				EffectiveBalance:  32e9,
+				ActivationEpoch:   5,
+				ExitEpoch:         10,
+				WithdrawableEpoch: 20,
+			},
+			want: types.ValidatorStatusWithdrawalPossible,
+		},
+		{
+			name:  "withdrawal done",
+			epoch: 20,
+			validator: &types.Validator{
+				ActivationEpoch:   5,
+				ExitEpoch:         10,
+				WithdrawableEpoch: 20,
+			},
+			want: types.ValidatorStatusWithdrawalDone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.validator.Status(tt.epoch))
+		})
+	}
+}
+
 func TestValidator_IsEligibleForActivation(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -37,10 +37,6 @@ type SidecarFactory[
 ] struct {
 	// chainSpec defines the specifications of the blockchain.
 	chainSpec ChainSpec
-	// kzgPosition is the position of the KZG commitment in the block.
-	//
-	// TODO: This needs to be made configurable / modular.
-	kzgPosition uint64
 	// metrics is used to collect and report factory metrics.
 	metrics *factoryMetrics
 }
@@ -51,15 +47,11 @@ func NewSidecarFactory[
 	BeaconBlockBodyT BeaconBlockBody,
 ](
 	chainSpec ChainSpec,
-	// todo: calculate from config.
-	kzgPosition uint64,
 	telemetrySink TelemetrySink,
 ) *SidecarFactory[BeaconBlockT, BeaconBlockBodyT] {
 	return &SidecarFactory[BeaconBlockT, BeaconBlockBodyT]{
 		chainSpec: chainSpec,
-		// TODO: This should be configurable / modular.
-		kzgPosition: kzgPosition,
-		metrics:     newFactoryMetrics(telemetrySink),
+		metrics:   newFactoryMetrics(telemetrySink),
 	}
 }
 
@@ -148,7 +140,8 @@ func (f *SidecarFactory[BeaconBlockT, BeaconBlockBodyT]) BuildBlockBodyProof(
 		return nil, err
 	}
 
-	return tree.MerkleProof(f.kzgPosition)
+	// The KZG commitments are the last field of the body of every fork.
+	return tree.MerkleProof(body.Length() - 1)
 }
 
 // BuildCommitmentProof builds a commitment proof.
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -82,6 +83,18 @@ func (sp *Processor[AvailabilityStoreT, BeaconBlockBodyT]) VerifyBlobs(
 		startTime, math.U64(sidecars.Len()),
 	)
 
+	// Verify the number of sidecars against the limit of the fork of the
+	// slot.
+	maxBlobs := sp.chainSpec.MaxBlobsPerBlock(
+		sp.chainSpec.ActiveForkVersionForSlot(slot),
+	)
+	if uint64(sidecars.Len()) > maxBlobs {
+		return errors.Wrapf(
+			types.ErrExceedsBlockBlobLimit,
+			"expected at most %d, got %d", maxBlobs, sidecars.Len(),
+		)
+	}
+
 	return sp.verifier.VerifyBlobs(
 		sidecars,
 		sp.blockBodyOffsetFn(slot, sp.chainSpec),
@@ -20,6 +20,12 @@
 
 package kzg
 
+import (
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/ckzg"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/gokzg"
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
 const (
 	// defaultTrustedSetupPath is the default path to the trusted setup.
 	defaultTrustedSetupPath = "./testing/files/kzg-trusted-setup.json"
@@ -42,3 +48,22 @@ func DefaultConfig() Config {
 		Implementation:   defaultImplementation,
 	}
 }
+
+// Validate returns the problems of the configuration. An empty
+// implementation selects the default one.
+func (c Config) Validate() error {
+	var errs errors.FieldErrors
+	if c.TrustedSetupPath == "" {
+		errs.Add("trusted-setup-path", errors.New("must be set"))
+	}
+	switch c.Implementation {
+	case "", gokzg.Implementation, ckzg.Implementation:
+	default:
+		errs.Add("implementation", errors.Wrapf(
+			ErrUnsupportedKzgImplementation,
+			"supplied: %s, supported: %s, %s",
+			c.Implementation, gokzg.Implementation, ckzg.Implementation,
+		))
+	}
+	return errs.Err()
+}
@@ -0,0 +1,377 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// An archive is a stream of blob sidecars that can be moved between nodes.
+// It is laid out as a header followed by records:
+//
+//	header  := magic[4] | version u16
+//	record  := kind u8 | length u32 | payload[length] | crc32c u32
+//	sidecar := slot u64 | index u64 | sszLength u32 | ssz[sszLength]
+//	end     := count u64
+//
+// Integers are big endian and the checksum of a record covers its kind,
+// length and payload. Importers skip records of unknown kinds and ignore
+// bytes trailing the known fields of a payload, so new record kinds and new
+// fields can be added without bumping the version. The version is only
+// bumped for changes that older importers cannot safely ignore.
+const (
+	// archiveVersion is the version of the archive format.
+	archiveVersion uint16 = 1
+	// archiveHeaderLen is the length of the magic plus the version.
+	archiveHeaderLen = 6
+	// recordHeaderLen is the length of the kind plus the payload length.
+	recordHeaderLen = 5
+	// checksumLen is the length of the checksum of a record.
+	checksumLen = 4
+	// sidecarPayloadLen is the length of the fixed fields of a sidecar
+	// record.
+	sidecarPayloadLen = 20
+	// endPayloadLen is the length of the fixed fields of an end record.
+	endPayloadLen = 8
+	// maxRecordLen bounds the payload of a record, so a corrupted length
+	// can not make the importer allocate an arbitrary amount of memory.
+	maxRecordLen = 1 << 20
+)
+
+// Record kinds.
+const (
+	recordSidecar byte = 1
+	recordEnd     byte = 0xff
+)
+
+//nolint:gochecknoglobals // constant byte sequence and table.
+var (
+	archiveMagic  = []byte("BKBA")
+	archiveCRC32C = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// ImportOption is an option for Import.
+type ImportOption func(*importConfig)
+
+// importConfig is the configuration of an import.
+type importConfig struct {
+	verifier  SidecarVerifier
+	kzgOffset uint64
+}
+
+// WithProofVerification verifies the KZG and inclusion proofs of every
+// imported sidecar before it is stored.
+func WithProofVerification(
+	verifier SidecarVerifier, kzgOffset uint64,
+) ImportOption {
+	return func(c *importConfig) {
+		c.verifier = verifier
+		c.kzgOffset = kzgOffset
+	}
+}
+
+// Export writes the sidecars stored for the slots in [fromSlot, toSlot) to w
+// as an archive and returns the number of sidecars written.
+func (s *Store[BeaconBlockBodyT]) Export(
+	ctx context.Context,
+	w io.Writer,
+	fromSlot, toSlot math.Slot,
+) (uint64, error) {
+	bw := bufio.NewWriter(w)
+	header := make([]byte, 0, archiveHeaderLen)
+	header = append(header, archiveMagic...)
+	header = binary.BigEndian.AppendUint16(header, archiveVersion)
+	if _, err := bw.Write(header); err != nil {
+		return 0, err
+	}
+
+	// Sidecars are copied from the store as they are encoded, they are only
+	// decoded by the importer.
+	sidecarLen := int64(new(types.BlobSidecar).SizeSSZ())
+	var count uint64
+	if err := s.iterateSidecars(fromSlot, toSlot, func(
+		slot uint64, r io.Reader, size int64,
+	) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if size != sidecarLen {
+			return errors.Newf(
+				"sidecar at %d has %d bytes, not %d", slot, size, sidecarLen,
+			)
+		}
+		prefix := make([]byte, sidecarIndexLen)
+		if _, err := io.ReadFull(r, prefix); err != nil {
+			return errors.Wrapf(err, "failed to read sidecar at %d", slot)
+		}
+
+		fields := make([]byte, 0, sidecarPayloadLen)
+		fields = binary.BigEndian.AppendUint64(fields, slot)
+		fields = binary.BigEndian.AppendUint64(
+			fields, binary.LittleEndian.Uint64(prefix),
+		)
+		//#nosec:G115 // the length of a sidecar is fixed.
+		fields = binary.BigEndian.AppendUint32(fields, uint32(size))
+		if err := writeRecordFrom(
+			bw, recordSidecar, fields,
+			io.MultiReader(bytes.NewReader(prefix), r), size,
+		); err != nil {
+			return err
+		}
+		count++
+		return nil
+	}); err != nil {
+		return count, err
+	}
+
+	if err := writeRecord(
+		bw, recordEnd, binary.BigEndian.AppendUint64(nil, count),
+	); err != nil {
+		return count, err
+	}
+	return count, bw.Flush()
+}
+
+// Import reads an archive from r, validates its sidecars and stores them,
+// and returns the number of sidecars stored. The sidecars of a slot are
+// stored together in a single batch once the whole slot has been read and
+// validated. A stream that is rejected part way leaves the slots read
+// before the error stored, so an interrupted import can simply be rerun.
+func (s *Store[BeaconBlockBodyT]) Import(
+	ctx context.Context,
+	r io.Reader,
+	opts ...ImportOption,
+) (uint64, error) {
+	cfg := &importConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	br := bufio.NewReader(r)
+	header := make([]byte, archiveHeaderLen)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return 0, errors.Wrap(ErrInvalidArchive, "missing header")
+	}
+	if string(header[:len(archiveMagic)]) != string(archiveMagic) {
+		return 0, errors.Wrap(ErrInvalidArchive, "bad magic")
+	}
+	if version := binary.BigEndian.Uint16(
+		header[len(archiveMagic):],
+	); version > archiveVersion {
+		return 0, errors.Wrapf(
+			ErrUnsupportedArchiveVersion, "version %d", version,
+		)
+	}
+
+	var (
+		stored, seen, slot uint64
+		pending            []*types.BlobSidecar
+	)
+	flush := func() error {
+		if len(pending) == 0 {
+			return nil
+		}
+		if err := s.importSlot(slot, pending, cfg); err != nil {
+			return err
+		}
+		stored += uint64(len(pending))
+		pending = nil
+		return nil
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return stored, err
+		}
+		kind, payload, err := readRecord(br)
+		if err != nil {
+			return stored, err
+		}
+
+		switch kind {
+		case recordSidecar:
+			recordSlot, sidecar, decodeErr := decodeSidecarRecord(payload)
+			if decodeErr != nil {
+				return stored, decodeErr
+			}
+			if recordSlot != slot {
+				if err = flush(); err != nil {
+					return stored, err
+				}
+			}
+			slot = recordSlot
+			pending = append(pending, sidecar)
+			seen++
+		case recordEnd:
+			if len(payload) < endPayloadLen {
+				return stored, errors.Wrap(
+					ErrInvalidArchive, "short end record",
+				)
+			}
+			if count := binary.BigEndian.Uint64(payload); count != seen {
+				return stored, errors.Wrapf(
+					ErrInvalidArchive,
+					"archive holds %d sidecars, read %d", count, seen,
+				)
+			}
+			return stored, flush()
+		default:
+			// Records of unknown kinds are skipped.
+		}
+	}
+}
+
+// importSlot validates the sidecars of a slot and stores them in a single
+// batch.
+func (s *Store[BeaconBlockBodyT]) importSlot(
+	slot uint64,
+	sidecars []*types.BlobSidecar,
+	cfg *importConfig,
+) error {
+	scs := &types.BlobSidecars{Sidecars: sidecars}
+	if err := scs.ValidateBlockRoots(); err != nil {
+		return errors.Wrapf(err, "invalid sidecars at %d", slot)
+	}
+	if cfg.verifier != nil {
+		if err := cfg.verifier.VerifyInclusionProofs(
+			scs, cfg.kzgOffset,
+		); err != nil {
+			return errors.Wrapf(err, "invalid sidecars at %d", slot)
+		}
+		if err := cfg.verifier.VerifyKZGProofs(scs); err != nil {
+			return errors.Wrapf(err, "invalid sidecars at %d", slot)
+		}
+	}
+
+	keys := make([][]byte, len(sidecars))
+	values := make([][]byte, len(sidecars))
+	for i, sidecar := range sidecars {
+		value, err := sidecar.MarshalSSZ()
+		if err != nil {
+			return err
+		}
+		keys[i] = sidecar.KzgCommitment[:]
+		values[i] = value
+	}
+	return s.SetBatch(slot, keys, values)
+}
+
+// decodeSidecarRecord decodes the payload of a sidecar record and checks
+// that it is consistent with the sidecar it holds.
+func decodeSidecarRecord(
+	payload []byte,
+) (uint64, *types.BlobSidecar, error) {
+	if len(payload) < sidecarPayloadLen {
+		return 0, nil, errors.Wrap(ErrInvalidArchive, "short sidecar record")
+	}
+	slot := binary.BigEndian.Uint64(payload)
+	index := binary.BigEndian.Uint64(payload[8:])
+	sszLen := binary.BigEndian.Uint32(payload[16:])
+	if uint64(sszLen) > uint64(len(payload)-sidecarPayloadLen) {
+		return 0, nil, errors.Wrap(ErrInvalidArchive, "short sidecar record")
+	}
+
+	sidecar := new(types.BlobSidecar)
+	if err := sidecar.UnmarshalSSZ(
+		payload[sidecarPayloadLen : sidecarPayloadLen+sszLen],
+	); err != nil {
+		return 0, nil, errors.Wrapf(
+			ErrInvalidArchive, "failed to decode sidecar at %d: %v", slot, err,
+		)
+	}
+	if sidecar.Index != index ||
+		sidecar.BeaconBlockHeader == nil ||
+		sidecar.BeaconBlockHeader.GetSlot().Unwrap() != slot {
+		return 0, nil, errors.Wrapf(
+			ErrInvalidArchive, "sidecar does not match record at %d", slot,
+		)
+	}
+	return slot, sidecar, nil
+}
+
+// writeRecord writes a record with its checksum.
+func writeRecord(w io.Writer, kind byte, payload []byte) error {
+	return writeRecordFrom(
+		w, kind, nil, bytes.NewReader(payload), int64(len(payload)),
+	)
+}
+
+// writeRecordFrom writes a record with its checksum, whose payload is the
+// fields followed by n bytes copied from r.
+func writeRecordFrom(
+	w io.Writer, kind byte, fields []byte, r io.Reader, n int64,
+) error {
+	header := make([]byte, 0, recordHeaderLen+len(fields))
+	header = append(header, kind)
+	//#nosec:G115 // payloads are bounded by maxRecordLen.
+	header = binary.BigEndian.AppendUint32(
+		header, uint32(int64(len(fields))+n),
+	)
+	header = append(header, fields...)
+
+	crc := crc32.New(archiveCRC32C)
+	hw := io.MultiWriter(w, crc)
+	if _, err := hw.Write(header); err != nil {
+		return err
+	}
+	if _, err := io.CopyN(hw, r, n); err != nil {
+		return err
+	}
+	_, err := w.Write(binary.BigEndian.AppendUint32(nil, crc.Sum32()))
+	return err
+}
+
+// readRecord reads the next record and verifies its checksum.
+func readRecord(r io.Reader) (byte, []byte, error) {
+	header := make([]byte, recordHeaderLen)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, nil, errors.Wrap(ErrTruncatedArchive, err.Error())
+	}
+	length := binary.BigEndian.Uint32(header[1:])
+	if length > maxRecordLen {
+		return 0, nil, errors.Wrapf(
+			ErrInvalidArchive, "record of %d bytes is too large", length,
+		)
+	}
+
+	body := make([]byte, length+checksumLen)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return 0, nil, errors.Wrap(ErrTruncatedArchive, err.Error())
+	}
+	payload, sum := body[:length], binary.BigEndian.Uint32(body[length:])
+
+	crc := crc32.Update(
+		crc32.Checksum(header, archiveCRC32C), archiveCRC32C, payload,
+	)
+	if crc != sum {
+		return 0, nil, ErrArchiveChecksumMismatch
+	}
+	return header[0], payload, nil
+}
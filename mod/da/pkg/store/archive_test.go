@@ -0,0 +1,248 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// memIndexDB is an in memory IndexDB.
+type memIndexDB struct {
+	entries map[uint64]map[string][]byte
+}
+
+func newMemIndexDB() *memIndexDB {
+	return &memIndexDB{entries: make(map[uint64]map[string][]byte)}
+}
+
+func (db *memIndexDB) Has(index uint64, key []byte) (bool, error) {
+	_, ok := db.entries[index][string(key)]
+	return ok, nil
+}
+
+func (db *memIndexDB) Set(index uint64, key []byte, value []byte) error {
+	if db.entries[index] == nil {
+		db.entries[index] = make(map[string][]byte)
+	}
+	db.entries[index][string(key)] = value
+	return nil
+}
+
+func (db *memIndexDB) SetBatch(index uint64, keys, values [][]byte) error {
+	for i := range keys {
+		if err := db.Set(index, keys[i], values[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *memIndexDB) Iterate(
+	from, to uint64, fn func(index uint64, key, value []byte) error,
+) error {
+	indexes := make([]uint64, 0, len(db.entries))
+	for index := range db.entries {
+		if index >= from && index < to {
+			indexes = append(indexes, index)
+		}
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+	for _, index := range indexes {
+		for key, value := range db.entries[index] {
+			if err := fn(index, []byte(key), value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// newSidecar returns a sidecar for the given slot and index.
+func newSidecar(slot, index uint64) *types.BlobSidecar {
+	header := &ctypes.BeaconBlockHeader{}
+	header.Slot = slot
+	sidecar := &types.BlobSidecar{
+		Index:             index,
+		BeaconBlockHeader: header,
+		InclusionProof:    make([][32]byte, 8),
+	}
+	sidecar.KzgCommitment[0] = byte(slot)
+	sidecar.KzgCommitment[1] = byte(index)
+	sidecar.Blob[0] = byte(slot)
+	return sidecar
+}
+
+// newArchiveTestStore returns a store holding two sidecars for every slot in
+// [from, to).
+func newArchiveTestStore(
+	t *testing.T, from, to uint64,
+) (*store.Store[*ctypes.BeaconBlockBody], *memIndexDB) {
+	t.Helper()
+	db := newMemIndexDB()
+	for slot := from; slot < to; slot++ {
+		for index := range uint64(2) {
+			sidecar := newSidecar(slot, index)
+			value, err := sidecar.MarshalSSZ()
+			require.NoError(t, err)
+			require.NoError(t, db.Set(slot, sidecar.KzgCommitment[:], value))
+		}
+	}
+	return store.New[*ctypes.BeaconBlockBody](
+		db, noop.NewLogger(), nil,
+	), db
+}
+
+// export exports the slots in [from, to) of the store.
+func export(
+	t *testing.T,
+	s *store.Store[*ctypes.BeaconBlockBody],
+	from, to uint64,
+) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	_, err := s.Export(
+		context.Background(), &buf, math.Slot(from), math.Slot(to),
+	)
+	require.NoError(t, err)
+	return buf.Bytes()
+}
+
+// failingVerifier is a SidecarVerifier that rejects every sidecar.
+type failingVerifier struct{}
+
+func (failingVerifier) VerifyInclusionProofs(
+	*types.BlobSidecars, uint64,
+) error {
+	return errors.New("invalid inclusion proof")
+}
+
+func (failingVerifier) VerifyKZGProofs(*types.BlobSidecars) error {
+	return nil
+}
+
+func TestStore_ExportImport(t *testing.T) {
+	src, srcDB := newArchiveTestStore(t, 1, 5)
+
+	var buf bytes.Buffer
+	count, err := src.Export(context.Background(), &buf, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), count)
+
+	dst, dstDB := newArchiveTestStore(t, 0, 0)
+	imported, err := dst.Import(context.Background(), &buf)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), imported)
+	require.Equal(t, srcDB.entries, dstDB.entries)
+}
+
+func TestStore_ExportRange(t *testing.T) {
+	src, _ := newArchiveTestStore(t, 1, 5)
+	dst, dstDB := newArchiveTestStore(t, 0, 0)
+
+	imported, err := dst.Import(
+		context.Background(), bytes.NewReader(export(t, src, 2, 4)),
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), imported)
+	require.Len(t, dstDB.entries, 2)
+	require.Contains(t, dstDB.entries, uint64(2))
+	require.Contains(t, dstDB.entries, uint64(3))
+}
+
+func TestStore_ImportRejectsCorruptedStream(t *testing.T) {
+	src, _ := newArchiveTestStore(t, 1, 3)
+	archive := export(t, src, 0, 10)
+
+	tests := []struct {
+		name    string
+		archive func() []byte
+		err     error
+	}{
+		{
+			name: "FlippedByte",
+			archive: func() []byte {
+				bz := bytes.Clone(archive)
+				bz[len(bz)/2] ^= 0xff
+				return bz
+			},
+			err: store.ErrArchiveChecksumMismatch,
+		},
+		{
+			name: "Truncated",
+			archive: func() []byte {
+				return archive[:len(archive)-1]
+			},
+			err: store.ErrTruncatedArchive,
+		},
+		{
+			name: "BadMagic",
+			archive: func() []byte {
+				bz := bytes.Clone(archive)
+				bz[0] = 'X'
+				return bz
+			},
+			err: store.ErrInvalidArchive,
+		},
+		{
+			name: "FutureVersion",
+			archive: func() []byte {
+				bz := bytes.Clone(archive)
+				bz[4] = 0xff
+				return bz
+			},
+			err: store.ErrUnsupportedArchiveVersion,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst, _ := newArchiveTestStore(t, 0, 0)
+			_, err := dst.Import(
+				context.Background(), bytes.NewReader(tt.archive()),
+			)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestStore_ImportVerifiesProofs(t *testing.T) {
+	src, _ := newArchiveTestStore(t, 1, 3)
+	dst, dstDB := newArchiveTestStore(t, 0, 0)
+
+	_, err := dst.Import(
+		context.Background(),
+		bytes.NewReader(export(t, src, 0, 10)),
+		store.WithProofVerification(failingVerifier{}, 0),
+	)
+	require.ErrorContains(t, err, "invalid inclusion proof")
+	require.Empty(t, dstDB.entries)
+}
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import (
+	"slices"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+const (
+	// defaultStorageBackend is the default backend blob sidecars are stored
+	// in.
+	defaultStorageBackend = "filedb"
+	// defaultCompression is the default codec used to compress blob sidecars
+	// on disk.
+	defaultCompression = "none"
+	// defaultSyncPolicy is the default policy controlling when blob
+	// sidecars are synced to disk.
+	defaultSyncPolicy = "per-batch"
+)
+
+//nolint:gochecknoglobals // read-only lists of options.
+var (
+	// storageBackends are the supported storage backends.
+	storageBackends = []string{"filedb", "pebble"}
+	// compressions are the supported compression codecs.
+	compressions = []string{"none", "snappy", "zstd"}
+	// syncPolicies are the supported sync policies.
+	syncPolicies = []string{"per-batch", "per-write", "never"}
+)
+
+// Config is the configuration for the availability store.
+type Config struct {
+	// StorageBackend is the backend blob sidecars are stored in.
+	// Options are "filedb" or "pebble".
+	StorageBackend string `mapstructure:"storage-backend"`
+	// Compression is the codec used to compress blob sidecars on disk.
+	// Options are "none", "snappy" or "zstd". Only used by the filedb
+	// backend.
+	Compression string `mapstructure:"compression"`
+	// SyncPolicy controls when blob sidecars are synced to disk. Options
+	// are "per-batch", "per-write" or "never". Only used by the filedb
+	// backend.
+	SyncPolicy string `mapstructure:"sync-policy"`
+	// SyncInterval is the minimum time between two syncs of the blob
+	// sidecars written outside of a batch under the "per-batch" policy.
+	// Zero keeps the default of the backend.
+	SyncInterval time.Duration `mapstructure:"sync-interval"`
+	// BlobRetentionEpochs is the number of epochs blob sidecars are kept
+	// for. Zero keeps them for the minimum of the chain spec.
+	BlobRetentionEpochs uint64 `mapstructure:"blob-retention-epochs"`
+	// UnsafeBlobRetention allows BlobRetentionEpochs to be lower than the
+	// minimum of the chain spec.
+	UnsafeBlobRetention bool `mapstructure:"unsafe-blob-retention"`
+}
+
+// DefaultConfig returns the default configuration for the availability store.
+func DefaultConfig() Config {
+	return Config{
+		StorageBackend: defaultStorageBackend,
+		Compression:    defaultCompression,
+		SyncPolicy:     defaultSyncPolicy,
+	}
+}
+
+// Validate returns the problems of the configuration. Empty options select
+// their default.
+func (c Config) Validate() error {
+	var errs errors.FieldErrors
+	if c.StorageBackend != "" &&
+		!slices.Contains(storageBackends, c.StorageBackend) {
+		errs.Add("storage-backend", errors.Newf(
+			"unsupported backend %q, expected one of %v",
+			c.StorageBackend, storageBackends,
+		))
+	}
+	switch {
+	case c.Compression == "" || c.Compression == defaultCompression:
+	case !slices.Contains(compressions, c.Compression):
+		errs.Add("compression", errors.Newf(
+			"unsupported codec %q, expected one of %v",
+			c.Compression, compressions,
+		))
+	case c.StorageBackend != "" && c.StorageBackend != defaultStorageBackend:
+		errs.Add("compression", errors.Newf(
+			"only supported by the %s backend", defaultStorageBackend,
+		))
+	}
+	switch {
+	case c.SyncPolicy == "" || c.SyncPolicy == defaultSyncPolicy:
+	case !slices.Contains(syncPolicies, c.SyncPolicy):
+		errs.Add("sync-policy", errors.Newf(
+			"unsupported policy %q, expected one of %v",
+			c.SyncPolicy, syncPolicies,
+		))
+	case c.StorageBackend != "" && c.StorageBackend != defaultStorageBackend:
+		errs.Add("sync-policy", errors.Newf(
+			"only supported by the %s backend", defaultStorageBackend,
+		))
+	}
+	if c.SyncInterval < 0 {
+		errs.Add("sync-interval", errors.New("must not be negative"))
+	}
+	if c.UnsafeBlobRetention && c.BlobRetentionEpochs == 0 {
+		errs.Add("unsafe-blob-retention", errors.New(
+			"requires blob-retention-epochs to be set",
+		))
+	}
+	return errs.Err()
+}
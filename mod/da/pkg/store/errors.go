@@ -34,4 +34,25 @@ var (
 	ErrAttemptedToVerifyNilSidecars = errors.New(
 		"attempted to verify nil sidecars",
 	)
+
+	// ErrSidecarNotFound is returned when the requested sidecar is not
+	// stored.
+	ErrSidecarNotFound = errors.New("blob sidecar not found")
+
+	// ErrInvalidArchive is returned when an archive is malformed.
+	ErrInvalidArchive = errors.New("invalid blob archive")
+
+	// ErrTruncatedArchive is returned when an archive ends before its end
+	// record.
+	ErrTruncatedArchive = errors.New("truncated blob archive")
+
+	// ErrUnsupportedArchiveVersion is returned when an archive was written
+	// with a newer, incompatible version of the format.
+	ErrUnsupportedArchiveVersion = errors.New(
+		"unsupported blob archive version",
+	)
+
+	// ErrArchiveChecksumMismatch is returned when a record of an archive
+	// does not match its checksum.
+	ErrArchiveChecksumMismatch = errors.New("blob archive checksum mismatch")
 )
@@ -24,12 +24,31 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives"
 )
 
+// RetentionEpochs returns the number of epochs blob sidecars are kept for,
+// given the configured retention. A zero retention means the minimum of the
+// chain spec. Retentions below the minimum are raised to it unless
+// allowUnsafe is set, since peers may still request those sidecars.
+func RetentionEpochs(
+	cs primitives.ChainSpec, retention uint64, allowUnsafe bool,
+) uint64 {
+	minimum := cs.MinEpochsForBlobsSidecarsRequest()
+	if retention == 0 || (retention < minimum && !allowUnsafe) {
+		return minimum
+	}
+	return retention
+}
+
+// BuildPruneRangeFn returns a function that computes the range of slots to
+// prune for a block, keeping the blob sidecars of the last retentionEpochs
+// epochs.
 func BuildPruneRangeFn[
 	BeaconBlockT BeaconBlock,
 	BlockEventT BlockEvent[BeaconBlockT],
-](cs primitives.ChainSpec) func(BlockEventT) (uint64, uint64) {
+](
+	cs primitives.ChainSpec, retentionEpochs uint64,
+) func(BlockEventT) (uint64, uint64) {
 	return func(event BlockEventT) (uint64, uint64) {
-		window := cs.MinEpochsForBlobsSidecarsRequest() * cs.SlotsPerEpoch()
+		window := retentionEpochs * cs.SlotsPerEpoch()
 		if event.Data().GetSlot().Unwrap() < window {
 			return 0, 0
 		}
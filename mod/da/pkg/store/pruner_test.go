@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSlotsPerEpoch      = 32
+	testMinRetentionEpochs = 4096
+)
+
+type testBlock struct{ slot math.Slot }
+
+func (b testBlock) GetSlot() math.U64 { return b.slot }
+
+type testEvent struct{ block testBlock }
+
+func (e testEvent) Data() testBlock { return e.block }
+
+func testChainSpec() primitives.ChainSpec {
+	return chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+	]{
+		SlotsPerEpoch:                    testSlotsPerEpoch,
+		MinEpochsForBlobsSidecarsRequest: testMinRetentionEpochs,
+	})
+}
+
+func TestRetentionEpochs(t *testing.T) {
+	tests := []struct {
+		name        string
+		retention   uint64
+		allowUnsafe bool
+		want        uint64
+	}{
+		{
+			name: "ZeroMeansSpecDefault",
+			want: testMinRetentionEpochs,
+		},
+		{
+			name:        "ZeroMeansSpecDefaultWhenUnsafe",
+			allowUnsafe: true,
+			want:        testMinRetentionEpochs,
+		},
+		{
+			name:      "BelowMinimumIsClamped",
+			retention: 16,
+			want:      testMinRetentionEpochs,
+		},
+		{
+			name:        "BelowMinimumAllowedWhenUnsafe",
+			retention:   16,
+			allowUnsafe: true,
+			want:        16,
+		},
+		{
+			name:      "AboveMinimum",
+			retention: 2 * testMinRetentionEpochs,
+			want:      2 * testMinRetentionEpochs,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, store.RetentionEpochs(
+				testChainSpec(), tt.retention, tt.allowUnsafe,
+			))
+		})
+	}
+}
+
+func TestBuildPruneRangeFn(t *testing.T) {
+	const retention = 2
+	pruneRangeFn := store.BuildPruneRangeFn[testBlock, testEvent](
+		testChainSpec(), retention,
+	)
+
+	window := uint64(retention * testSlotsPerEpoch)
+	start, end := pruneRangeFn(testEvent{testBlock{math.Slot(window - 1)}})
+	require.Zero(t, start)
+	require.Zero(t, end)
+
+	start, end = pruneRangeFn(testEvent{testBlock{math.Slot(window + 10)}})
+	require.Zero(t, start)
+	require.Equal(t, uint64(10), end)
+}
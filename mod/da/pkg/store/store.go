@@ -21,7 +21,13 @@
 package store
 
 import (
+	"bytes"
+	"cmp"
 	"context"
+	"encoding/binary"
+	"io"
+	"io/fs"
+	"slices"
 
 	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/errors"
@@ -31,6 +37,10 @@ import (
 	"github.com/sourcegraph/conc/iter"
 )
 
+// sidecarIndexLen is the length of the index of a sidecar, the first field
+// of its SSZ encoding.
+const sidecarIndexLen = 8
+
 // Store is the default implementation of the AvailabilityStore.
 type Store[BeaconBlockBodyT BeaconBlockBody] struct {
 	// IndexDB is a basic database interface.
@@ -94,24 +104,160 @@ func (s *Store[BeaconBlockT]) Persist(
 		return nil
 	}
 
-	// Store each sidecar in parallel.
-	if err := errors.Join(iter.Map(
+	// Marshal each sidecar in parallel.
+	values, err := iter.MapErr(
 		sidecars.Sidecars,
-		func(sidecar **types.BlobSidecar) error {
+		func(sidecar **types.BlobSidecar) ([]byte, error) {
 			if *sidecar == nil {
-				return ErrAttemptedToStoreNilSidecar
-			}
-			sc := *sidecar
-			bz, err := sc.MarshalSSZ()
-			if err != nil {
-				return err
+				return nil, ErrAttemptedToStoreNilSidecar
 			}
-			return s.Set(uint64(slot), sc.KzgCommitment[:], bz)
+			return (*sidecar).MarshalSSZ()
 		},
-	)...); err != nil {
+	)
+	if err != nil {
+		return err
+	}
+
+	// Store all sidecars of the slot in a single batch so that a crash can
+	// not leave a partially persisted slot behind.
+	keys := make([][]byte, len(sidecars.Sidecars))
+	for i, sc := range sidecars.Sidecars {
+		keys[i] = sc.KzgCommitment[:]
+	}
+	if err = s.SetBatch(uint64(slot), keys, values); err != nil {
 		return err
 	}
 
 	s.logger.Info("successfully stored all blob sidecars 🚗", "slot", slot)
 	return nil
 }
+
+// GetBlobSidecars returns the sidecars stored for the slot, ordered by their
+// index. An empty set is returned if no sidecars are stored for the slot,
+// e.g. because they were pruned.
+func (s *Store[BeaconBlockBodyT]) GetBlobSidecars(
+	slot math.Slot,
+) (*types.BlobSidecars, error) {
+	sidecars := make([]*types.BlobSidecar, 0)
+	if err := s.Iterate(slot.Unwrap(), slot.Unwrap()+1, func(
+		_ uint64, _, value []byte,
+	) error {
+		sidecar := new(types.BlobSidecar)
+		if err := sidecar.UnmarshalSSZ(value); err != nil {
+			return errors.Wrapf(err, "failed to decode sidecar at %d", slot)
+		}
+		sidecars = append(sidecars, sidecar)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	slices.SortFunc(sidecars, func(a, b *types.BlobSidecar) int {
+		return cmp.Compare(a.Index, b.Index)
+	})
+	return &types.BlobSidecars{Sidecars: sidecars}, nil
+}
+
+// GetBlobSidecarReader returns a reader of the SSZ encoding of the sidecar
+// of the given index stored for the slot, with its length. The sidecar is
+// streamed from the database if it supports it, rather than decoded, so
+// serving it does not hold it whole in memory. It returns
+// ErrSidecarNotFound if the sidecar is not stored. The reader must be
+// closed.
+func (s *Store[BeaconBlockBodyT]) GetBlobSidecarReader(
+	slot math.Slot,
+	index uint64,
+) (io.ReadCloser, int64, error) {
+	db, ok := s.IndexDB.(StreamingIndexDB)
+	if !ok {
+		return s.readBlobSidecar(slot, index)
+	}
+
+	keys, err := db.Keys(slot.Unwrap())
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, key := range keys {
+		r, size, openErr := db.GetReader(slot.Unwrap(), key)
+		if errors.Is(openErr, fs.ErrNotExist) {
+			// The slot is being pruned.
+			continue
+		} else if openErr != nil {
+			return nil, 0, openErr
+		}
+
+		prefix := make([]byte, sidecarIndexLen)
+		if _, err = io.ReadFull(r, prefix); err != nil {
+			return nil, 0, errors.Join(
+				errors.Wrapf(err, "failed to read sidecar at %d", slot),
+				r.Close(),
+			)
+		}
+		if binary.LittleEndian.Uint64(prefix) == index {
+			return readCloser{
+				Reader: io.MultiReader(bytes.NewReader(prefix), r),
+				Closer: r,
+			}, size, nil
+		}
+		if err = r.Close(); err != nil {
+			return nil, 0, err
+		}
+	}
+	return nil, 0, errors.Wrapf(
+		ErrSidecarNotFound, "sidecar %d at %d", index, slot,
+	)
+}
+
+// readBlobSidecar returns a reader of the SSZ encoding of the sidecar of the
+// given index stored for the slot, read whole from a database that does not
+// stream its values.
+func (s *Store[BeaconBlockBodyT]) readBlobSidecar(
+	slot math.Slot,
+	index uint64,
+) (io.ReadCloser, int64, error) {
+	var sidecar []byte
+	if err := s.Iterate(slot.Unwrap(), slot.Unwrap()+1, func(
+		_ uint64, _, value []byte,
+	) error {
+		if len(value) >= sidecarIndexLen &&
+			binary.LittleEndian.Uint64(value) == index {
+			sidecar = value
+		}
+		return nil
+	}); err != nil {
+		return nil, 0, err
+	}
+	if sidecar == nil {
+		return nil, 0, errors.Wrapf(
+			ErrSidecarNotFound, "sidecar %d at %d", index, slot,
+		)
+	}
+	return io.NopCloser(bytes.NewReader(sidecar)), int64(len(sidecar)), nil
+}
+
+// iterateSidecars calls fn with a reader of the SSZ encoding of every
+// sidecar stored for the slots in [from, to) and its length, in ascending
+// order of slot. The sidecars are streamed from the database if it supports
+// it.
+func (s *Store[BeaconBlockBodyT]) iterateSidecars(
+	from, to math.Slot,
+	fn func(slot uint64, r io.Reader, size int64) error,
+) error {
+	if db, ok := s.IndexDB.(StreamingIndexDB); ok {
+		return db.IterateReaders(from.Unwrap(), to.Unwrap(), func(
+			slot uint64, _ []byte, r io.Reader, size int64,
+		) error {
+			return fn(slot, r, size)
+		})
+	}
+	return s.Iterate(from.Unwrap(), to.Unwrap(), func(
+		slot uint64, _, value []byte,
+	) error {
+		return fn(slot, bytes.NewReader(value), int64(len(value)))
+	})
+}
+
+// readCloser is a reader closed by a closer of its own.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
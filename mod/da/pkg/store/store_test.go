@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/fs"
+	"sync/atomic"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_GetBlobSidecars(t *testing.T) {
+	s, db := newArchiveTestStore(t, 1, 3)
+	// Store a later index first to check the sidecars come back ordered.
+	sidecar := newSidecar(2, 5)
+	value, err := sidecar.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, db.Set(2, sidecar.KzgCommitment[:], value))
+
+	sidecars, err := s.GetBlobSidecars(math.Slot(2))
+	require.NoError(t, err)
+	require.Len(t, sidecars.Sidecars, 3)
+	for i, index := range []uint64{0, 1, 5} {
+		require.Equal(t, index, sidecars.Sidecars[i].Index)
+		require.Equal(t, uint64(2), sidecars.Sidecars[i].BeaconBlockHeader.Slot)
+	}
+
+	sidecars, err = s.GetBlobSidecars(math.Slot(7))
+	require.NoError(t, err)
+	require.Empty(t, sidecars.Sidecars)
+}
+
+// streamingIndexDB is an in memory StreamingIndexDB, counting the readers
+// left open.
+type streamingIndexDB struct {
+	*memIndexDB
+	open atomic.Int64
+}
+
+func (db *streamingIndexDB) Keys(index uint64) ([][]byte, error) {
+	keys := make([][]byte, 0, len(db.entries[index]))
+	for key := range db.entries[index] {
+		keys = append(keys, []byte(key))
+	}
+	return keys, nil
+}
+
+func (db *streamingIndexDB) GetReader(
+	index uint64, key []byte,
+) (io.ReadCloser, int64, error) {
+	value, ok := db.entries[index][string(key)]
+	if !ok {
+		return nil, 0, fs.ErrNotExist
+	}
+	db.open.Add(1)
+	return &countedReader{Reader: bytes.NewReader(value), db: db},
+		int64(len(value)), nil
+}
+
+func (db *streamingIndexDB) IterateReaders(
+	from, to uint64,
+	fn func(index uint64, key []byte, r io.Reader, size int64) error,
+) error {
+	return db.Iterate(from, to, func(index uint64, key, value []byte) error {
+		return fn(index, key, bytes.NewReader(value), int64(len(value)))
+	})
+}
+
+// countedReader is a reader of a streamingIndexDB.
+type countedReader struct {
+	io.Reader
+	db *streamingIndexDB
+}
+
+func (r *countedReader) Close() error {
+	r.db.open.Add(-1)
+	return nil
+}
+
+func TestStore_GetBlobSidecarReader(t *testing.T) {
+	mem, memDB := newArchiveTestStore(t, 1, 3)
+	streamingDB := &streamingIndexDB{memIndexDB: memDB}
+	streaming := store.New[*ctypes.BeaconBlockBody](
+		streamingDB, noop.NewLogger(), nil,
+	)
+
+	sidecars, err := mem.GetBlobSidecars(math.Slot(2))
+	require.NoError(t, err)
+	for _, s := range []*store.Store[*ctypes.BeaconBlockBody]{
+		mem, streaming,
+	} {
+		for _, sidecar := range sidecars.Sidecars {
+			want, marshalErr := sidecar.MarshalSSZ()
+			require.NoError(t, marshalErr)
+
+			r, size, readErr := s.GetBlobSidecarReader(2, sidecar.Index)
+			require.NoError(t, readErr)
+			got, readErr := io.ReadAll(r)
+			require.NoError(t, readErr)
+			require.NoError(t, r.Close())
+			require.Equal(t, int64(len(want)), size)
+			require.Equal(t, want, got)
+		}
+
+		_, _, err = s.GetBlobSidecarReader(2, 5)
+		require.ErrorIs(t, err, store.ErrSidecarNotFound)
+		_, _, err = s.GetBlobSidecarReader(7, 0)
+		require.ErrorIs(t, err, store.ErrSidecarNotFound)
+	}
+	// The sidecars opened while looking for an index are closed.
+	require.Equal(t, int64(0), streamingDB.open.Load())
+}
+
+func TestStore_ExportStreamed(t *testing.T) {
+	mem, memDB := newArchiveTestStore(t, 1, 4)
+	streaming := store.New[*ctypes.BeaconBlockBody](
+		&streamingIndexDB{memIndexDB: memDB}, noop.NewLogger(), nil,
+	)
+
+	dst, dstDB := newArchiveTestStore(t, 0, 0)
+	imported, err := dst.Import(
+		context.Background(), bytes.NewReader(export(t, streaming, 0, 10)),
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), imported)
+	require.Equal(t, memDB.entries, dstDB.entries)
+	require.Len(t, export(t, streaming, 0, 10), len(export(t, mem, 0, 10)))
+
+	// A value that is not a sidecar is not exported.
+	require.NoError(t, memDB.Set(2, []byte("bad"), []byte("not a sidecar")))
+	var buf bytes.Buffer
+	_, err = streaming.Export(context.Background(), &buf, 0, 10)
+	require.ErrorContains(t, err, "sidecar at 2")
+}
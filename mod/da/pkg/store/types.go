@@ -21,6 +21,9 @@
 package store
 
 import (
+	"io"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -41,6 +44,40 @@ type IndexDB interface {
 	// Has
 	Has(index uint64, key []byte) (bool, error)
 	Set(index uint64, key []byte, value []byte) error
+	// SetBatch stores the values for the given keys at the given index
+	// atomically, either all of them are stored or none of them is.
+	SetBatch(index uint64, keys, values [][]byte) error
+	// Iterate calls fn for every entry with an index in [from, to), in
+	// ascending order of index.
+	Iterate(
+		from, to uint64, fn func(index uint64, key, value []byte) error,
+	) error
+}
+
+// StreamingIndexDB is an IndexDB that streams its values instead of reading
+// them whole.
+type StreamingIndexDB interface {
+	IndexDB
+	// Keys returns the keys stored at index.
+	Keys(index uint64) ([][]byte, error)
+	// GetReader returns a reader of the value of the key at index, with its
+	// length. The reader must be closed.
+	GetReader(index uint64, key []byte) (io.ReadCloser, int64, error)
+	// IterateReaders calls fn with a reader of every entry with an index in
+	// [from, to) and its length, in ascending order of index. A reader is
+	// only valid until fn returns.
+	IterateReaders(
+		from, to uint64,
+		fn func(index uint64, key []byte, r io.Reader, size int64) error,
+	) error
+}
+
+// SidecarVerifier verifies the proofs of blob sidecars.
+type SidecarVerifier interface {
+	// VerifyInclusionProofs verifies the inclusion proofs of the sidecars.
+	VerifyInclusionProofs(scs *types.BlobSidecars, kzgOffset uint64) error
+	// VerifyKZGProofs verifies the KZG proofs of the sidecars.
+	VerifyKZGProofs(scs *types.BlobSidecars) error
 }
 
 // BeaconBlockBody is the body of a beacon block.
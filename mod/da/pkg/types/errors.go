@@ -38,4 +38,9 @@ var (
 	// inclusion.
 	ErrInvalidInclusionProof = errors.New(
 		"invalid KZG commitment inclusion proof")
+
+	// ErrExceedsBlockBlobLimit is returned when there are more sidecars
+	// than the maximum number of blobs per block.
+	ErrExceedsBlockBlobLimit = errors.New(
+		"sidecars exceed the maximum number of blobs per block")
 )
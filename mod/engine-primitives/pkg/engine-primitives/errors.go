@@ -20,12 +20,20 @@
 
 package engineprimitives
 
-import "github.com/berachain/beacon-kit/mod/errors"
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
 
 var (
 	// ErrInvalidTimestamp indicates that the provided timestamp is not valid.
 	ErrInvalidTimestamp = errors.New("invalid timestamp")
 
+	// ErrPayloadTimestampMismatch indicates that the timestamp of a payload
+	// is not the one of its slot.
+	ErrPayloadTimestampMismatch = errors.New("payload timestamp mismatch")
+
 	// ErrInvalidRandao indicates that the provided RANDAO value is not valid.
 	ErrInvalidRandao = errors.New("invalid randao")
 
@@ -55,4 +63,66 @@ var (
 	ErrPayloadBlockHashMismatch = errors.New(
 		"block hash in payload does not match assembled block",
 	)
+
+	// ErrNilWithdrawal indicates that a withdrawal of a withdrawals list is
+	// nil.
+	ErrNilWithdrawal = errors.New("nil withdrawal")
+
+	// ErrWithdrawalIndexNotSequential indicates that the index of a
+	// withdrawal does not follow the one of the previous withdrawal.
+	ErrWithdrawalIndexNotSequential = errors.New(
+		"withdrawal index not sequential",
+	)
+
+	// ErrNilForkchoiceState indicates that a forkchoice update request has
+	// no forkchoice state.
+	ErrNilForkchoiceState = errors.New("nil forkchoice state")
+
+	// ErrZeroHeadBlockHash indicates that the head block hash of a
+	// forkchoice state is zero.
+	ErrZeroHeadBlockHash = errors.New("zero head block hash")
+
+	// ErrFinalizedWithoutSafe indicates that a forkchoice state has a
+	// finalized block hash but no safe block hash, while the finalized
+	// block is an ancestor of the safe block.
+	ErrFinalizedWithoutSafe = errors.New(
+		"finalized block hash without safe block hash",
+	)
+
+	// ErrUnknownForkVersion indicates that the fork version of a request is
+	// not a known fork version.
+	ErrUnknownForkVersion = errors.New("unknown fork version")
+
+	// ErrPayloadAttributesVersionMismatch indicates that the payload
+	// attributes of a forkchoice update request are not of its fork version.
+	ErrPayloadAttributesVersionMismatch = errors.New(
+		"payload attributes version mismatch",
+	)
+
+	// ErrTooManyTransactions indicates that a transactions list is longer
+	// than the maximum number of transactions of a payload.
+	ErrTooManyTransactions = errors.New("too many transactions")
 )
+
+// ErrTooManyWithdrawals is returned when a withdrawals list has more
+// withdrawals than allowed. Any ErrTooManyWithdrawals matches it with
+// errors.Is, whatever its counts.
+type ErrTooManyWithdrawals struct {
+	// Got is the number of withdrawals of the list.
+	Got uint64
+	// Max is the maximum number of withdrawals allowed.
+	Max uint64
+}
+
+// Error implements the error interface.
+func (e ErrTooManyWithdrawals) Error() string {
+	return fmt.Sprintf(
+		"too many withdrawals: got %d, max %d", e.Got, e.Max,
+	)
+}
+
+// Is returns whether target is an ErrTooManyWithdrawals.
+func (ErrTooManyWithdrawals) Is(target error) bool {
+	_, ok := target.(ErrTooManyWithdrawals)
+	return ok
+}
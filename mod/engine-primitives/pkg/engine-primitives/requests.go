@@ -27,6 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/trie"
 )
@@ -253,6 +254,46 @@ func BuildForkchoiceUpdateRequest(
 	}
 }
 
+// Validate returns an error if the request would be rejected by the
+// execution client, for it not to be sent: if the forkchoice state is nil,
+// has a zero head hash, or a finalized hash without a safe hash, if the
+// fork version is not known, or if the payload attributes are not of the
+// fork version or not valid.
+func (f *ForkchoiceUpdateRequest) Validate() error {
+	switch {
+	case f == nil || f.State == nil:
+		return ErrNilForkchoiceState
+	case f.State.HeadBlockHash == (common.ExecutionHash{}):
+		return ErrZeroHeadBlockHash
+	case f.State.SafeBlockHash == (common.ExecutionHash{}) &&
+		f.State.FinalizedBlockHash != (common.ExecutionHash{}):
+		return errors.Wrapf(
+			ErrFinalizedWithoutSafe, "finalized block hash %s",
+			f.State.FinalizedBlockHash,
+		)
+	case !version.IsKnown(f.ForkVersion):
+		return errors.Wrapf(
+			ErrUnknownForkVersion, "%s", version.Name(f.ForkVersion),
+		)
+	}
+
+	if f.PayloadAttributes == nil || f.PayloadAttributes.IsNil() {
+		return nil
+	}
+	if attrsVersion := f.PayloadAttributes.Version(); attrsVersion !=
+		f.ForkVersion {
+		return errors.Wrapf(
+			ErrPayloadAttributesVersionMismatch,
+			"payload attributes of %s for %s",
+			version.Name(attrsVersion), version.Name(f.ForkVersion),
+		)
+	}
+	if err := f.PayloadAttributes.Validate(); err != nil {
+		return errors.Wrap(err, "invalid payload attributes")
+	}
+	return nil
+}
+
 // GetPayloadRequest represents a request to get a payload.
 type GetPayloadRequest struct {
 	// PayloadID is the payload ID.
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives_test
+
+import (
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkchoiceUpdateRequest_Validate(t *testing.T) {
+	var (
+		head      = common.ExecutionHash{0x01}
+		safe      = common.ExecutionHash{0x02}
+		finalized = common.ExecutionHash{0x03}
+	)
+	newAttributes := func(
+		forkVersion uint32,
+	) *engineprimitives.PayloadAttributes[*engineprimitives.Withdrawal] {
+		attrs, err := engineprimitives.NewPayloadAttributes(
+			forkVersion, 12, primitives.Bytes32{0x04},
+			common.ExecutionAddress{0x05},
+			[]*engineprimitives.Withdrawal{}, primitives.Root{0x06},
+		)
+		require.NoError(t, err)
+		return attrs
+	}
+
+	tests := []struct {
+		name    string
+		req     *engineprimitives.ForkchoiceUpdateRequest
+		wantErr error
+	}{
+		{
+			name:    "nil request",
+			req:     nil,
+			wantErr: engineprimitives.ErrNilForkchoiceState,
+		},
+		{
+			name: "nil state",
+			req: engineprimitives.BuildForkchoiceUpdateRequest(
+				nil, nil, version.Deneb,
+			),
+			wantErr: engineprimitives.ErrNilForkchoiceState,
+		},
+		{
+			name: "zero head",
+			req: engineprimitives.BuildForkchoiceUpdateRequest(
+				&engineprimitives.ForkchoiceStateV1{
+					SafeBlockHash:      safe,
+					FinalizedBlockHash: finalized,
+				},
+				nil,
+				version.Deneb,
+			),
+			wantErr: engineprimitives.ErrZeroHeadBlockHash,
+		},
+		{
+			name: "finalized without safe",
+			req: engineprimitives.BuildForkchoiceUpdateRequest(
+				&engineprimitives.ForkchoiceStateV1{
+					HeadBlockHash:      head,
+					FinalizedBlockHash: finalized,
+				},
+				nil,
+				version.Deneb,
+			),
+			wantErr: engineprimitives.ErrFinalizedWithoutSafe,
+		},
+		{
+			name: "unknown fork version",
+			req: engineprimitives.BuildForkchoiceUpdateRequest(
+				&engineprimitives.ForkchoiceStateV1{HeadBlockHash: head},
+				nil,
+				42,
+			),
+			wantErr: engineprimitives.ErrUnknownForkVersion,
+		},
+		{
+			name: "attributes of another fork",
+			req: engineprimitives.BuildForkchoiceUpdateRequest(
+				&engineprimitives.ForkchoiceStateV1{HeadBlockHash: head},
+				newAttributes(version.Capella),
+				version.Deneb,
+			),
+			wantErr: engineprimitives.ErrPayloadAttributesVersionMismatch,
+		},
+		{
+			name: "invalid attributes",
+			req: engineprimitives.BuildForkchoiceUpdateRequest(
+				&engineprimitives.ForkchoiceStateV1{HeadBlockHash: head},
+				&engineprimitives.PayloadAttributes[*engineprimitives.Withdrawal]{},
+				version.Phase0,
+			),
+			wantErr: engineprimitives.ErrInvalidTimestamp,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, tt.req.Validate(), tt.wantErr)
+		})
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		for _, req := range []*engineprimitives.ForkchoiceUpdateRequest{
+			// The safe and finalized hashes are zero until known.
+			engineprimitives.BuildForkchoiceUpdateRequest(
+				&engineprimitives.ForkchoiceStateV1{HeadBlockHash: head},
+				nil,
+				version.Deneb,
+			),
+			engineprimitives.BuildForkchoiceUpdateRequest(
+				&engineprimitives.ForkchoiceStateV1{
+					HeadBlockHash: head,
+					SafeBlockHash: safe,
+				},
+				nil,
+				version.Deneb,
+			),
+			engineprimitives.BuildForkchoiceUpdateRequest(
+				&engineprimitives.ForkchoiceStateV1{
+					HeadBlockHash:      head,
+					SafeBlockHash:      safe,
+					FinalizedBlockHash: finalized,
+				},
+				newAttributes(version.Deneb),
+				version.Deneb,
+			),
+		} {
+			state := *req.State
+			want := *req
+			require.NoError(t, req.Validate())
+			require.Equal(t, want, *req)
+			require.Equal(t, state, *req.State)
+		}
+	})
+}
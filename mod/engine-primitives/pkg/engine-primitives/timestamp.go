@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// PayloadTimestampError is returned when the timestamp of a payload is not
+// the one of its slot. It matches ErrPayloadTimestampMismatch.
+type PayloadTimestampError struct {
+	// Slot is the slot of the payload.
+	Slot math.Slot
+	// Expected is the timestamp of the slot.
+	Expected uint64
+	// Actual is the timestamp of the payload.
+	Actual uint64
+}
+
+// Error implements error.
+func (e *PayloadTimestampError) Error() string {
+	return fmt.Sprintf(
+		"%s: slot %d, expected %d, got %d",
+		ErrPayloadTimestampMismatch, e.Slot, e.Expected, e.Actual,
+	)
+}
+
+// Unwrap returns ErrPayloadTimestampMismatch, for the error to be matched
+// with errors.Is.
+func (e *PayloadTimestampError) Unwrap() error {
+	return ErrPayloadTimestampMismatch
+}
+
+// VerifyPayloadTimestamp checks that the timestamp of the payload of slot is
+// the one of the slot. It is not checked if the chain spec does not define
+// the timestamps of the slots.
+func VerifyPayloadTimestamp(
+	cs primitives.ChainSpec,
+	slot math.Slot,
+	timestamp uint64,
+) error {
+	expected, ok := cs.SlotTimestamp(slot)
+	if !ok || timestamp == expected {
+		return nil
+	}
+	return &PayloadTimestampError{
+		Slot:     slot,
+		Expected: expected,
+		Actual:   timestamp,
+	}
+}
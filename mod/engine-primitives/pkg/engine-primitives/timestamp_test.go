@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives_test
+
+import (
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func timestampSpec(secondsPerSlot uint64) chain.Spec[
+	common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+] {
+	return chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+	]{
+		GenesisTime:    1_700_000_000,
+		SecondsPerSlot: secondsPerSlot,
+	})
+}
+
+func TestVerifyPayloadTimestamp(t *testing.T) {
+	tests := []struct {
+		name      string
+		timestamp uint64
+		err       bool
+	}{
+		{name: "ExactMatch", timestamp: 1_700_000_020},
+		{name: "OneSecondEarly", timestamp: 1_700_000_019, err: true},
+		{name: "OneSecondLate", timestamp: 1_700_000_021, err: true},
+		{name: "NextSlot", timestamp: 1_700_000_022, err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The timestamp of slot 10 is 10 slots of 2 seconds after the
+			// genesis.
+			err := engineprimitives.VerifyPayloadTimestamp(
+				timestampSpec(2), 10, tt.timestamp,
+			)
+			if !tt.err {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, engineprimitives.ErrPayloadTimestampMismatch)
+			var timestampErr *engineprimitives.PayloadTimestampError
+			require.ErrorAs(t, err, &timestampErr)
+			require.Equal(t, &engineprimitives.PayloadTimestampError{
+				Slot:     10,
+				Expected: 1_700_000_020,
+				Actual:   tt.timestamp,
+			}, timestampErr)
+		})
+	}
+}
+
+func TestVerifyPayloadTimestamp_NoSlotDuration(t *testing.T) {
+	// The slots follow the blocks of CometBFT, so any timestamp is accepted.
+	for _, timestamp := range []uint64{0, 1_700_000_019, 1_700_000_021} {
+		require.NoError(t, engineprimitives.VerifyPayloadTimestamp(
+			timestampSpec(0), 10, timestamp,
+		))
+	}
+}
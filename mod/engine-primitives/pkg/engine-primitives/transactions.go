@@ -21,29 +21,129 @@
 package engineprimitives
 
 import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/prysmaticlabs/gohashtree"
+	"golang.org/x/sync/errgroup"
 )
 
+// minTxsPerWorker is the minimum number of transactions hashed by each
+// worker, below which hashing them in parallel is not worth its overhead.
+const minTxsPerWorker = 16
+
+// txScratchPool holds the scratch buffers the transactions are hashed in,
+// so that the chunks of a transaction are not allocated at every hash.
+//
+//nolint:gochecknoglobals // pool.
+var txScratchPool = sync.Pool{
+	New: func() any { return new(txScratch) },
+}
+
 // Transactions is a typealias for [][]byte, which is how transactions are
 // received in the execution payload.
 type Transactions [][]byte
 
 // HashTreeRoot returns the hash tree root of the Transactions list.
+//
+// The roots of the transactions are computed by a pool of workers bounded
+// by GOMAXPROCS, each taking the next transaction not hashed yet so that
+// large transactions do not hold the others back, and are then merkleized
+// as a list of at most MaxTxsPerPayload roots.
 func (txs Transactions) HashTreeRoot() (primitives.Root, error) {
-	var err error
+	if uint64(len(txs)) > constants.MaxTxsPerPayload {
+		return primitives.Root{}, ErrTooManyTransactions
+	}
 
 	roots := make([]primitives.Root, len(txs))
-	for i, tx := range txs {
-		roots[i], err = ssz.MerkleizeByteSlice[math.U64, primitives.Root](tx)
-		if err != nil {
+	workers := min(runtime.GOMAXPROCS(0), len(txs)/minTxsPerWorker)
+	if workers <= 1 {
+		if err := txs.hashRoots(roots, new(atomic.Int64)); err != nil {
+			return primitives.Root{}, err
+		}
+	} else {
+		var (
+			next atomic.Int64
+			eg   errgroup.Group
+		)
+		for range workers {
+			eg.Go(func() error { return txs.hashRoots(roots, &next) })
+		}
+		if err := eg.Wait(); err != nil {
 			return primitives.Root{}, err
 		}
 	}
 
-	return ssz.MerkleizeListComposite[any, math.U64](
-		roots, constants.MaxTxsPerPayload,
-	)
+	// The tree is built from the roots only, with zero hashes in place of
+	// the empty subtrees up to the depth of the list limit.
+	root, err := merkle.NewRootWithMaxLeaves[
+		math.U64, primitives.Root, primitives.Root,
+	](roots, constants.MaxTxsPerPayload)
+	if err != nil {
+		return primitives.Root{}, err
+	}
+	return merkle.MixinLength(root, uint64(len(txs))), nil
+}
+
+// hashRoots sets the roots of the transactions, taking the index of the
+// next transaction to hash from next until all of them are hashed.
+func (txs Transactions) hashRoots(
+	roots []primitives.Root, next *atomic.Int64,
+) error {
+	scratch, _ := txScratchPool.Get().(*txScratch)
+	defer txScratchPool.Put(scratch)
+	for {
+		i := int(next.Add(1) - 1)
+		if i >= len(txs) {
+			return nil
+		}
+		root, err := scratch.root(txs[i])
+		if err != nil {
+			return err
+		}
+		roots[i] = root
+	}
+}
+
+// txScratch is a buffer a transaction is hashed in.
+type txScratch struct {
+	chunks [][32]byte
+}
+
+// root returns the root of tx, merkleized as a vector of chunks padded with
+// zero chunks to the next power of two.
+func (s *txScratch) root(tx []byte) (primitives.Root, error) {
+	//nolint:mnd // round up to a whole chunk.
+	numChunks := max((len(tx)+31)/constants.RootLength, 1)
+	width := int(math.U64(numChunks).NextPowerOfTwo())
+
+	// The leaves take the first width chunks, the layers above them are
+	// hashed alternately after the leaves and back at the start.
+	//nolint:mnd // the leaves and the first layer above them.
+	size := width + width/2
+	if cap(s.chunks) < size {
+		s.chunks = make([][32]byte, size)
+	}
+	s.chunks = s.chunks[:size]
+	leaves := s.chunks[:width]
+	for i := range leaves {
+		n := copy(leaves[i][:], tx[min(i*constants.RootLength, len(tx)):])
+		clear(leaves[i][n:])
+	}
+
+	layer, free := leaves, s.chunks[width:]
+	for len(layer) > 1 {
+		//nolint:mnd // each node hashes two children.
+		parents := free[:len(layer)/2]
+		if err := gohashtree.Hash(parents, layer); err != nil {
+			return primitives.Root{}, err
+		}
+		layer, free = parents, s.chunks
+	}
+	return layer[0], nil
 }
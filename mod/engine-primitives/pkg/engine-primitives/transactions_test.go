@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives_test
+
+import (
+	"math/rand"
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+	"github.com/stretchr/testify/require"
+)
+
+// mixedTxs returns n transactions of sizes from empty to 128KiB, around
+// and across the boundaries of a chunk.
+func mixedTxs(n int) engineprimitives.Transactions {
+	sizes := []int{0, 1, 31, 32, 33, 64, 65, 110, 250, 1024, 4096, 131072}
+	txs := make(engineprimitives.Transactions, n)
+	for i := range txs {
+		txs[i] = make([]byte, sizes[i%len(sizes)]+i%7)
+		for j := range txs[i] {
+			txs[i][j] = byte(i*31 + j*7)
+		}
+	}
+	return txs
+}
+
+// referenceRoot returns the root of txs by merkleizing each transaction as
+// a byte slice in turn.
+func referenceRoot(
+	t require.TestingT, txs engineprimitives.Transactions,
+) primitives.Root {
+	roots := make([]primitives.Root, len(txs))
+	for i, tx := range txs {
+		var err error
+		roots[i], err = ssz.MerkleizeByteSlice[math.U64, primitives.Root](tx)
+		require.NoError(t, err)
+	}
+	root, err := ssz.MerkleizeListComposite[any, math.U64](
+		roots, constants.MaxTxsPerPayload,
+	)
+	require.NoError(t, err)
+	return root
+}
+
+func TestTransactions_HashTreeRoot(t *testing.T) {
+	tests := []struct {
+		name string
+		txs  engineprimitives.Transactions
+		want string
+	}{
+		{
+			name: "no transactions",
+			txs:  engineprimitives.Transactions{},
+			want: "0x7ffe241ea60187fdb0187bfa22de35d1f9bed7ab061d9401fd47e34a54fbede1",
+		},
+		{
+			name: "single empty transaction",
+			txs:  engineprimitives.Transactions{{}},
+			want: "0x934debe22ad325d1d286ec806b590cd48b1b1cd4686f2aab9d804d10decb5be8",
+		},
+		{
+			name: "two transactions",
+			txs:  mixedTxs(2),
+			want: "0x3952915f706f54d0cbd22cf76460d37552cc35bb8c51a040b62acc9f82aeb363",
+		},
+		{
+			name: "every size once",
+			txs:  mixedTxs(12),
+			want: "0x640ad92e7b8a636cacce6939e0f472c746956ddd91194d474600b09517bb83de",
+		},
+		{
+			name: "hashed in parallel",
+			txs:  mixedTxs(1000),
+			want: "0xa43c36353febf17697dc92a26094e14d5c8f170730b98d3bfedda3503555614a",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, err := tt.txs.HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, root.String())
+		})
+	}
+}
+
+func TestTransactions_HashTreeRoot_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for range 20 {
+		txs := make(engineprimitives.Transactions, r.Intn(200))
+		for i := range txs {
+			txs[i] = make([]byte, r.Intn(2048))
+			r.Read(txs[i])
+		}
+		root, err := txs.HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, referenceRoot(t, txs), root)
+	}
+}
+
+func TestTransactions_HashTreeRoot_TooMany(t *testing.T) {
+	txs := make(
+		engineprimitives.Transactions, constants.MaxTxsPerPayload+1,
+	)
+	_, err := txs.HashTreeRoot()
+	require.ErrorIs(t, err, engineprimitives.ErrTooManyTransactions)
+}
+
+func BenchmarkTransactions_HashTreeRoot(b *testing.B) {
+	txs := mixedTxs(1000)
+	b.Run("reference", func(b *testing.B) {
+		for range b.N {
+			referenceRoot(b, txs)
+		}
+	})
+	b.Run("chunked", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			_, err := txs.HashTreeRoot()
+			require.NoError(b, err)
+		}
+	})
+}
@@ -21,12 +21,17 @@
 package engineprimitives
 
 import (
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+	fastssz "github.com/ferranbt/fastssz"
 )
 
+// withdrawalSize is the size of the SSZ encoding of a Withdrawal.
+const withdrawalSize = 44
+
 // Withdrawal represents a validator withdrawal from the consensus layer.
 //
 //go:generate go run github.com/ferranbt/fastssz/sszgen -path withdrawal.go -objs Withdrawal -include ../../../primitives/pkg/math,../../../primitives/pkg/common,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output withdrawal.ssz.go
@@ -72,12 +77,129 @@ func (w *Withdrawal) GetAmount() math.Gwei {
 }
 
 // Withdrawals represents a slice of withdrawals.
+//
+// Its SSZ encoding and hash tree root are the ones of a list of at most
+// MaxWithdrawalsPerPayload withdrawals, and longer lists are rejected with
+// an ErrTooManyWithdrawals rather than encoded past the limit.
 type Withdrawals []*Withdrawal
 
+// SizeSSZ returns the size of the SSZ encoding of the Withdrawals list.
+func (w Withdrawals) SizeSSZ() int {
+	return len(w) * withdrawalSize
+}
+
+// MarshalSSZ returns the SSZ encoding of the Withdrawals list.
+func (w Withdrawals) MarshalSSZ() ([]byte, error) {
+	return w.MarshalSSZTo(make([]byte, 0, w.SizeSSZ()))
+}
+
+// MarshalSSZTo appends the SSZ encoding of the Withdrawals list to dst.
+func (w Withdrawals) MarshalSSZTo(dst []byte) ([]byte, error) {
+	if err := checkWithdrawalsLimit(
+		len(w), constants.MaxWithdrawalsPerPayload,
+	); err != nil {
+		return nil, err
+	}
+	var err error
+	for i, withdrawal := range w {
+		if withdrawal == nil {
+			return nil, errors.Wrapf(ErrNilWithdrawal, "index %d", i)
+		}
+		if dst, err = withdrawal.MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	}
+	return dst, nil
+}
+
+// UnmarshalSSZ decodes the SSZ encoded Withdrawals list in buf.
+func (w *Withdrawals) UnmarshalSSZ(buf []byte) error {
+	if len(buf)%withdrawalSize != 0 {
+		return errors.Wrapf(
+			fastssz.ErrSize, "%d bytes is not a multiple of %d",
+			len(buf), withdrawalSize,
+		)
+	}
+	n := len(buf) / withdrawalSize
+	if err := checkWithdrawalsLimit(
+		n, constants.MaxWithdrawalsPerPayload,
+	); err != nil {
+		return err
+	}
+	withdrawals := make(Withdrawals, n)
+	for i := range withdrawals {
+		withdrawals[i] = new(Withdrawal)
+		if err := withdrawals[i].UnmarshalSSZ(
+			buf[i*withdrawalSize : (i+1)*withdrawalSize],
+		); err != nil {
+			return err
+		}
+	}
+	*w = withdrawals
+	return nil
+}
+
 // HashTreeRoot returns the hash tree root of the Withdrawals list.
 func (w Withdrawals) HashTreeRoot() (common.Root, error) {
-	// TODO: read max withdrawals from the chain spec.
+	if err := checkWithdrawalsLimit(
+		len(w), constants.MaxWithdrawalsPerPayload,
+	); err != nil {
+		return common.Root{}, err
+	}
+	for i, withdrawal := range w {
+		if withdrawal == nil {
+			return common.Root{}, errors.Wrapf(
+				ErrNilWithdrawal, "index %d", i,
+			)
+		}
+	}
 	return ssz.MerkleizeListComposite[any, math.U64](
 		w, constants.MaxWithdrawalsPerPayload,
 	)
 }
+
+// Validate returns an error if the Withdrawals list has more than
+// maxWithdrawals withdrawals, as configured for the fork of its payload,
+// has a nil withdrawal, or has withdrawal indices which do not follow each
+// other. The amounts are not checked.
+func (w Withdrawals) Validate(maxWithdrawals uint64) error {
+	for i, withdrawal := range w {
+		if withdrawal == nil {
+			return errors.Wrapf(ErrNilWithdrawal, "index %d", i)
+		}
+	}
+	return ValidateWithdrawals(w, maxWithdrawals)
+}
+
+// ValidateWithdrawals returns an error if there are more than maxWithdrawals
+// withdrawals, or if their indices do not follow each other. The
+// withdrawals must not be nil.
+func ValidateWithdrawals[WithdrawalT interface{ GetIndex() math.U64 }](
+	withdrawals []WithdrawalT, maxWithdrawals uint64,
+) error {
+	if err := checkWithdrawalsLimit(
+		len(withdrawals), maxWithdrawals,
+	); err != nil {
+		return err
+	}
+	for i := 1; i < len(withdrawals); i++ {
+		prev, index := withdrawals[i-1].GetIndex(), withdrawals[i].GetIndex()
+		if index != prev+1 {
+			return errors.Wrapf(
+				ErrWithdrawalIndexNotSequential,
+				"withdrawal %d has index %d after index %d",
+				i, index, prev,
+			)
+		}
+	}
+	return nil
+}
+
+// checkWithdrawalsLimit returns an ErrTooManyWithdrawals if n is above
+// maxWithdrawals.
+func checkWithdrawalsLimit(n int, maxWithdrawals uint64) error {
+	if uint64(n) > maxWithdrawals {
+		return ErrTooManyWithdrawals{Got: uint64(n), Max: maxWithdrawals}
+	}
+	return nil
+}
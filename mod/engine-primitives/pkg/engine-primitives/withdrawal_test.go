@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// sequentialWithdrawals returns n withdrawals with indices following each
+// other from first.
+func sequentialWithdrawals(first, n int) engineprimitives.Withdrawals {
+	withdrawals := make(engineprimitives.Withdrawals, n)
+	for i := range withdrawals {
+		withdrawals[i] = &engineprimitives.Withdrawal{
+			Index:     math.U64(first + i),
+			Validator: math.ValidatorIndex(i * 3),
+			Address:   common.ExecutionAddress{byte(i), 0xaa},
+			Amount:    math.Gwei(1e9 + i),
+		}
+	}
+	return withdrawals
+}
+
+// withdrawalsRoot returns the root of withdrawals as a list of at most
+// MaxWithdrawalsPerPayload containers, hashing the tree by hand.
+func withdrawalsRoot(
+	t *testing.T, withdrawals engineprimitives.Withdrawals,
+) common.Root {
+	t.Helper()
+	layer := make([][32]byte, constants.MaxWithdrawalsPerPayload)
+	for i, withdrawal := range withdrawals {
+		var err error
+		layer[i], err = withdrawal.HashTreeRoot()
+		require.NoError(t, err)
+	}
+	for len(layer) > 1 {
+		parents := make([][32]byte, len(layer)/2)
+		for i := range parents {
+			parents[i] = sha256.Sum256(
+				append(layer[2*i][:], layer[2*i+1][:]...),
+			)
+		}
+		layer = parents
+	}
+	var length [32]byte
+	binary.LittleEndian.PutUint64(length[:], uint64(len(withdrawals)))
+	return sha256.Sum256(append(layer[0][:], length[:]...))
+}
+
+func TestWithdrawals_HashTreeRoot(t *testing.T) {
+	for _, n := range []int{0, 1, 5, 16} {
+		withdrawals := sequentialWithdrawals(7, n)
+		root, err := withdrawals.HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, withdrawalsRoot(t, withdrawals), root, "n=%d", n)
+	}
+
+	// The length is mixed in, so that a list with a trailing zero
+	// withdrawal does not share the root of the list without it.
+	withdrawals := append(
+		sequentialWithdrawals(0, 3), &engineprimitives.Withdrawal{},
+	)
+	root, err := withdrawals.HashTreeRoot()
+	require.NoError(t, err)
+	shorter, err := withdrawals[:3].HashTreeRoot()
+	require.NoError(t, err)
+	require.NotEqual(t, shorter, root)
+}
+
+func TestWithdrawals_Limit(t *testing.T) {
+	atLimit := sequentialWithdrawals(0, 16)
+	overLimit := sequentialWithdrawals(0, 17)
+	tooMany := engineprimitives.ErrTooManyWithdrawals{Got: 17, Max: 16}
+
+	_, err := atLimit.HashTreeRoot()
+	require.NoError(t, err)
+	_, err = overLimit.HashTreeRoot()
+	require.Equal(t, tooMany, err)
+
+	bz, err := atLimit.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, bz, atLimit.SizeSSZ())
+	var decoded engineprimitives.Withdrawals
+	require.NoError(t, decoded.UnmarshalSSZ(bz))
+	require.Equal(t, atLimit, decoded)
+
+	_, err = overLimit.MarshalSSZ()
+	require.Equal(t, tooMany, err)
+	bz = append(bz, bz[:overLimit.SizeSSZ()-len(bz)]...)
+	decoded = nil
+	require.Equal(t, tooMany, decoded.UnmarshalSSZ(bz))
+	require.Nil(t, decoded)
+
+	require.NoError(t, atLimit.Validate(16))
+	require.Equal(t, tooMany, overLimit.Validate(16))
+	err = atLimit.Validate(4)
+	require.ErrorIs(t, err, engineprimitives.ErrTooManyWithdrawals{})
+	require.ErrorContains(t, err, "got 16, max 4")
+}
+
+func TestWithdrawals_UnmarshalSSZ_Size(t *testing.T) {
+	bz, err := sequentialWithdrawals(0, 2).MarshalSSZ()
+	require.NoError(t, err)
+	var decoded engineprimitives.Withdrawals
+	require.Error(t, decoded.UnmarshalSSZ(bz[:len(bz)-1]))
+}
+
+func TestWithdrawals_Validate(t *testing.T) {
+	require.NoError(t, engineprimitives.Withdrawals{}.Validate(16))
+
+	withdrawals := sequentialWithdrawals(3, 4)
+	withdrawals[2].Index = 9
+	require.ErrorIs(
+		t, withdrawals.Validate(16),
+		engineprimitives.ErrWithdrawalIndexNotSequential,
+	)
+
+	withdrawals = sequentialWithdrawals(3, 4)
+	withdrawals[1] = nil
+	require.ErrorIs(
+		t, withdrawals.Validate(16), engineprimitives.ErrNilWithdrawal,
+	)
+	_, err := withdrawals.HashTreeRoot()
+	require.ErrorIs(t, err, engineprimitives.ErrNilWithdrawal)
+	_, err = withdrawals.MarshalSSZ()
+	require.ErrorIs(t, err, engineprimitives.ErrNilWithdrawal)
+}
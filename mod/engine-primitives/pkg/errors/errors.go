@@ -21,7 +21,26 @@
 package errors
 
 import (
+	"fmt"
+
 	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// The codes of the errors of the engine API, stable for the node API and the
+// metrics to report them.
+//
+//nolint:lll // aligned.
+const (
+	CodeEngineAPITimeout              errors.Code = "ENGINE_API_TIMEOUT"
+	CodeUnknownPayload                errors.Code = "ENGINE_UNKNOWN_PAYLOAD"
+	CodeInvalidForkchoiceState        errors.Code = "ENGINE_INVALID_FORKCHOICE_STATE"
+	CodeInvalidPayloadAttributes      errors.Code = "ENGINE_INVALID_PAYLOAD_ATTRIBUTES"
+	CodeRequestTooLarge               errors.Code = "ENGINE_REQUEST_TOO_LARGE"
+	CodeAcceptedPayloadStatus         errors.Code = "ENGINE_PAYLOAD_ACCEPTED"
+	CodeSyncingPayloadStatus          errors.Code = "ENGINE_PAYLOAD_SYNCING"
+	CodeInvalidPayloadStatus          errors.Code = "ENGINE_PAYLOAD_INVALID"
+	CodeInvalidBlockHashPayloadStatus errors.Code = "ENGINE_PAYLOAD_INVALID_BLOCK_HASH"
 )
 
 var (
@@ -33,24 +52,31 @@ var (
 
 	// ErrUnknownPayload indicates an unavailable or non-existent payload
 	// (JSON-RPC code -38001).
-	ErrUnknownPayload = errors.New(
-		"payload does not exist or is not available")
+	ErrUnknownPayload = errors.WithCode(
+		errors.New("payload does not exist or is not available"),
+		CodeUnknownPayload,
+	)
 
 	// ErrInvalidForkchoiceState indicates an invalid fork choice state
 	// (JSON-RPC code -38002).
-	ErrInvalidForkchoiceState = errors.New(
-		"invalid forkchoice state")
+	ErrInvalidForkchoiceState = errors.WithCode(
+		errors.New("invalid forkchoice state"),
+		CodeInvalidForkchoiceState,
+	)
 
 	// ErrInvalidPayloadAttributes indicates invalid or inconsistent payload
 	// attributes
 	// (JSON-RPC code -38003).
-	ErrInvalidPayloadAttributes = errors.New(
-		"payload attributes are invalid / inconsistent")
+	ErrInvalidPayloadAttributes = errors.WithCode(
+		errors.New("payload attributes are invalid / inconsistent"),
+		CodeInvalidPayloadAttributes,
+	)
 
 	// ErrRequestTooLarge indicates that the request is too large
 	// (JSON-RPC code -38004).
-	ErrRequestTooLarge = errors.New(
-		"request is too large",
+	ErrRequestTooLarge = errors.WithCode(
+		errors.New("request is too large"),
+		CodeRequestTooLarge,
 	)
 
 	// ErrUnknownPayloadStatus indicates an unknown payload status.
@@ -58,22 +84,29 @@ var (
 		"unknown payload status")
 
 	// ErrAcceptedPayloadStatus indicates a payload status of ACCEPTED.
-	ErrAcceptedPayloadStatus = errors.New(
-		"payload status is ACCEPTED")
+	ErrAcceptedPayloadStatus = errors.WithCode(
+		errors.New("payload status is ACCEPTED"),
+		CodeAcceptedPayloadStatus,
+	)
 
 	// ErrSyncingPayloadStatus indicates a payload status of SYNCING.
-	ErrSyncingPayloadStatus = errors.New(
-		"payload status is SYNCING",
+	ErrSyncingPayloadStatus = errors.WithCode(
+		errors.New("payload status is SYNCING"),
+		CodeSyncingPayloadStatus,
 	)
 
 	// ErrInvalidPayloadStatus indicates an invalid payload status.
-	ErrInvalidPayloadStatus = errors.New(
-		"payload status is INVALID")
+	ErrInvalidPayloadStatus = errors.WithCode(
+		errors.New("payload status is INVALID"),
+		CodeInvalidPayloadStatus,
+	)
 
 	// ErrInvalidBlockHashPayloadStatus indicates a failure in validating the
 	// block hash for the payload.
-	ErrInvalidBlockHashPayloadStatus = errors.New(
-		"payload status is INVALID_BLOCK_HASH")
+	ErrInvalidBlockHashPayloadStatus = errors.WithCode(
+		errors.New("payload status is INVALID_BLOCK_HASH"),
+		CodeInvalidBlockHashPayloadStatus,
+	)
 
 	// ErrNilForkchoiceResponse indicates a nil forkchoice response.
 	ErrNilForkchoiceResponse = errors.New(
@@ -125,7 +158,53 @@ var (
 	)
 
 	// ErrEngineAPITimeout is returned when the engine API call times out.
-	ErrEngineAPITimeout = errors.New(
-		"engine API call timed out",
+	ErrEngineAPITimeout = errors.WithCode(
+		errors.New("engine API call timed out"),
+		CodeEngineAPITimeout,
 	)
 )
+
+// InvalidPayloadError is returned for a payload status of INVALID, with the
+// details given by the execution client. It wraps Status, the status error
+// it details, which is still matched by Is and gives it its code.
+type InvalidPayloadError struct {
+	// Status is ErrInvalidPayloadStatus or ErrInvalidBlockHashPayloadStatus.
+	Status error
+	// LatestValidHash is the hash of the most recent valid block in the
+	// branch of the payload, to move the head of the execution client back
+	// to. It is nil if the execution client does not know it, and zero if
+	// no block of the branch is valid.
+	LatestValidHash *common.ExecutionHash
+	// ValidationError is the reason given by the execution client, if any.
+	ValidationError string
+}
+
+// Error implements the error interface.
+func (e *InvalidPayloadError) Error() string {
+	msg := e.Status.Error()
+	if e.LatestValidHash != nil {
+		msg = fmt.Sprintf("%s, latest valid hash %s", msg, e.LatestValidHash)
+	}
+	if e.ValidationError != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.ValidationError)
+	}
+	return msg
+}
+
+// Unwrap returns the status error.
+func (e *InvalidPayloadError) Unwrap() error {
+	return e.Status
+}
+
+// LatestValidHash returns the latest valid hash of the INVALID payload
+// status err carries, and whether it is the hash of a valid block: it is
+// not if err carries no INVALID payload status, or the execution client did
+// not know it, or no block of the branch is valid.
+func LatestValidHash(err error) (common.ExecutionHash, bool) {
+	var invalidErr *InvalidPayloadError
+	if !errors.As(err, &invalidErr) || invalidErr.LatestValidHash == nil ||
+		*invalidErr.LatestValidHash == (common.ExecutionHash{}) {
+		return common.ExecutionHash{}, false
+	}
+	return *invalidErr.LatestValidHash, true
+}
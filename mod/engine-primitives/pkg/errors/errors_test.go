@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package errors_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrors_Wrapping(t *testing.T) {
+	tests := []struct {
+		sentinel error
+		code     errors.Code
+	}{
+		{engineerrors.ErrEngineAPITimeout, engineerrors.CodeEngineAPITimeout},
+		{engineerrors.ErrUnknownPayload, engineerrors.CodeUnknownPayload},
+		{
+			engineerrors.ErrInvalidForkchoiceState,
+			engineerrors.CodeInvalidForkchoiceState,
+		},
+		{
+			engineerrors.ErrInvalidPayloadAttributes,
+			engineerrors.CodeInvalidPayloadAttributes,
+		},
+		{engineerrors.ErrRequestTooLarge, engineerrors.CodeRequestTooLarge},
+		{
+			engineerrors.ErrSyncingPayloadStatus,
+			engineerrors.CodeSyncingPayloadStatus,
+		},
+		{
+			engineerrors.ErrInvalidPayloadStatus,
+			engineerrors.CodeInvalidPayloadStatus,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.code), func(t *testing.T) {
+			// The error is wrapped by the engine client, the payload builder
+			// and the validator service.
+			err := errors.Join(tt.sentinel, context.DeadlineExceeded)
+			err = errors.Wrap(err, "failed to get payload attributes")
+			err = errors.Newf("failed to retrieve payload: %w", err)
+
+			require.ErrorIs(t, err, tt.sentinel)
+			require.ErrorIs(t, err, context.DeadlineExceeded)
+			var codedErr *errors.CodedError
+			require.ErrorAs(t, err, &codedErr)
+			require.Equal(t, tt.code, codedErr.Code())
+			require.Equal(t, tt.code, errors.GetCode(err))
+		})
+	}
+}
+
+// rpcError is a JSON-RPC error, whose message embeds a block hash.
+type rpcError struct {
+	code int
+}
+
+func (rpcError) Error() string {
+	return "invalid ancestor 0x3f9d...c2a1 at offset 4512"
+}
+
+func (e rpcError) ErrorCode() int { return e.code }
+
+func TestMetricLabel(t *testing.T) {
+	wrap := func(err error) error {
+		return errors.Wrapf(err, "failed to notify forkchoice 0x%x", 42)
+	}
+	tests := []struct {
+		name  string
+		err   error
+		label string
+	}{
+		{"Nil", nil, engineerrors.LabelUnknown},
+		{"Deadline", wrap(context.DeadlineExceeded), engineerrors.LabelTimeout},
+		{
+			"EngineAPITimeout",
+			wrap(engineerrors.ErrEngineAPITimeout),
+			engineerrors.LabelTimeout,
+		},
+		{
+			"NetTimeout",
+			wrap(&net.OpError{Op: "read", Err: os.ErrDeadlineExceeded}),
+			engineerrors.LabelTimeout,
+		},
+		{
+			"ConnectionRefused",
+			wrap(&net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}),
+			engineerrors.LabelConnection,
+		},
+		{"EOF", wrap(io.ErrUnexpectedEOF), engineerrors.LabelConnection},
+		{
+			"InvalidPayload",
+			wrap(errors.Join(
+				engineerrors.ErrInvalidPayloadStatus, rpcError{-32000},
+			)),
+			engineerrors.LabelInvalidPayload,
+		},
+		{
+			"InvalidBlockHash",
+			wrap(engineerrors.ErrInvalidBlockHashPayloadStatus),
+			engineerrors.LabelInvalidPayload,
+		},
+		{"RPCCode", wrap(rpcError{-38001}), "rpc_code_-38001"},
+		{"Unknown", wrap(errors.New("block 0x01 not found")), "unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.label, engineerrors.MetricLabel(tt.err))
+		})
+	}
+}
+
+func TestInvalidPayloadError(t *testing.T) {
+	latestValidHash := common.ExecutionHash{0x01}
+	err := errors.Wrap(&engineerrors.InvalidPayloadError{
+		Status:          engineerrors.ErrInvalidPayloadStatus,
+		LatestValidHash: &latestValidHash,
+		ValidationError: "invalid state root",
+	}, "failed to verify payload")
+
+	require.ErrorIs(t, err, engineerrors.ErrInvalidPayloadStatus)
+	require.Equal(t, engineerrors.CodeInvalidPayloadStatus, errors.GetCode(err))
+	require.Equal(t, engineerrors.LabelInvalidPayload,
+		engineerrors.MetricLabel(err))
+	require.ErrorContains(t, err, latestValidHash.Hex())
+	require.ErrorContains(t, err, "invalid state root")
+	hash, ok := engineerrors.LatestValidHash(err)
+	require.True(t, ok)
+	require.Equal(t, latestValidHash, hash)
+
+	// Neither an unknown nor a zero latest valid hash is the hash of a
+	// valid block.
+	for _, latestValidHash := range []*common.ExecutionHash{
+		nil, {},
+	} {
+		_, ok = engineerrors.LatestValidHash(&engineerrors.InvalidPayloadError{
+			Status:          engineerrors.ErrInvalidPayloadStatus,
+			LatestValidHash: latestValidHash,
+		})
+		require.False(t, ok)
+	}
+	_, ok = engineerrors.LatestValidHash(engineerrors.ErrInvalidPayloadStatus)
+	require.False(t, ok)
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package errors
+
+import (
+	"context"
+	"io"
+	"net"
+	"strconv"
+	"syscall"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// The labels of the errors in the metrics. Unlike the messages of the
+// errors, which embed hashes and offsets, they are a bounded set.
+const (
+	LabelTimeout        = "timeout"
+	LabelConnection     = "connection"
+	LabelInvalidPayload = "invalid_payload"
+	LabelUnknown        = "unknown"
+	// labelRPCCodePrefix prefixes the JSON-RPC code of an error returned by
+	// the execution client.
+	labelRPCCodePrefix = "rpc_code_"
+)
+
+// MetricLabel returns the label of err for the metrics: timeout, connection,
+// invalid_payload, rpc_code_N for a JSON-RPC error of code N, or unknown.
+// The error itself is only meant for the logs.
+func MetricLabel(err error) string {
+	if err == nil {
+		return LabelUnknown
+	}
+
+	var netErr net.Error
+	isNetErr := errors.As(err, &netErr)
+	switch {
+	case errors.Is(err, context.DeadlineExceeded),
+		errors.GetCode(err) == CodeEngineAPITimeout,
+		isNetErr && netErr.Timeout():
+		return LabelTimeout
+	case errors.IsAny(
+		err, ErrInvalidPayloadStatus, ErrInvalidBlockHashPayloadStatus,
+	):
+		return LabelInvalidPayload
+	}
+
+	var rpcErr interface{ ErrorCode() int }
+	if errors.As(err, &rpcErr) {
+		return labelRPCCodePrefix + strconv.Itoa(rpcErr.ErrorCode())
+	}
+
+	if isNetErr || errors.IsAny(
+		err,
+		io.EOF,
+		io.ErrUnexpectedEOF,
+		syscall.ECONNREFUSED,
+		syscall.ECONNRESET,
+	) {
+		return LabelConnection
+	}
+	return LabelUnknown
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package errors
+
+import "github.com/cockroachdb/errors"
+
+// Code is a stable code identifying a class of errors, for the node API and
+// the metrics to report an error independently of its message.
+type Code string
+
+// CodeUnknown is the code of the errors without a code.
+const CodeUnknown Code = "UNKNOWN"
+
+// CodedError is an error with a code. It wraps the error it gives a code to,
+// which is still matched by Is and As.
+type CodedError struct {
+	error
+	// code is the code of the error.
+	code Code
+}
+
+// WithCode returns err with code, nil if err is nil. The errors wrapping it
+// have the same code, unless they are given another one.
+func WithCode(err error, code Code) error {
+	if err == nil {
+		return nil
+	}
+	return &CodedError{error: err, code: code}
+}
+
+// Code returns the code of the error.
+func (e *CodedError) Code() Code {
+	return e.code
+}
+
+// Unwrap returns the error given a code.
+func (e *CodedError) Unwrap() error {
+	return e.error
+}
+
+// GetCode returns the code of the outermost error with a code in the chain
+// of err, CodeUnknown if there is none.
+func GetCode(err error) Code {
+	var codedErr *CodedError
+	if errors.As(err, &codedErr) {
+		return codedErr.code
+	}
+	return CodeUnknown
+}
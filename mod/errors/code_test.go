@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package errors_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithCode(t *testing.T) {
+	const (
+		codeA errors.Code = "A"
+		codeB errors.Code = "B"
+	)
+	errA := errors.WithCode(errors.New("a"), codeA)
+	require.Equal(t, "a", errA.Error())
+	require.Equal(t, codeA, errors.GetCode(errA))
+	require.NoError(t, errors.WithCode(nil, codeA))
+
+	// The wrapping errors have the code of the error they wrap, unless they
+	// are given another one.
+	wrapped := errors.Wrap(errors.Newf("b: %w", errA), "c")
+	require.ErrorIs(t, wrapped, errA)
+	require.Equal(t, codeA, errors.GetCode(wrapped))
+	recoded := errors.WithCode(wrapped, codeB)
+	require.ErrorIs(t, recoded, errA)
+	require.Equal(t, codeB, errors.GetCode(recoded))
+	require.Equal(t, codeA, errors.GetCode(errors.Join(errors.New("d"), errA)))
+
+	require.Equal(t, errors.CodeUnknown, errors.GetCode(errors.New("e")))
+	require.Equal(t, errors.CodeUnknown, errors.GetCode(nil))
+}
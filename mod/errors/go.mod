@@ -2,7 +2,10 @@ module github.com/berachain/beacon-kit/mod/errors
 
 go 1.22.4
 
-require github.com/cockroachdb/errors v1.11.3
+require (
+	github.com/cockroachdb/errors v1.11.3
+	github.com/stretchr/testify v1.9.0
+)
 
 require (
 	github.com/cockroachdb/logtags v0.0.0-20230118201751-21c54148d20b // indirect
@@ -16,7 +19,6 @@ require (
 	github.com/pkg/errors v0.9.1 // indirect
 	github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2 // indirect
 	github.com/rogpeppe/go-internal v1.12.0 // indirect
-	github.com/stretchr/testify v1.9.0 // indirect
 	golang.org/x/sys v0.20.0 // indirect
 	golang.org/x/text v0.15.0 // indirect
 )
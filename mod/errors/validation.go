@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package errors
+
+import "strings"
+
+// FieldError is an error of the value of a configuration key.
+type FieldError struct {
+	// Key is the path of the configuration key, e.g.
+	// "beacon-kit.engine.rpc-timeout".
+	Key string
+	// Err is the problem of the value.
+	Err error
+}
+
+// Error returns the key followed by the problem of its value.
+func (e *FieldError) Error() string {
+	return e.Key + ": " + e.Err.Error()
+}
+
+// Unwrap returns the problem of the value.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// FieldErrors aggregates the errors of a configuration, so that all of them
+// are reported at once.
+type FieldErrors []*FieldError
+
+// Add records err for key if it is not nil.
+func (e *FieldErrors) Add(key string, err error) {
+	if err != nil {
+		*e = append(*e, &FieldError{Key: key, Err: err})
+	}
+}
+
+// Merge records the errors returned by the validation of the configuration
+// at prefix, prefixing their keys. An error that is not a FieldErrors is
+// recorded for prefix itself.
+func (e *FieldErrors) Merge(prefix string, err error) {
+	if err == nil {
+		return
+	}
+	var nested FieldErrors
+	if !As(err, &nested) {
+		e.Add(prefix, err)
+		return
+	}
+	for _, fieldErr := range nested {
+		e.Add(prefix+"."+fieldErr.Key, fieldErr.Err)
+	}
+}
+
+// Err returns e, or nil if no error was recorded.
+func (e FieldErrors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// Error lists the errors, one per line.
+func (e FieldErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, fieldErr := range e {
+		msgs[i] = fieldErr.Error()
+	}
+	return "invalid configuration:\n\t" + strings.Join(msgs, "\n\t")
+}
+
+// Unwrap returns the errors, so that they can be matched with Is and As.
+func (e FieldErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, fieldErr := range e {
+		errs[i] = fieldErr
+	}
+	return errs
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 )
 
@@ -52,15 +53,23 @@ type EngineClient[
 	// cfg is the supplied configuration for the engine client.
 	cfg *Config
 	// logger is the logger for the engine client.
-	logger log.Logger[any]
+	logger *log.ThrottledLogger[any]
 	// jwtSecret is the JWT secret for the execution client.
 	jwtSecret *jwt.Secret
 	// eth1ChainID is the chain ID of the execution client.
 	eth1ChainID *big.Int
 	// clientMetrics is the metrics for the engine client.
 	metrics *clientMetrics
+	// conn tracks whether the connection to the execution client is up
+	// from the outcomes of the calls to it.
+	conn *ConnectionTracker
 	// capabilities is a map of capabilities that the execution client has.
 	capabilities map[string]struct{}
+	// capabilitiesMu protects capabilities, which are exchanged again when
+	// checking the readiness of the execution client for a fork.
+	capabilitiesMu sync.RWMutex
+	// forks holds the engine API methods called for each fork.
+	forks *version.Registry[ForkMethods[ExecutionPayloadT]]
 	// engineCache is an all-in-one cache for data
 	// that are retrieved by the EngineClient.
 	engineCache *cache.EngineCache
@@ -70,6 +79,11 @@ type EngineClient[
 	statusErrMu *sync.RWMutex
 	// statusErr is the status error of the engine client.
 	statusErr error
+	// cancel cancels the context the JWT refresh loop was started with, it
+	// is nil when the engine client is not running.
+	cancel context.CancelFunc
+	// running tracks the goroutines of the engine client.
+	running sync.WaitGroup
 }
 
 // New creates a new engine client EngineClient.
@@ -90,15 +104,17 @@ func New[ExecutionPayloadT interface {
 	statusErrMu := new(sync.RWMutex)
 	return &EngineClient[ExecutionPayloadT]{
 		cfg:           cfg,
-		logger:        logger,
+		logger:        log.NewThrottledLogger(logger),
 		jwtSecret:     jwtSecret,
 		Eth1Client:    new(ethclient.Eth1Client[ExecutionPayloadT]),
 		capabilities:  make(map[string]struct{}),
 		statusErrMu:   statusErrMu,
 		statusErrCond: sync.NewCond(statusErrMu),
+		forks:         newForkRegistry[ExecutionPayloadT](),
 		engineCache:   cache.NewEngineCacheWithDefaultConfig(),
 		eth1ChainID:   eth1ChainID,
 		metrics:       newClientMetrics(telemetrySink, logger),
+		conn:          NewConnectionTracker(logger, telemetrySink),
 	}
 }
 
@@ -106,6 +122,7 @@ func New[ExecutionPayloadT interface {
 func (s *EngineClient[ExecutionPayloadT]) Start(
 	ctx context.Context,
 ) error {
+	ctx, s.cancel = context.WithCancel(ctx)
 	if s.cfg.RPCDialURL.IsHTTP() || s.cfg.RPCDialURL.IsHTTPS() {
 		// If we are dialing with HTTP(S), start the JWT refresh loop.
 		defer func() {
@@ -116,12 +133,44 @@ func (s *EngineClient[ExecutionPayloadT]) Start(
 				)
 				return
 			}
-			go s.jwtRefreshLoop(ctx)
+			s.running.Add(1)
+			go func() {
+				defer s.running.Done()
+				s.jwtRefreshLoop(ctx)
+			}()
 		}()
 	}
 	return s.initializeConnection(ctx)
 }
 
+// Stop stops the JWT refresh loop and closes the connection to the execution
+// client once the loop has exited, or ctx is done.
+func (s *EngineClient[ExecutionPayloadT]) Stop(ctx context.Context) error {
+	if s.cancel == nil {
+		return nil
+	}
+	s.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		s.running.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	s.statusErrMu.Lock()
+	defer s.statusErrMu.Unlock()
+	if s.Eth1Client != nil && s.Client != nil {
+		s.Client.Close()
+	}
+	return nil
+}
+
 // Status verifies the chain ID via JSON-RPC. By proxy
 // we will also verify the connection to the execution client.
 func (s *EngineClient[ExecutionPayloadT]) Status() error {
@@ -155,12 +204,14 @@ func (s *EngineClient[ExecutionPayloadT]) VerifyChainID(
 	ctx context.Context,
 ) error {
 	chainID, err := s.Client.ChainID(ctx)
+	s.conn.Observe(err)
 	if err != nil {
 		return err
 	}
 
 	if chainID.Uint64() != s.eth1ChainID.Uint64() {
-		return errors.Newf(
+		return errors.Wrapf(
+			ErrMismatchedChainID,
 			"wanted chain ID %d, got %d",
 			s.eth1ChainID,
 			chainID.Uint64(),
@@ -172,60 +223,59 @@ func (s *EngineClient[ExecutionPayloadT]) VerifyChainID(
 
 // ============================== HELPERS ==============================
 
+// initializeConnection probes the execution client every
+// RPCStartupCheckInterval until it answers, giving up once RPCStartupMaxWait
+// elapsed. The outcome of the last probe is reported by Status. An execution
+// client on another chain is a configuration error, it fails at once.
 func (s *EngineClient[ExecutionPayloadT]) initializeConnection(
 	ctx context.Context,
 ) error {
-	// Initialize the connection to the execution client.
-	var (
-		err     error
-		chainID *big.Int
-	)
-	for {
+	ticker := time.NewTicker(s.cfg.RPCStartupCheckInterval)
+	defer ticker.Stop()
+
+	start := time.Now()
+	for probes := 1; ; probes++ {
+		err := s.probe(ctx)
+		if err != nil && !errors.Is(err, ErrMismatchedChainID) &&
+			s.cfg.RPCStartupMaxWait > 0 &&
+			time.Since(start) >= s.cfg.RPCStartupMaxWait {
+			err = errors.Wrapf(
+				errors.Join(ErrExecutionClientUnavailable, err),
+				"no answer to %d probes in %s", probes, time.Since(start),
+			)
+		}
+		s.statusErrMu.Lock()
+		s.statusErr = err
+		s.statusErrMu.Unlock()
+
+		switch {
+		case err == nil:
+			return nil
+		case errors.Is(err, ErrMismatchedChainID):
+			s.logger.Error("execution client is on another chain", "err", err)
+			return err
+		case errors.Is(err, ErrExecutionClientUnavailable):
+			return err
+		}
+
 		s.logger.Info(
 			"waiting for execution client to start 🍺🕔",
 			"dial_url", s.cfg.RPCDialURL,
+			"probes", probes,
+			"elapsed", time.Since(start).Round(time.Second),
+			"err", err,
 		)
-		if err = s.setupExecutionClientConnection(ctx); err != nil {
-			s.statusErrMu.Lock()
-			s.statusErr = err
-			s.statusErrMu.Unlock()
-			time.Sleep(s.cfg.RPCStartupCheckInterval)
-			s.logger.Error("failed to setup execution client", "err", err)
-			continue
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
 		}
-		break
-	}
-	// Get the chain ID from the execution client.
-	chainID, err = s.ChainID(ctx)
-	if err != nil {
-		s.logger.Error("failed to get chain ID", "err", err)
-		return err
 	}
-
-	// Log the chain ID.
-	s.logger.Info(
-		"connected to execution client 🔌",
-		"dial_url",
-		s.cfg.RPCDialURL.String(),
-		"chain_id",
-		chainID.Uint64(),
-		"required_chain_id",
-		s.eth1ChainID,
-	)
-
-	// Exchange capabilities with the execution client.
-	if _, err = s.ExchangeCapabilities(ctx); err != nil {
-		s.logger.Error("failed to exchange capabilities", "err", err)
-		return err
-	}
-	return nil
 }
 
-// setupExecutionClientConnections dials the execution client and
-// ensures the chain ID is correct.
-func (s *EngineClient[ExecutionPayloadT]) setupExecutionClientConnection(
-	ctx context.Context,
-) error {
+// probe dials the execution client, ensures it is on the expected chain and
+// exchanges capabilities with it.
+func (s *EngineClient[ExecutionPayloadT]) probe(ctx context.Context) error {
 	// Dial the execution client.
 	if err := s.dialExecutionRPCClient(ctx); err != nil {
 		return err
@@ -240,6 +290,18 @@ func (s *EngineClient[ExecutionPayloadT]) setupExecutionClientConnection(
 		}
 		return err
 	}
+
+	// Exchange capabilities with the execution client.
+	if _, err := s.ExchangeCapabilities(ctx); err != nil {
+		s.Client.Close()
+		return err
+	}
+
+	s.logger.Info(
+		"connected to execution client 🔌",
+		"dial_url", s.cfg.RPCDialURL.String(),
+		"chain_id", s.eth1ChainID,
+	)
 	return nil
 }
 
@@ -354,8 +416,12 @@ func (s *EngineClient[ExecutionPayloadT]) Name() string {
 func (s *EngineClient[ExecutionPayloadT]) status(
 	ctx context.Context,
 ) error {
-	// If the client is not started, we return an error.
+	// If the client is not started, we return the outcome of the last
+	// probe, if any.
 	if s.Eth1Client.Client == nil {
+		if s.statusErr != nil {
+			return s.statusErr
+		}
 		return ErrNotStarted
 	}
 
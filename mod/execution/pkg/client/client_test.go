@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client_test
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/log"
+	metricstesting "github.com/berachain/beacon-kit/mod/primitives/pkg/metrics/testing"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// chainIDEL is an execution client answering the chain ID requests.
+type chainIDEL struct {
+	chainID uint64
+}
+
+func (el *chainIDEL) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(new(big.Int).SetUint64(el.chainID))
+}
+
+// startingEL serves an execution client over HTTP, failing the first down
+// requests as if it was not up yet, and counting the requests it receives.
+func startingEL(
+	t *testing.T, chainID uint64, down int64,
+) (*httptest.Server, *atomic.Int64) {
+	t.Helper()
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", &chainIDEL{chainID}))
+	require.NoError(t, server.RegisterName("engine", &capabilitiesEL{}))
+	t.Cleanup(server.Stop)
+
+	requests := new(atomic.Int64)
+	el := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if requests.Add(1) <= down {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			server.ServeHTTP(w, r)
+		},
+	))
+	t.Cleanup(el.Close)
+	return el, requests
+}
+
+// newStartupClient returns an engine client expecting chain ID 80087 from
+// the execution client at dialURL.
+func newStartupClient(
+	t *testing.T, dialURL string, maxWait time.Duration,
+) *client.EngineClient[*futurePayload] {
+	t.Helper()
+	var err error
+	cfg := client.DefaultConfig()
+	cfg.RPCDialURL, err = url.NewFromRaw(dialURL)
+	require.NoError(t, err)
+	cfg.RPCStartupCheckInterval = 10 * time.Millisecond
+	cfg.RPCStartupMaxWait = maxWait
+	ec := client.New[*futurePayload](
+		&cfg,
+		log.NewTestLogger(t),
+		nil,
+		metricstesting.NoopSink{},
+		big.NewInt(80087),
+	)
+	t.Cleanup(func() { require.NoError(t, ec.Stop(context.Background())) })
+	return ec
+}
+
+func TestEngineClient_Start(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("waits for the execution client", func(t *testing.T) {
+		el, requests := startingEL(t, 80087, 3)
+		ec := newStartupClient(t, el.URL, time.Minute)
+		require.ErrorIs(t, ec.Status(), client.ErrNotStarted)
+
+		// Each probe fails at its chain ID request until the execution
+		// client is up, then requests the chain ID and the capabilities.
+		require.NoError(t, ec.Start(ctx))
+		require.Equal(t, int64(5), requests.Load())
+		require.NoError(t, ec.Status())
+	})
+
+	t.Run("fails on another chain", func(t *testing.T) {
+		el, requests := startingEL(t, 1, 0)
+		ec := newStartupClient(t, el.URL, time.Minute)
+
+		// The chain ID is not probed again.
+		require.ErrorIs(t, ec.Start(ctx), client.ErrMismatchedChainID)
+		require.ErrorIs(t, ec.Status(), client.ErrMismatchedChainID)
+		require.Equal(t, int64(1), requests.Load())
+	})
+
+	t.Run("gives up after the max wait", func(t *testing.T) {
+		el, _ := startingEL(t, 80087, 1<<62)
+		ec := newStartupClient(t, el.URL, 50*time.Millisecond)
+
+		require.ErrorIs(t, ec.Start(ctx), client.ErrExecutionClientUnavailable)
+		require.ErrorIs(t, ec.Status(), client.ErrExecutionClientUnavailable)
+	})
+}
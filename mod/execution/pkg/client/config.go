@@ -23,6 +23,7 @@ package client
 import (
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
 )
 
@@ -31,9 +32,15 @@ const (
 	defaultRPCRetries              = 3
 	defaultRPCTimeout              = 2 * time.Second
 	defaultRPCStartupCheckInterval = 3 * time.Second
+	defaultRPCStartupMaxWait       = 5 * time.Minute
 	defaultRPCJWTRefreshInterval   = 30 * time.Second
+	defaultPayloadCacheSize        = 64
+	defaultInvalidPayloadRetention = 64
 	//#nosec:G101 // false positive.
 	defaultJWTSecretPath = "./jwt.hex"
+	// maxJWTRefreshInterval bounds the JWT refresh interval, since the
+	// execution client rejects tokens issued more than 60 seconds ago.
+	maxJWTRefreshInterval = 60 * time.Second
 )
 
 // DefaultConfig is the default configuration for the engine client.
@@ -45,8 +52,11 @@ func DefaultConfig() Config {
 		RPCRetries:              defaultRPCRetries,
 		RPCTimeout:              defaultRPCTimeout,
 		RPCStartupCheckInterval: defaultRPCStartupCheckInterval,
+		RPCStartupMaxWait:       defaultRPCStartupMaxWait,
 		RPCJWTRefreshInterval:   defaultRPCJWTRefreshInterval,
 		JWTSecretPath:           defaultJWTSecretPath,
+		PayloadCacheSize:        defaultPayloadCacheSize,
+		InvalidPayloadRetention: defaultInvalidPayloadRetention,
 	}
 }
 
@@ -63,8 +73,76 @@ type Config struct {
 	RPCTimeout time.Duration `mapstructure:"rpc-timeout"`
 	// RPCStartupCheckInterval is the Interval for the startup check.
 	RPCStartupCheckInterval time.Duration `mapstructure:"rpc-startup-check-interval"`
+	// RPCStartupMaxWait is how long the execution client is waited for at
+	// startup, 0 waits for it indefinitely.
+	RPCStartupMaxWait time.Duration `mapstructure:"rpc-startup-max-wait"`
 	// JWTRefreshInterval is the Interval for the JWT refresh.
 	RPCJWTRefreshInterval time.Duration `mapstructure:"rpc-jwt-refresh-interval"`
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
+	// PayloadCacheSize is the number of recent payloads found VALID by the
+	// execution client which are not sent to it again, 0 disables the
+	// cache.
+	PayloadCacheSize int `mapstructure:"payload-cache-size"`
+	// InvalidPayloadRetention is the number of slots the payloads found
+	// INVALID by the execution client are remembered for, to fail their
+	// descendants without sending them to it, 0 disables it.
+	InvalidPayloadRetention uint64 `mapstructure:"invalid-payload-retention"`
+}
+
+// Validate returns the problems of the configuration.
+func (c Config) Validate() error {
+	var errs errors.FieldErrors
+	switch {
+	case c.RPCDialURL == nil || c.RPCDialURL.URL == nil:
+		errs.Add("rpc-dial-url", errors.New("must be set"))
+	case c.RPCDialURL.IsIPC():
+		if c.RPCDialURL.Path == "" {
+			errs.Add("rpc-dial-url", errors.Newf(
+				"ipc url has no path: %s", c.RPCDialURL,
+			))
+		}
+	case c.RPCDialURL.IsHTTP() || c.RPCDialURL.IsHTTPS():
+		if c.RPCDialURL.Host == "" {
+			errs.Add("rpc-dial-url", errors.Newf(
+				"url has no host: %s", c.RPCDialURL,
+			))
+		}
+	default:
+		errs.Add("rpc-dial-url", errors.Newf(
+			"unsupported scheme %q, expected http, https or ipc",
+			c.RPCDialURL.Scheme,
+		))
+	}
+	for _, field := range []struct {
+		key string
+		d   time.Duration
+	}{
+		{"rpc-timeout", c.RPCTimeout},
+		{"rpc-startup-check-interval", c.RPCStartupCheckInterval},
+		{"rpc-jwt-refresh-interval", c.RPCJWTRefreshInterval},
+	} {
+		if field.d <= 0 {
+			errs.Add(field.key, errors.Newf(
+				"must be positive, got %s", field.d,
+			))
+		}
+	}
+	if c.RPCStartupMaxWait < 0 {
+		errs.Add("rpc-startup-max-wait", errors.Newf(
+			"must not be negative, got %s", c.RPCStartupMaxWait,
+		))
+	}
+	if c.PayloadCacheSize < 0 {
+		errs.Add("payload-cache-size", errors.Newf(
+			"must not be negative, got %d", c.PayloadCacheSize,
+		))
+	}
+	if c.RPCJWTRefreshInterval >= maxJWTRefreshInterval {
+		errs.Add("rpc-jwt-refresh-interval", errors.Newf(
+			"must be below %s, got %s",
+			maxJWTRefreshInterval, c.RPCJWTRefreshInterval,
+		))
+	}
+	return errs.Err()
 }
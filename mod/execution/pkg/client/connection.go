@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+)
+
+// defaultConnectionFailureThreshold is the default number of consecutive
+// transport failures after which the connection is down.
+const defaultConnectionFailureThreshold = 3
+
+// ConnectionOption configures a ConnectionTracker.
+type ConnectionOption func(*connectionConfig)
+
+// connectionConfig is the configuration of a ConnectionTracker.
+type connectionConfig struct {
+	now              func() time.Time
+	failureThreshold int
+}
+
+// WithConnectionClock sets the clock the outages are measured by, time.Now
+// by default.
+func WithConnectionClock(now func() time.Time) ConnectionOption {
+	return func(c *connectionConfig) {
+		c.now = now
+	}
+}
+
+// WithFailureThreshold sets the number of consecutive transport failures
+// after which the connection is down, 3 by default.
+func WithFailureThreshold(failureThreshold int) ConnectionOption {
+	return func(c *connectionConfig) {
+		c.failureThreshold = max(failureThreshold, 1)
+	}
+}
+
+// ConnectionTracker tracks whether the connection to the execution client
+// is up from the outcomes of the calls to it. The connection is down until
+// a first call succeeds, and goes down after consecutive calls failing on
+// the transport, a timeout or a connection error. An error answered by the
+// execution client is a success of the transport. Each transition is logged
+// once, and exported as a 0/1 gauge, with a counter of the reconnections
+// and the duration of the last outage. It is safe for concurrent use.
+type ConnectionTracker struct {
+	cfg     connectionConfig
+	logger  log.Logger[any]
+	metrics *clientMetrics
+
+	mu sync.Mutex
+	// up is whether the connection is up.
+	up bool
+	// connected is whether the connection was ever up.
+	connected bool
+	// failures is the number of consecutive transport failures.
+	failures int
+	// failingSince is the time of the first of the consecutive transport
+	// failures, when the outage started.
+	failingSince time.Time
+}
+
+// NewConnectionTracker returns a ConnectionTracker of a connection not up
+// yet, logging its transitions to logger and exporting its metrics to sink.
+func NewConnectionTracker(
+	logger log.Logger[any],
+	sink TelemetrySink,
+	opts ...ConnectionOption,
+) *ConnectionTracker {
+	cfg := connectionConfig{
+		now:              time.Now,
+		failureThreshold: defaultConnectionFailureThreshold,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	c := &ConnectionTracker{
+		cfg:     cfg,
+		logger:  logger,
+		metrics: newClientMetrics(sink, logger),
+	}
+	c.metrics.setConnectionUp(false)
+	return c
+}
+
+// Up returns whether the connection is up.
+func (c *ConnectionTracker) Up() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.up
+}
+
+// Observe records the outcome of a call to the execution client, err being
+// the error of the call before it is handled. A call canceled by the caller
+// says nothing of the connection, it is ignored.
+func (c *ConnectionTracker) Observe(err error) {
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !isTransportError(err) {
+		c.succeeded()
+		return
+	}
+	if c.failures == 0 {
+		c.failingSince = c.cfg.now()
+	}
+	c.failures++
+	if c.up && c.failures >= c.cfg.failureThreshold {
+		c.up = false
+		c.metrics.setConnectionUp(false)
+		c.logger.Error(
+			"connection to execution client is down 🔌",
+			"failures", c.failures,
+			"error", err,
+		)
+	}
+}
+
+// succeeded records a call the execution client answered. The lock must be
+// held.
+func (c *ConnectionTracker) succeeded() {
+	defer func() { c.failures = 0 }()
+	if c.up {
+		return
+	}
+
+	c.up = true
+	c.metrics.setConnectionUp(true)
+	if !c.connected {
+		c.connected = true
+		c.logger.Info("connection to execution client is up 🔌")
+		return
+	}
+	outage := c.cfg.now().Sub(c.failingSince)
+	c.metrics.markReconnect(outage)
+	c.logger.Info(
+		"connection to execution client is back up 🔌",
+		"outage", outage,
+	)
+}
+
+// isTransportError returns whether err is a failure of the transport to the
+// execution client, which did not answer.
+func isTransportError(err error) bool {
+	if err == nil {
+		return false
+	}
+	switch engineerrors.MetricLabel(err) {
+	case engineerrors.LabelTimeout, engineerrors.LabelConnection:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/log"
+	metricstesting "github.com/berachain/beacon-kit/mod/primitives/pkg/metrics/testing"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	connectionUpKey  = "beacon_kit.execution.client.connection_up"
+	reconnectsKey    = "beacon_kit.execution.client.reconnects"
+	lastOutageMsKey  = "beacon_kit.execution.client.last_outage_duration_ms"
+	scriptedCallStep = time.Second
+)
+
+var (
+	errRefused = &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+	errAnswer  = rpc.ErrNoResult
+	errCode    = codeError(-38001)
+)
+
+// codeError is a JSON-RPC error answered by the execution client.
+type codeError int
+
+func (e codeError) Error() string  { return "unknown payload" }
+func (e codeError) ErrorCode() int { return int(e) }
+
+// scriptedTracker returns a connection tracker whose clock steps a second
+// per call observed, with the sink it exports its metrics to.
+func scriptedTracker(
+	t *testing.T,
+) (*client.ConnectionTracker, *metricstesting.RecordingSink, func(error)) {
+	t.Helper()
+	now := time.Unix(0, 0)
+	sink := metricstesting.NewRecordingSink()
+	tracker := client.NewConnectionTracker(
+		log.NewTestLogger(t), sink,
+		client.WithConnectionClock(func() time.Time { return now }),
+		client.WithFailureThreshold(3),
+	)
+	return tracker, sink, func(err error) {
+		tracker.Observe(err)
+		now = now.Add(scriptedCallStep)
+	}
+}
+
+func TestConnectionTracker(t *testing.T) {
+	tests := []struct {
+		name       string
+		calls      []error
+		up         bool
+		gauge      int64
+		reconnects int
+		outageMs   int64
+	}{
+		{
+			name:  "NotUpBeforeFirstAnswer",
+			calls: nil,
+			up:    false,
+			gauge: 0,
+		},
+		{
+			name:  "UpOnFirstAnswer",
+			calls: []error{errRefused, errRefused, errRefused, nil},
+			up:    true,
+			gauge: 1,
+		},
+		{
+			name:  "ErrorAnsweredIsUp",
+			calls: []error{errCode},
+			up:    true,
+			gauge: 1,
+		},
+		{
+			name:  "FailuresBelowThresholdStayUp",
+			calls: []error{nil, io.EOF, errRefused, nil, io.EOF, errRefused},
+			up:    true,
+			gauge: 1,
+		},
+		{
+			name: "ConsecutiveFailuresGoDown",
+			calls: []error{
+				nil, io.EOF, context.DeadlineExceeded, errRefused, errRefused,
+			},
+			up:    false,
+			gauge: 0,
+		},
+		{
+			name:  "CanceledCallsIgnored",
+			calls: []error{context.Canceled, nil, context.Canceled},
+			up:    true,
+			gauge: 1,
+		},
+		{
+			name: "Reconnect",
+			calls: []error{
+				nil, io.EOF, errRefused, errRefused, errRefused, errAnswer,
+			},
+			up:         true,
+			gauge:      1,
+			reconnects: 1,
+			outageMs:   4000,
+		},
+		{
+			name: "TwoReconnects",
+			calls: []error{
+				nil,
+				io.EOF, io.EOF, io.EOF, nil,
+				errRefused, errRefused, errRefused, errRefused, errRefused,
+				errCode,
+			},
+			up:         true,
+			gauge:      1,
+			reconnects: 2,
+			outageMs:   5000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker, sink, call := scriptedTracker(t)
+			for _, err := range tt.calls {
+				call(err)
+			}
+
+			require.Equal(t, tt.up, tracker.Up())
+			gauge, ok := sink.LastGauge(connectionUpKey)
+			require.True(t, ok)
+			require.Equal(t, tt.gauge, gauge)
+			require.Equal(t, tt.reconnects, sink.CountFor(reconnectsKey))
+			outageMs, ok := sink.LastGauge(lastOutageMsKey)
+			require.Equal(t, tt.reconnects > 0, ok)
+			require.Equal(t, tt.outageMs, outageMs)
+		})
+	}
+}
+
+func TestConnectionTracker_TransitionsOnce(t *testing.T) {
+	tracker, sink, call := scriptedTracker(t)
+	call(nil)
+	for range 10 {
+		call(errRefused)
+	}
+	require.False(t, tracker.Up())
+	for range 10 {
+		call(nil)
+	}
+	require.True(t, tracker.Up())
+
+	// The gauge is set once at construction and once per transition: up,
+	// down and up again.
+	var values []float64
+	for _, c := range sink.Calls() {
+		if c.Kind == metricstesting.KindGauge && c.Key == connectionUpKey {
+			values = append(values, c.Value)
+		}
+	}
+	require.Equal(t, []float64{0, 1, 0, 1}, values)
+	require.Equal(t, 1, sink.CountFor(reconnectsKey))
+}
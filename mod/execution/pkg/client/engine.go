@@ -27,12 +27,15 @@ import (
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
 	"github.com/berachain/beacon-kit/mod/errors"
-	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
+// feeRecipientWarningInterval is the minimum interval between two warnings
+// about an unset suggested fee recipient, which would otherwise be logged at every block.
+const feeRecipientWarningInterval = 5 * time.Minute
+
 // NewPayload calls the engine_newPayloadVX method via JSON-RPC.
 func (s *EngineClient[ExecutionPayloadT]) NewPayload(
 	ctx context.Context,
@@ -55,6 +58,7 @@ func (s *EngineClient[ExecutionPayloadT]) NewPayload(
 		parentBeaconBlockRoot,
 	)
 	if err != nil {
+		err = withTimeoutCause(dctx, err)
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementNewPayloadTimeout()
 		}
@@ -63,39 +67,28 @@ func (s *EngineClient[ExecutionPayloadT]) NewPayload(
 		return nil, engineerrors.ErrNilPayloadStatus
 	}
 
-	// This case is only true when the payload is invalid, so
-	// `processPayloadStatusResult` below will return an error.
-	if validationErr := result.ValidationError; validationErr != nil {
-		s.logger.Error(
-			"Got a validation error in newPayload",
-			"err",
-			errors.New(*validationErr),
-		)
-	}
-
+	// The validation error of an INVALID payload is carried by the error
+	// returned.
 	return processPayloadStatusResult(result)
 }
 
-// callNewPayloadRPC calls the engine_newPayloadVX method via JSON-RPC.
+// callNewPayloadRPC calls the engine_newPayloadVX method of the fork of the
+// payload via JSON-RPC.
 func (s *EngineClient[ExecutionPayloadT]) callNewPayloadRPC(
 	ctx context.Context,
-	payload ExecutionPayload,
+	payload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *primitives.Root,
 ) (*engineprimitives.PayloadStatusV1, error) {
-	switch payload.Version() {
-	case version.Deneb:
-		return s.NewPayloadV3(
-			ctx,
-			payload,
-			versionedHashes,
-			parentBeaconBlockRoot,
-		)
-	case version.Electra:
-		return nil, errors.New("TODO: implement Electra payload")
-	default:
-		return nil, engineerrors.ErrInvalidPayloadType
+	methods, err := s.forks.Get(payload.Version())
+	if err != nil {
+		return nil, err
 	}
+	result, err := methods.NewPayload(
+		s.Eth1Client, ctx, payload, versionedHashes, parentBeaconBlockRoot,
+	)
+	s.conn.Observe(err)
+	return result, err
 }
 
 // ForkchoiceUpdated calls the engine_forkchoiceUpdatedV1 method via JSON-RPC.
@@ -115,20 +108,28 @@ func (s *EngineClient[ExecutionPayloadT]) ForkchoiceUpdated(
 	// If the suggested fee recipient is not set, log a warning.
 	if attrs != nil && !attrs.IsNil() &&
 		attrs.GetSuggestedFeeRecipient() == (common.ZeroAddress) {
-		s.logger.Warn(
+		s.logger.WarnThrottled(
+			"fee-recipient-not-configured",
+			feeRecipientWarningInterval,
 			"suggested fee recipient is not configured 🔆",
 			"fee-recipent", common.DisplayBytes(
 				common.ZeroAddress[:]).TerminalString(),
 		)
 	}
 
-	result, err := s.callUpdatedForkchoiceRPC(dctx, state, attrs, forkVersion)
-
+	// Call the engine_forkchoiceUpdatedVX method of the fork.
+	methods, err := s.forks.Get(forkVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+	result, err := methods.ForkchoiceUpdated(s.Eth1Client, dctx, state, attrs)
+	s.conn.Observe(err)
 	if err != nil {
+		err = withTimeoutCause(dctx, s.handleRPCError(err))
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementForkchoiceUpdateTimeout()
 		}
-		return nil, nil, s.handleRPCError(err)
+		return nil, nil, err
 	} else if result == nil {
 		return nil, nil, engineerrors.ErrNilForkchoiceResponse
 	}
@@ -140,24 +141,6 @@ func (s *EngineClient[ExecutionPayloadT]) ForkchoiceUpdated(
 	return result.PayloadID, latestValidHash, nil
 }
 
-// updateForkChoiceByVersion calls the engine_forkchoiceUpdatedVX method via
-// JSON-RPC.
-func (s *EngineClient[ExecutionPayloadT]) callUpdatedForkchoiceRPC(
-	ctx context.Context,
-	state *engineprimitives.ForkchoiceStateV1,
-	attrs engineprimitives.PayloadAttributer,
-	forkVersion uint32,
-) (*engineprimitives.ForkchoiceResponseV1, error) {
-	switch forkVersion {
-	case version.Deneb:
-		return s.ForkchoiceUpdatedV3(ctx, state, attrs)
-	case version.Electra:
-		return nil, errors.New("TODO: implement Electra hardfork")
-	default:
-		return nil, engineerrors.ErrInvalidPayloadAttributes
-	}
-}
-
 // GetPayload calls the engine_getPayloadVX method via JSON-RPC. It returns
 // the execution data as well as the blobs bundle.
 func (s *EngineClient[ExecutionPayloadT]) GetPayload(
@@ -173,29 +156,25 @@ func (s *EngineClient[ExecutionPayloadT]) GetPayload(
 	defer cancel()
 
 	// Determine what version we want to call.
-	var fn func(
-		context.Context, engineprimitives.PayloadID,
-	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
-	switch forkVersion {
-	case version.Deneb:
-		fn = s.GetPayloadV3
-	case version.Electra:
-		return nil, errors.New("TODO: implement Electra getPayload")
-	default:
-		return nil, engineerrors.ErrInvalidGetPayloadVersion
+	methods, err := s.forks.Get(forkVersion)
+	if err != nil {
+		return nil, err
 	}
 
 	// Call and check for errors.
-	result, err := fn(dctx, payloadID)
+	result, err := methods.GetPayload(s.Eth1Client, dctx, payloadID)
+	s.conn.Observe(err)
 	switch {
 	case err != nil:
+		err = withTimeoutCause(dctx, s.handleRPCError(err))
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementGetPayloadTimeout()
 		}
-		return result, s.handleRPCError(err)
+		return result, err
 	case result == nil:
 		return result, engineerrors.ErrNilExecutionPayloadEnvelope
-	case result.GetBlobsBundle() == nil && forkVersion >= version.Deneb:
+	case result.GetBlobsBundle() == nil &&
+		version.IsAtLeast(forkVersion, version.Deneb):
 		return result, engineerrors.ErrNilBlobsBundle
 	}
 
@@ -207,9 +186,9 @@ func (s *EngineClient[ExecutionPayloadT]) GetPayload(
 func (s *EngineClient[ExecutionPayloadT]) ExchangeCapabilities(
 	ctx context.Context,
 ) ([]string, error) {
-	result, err := s.Eth1Client.ExchangeCapabilities(
-		ctx, ethclient.BeaconKitSupportedCapabilities(),
-	)
+	supported := s.supportedCapabilities()
+	result, err := s.Eth1Client.ExchangeCapabilities(ctx, supported)
+	s.conn.Observe(err)
 	if err != nil {
 		s.statusErrMu.Lock()
 		defer s.statusErrMu.Unlock()
@@ -219,14 +198,18 @@ func (s *EngineClient[ExecutionPayloadT]) ExchangeCapabilities(
 	}
 
 	// Capture and log the capabilities that the execution client has.
+	capabilities := make(map[string]struct{}, len(result))
 	for _, capability := range result {
 		s.logger.Info("exchanged capability", "capability", capability)
-		s.capabilities[capability] = struct{}{}
+		capabilities[capability] = struct{}{}
 	}
+	s.capabilitiesMu.Lock()
+	s.capabilities = capabilities
+	s.capabilitiesMu.Unlock()
 
 	// Log the capabilities that the execution client does not have.
-	for _, capability := range ethclient.BeaconKitSupportedCapabilities() {
-		if _, exists := s.capabilities[capability]; !exists {
+	for _, capability := range supported {
+		if _, exists := capabilities[capability]; !exists {
 			s.logger.Warn(
 				"your execution client may require an update 🚸",
 				"unsupported_capability", capability,
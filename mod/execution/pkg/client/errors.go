@@ -21,6 +21,8 @@
 package client
 
 import (
+	"context"
+
 	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/http"
@@ -47,8 +49,30 @@ const (
 var (
 	// ErrNotStarted indicates that the execution client is not started.
 	ErrNotStarted = errors.New("engine client is not started")
+
+	// ErrMismatchedChainID indicates that the execution client is not on the
+	// expected chain.
+	ErrMismatchedChainID = errors.New("execution client chain ID mismatch")
+
+	// ErrExecutionClientUnavailable indicates that the execution client did
+	// not answer before the startup wait elapsed.
+	ErrExecutionClientUnavailable = errors.New(
+		"execution client unavailable at startup",
+	)
 )
 
+// withTimeoutCause returns err joined with ErrEngineAPITimeout if the call
+// failed as ctx reached the RPC timeout. The RPC client returns the error of
+// the context, which does not match the cause of its timeout.
+func withTimeoutCause(ctx context.Context, err error) error {
+	if err == nil ||
+		errors.Is(err, engineerrors.ErrEngineAPITimeout) ||
+		!errors.Is(context.Cause(ctx), engineerrors.ErrEngineAPITimeout) {
+		return err
+	}
+	return errors.Join(engineerrors.ErrEngineAPITimeout, err)
+}
+
 // Handles errors received from the RPC server according to the specification.
 func (s *EngineClient[ExecutionPayloadDenebT]) handleRPCError(err error) error {
 	// Exit early if there is no error.
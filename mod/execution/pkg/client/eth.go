@@ -75,3 +75,25 @@ func (s *EngineClient[ExecutionPayloadDenebT]) HeaderByHash(
 	s.engineCache.AddHeader(header)
 	return header, nil
 }
+
+// IsSyncing returns whether the execution client is syncing. It fails with
+// ErrNotStarted until the client has been dialed.
+func (s *EngineClient[ExecutionPayloadDenebT]) IsSyncing(
+	ctx context.Context,
+) (bool, error) {
+	if s.Eth1Client.Client == nil {
+		return false, ErrNotStarted
+	}
+	progress, err := s.SyncProgress(ctx)
+	if err != nil {
+		return false, err
+	}
+	if progress == nil {
+		s.metrics.setSyncDistance(0)
+		return false, nil
+	}
+	s.metrics.setSyncDistance(
+		progress.HighestBlock - min(progress.CurrentBlock, progress.HighestBlock),
+	)
+	return true, nil
+}
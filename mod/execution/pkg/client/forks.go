@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// ForkMethods are the engine API methods called by the engine client for
+// a fork, registered with RegisterFork. They are called on the Eth1Client
+// of the engine client.
+type ForkMethods[
+	ExecutionPayloadT interface {
+		Empty(uint32) ExecutionPayloadT
+		Version() uint32
+		json.Marshaler
+		json.Unmarshaler
+	},
+] struct {
+	// Capabilities are the engine API methods of the fork, which the
+	// execution client must advertise through engine_exchangeCapabilities
+	// to be ready for it.
+	Capabilities []string
+	// NewPayload calls the engine_newPayloadVX method of the fork.
+	NewPayload func(
+		client *ethclient.Eth1Client[ExecutionPayloadT],
+		ctx context.Context,
+		payload ExecutionPayloadT,
+		versionedHashes []common.ExecutionHash,
+		parentBeaconBlockRoot *primitives.Root,
+	) (*engineprimitives.PayloadStatusV1, error)
+	// ForkchoiceUpdated calls the engine_forkchoiceUpdatedVX method of the
+	// fork.
+	ForkchoiceUpdated func(
+		client *ethclient.Eth1Client[ExecutionPayloadT],
+		ctx context.Context,
+		state *engineprimitives.ForkchoiceStateV1,
+		attrs engineprimitives.PayloadAttributer,
+	) (*engineprimitives.ForkchoiceResponseV1, error)
+	// GetPayload calls the engine_getPayloadVX method of the fork.
+	GetPayload func(
+		client *ethclient.Eth1Client[ExecutionPayloadT],
+		ctx context.Context,
+		payloadID engineprimitives.PayloadID,
+	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
+}
+
+// newForkRegistry returns the registry of the engine API methods of the
+// forks, with those of Deneb registered.
+func newForkRegistry[
+	ExecutionPayloadT interface {
+		Empty(uint32) ExecutionPayloadT
+		Version() uint32
+		json.Marshaler
+		json.Unmarshaler
+	},
+]() *version.Registry[ForkMethods[ExecutionPayloadT]] {
+	r := version.NewRegistry[ForkMethods[ExecutionPayloadT]]("engine client")
+	r.MustRegister(version.Deneb, ForkMethods[ExecutionPayloadT]{
+		Capabilities: []string{
+			ethclient.NewPayloadMethodV3,
+			ethclient.ForkchoiceUpdatedMethodV3,
+			ethclient.GetPayloadMethodV3,
+		},
+		NewPayload: func(
+			client *ethclient.Eth1Client[ExecutionPayloadT],
+			ctx context.Context,
+			payload ExecutionPayloadT,
+			versionedHashes []common.ExecutionHash,
+			parentBeaconBlockRoot *primitives.Root,
+		) (*engineprimitives.PayloadStatusV1, error) {
+			return client.NewPayloadV3(
+				ctx, payload, versionedHashes, parentBeaconBlockRoot,
+			)
+		},
+		ForkchoiceUpdated: (*ethclient.Eth1Client[ExecutionPayloadT]).
+			ForkchoiceUpdatedV3,
+		GetPayload: (*ethclient.Eth1Client[ExecutionPayloadT]).GetPayloadV3,
+	})
+	return r
+}
+
+// RegisterFork registers the engine API methods called for the fork of
+// forkVersion.
+func (s *EngineClient[ExecutionPayloadT]) RegisterFork(
+	forkVersion uint32, methods ForkMethods[ExecutionPayloadT],
+) error {
+	return s.forks.Register(forkVersion, methods)
+}
+
+// MissingCapabilities exchanges the capabilities with the execution client
+// and returns the engine API methods of the fork of forkVersion it does not
+// advertise, none if it is ready for the fork.
+func (s *EngineClient[ExecutionPayloadT]) MissingCapabilities(
+	ctx context.Context, forkVersion uint32,
+) ([]string, error) {
+	methods, err := s.forks.Get(forkVersion)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.ExchangeCapabilities(ctx); err != nil {
+		return nil, err
+	}
+
+	s.capabilitiesMu.RLock()
+	defer s.capabilitiesMu.RUnlock()
+	var missing []string
+	for _, capability := range methods.Capabilities {
+		if _, ok := s.capabilities[capability]; !ok {
+			missing = append(missing, capability)
+		}
+	}
+	return missing, nil
+}
+
+// supportedCapabilities returns the capabilities of the beacon kit client,
+// with the engine API methods of the registered forks.
+func (s *EngineClient[ExecutionPayloadT]) supportedCapabilities() []string {
+	supported := ethclient.BeaconKitSupportedCapabilities()
+	for _, forkVersion := range s.forks.Versions() {
+		//#nosec:G703 // the version is registered.
+		methods, _ := s.forks.Get(forkVersion)
+		for _, capability := range methods.Capabilities {
+			if !slices.Contains(supported, capability) {
+				supported = append(supported, capability)
+			}
+		}
+	}
+	return supported
+}
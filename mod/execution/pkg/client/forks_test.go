@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	metricstesting "github.com/berachain/beacon-kit/mod/primitives/pkg/metrics/testing"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// futureFork is the version of a fork the engine client does not support
+// by default.
+const futureFork = version.Electra + 1
+
+// futurePayload is a payload of the future fork.
+type futurePayload struct {
+	BlockHash common.ExecutionHash `json:"blockHash"`
+}
+
+func (*futurePayload) Empty(uint32) *futurePayload {
+	return new(futurePayload)
+}
+
+func (*futurePayload) Version() uint32 { return futureFork }
+
+func (p *futurePayload) MarshalJSON() ([]byte, error) {
+	type payload futurePayload
+	return json.Marshal((*payload)(p))
+}
+
+func (p *futurePayload) UnmarshalJSON(bz []byte) error {
+	type payload futurePayload
+	return json.Unmarshal(bz, (*payload)(p))
+}
+
+type futureEnvelope = engineprimitives.ExecutionPayloadEnvelope[
+	*futurePayload,
+	*engineprimitives.BlobsBundleV1[
+		eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+	],
+]
+
+// futureEL is an execution client building the payloads of the future fork,
+// recording the engine API calls it receives.
+type futureEL struct {
+	payloadID engineprimitives.PayloadID
+	built     *futurePayload
+	calls     []string
+	requested engineprimitives.PayloadID
+	received  *futurePayload
+}
+
+func (el *futureEL) methods() client.ForkMethods[*futurePayload] {
+	valid := engineprimitives.PayloadStatusV1{
+		Status: engineprimitives.PayloadStatusValid,
+	}
+	return client.ForkMethods[*futurePayload]{
+		NewPayload: func(
+			_ *ethclient.Eth1Client[*futurePayload],
+			_ context.Context,
+			payload *futurePayload,
+			_ []common.ExecutionHash,
+			_ *primitives.Root,
+		) (*engineprimitives.PayloadStatusV1, error) {
+			el.calls = append(el.calls, "newPayload")
+			el.received = payload
+			return &valid, nil
+		},
+		ForkchoiceUpdated: func(
+			_ *ethclient.Eth1Client[*futurePayload],
+			_ context.Context,
+			_ *engineprimitives.ForkchoiceStateV1,
+			_ engineprimitives.PayloadAttributer,
+		) (*engineprimitives.ForkchoiceResponseV1, error) {
+			el.calls = append(el.calls, "forkchoiceUpdated")
+			return &engineprimitives.ForkchoiceResponseV1{
+				PayloadStatus: valid,
+				PayloadID:     &el.payloadID,
+			}, nil
+		},
+		GetPayload: func(
+			_ *ethclient.Eth1Client[*futurePayload],
+			_ context.Context,
+			payloadID engineprimitives.PayloadID,
+		) (engineprimitives.BuiltExecutionPayloadEnv[*futurePayload], error) {
+			el.calls = append(el.calls, "getPayload")
+			el.requested = payloadID
+			return &futureEnvelope{
+				ExecutionPayload: el.built,
+				BlobsBundle: &engineprimitives.BlobsBundleV1[
+					eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+				]{},
+			}, nil
+		},
+	}
+}
+
+func newFutureClient(t *testing.T) *client.EngineClient[*futurePayload] {
+	t.Helper()
+	cfg := client.DefaultConfig()
+	return client.New[*futurePayload](
+		&cfg,
+		log.NewTestLogger(t),
+		nil,
+		metricstesting.NoopSink{},
+		big.NewInt(80087),
+	)
+}
+
+func TestEngineClient_RegisterFork(t *testing.T) {
+	ctx := context.Background()
+	ec := newFutureClient(t)
+	el := &futureEL{
+		payloadID: engineprimitives.PayloadID{0x01},
+		built:     &futurePayload{BlockHash: common.ExecutionHash{0x02}},
+	}
+
+	// The future fork is not supported until registered.
+	_, _, err := ec.ForkchoiceUpdated(
+		ctx, &engineprimitives.ForkchoiceStateV1{}, nil, futureFork,
+	)
+	require.Equal(t, version.ErrForkNotSupported{
+		Version:   futureFork,
+		Subsystem: "engine client",
+	}, err)
+	_, err = ec.GetPayload(ctx, el.payloadID, futureFork)
+	require.ErrorIs(t, err, version.ErrForkNotSupported{})
+	_, err = ec.NewPayload(ctx, el.built, nil, nil)
+	require.ErrorIs(t, err, version.ErrForkNotSupported{})
+
+	require.NoError(t, ec.RegisterFork(futureFork, el.methods()))
+	require.ErrorIs(t,
+		ec.RegisterFork(futureFork, el.methods()),
+		version.ErrForkAlreadyRegistered,
+	)
+
+	// Build a payload of the future fork and notify it back.
+	payloadID, _, err := ec.ForkchoiceUpdated(
+		ctx, &engineprimitives.ForkchoiceStateV1{}, nil, futureFork,
+	)
+	require.NoError(t, err)
+	require.Equal(t, el.payloadID, *payloadID)
+	envelope, err := ec.GetPayload(ctx, *payloadID, futureFork)
+	require.NoError(t, err)
+	_, err = ec.NewPayload(
+		ctx, envelope.GetExecutionPayload(), nil, &primitives.Root{},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		[]string{"forkchoiceUpdated", "getPayload", "newPayload"}, el.calls,
+	)
+	require.Equal(t, el.payloadID, el.requested)
+	require.Same(t, el.built, el.received)
+}
+
+// capabilitiesEL is an execution client advertising a fixed set of engine
+// API methods.
+type capabilitiesEL struct {
+	capabilities []string
+}
+
+func (el *capabilitiesEL) ExchangeCapabilities([]string) []string {
+	return el.capabilities
+}
+
+func TestEngineClient_MissingCapabilities(t *testing.T) {
+	ctx := context.Background()
+	el := &capabilitiesEL{}
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("engine", el))
+	t.Cleanup(server.Stop)
+
+	var err error
+	ec := newFutureClient(t)
+	ec.Eth1Client, err = ethclient.NewFromRPCClient[*futurePayload](
+		rpc.DialInProc(server),
+	)
+	require.NoError(t, err)
+
+	// An execution client missing a method of Deneb is not ready for it.
+	el.capabilities = []string{
+		ethclient.NewPayloadMethodV3, ethclient.ForkchoiceUpdatedMethodV3,
+	}
+	missing, err := ec.MissingCapabilities(ctx, version.Deneb)
+	require.NoError(t, err)
+	require.Equal(t, []string{ethclient.GetPayloadMethodV3}, missing)
+
+	el.capabilities = append(el.capabilities, ethclient.GetPayloadMethodV3)
+	missing, err = ec.MissingCapabilities(ctx, version.Deneb)
+	require.NoError(t, err)
+	require.Empty(t, missing)
+
+	// The engine client itself must support the future fork.
+	_, err = ec.MissingCapabilities(ctx, futureFork)
+	require.ErrorIs(t, err, version.ErrForkNotSupported{})
+
+	methods := (&futureEL{}).methods()
+	methods.Capabilities = []string{"engine_newPayloadV9"}
+	require.NoError(t, ec.RegisterFork(futureFork, methods))
+	missing, err = ec.MissingCapabilities(ctx, futureFork)
+	require.NoError(t, err)
+	require.Equal(t, []string{"engine_newPayloadV9"}, missing)
+
+	el.capabilities = append(el.capabilities, "engine_newPayloadV9")
+	missing, err = ec.MissingCapabilities(ctx, futureFork)
+	require.NoError(t, err)
+	require.Empty(t, missing)
+}
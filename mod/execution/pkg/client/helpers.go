@@ -32,7 +32,9 @@ import (
 )
 
 // processPayloadStatusResult processes the payload status result and
-// returns the latest valid hash or an error.
+// returns the latest valid hash or an error. An INVALID status is returned
+// as an InvalidPayloadError, carrying the latest valid hash and the
+// validation error of the result.
 func processPayloadStatusResult(
 	result *engineprimitives.PayloadStatusV1,
 ) (*common.ExecutionHash, error) {
@@ -42,7 +44,14 @@ func processPayloadStatusResult(
 	case engineprimitives.PayloadStatusSyncing:
 		return nil, engineerrors.ErrSyncingPayloadStatus
 	case engineprimitives.PayloadStatusInvalid:
-		return result.LatestValidHash, engineerrors.ErrInvalidPayloadStatus
+		invalidErr := &engineerrors.InvalidPayloadError{
+			Status:          engineerrors.ErrInvalidPayloadStatus,
+			LatestValidHash: result.LatestValidHash,
+		}
+		if result.ValidationError != nil {
+			invalidErr.ValidationError = *result.ValidationError
+		}
+		return result.LatestValidHash, invalidErr
 	case engineprimitives.PayloadStatusValid:
 		return result.LatestValidHash, nil
 	default:
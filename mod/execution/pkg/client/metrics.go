@@ -55,12 +55,42 @@ func (cm *clientMetrics) measureForkchoiceUpdateDuration(startTime time.Time) {
 	)
 }
 
-// measureNewPayloadDuration measures the duration of the new payload.
+// measureNewPayloadDuration records the duration of the new payload, in
+// seconds, to its histogram.
 func (cm *clientMetrics) measureNewPayloadDuration(startTime time.Time) {
 	// TODO: Add Labels.
-	cm.sink.MeasureSince(
-		"beacon_kit.execution.client.new_payload_duration",
-		startTime,
+	cm.sink.ObserveHistogram(
+		"beacon_kit.execution.client.new_payload_duration_seconds",
+		time.Since(startTime).Seconds(),
+	)
+}
+
+// setSyncDistance sets the number of blocks the execution client is behind
+// the highest block it knows of, zero once synced.
+func (cm *clientMetrics) setSyncDistance(distance uint64) {
+	cm.sink.SetGauge(
+		"beacon_kit.execution.client.sync_distance", int64(distance),
+	)
+}
+
+// setConnectionUp sets the gauge of the connection to the execution client
+// to 1 if it is up, 0 otherwise.
+func (cm *clientMetrics) setConnectionUp(up bool) {
+	var value int64
+	if up {
+		value = 1
+	}
+	cm.sink.SetGauge("beacon_kit.execution.client.connection_up", value)
+}
+
+// markReconnect increments the counter of the reconnections to the
+// execution client, and sets the duration of the outage before it, in
+// milliseconds.
+func (cm *clientMetrics) markReconnect(outage time.Duration) {
+	cm.sink.IncrementCounter("beacon_kit.execution.client.reconnects")
+	cm.sink.SetGauge(
+		"beacon_kit.execution.client.last_outage_duration_ms",
+		outage.Milliseconds(),
 	)
 }
 
@@ -41,4 +41,7 @@ type TelemetrySink interface {
 	// MeasureSince measures the time since the provided start time,
 	// identified by the provided keys.
 	MeasureSince(key string, start time.Time, args ...string)
+	// ObserveHistogram records the value in the distribution of a histogram
+	// metric identified by the provided keys.
+	ObserveHistogram(key string, value float64, args ...string)
 }
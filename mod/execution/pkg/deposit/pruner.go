@@ -21,14 +21,19 @@
 package deposit
 
 import (
-	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
+// BuildPruneRangeFn returns a function that computes the range of deposits to
+// prune when a block is finalized. Deposits are processed in order of index,
+// so every deposit below the eth1 deposit index of the state after the
+// finalized block has been processed. Every deposit below that index, minus
+// the safety margin, is pruned, so deposits that are not yet reflected in the
+// state are never pruned.
 func BuildPruneRangeFn[
 	BeaconBlockBodyT BeaconBlockBody[DepositT, ExecutionPayloadT],
 	BeaconBlockT BeaconBlock[DepositT, BeaconBlockBodyT, ExecutionPayloadT],
-	BlockEventT BlockEvent[
+	FinalizedBlockEventT FinalizedBlockEvent[
 		DepositT, BeaconBlockBodyT, BeaconBlockT, ExecutionPayloadT,
 	],
 	DepositT Deposit[DepositT, WithdrawalCredentialsT],
@@ -36,20 +41,12 @@ func BuildPruneRangeFn[
 		GetNumber() math.U64
 	},
 	WithdrawalCredentialsT any,
-
-](cs primitives.ChainSpec) func(BlockEventT) (uint64, uint64) {
-	return func(event BlockEventT) (uint64, uint64) {
-		deposits := event.Data().GetBody().GetDeposits()
-		if len(deposits) == 0 {
+](safetyMargin uint64) func(FinalizedBlockEventT) (uint64, uint64) {
+	return func(event FinalizedBlockEventT) (uint64, uint64) {
+		depositIndex := event.Data().Eth1DepositIndex
+		if depositIndex <= safetyMargin {
 			return 0, 0
 		}
-		index := deposits[len(deposits)-1].GetIndex()
-
-		end := min(index, cs.MaxDepositsPerBlock())
-		if index < cs.MaxDepositsPerBlock() {
-			return 0, end
-		}
-
-		return index - cs.MaxDepositsPerBlock(), end
+		return 0, depositIndex - safetyMargin
 	}
 }
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+type testDeposit struct{ index uint64 }
+
+func (testDeposit) New(
+	crypto.BLSPubkey, any, math.U64, crypto.BLSSignature, uint64,
+) testDeposit {
+	return testDeposit{}
+}
+
+func (d testDeposit) GetIndex() uint64 { return d.index }
+
+func (d testDeposit) GetPubkey() crypto.BLSPubkey {
+	return crypto.BLSPubkey{byte(d.index)}
+}
+
+func (d testDeposit) GetAmount() math.Gwei { return math.Gwei(d.index) * 1e9 }
+
+type testPayload struct{}
+
+func (testPayload) GetNumber() math.U64 { return 0 }
+
+type testBody struct{ deposits []testDeposit }
+
+func (b testBody) GetDeposits() []testDeposit { return b.deposits }
+
+func (testBody) GetExecutionPayload() testPayload { return testPayload{} }
+
+type testBlock struct{ body testBody }
+
+func (testBlock) GetSlot() math.U64 { return 0 }
+
+func (b testBlock) GetBody() testBody { return b.body }
+
+type testEvent struct {
+	block        testBlock
+	depositIndex uint64
+}
+
+func (testEvent) Name() string { return "" }
+
+func (testEvent) Is(string) bool { return true }
+
+func (testEvent) Context() context.Context { return context.Background() }
+
+func (e testEvent) Data() *events.BeaconBlockFinalizedEvent[testBlock] {
+	return &events.BeaconBlockFinalizedEvent[testBlock]{
+		Block:            e.block,
+		Eth1DepositIndex: e.depositIndex,
+	}
+}
+
+// finalize returns the event of a finalized block with deposits in
+// [from, to), after which the eth1 deposit index of the state is
+// depositIndex.
+func finalize(from, to, depositIndex uint64) testEvent {
+	deposits := make([]testDeposit, 0, to-from)
+	for index := from; index < to; index++ {
+		deposits = append(deposits, testDeposit{index: index})
+	}
+	return testEvent{testBlock{testBody{deposits: deposits}}, depositIndex}
+}
+
+func TestBuildPruneRangeFn(t *testing.T) {
+	const safetyMargin = 4
+	pruneRangeFn := deposit.BuildPruneRangeFn[
+		testBody, testBlock, testEvent, testDeposit, testPayload, any,
+	](safetyMargin)
+
+	steps := []struct {
+		name  string
+		event testEvent
+		end   uint64
+	}{
+		{
+			name:  "NoDeposits",
+			event: finalize(0, 0, 0),
+			end:   0,
+		},
+		{
+			name:  "WithinSafetyMargin",
+			event: finalize(0, 4, 4),
+			end:   0,
+		},
+		{
+			name:  "BeyondSafetyMargin",
+			event: finalize(4, 10, 10),
+			end:   6,
+		},
+		{
+			name:  "BlockWithoutDepositsKeepsBoundary",
+			event: finalize(0, 0, 10),
+			end:   6,
+		},
+		{
+			name:  "FinalizationProgresses",
+			event: finalize(10, 11, 11),
+			end:   7,
+		},
+		{
+			// The deposits of the state are pruned even if the pruner did
+			// not see the blocks that contained them, e.g. after a restart.
+			name:  "BoundaryFollowsState",
+			event: finalize(0, 0, 20),
+			end:   16,
+		},
+	}
+
+	for _, step := range steps {
+		start, end := pruneRangeFn(step.event)
+		require.Zero(t, start, step.name)
+		require.Equal(t, step.end, end, step.name)
+	}
+}
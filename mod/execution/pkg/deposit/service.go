@@ -22,9 +22,12 @@ package deposit
 
 import (
 	"context"
+	"sync"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -60,12 +63,21 @@ type Service[
 		ExecutionPayloadT,
 		SubscriptionT,
 	]
+	// depositFeed is the event feed for the stored deposits.
+	depositFeed EventFeed[*feed.Event[*events.DepositProcessedEvent]]
 	// metrics is the metrics for the deposit service.
 	metrics *depositMetrics
 	// newBlock is the channel for new blocks.
 	newBlock chan BeaconBlockT
-	// failedBlocks
+	// failedBlocksMu protects failedBlocks.
+	failedBlocksMu sync.Mutex
+	// failedBlocks are the blocks whose deposits could not be fetched.
 	failedBlocks map[math.U64]struct{}
+	// cancel cancels the context the goroutines of the service were started
+	// with, it is nil when the service is not running.
+	cancel context.CancelFunc
+	// running tracks the goroutines of the service.
+	running sync.WaitGroup
 }
 
 // NewService creates a new instance of the Service struct.
@@ -94,6 +106,7 @@ func NewService[
 		DepositT, BeaconBlockBodyT, BeaconBlockT, BlockEventT,
 		ExecutionPayloadT, SubscriptionT,
 	],
+	depositFeed EventFeed[*feed.Event[*events.DepositProcessedEvent]],
 ) *Service[
 	BeaconBlockT, BeaconBlockBodyT, BlockEventT, DepositT,
 	ExecutionPayloadT, SubscriptionT,
@@ -105,6 +118,7 @@ func NewService[
 		WithdrawalCredentialsT,
 	]{
 		feed:               feed,
+		depositFeed:        depositFeed,
 		logger:             logger,
 		ethclient:          ethclient,
 		eth1FollowDistance: eth1FollowDistance,
@@ -124,12 +138,47 @@ func (s *Service[
 ]) Start(
 	ctx context.Context,
 ) error {
-	go s.blockFeedListener(ctx)
-	go s.depositFetcher(ctx)
-	go s.depositCatchupFetcher(ctx)
+	ctx, s.cancel = context.WithCancel(ctx)
+	for _, fn := range []func(context.Context){
+		s.blockFeedListener,
+		s.depositFetcher,
+		s.depositCatchupFetcher,
+	} {
+		s.running.Add(1)
+		go func() {
+			defer s.running.Done()
+			fn(ctx)
+		}()
+	}
 	return nil
 }
 
+// Stop cancels the goroutines of the service and waits for them to exit, so
+// that no deposits are being stored when the node exits.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
+	ExecutionPayloadT, SubscriptionT,
+	WithdrawalCredentialsT, DepositT,
+]) Stop(ctx context.Context) error {
+	if s.cancel == nil {
+		return nil
+	}
+	s.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		s.running.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func (s *Service[
 	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
 	ExecutionPayloadT, SubscriptionT,
@@ -143,8 +192,13 @@ func (s *Service[
 		case <-ctx.Done():
 			return
 		case event := <-ch:
-			if event.Is(events.BeaconBlockFinalized) {
-				s.newBlock <- event.Data()
+			if !event.Is(events.BeaconBlockFinalized) {
+				continue
+			}
+			select {
+			case s.newBlock <- event.Data():
+			case <-ctx.Done():
+				return
 			}
 		}
 	}
@@ -159,12 +213,20 @@ func (s *Service[
 	return "deposit-handler"
 }
 
-// Status returns the current status of the service.
+// Status returns ErrDepositsLagging while the deposits of some blocks could
+// not be fetched.
 func (s *Service[
 	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
 	ExecutionPayloadT, SubscriptionT,
 	WithdrawalCredentialsT, DepositT,
 ]) Status() error {
+	s.failedBlocksMu.Lock()
+	defer s.failedBlocksMu.Unlock()
+	if len(s.failedBlocks) > 0 {
+		return errors.Wrapf(
+			ErrDepositsLagging, "%d blocks pending", len(s.failedBlocks),
+		)
+	}
 	return nil
 }
 
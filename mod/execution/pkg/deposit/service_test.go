@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	metricstesting "github.com/berachain/beacon-kit/mod/primitives/pkg/metrics/testing"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/stretchr/testify/require"
+)
+
+type testBlockEvent = *feed.Event[testBlock]
+
+type testContract struct {
+	deposits []testDeposit
+	err      error
+}
+
+func (c testContract) ReadDeposits(
+	context.Context, math.U64,
+) ([]testDeposit, error) {
+	return c.deposits, c.err
+}
+
+type testStore struct {
+	mu       sync.Mutex
+	deposits []testDeposit
+}
+
+func (*testStore) Prune(uint64, uint64) error { return nil }
+
+func (s *testStore) EnqueueDeposits(deposits []testDeposit) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deposits = append(s.deposits, deposits...)
+	return nil
+}
+
+func (s *testStore) stored() []testDeposit {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.deposits
+}
+
+// startService starts a deposit service reading from contract, and returns
+// the block feed it listens to and the deposit feed it publishes to.
+func startService(
+	t *testing.T,
+	contract testContract,
+	store *testStore,
+	sink deposit.TelemetrySink,
+) (
+	*feed.Topic[testBlockEvent],
+	*feed.Topic[*feed.Event[*events.DepositProcessedEvent]],
+	*deposit.Service[
+		testBlock, testBody, testBlockEvent, testDeposit, testPayload,
+		event.Subscription, any,
+	],
+) {
+	t.Helper()
+	var (
+		blockFeed   feed.Topic[testBlockEvent]
+		depositFeed feed.Topic[*feed.Event[*events.DepositProcessedEvent]]
+	)
+	svc := deposit.NewService[
+		testBody, testBlock, testBlockEvent, *testStore, testPayload,
+		event.Subscription, any, testDeposit,
+	](
+		log.NewTestLogger(t), 0, nil, sink, store, contract,
+		&blockFeed, &depositFeed,
+	)
+	require.NoError(t, svc.Start(context.Background()))
+	t.Cleanup(func() { require.NoError(t, svc.Stop(context.Background())) })
+	require.Eventually(t, func() bool {
+		return blockFeed.Subscribers() == 1
+	}, time.Second, time.Millisecond)
+	return &blockFeed, &depositFeed, svc
+}
+
+func TestService_DepositProcessedEvents(t *testing.T) {
+	store := new(testStore)
+	deposits := []testDeposit{{index: 3}, {index: 4}}
+	blockFeed, depositFeed, _ := startService(
+		t, testContract{deposits: deposits}, store, metricstesting.NoopSink{},
+	)
+	ch := make(chan *feed.Event[*events.DepositProcessedEvent], 2)
+	sub := depositFeed.Subscribe(ch)
+	defer sub.Unsubscribe()
+
+	// Deposits are only fetched for finalized blocks.
+	blockFeed.Send(feed.NewEvent(
+		context.Background(), events.BeaconBlockAccepted, testBlock{},
+	))
+	blockFeed.Send(feed.NewEvent(
+		context.Background(), events.BeaconBlockFinalized, testBlock{},
+	))
+
+	for _, dep := range deposits {
+		select {
+		case ev := <-ch:
+			require.True(t, ev.Is(events.DepositProcessed))
+			require.Equal(t, &events.DepositProcessedEvent{
+				Index:  dep.GetIndex(),
+				Pubkey: dep.GetPubkey(),
+				Amount: dep.GetAmount(),
+			}, ev.Data())
+		case <-time.After(time.Second):
+			t.Fatal("deposit event not published")
+		}
+		// The event is only published once the deposit is stored.
+		require.Contains(t, store.stored(), dep)
+	}
+	select {
+	case ev := <-ch:
+		t.Fatalf("unexpected deposit event %v", ev.Data())
+	default:
+	}
+}
+
+func TestService_NoEventsWhenReadFails(t *testing.T) {
+	sink := metricstesting.NewRecordingSink()
+	blockFeed, depositFeed, svc := startService(
+		t, testContract{err: errors.New("unavailable")}, new(testStore), sink,
+	)
+	ch := make(chan *feed.Event[*events.DepositProcessedEvent], 1)
+	sub := depositFeed.Subscribe(ch)
+	defer sub.Unsubscribe()
+
+	blockFeed.Send(feed.NewEvent(
+		context.Background(), events.BeaconBlockFinalized, testBlock{},
+	))
+	require.Eventually(t, func() bool {
+		return errors.Is(svc.Status(), deposit.ErrDepositsLagging)
+	}, time.Second, time.Millisecond)
+	require.Positive(t, sink.CountFor(
+		"beacon_kit.execution.deposit.failed_to_get_block_logs",
+	))
+	select {
+	case ev := <-ch:
+		t.Fatalf("unexpected deposit event %v", ev.Data())
+	default:
+	}
+}
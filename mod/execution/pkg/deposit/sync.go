@@ -24,6 +24,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -56,22 +58,29 @@ func (s *Service[
 	WithdrawalCredentialsT, DepositT,
 ]) depositCatchupFetcher(ctx context.Context) {
 	ticker := time.NewTicker(defaultRetryInterval)
+	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			if len(s.failedBlocks) == 0 {
+			s.failedBlocksMu.Lock()
+			failedBlocks := make([]math.U64, 0, len(s.failedBlocks))
+			for blockNum := range s.failedBlocks {
+				failedBlocks = append(failedBlocks, blockNum)
+			}
+			s.failedBlocksMu.Unlock()
+			if len(failedBlocks) == 0 {
 				continue
 			}
 			s.logger.Warn(
 				"failed to get deposits from block(s), retrying...",
 				"num_blocks",
-				s.failedBlocks,
+				failedBlocks,
 			)
 
 			// Fetch deposits for blocks that failed to be processed.
-			for blockNum := range s.failedBlocks {
+			for _, blockNum := range failedBlocks {
 				s.fetchAndStoreDeposits(ctx, blockNum)
 			}
 		}
@@ -85,7 +94,7 @@ func (s *Service[
 	deposits, err := s.dc.ReadDeposits(ctx, blockNum)
 	if err != nil {
 		s.metrics.markFailedToGetBlockLogs(blockNum)
-		s.failedBlocks[blockNum] = struct{}{}
+		s.markFailed(blockNum, true)
 		return
 	}
 
@@ -98,9 +107,33 @@ func (s *Service[
 
 	if err = s.ds.EnqueueDeposits(deposits); err != nil {
 		s.logger.Error("Failed to store deposits", "error", err)
-		s.failedBlocks[blockNum] = struct{}{}
+		s.markFailed(blockNum, true)
 		return
 	}
 
+	s.markFailed(blockNum, false)
+	for _, dep := range deposits {
+		s.depositFeed.Send(feed.NewEvent(
+			ctx, events.DepositProcessed, &events.DepositProcessedEvent{
+				Index:  dep.GetIndex(),
+				Pubkey: dep.GetPubkey(),
+				Amount: dep.GetAmount(),
+			},
+		))
+	}
+}
+
+// markFailed records whether the deposits of a block could not be fetched.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
+	ExecutionPayloadT, SubscriptionT,
+	WithdrawalCredentialsT, DepositT,
+]) markFailed(blockNum math.U64, failed bool) {
+	s.failedBlocksMu.Lock()
+	defer s.failedBlocksMu.Unlock()
+	if failed {
+		s.failedBlocks[blockNum] = struct{}{}
+		return
+	}
 	delete(s.failedBlocks, blockNum)
 }
@@ -26,6 +26,7 @@ import (
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -60,6 +61,17 @@ type BlockEvent[
 	Data() BeaconBlockT
 }
 
+// FinalizedBlockEvent is an interface for the events of finalized blocks.
+type FinalizedBlockEvent[
+	DepositT any,
+	BeaconBlockBodyT BeaconBlockBody[DepositT, ExecutionPayloadT],
+	BeaconBlockT BeaconBlock[DepositT, BeaconBlockBodyT, ExecutionPayloadT],
+	ExecutionPayloadT interface{ GetNumber() math.U64 },
+] interface {
+	Is(string) bool
+	Data() *events.BeaconBlockFinalizedEvent[BeaconBlockT]
+}
+
 // BlockFeed is an interface for subscribing to block events.
 type BlockFeed[
 	DepositT any,
@@ -96,6 +108,17 @@ type Deposit[DepositT, WithdrawalCredentialsT any] interface {
 	) DepositT
 	// GetIndex returns the index of the deposit.
 	GetIndex() uint64
+	// GetPubkey returns the public key of the validator of the deposit.
+	GetPubkey() crypto.BLSPubkey
+	// GetAmount returns the amount of the deposit.
+	GetAmount() math.Gwei
+}
+
+// EventFeed is an interface for sending events.
+type EventFeed[EventT any] interface {
+	// Send sends an event and returns the number of subscribers that
+	// received it.
+	Send(event EventT) int
 }
 
 // EthClient is an interface for interacting with the Ethereum 1.0 client.
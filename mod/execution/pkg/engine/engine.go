@@ -22,6 +22,8 @@ package engine
 
 import (
 	"context"
+	"strings"
+	"sync/atomic"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
@@ -46,9 +48,21 @@ type Engine[
 	logger log.Logger[any]
 	// metrics is the metrics for the engine.
 	metrics *engineMetrics
+	// syncing is true if the execution client answered the latest
+	// forkchoice update or new payload as SYNCING or ACCEPTED.
+	syncing atomic.Bool
+	// payloads holds the recent payloads the execution client found VALID,
+	// it is nil if disabled.
+	payloads *payloadCache
+	// invalid holds the recent payloads known to be INVALID, it is nil if
+	// disabled.
+	invalid *invalidPayloads
 }
 
-// New creates a new Engine.
+// New creates a new Engine, which does not send again the last
+// payloadCacheSize payloads the execution client found VALID, nor the
+// descendants of the payloads found INVALID in the last
+// invalidPayloadRetention slots. Either is disabled if 0.
 func New[
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, *engineprimitives.Withdrawal,
@@ -57,11 +71,15 @@ func New[
 	ec *client.EngineClient[ExecutionPayloadT],
 	logger log.Logger[any],
 	ts TelemetrySink,
+	payloadCacheSize int,
+	invalidPayloadRetention uint64,
 ) *Engine[ExecutionPayloadT] {
 	return &Engine[ExecutionPayloadT]{
-		ec:      ec,
-		logger:  logger,
-		metrics: newEngineMetrics(ts, logger),
+		ec:       ec,
+		logger:   logger,
+		metrics:  newEngineMetrics(ts, logger),
+		payloads: newPayloadCache(payloadCacheSize),
+		invalid:  newInvalidPayloads(invalidPayloadRetention),
 	}
 }
 
@@ -83,6 +101,34 @@ func (ee *Engine[ExecutionPayloadT]) Status() error {
 	return ee.ec.Status()
 }
 
+// SyncStatus returns ErrExecutionClientSyncing if the execution client
+// answered the latest forkchoice update or new payload as SYNCING or
+// ACCEPTED.
+func (ee *Engine[ExecutionPayloadT]) SyncStatus() error {
+	if ee.syncing.Load() {
+		return ErrExecutionClientSyncing
+	}
+	return nil
+}
+
+// CheckForkReadiness returns ErrMissingCapabilities, listing the missing
+// methods, if the execution client does not advertise the engine API
+// methods required by the fork of forkVersion, and reports whether it is
+// ready for the fork.
+func (ee *Engine[ExecutionPayloadT]) CheckForkReadiness(
+	ctx context.Context,
+	forkVersion uint32,
+) error {
+	missing, err := ee.ec.MissingCapabilities(ctx, forkVersion)
+	if err == nil && len(missing) > 0 {
+		err = errors.Wrapf(
+			ErrMissingCapabilities, "%s", strings.Join(missing, ", "),
+		)
+	}
+	ee.metrics.setForkReadiness(forkVersion, err == nil)
+	return err
+}
+
 // GetPayload returns the payload and blobs bundle for the given slot.
 func (ee *Engine[ExecutionPayloadT]) GetPayload(
 	ctx context.Context,
@@ -99,6 +145,13 @@ func (ee *Engine[ExecutionPayloadT]) NotifyForkchoiceUpdate(
 	ctx context.Context,
 	req *engineprimitives.ForkchoiceUpdateRequest,
 ) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
+	// A malformed request is failed without the round trip to the execution
+	// client, which would reject it.
+	if err := req.Validate(); err != nil {
+		ee.metrics.markForkchoiceUpdateRequestInvalid(err)
+		return nil, nil, err
+	}
+
 	// Log the forkchoice update attempt.
 	hasPayloadAttributes := req.PayloadAttributes != nil &&
 		!req.PayloadAttributes.IsNil()
@@ -123,6 +176,7 @@ func (ee *Engine[ExecutionPayloadT]) NotifyForkchoiceUpdate(
 		engineerrors.ErrSyncingPayloadStatus,
 	):
 		ee.metrics.markForkchoiceUpdateAcceptedSyncing(req.State, err)
+		ee.syncing.Store(true)
 		return payloadID, nil, nil
 
 	// If we get invalid payload status, we will need to find a valid
@@ -136,7 +190,13 @@ func (ee *Engine[ExecutionPayloadT]) NotifyForkchoiceUpdate(
 		engineerrors.ErrInvalidBlockHashPayloadStatus,
 	):
 		ee.metrics.markForkchoiceUpdateInvalid(req.State, err)
-		return payloadID, latestValidHash, ErrBadBlockProduced
+		ee.payloads.markInvalid(req.State.HeadBlockHash)
+		ee.invalid.markHeadInvalid(req.State.HeadBlockHash, latestValidHash)
+		// The error of the status is kept for the caller to move the head
+		// back to the latest valid hash.
+		return payloadID, latestValidHash, errors.Join(
+			ErrBadBlockProduced, err,
+		)
 
 	// JSON-RPC errors are predefined and should be handled as such.
 	case jsonrpc.IsPreDefinedError(err):
@@ -148,6 +208,7 @@ func (ee *Engine[ExecutionPayloadT]) NotifyForkchoiceUpdate(
 		ee.metrics.markForkchoiceUpdateUndefinedError(err)
 		return nil, nil, err
 	}
+	ee.syncing.Store(false)
 
 	// If we reached here, and we have a nil payload ID, we should log a
 	// warning.
@@ -186,6 +247,52 @@ func (ee *Engine[ExecutionPayloadT]) VerifyAndNotifyNewPayload(
 		return err
 	}
 
+	// The descendants of an INVALID payload are INVALID, they are failed
+	// without being sent to the execution client, and recorded to fail
+	// their own descendants. They share the latest valid hash of their
+	// invalid ancestor.
+	var (
+		blockHash  = req.ExecutionPayload.GetBlockHash()
+		parentHash = req.ExecutionPayload.GetParentHash()
+		number     = req.ExecutionPayload.GetNumber().Unwrap()
+	)
+	ee.invalid.see(number)
+	if latestValidHash, ok := ee.invalid.isInvalid(blockHash); ok {
+		ee.metrics.markNewPayloadInvalidAncestor(blockHash, parentHash)
+		return errors.Join(
+			ErrBadBlockProduced, &engineerrors.InvalidPayloadError{
+				Status:          engineerrors.ErrInvalidPayloadStatus,
+				LatestValidHash: latestValidHash,
+			},
+		)
+	}
+	if latestValidHash, ok := ee.invalid.isInvalid(parentHash); ok {
+		ee.metrics.markNewPayloadInvalidAncestor(blockHash, parentHash)
+		ee.invalid.markInvalid(blockHash, number, latestValidHash)
+		return errors.Join(
+			errors.Wrapf(
+				ErrInvalidAncestor, "payload %s has invalid parent %s",
+				blockHash, parentHash,
+			),
+			&engineerrors.InvalidPayloadError{
+				Status:          engineerrors.ErrInvalidPayloadStatus,
+				LatestValidHash: latestValidHash,
+				ValidationError: "links to previously rejected block",
+			},
+		)
+	}
+
+	// The payload is not sent again if the execution client already found
+	// it VALID. SYNCING and INVALID are never cached, the execution client
+	// is asked again.
+	if ee.payloads != nil {
+		if ee.payloads.isValid(blockHash) {
+			ee.metrics.markNewPayloadCacheHit()
+			return nil
+		}
+		ee.metrics.markNewPayloadCacheMiss()
+	}
+
 	// Otherwise we will send the payload to the execution client.
 	lastValidHash, err := ee.ec.NewPayload(
 		ctx,
@@ -211,6 +318,7 @@ func (ee *Engine[ExecutionPayloadT]) VerifyAndNotifyNewPayload(
 			req.ExecutionPayload.GetParentHash(),
 			req.Optimistic,
 		)
+		ee.syncing.Store(true)
 
 	// These two cases are semantically the same:
 	// https://github.com/ethereum/execution-apis/issues/270
@@ -223,12 +331,22 @@ func (ee *Engine[ExecutionPayloadT]) VerifyAndNotifyNewPayload(
 			req.ExecutionPayload.GetBlockHash(),
 			req.Optimistic,
 		)
+		ee.payloads.markInvalid(blockHash)
+		ee.invalid.markInvalid(blockHash, number, lastValidHash)
+		// Unless it is the latest valid one, the parent is INVALID too.
+		if lastValidHash != nil && number > 0 &&
+			*lastValidHash != (common.ExecutionHash{}) &&
+			*lastValidHash != parentHash {
+			ee.invalid.markInvalid(parentHash, number-1, lastValidHash)
+		}
 
 		// We want to return bad block irrespective of
-		// if we are running in optimistic mode or not.
+		// if we are running in optimistic mode or not. The error of the
+		// status is kept for the caller to move the head back to the latest
+		// valid hash.
 		//
 		// TODO: should we still nillify the error in optimistic mode?
-		return ErrBadBlockProduced
+		return errors.Join(ErrBadBlockProduced, err)
 
 	case jsonrpc.IsPreDefinedError(err):
 		// Protect against possible nil value.
@@ -250,6 +368,9 @@ func (ee *Engine[ExecutionPayloadT]) VerifyAndNotifyNewPayload(
 			req.Optimistic,
 			err,
 		)
+	default:
+		ee.syncing.Store(false)
+		ee.payloads.markValid(blockHash)
 	}
 
 	// Under the optimistic condition, we are fine ignoring the error. This
@@ -0,0 +1,497 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/engine"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	metricstesting "github.com/berachain/beacon-kit/mod/primitives/pkg/metrics/testing"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// testPayload is an empty Deneb payload, only its block hash, parent hash
+// and number are set.
+type testPayload struct {
+	BlockHash  common.ExecutionHash `json:"blockHash"`
+	ParentHash common.ExecutionHash `json:"parentHash"`
+	Number     math.U64             `json:"blockNumber"`
+}
+
+func (*testPayload) Empty(uint32) *testPayload {
+	return new(testPayload)
+}
+
+func (p *testPayload) IsNil() bool {
+	return p == nil
+}
+
+func (*testPayload) Version() uint32 {
+	return version.Deneb
+}
+
+func (*testPayload) GetPrevRandao() primitives.Bytes32 {
+	return primitives.Bytes32{}
+}
+
+func (p *testPayload) GetBlockHash() common.ExecutionHash {
+	return p.BlockHash
+}
+
+func (p *testPayload) GetParentHash() common.ExecutionHash {
+	return p.ParentHash
+}
+
+func (p *testPayload) GetNumber() math.U64 {
+	return p.Number
+}
+
+func (*testPayload) GetGasLimit() math.U64 {
+	return 0
+}
+
+func (*testPayload) GetGasUsed() math.U64 {
+	return 0
+}
+
+func (*testPayload) GetTimestamp() math.U64 {
+	return 0
+}
+
+func (*testPayload) GetExtraData() []byte {
+	return nil
+}
+
+func (*testPayload) GetBaseFeePerGas() math.Wei {
+	return math.Wei{}
+}
+
+func (*testPayload) GetFeeRecipient() common.ExecutionAddress {
+	return common.ExecutionAddress{}
+}
+
+func (*testPayload) GetStateRoot() primitives.Bytes32 {
+	return primitives.Bytes32{}
+}
+
+func (*testPayload) GetReceiptsRoot() primitives.Bytes32 {
+	return primitives.Bytes32{}
+}
+
+func (*testPayload) GetLogsBloom() []byte {
+	return nil
+}
+
+func (*testPayload) GetBlobGasUsed() math.U64 {
+	return 0
+}
+
+func (*testPayload) GetExcessBlobGas() math.U64 {
+	return 0
+}
+
+func (*testPayload) GetWithdrawals() []*engineprimitives.Withdrawal {
+	return nil
+}
+
+func (*testPayload) GetTransactions() [][]byte {
+	return nil
+}
+
+func (p *testPayload) MarshalJSON() ([]byte, error) {
+	type payload testPayload
+	return json.Marshal((*payload)(p))
+}
+
+func (p *testPayload) UnmarshalJSON(bz []byte) error {
+	type payload testPayload
+	return json.Unmarshal(bz, (*payload)(p))
+}
+
+// engineAPI is an execution client answering the engine API calls with a
+// fixed status, counting the new payloads and forkchoice updates it
+// receives.
+type engineAPI struct {
+	status            string
+	latestValidHash   *common.ExecutionHash
+	validationError   *string
+	newPayloads       int
+	forkchoiceUpdates int
+}
+
+func (api *engineAPI) NewPayloadV3(
+	json.RawMessage, []common.ExecutionHash, *common.ExecutionHash,
+) engineprimitives.PayloadStatusV1 {
+	api.newPayloads++
+	return engineprimitives.PayloadStatusV1{
+		Status:          api.status,
+		LatestValidHash: api.latestValidHash,
+		ValidationError: api.validationError,
+	}
+}
+
+func (api *engineAPI) ForkchoiceUpdatedV3(
+	engineprimitives.ForkchoiceStateV1, *json.RawMessage,
+) engineprimitives.ForkchoiceResponseV1 {
+	api.forkchoiceUpdates++
+	return engineprimitives.ForkchoiceResponseV1{
+		PayloadStatus: engineprimitives.PayloadStatusV1{
+			Status:          api.status,
+			LatestValidHash: api.latestValidHash,
+			ValidationError: api.validationError,
+		},
+	}
+}
+
+func newTestEngine(
+	t *testing.T,
+	api *engineAPI,
+	payloadCacheSize int,
+	invalidPayloadRetention uint64,
+) *engine.Engine[*testPayload] {
+	t.Helper()
+	return newTestEngineWithSink(
+		t, api, metricstesting.NoopSink{},
+		payloadCacheSize, invalidPayloadRetention,
+	)
+}
+
+// newTestEngineWithSink returns an engine of the execution client api,
+// recording its metrics in ts.
+func newTestEngineWithSink(
+	t *testing.T,
+	api *engineAPI,
+	ts engine.TelemetrySink,
+	payloadCacheSize int,
+	invalidPayloadRetention uint64,
+) *engine.Engine[*testPayload] {
+	t.Helper()
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("engine", api))
+	t.Cleanup(server.Stop)
+
+	cfg := client.DefaultConfig()
+	ec := client.New[*testPayload](
+		&cfg, log.NewTestLogger(t), nil, metricstesting.NoopSink{},
+		big.NewInt(80087),
+	)
+	var err error
+	ec.Eth1Client, err = ethclient.NewFromRPCClient[*testPayload](
+		rpc.DialInProc(server),
+	)
+	require.NoError(t, err)
+	return engine.New[*testPayload](
+		ec, log.NewTestLogger(t), ts,
+		payloadCacheSize, invalidPayloadRetention,
+	)
+}
+
+// newPayloadRequest returns a request for an empty payload of parentHash
+// and number with a valid block hash.
+func newPayloadRequest(
+	parentHash common.ExecutionHash,
+	number uint64,
+) *engineprimitives.NewPayloadRequest[
+	*testPayload, *engineprimitives.Withdrawal,
+] {
+	parentBeaconBlockRoot := primitives.Root{0x01}
+	header := &gethtypes.Header{
+		ParentHash:       parentHash,
+		UncleHash:        gethtypes.EmptyUncleHash,
+		TxHash:           gethtypes.EmptyTxsHash,
+		Difficulty:       big.NewInt(0),
+		Number:           new(big.Int).SetUint64(number),
+		BaseFee:          big.NewInt(0),
+		ExcessBlobGas:    new(uint64),
+		BlobGasUsed:      new(uint64),
+		ParentBeaconRoot: (*common.ExecutionHash)(&parentBeaconBlockRoot),
+	}
+	return engineprimitives.BuildNewPayloadRequest(
+		&testPayload{
+			BlockHash:  header.Hash(),
+			ParentHash: parentHash,
+			Number:     math.U64(number),
+		},
+		nil,
+		&parentBeaconBlockRoot,
+		false,
+	)
+}
+
+func TestEngine_PayloadCache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("VALID is cached", func(t *testing.T) {
+		api := &engineAPI{status: engineprimitives.PayloadStatusValid}
+		ee := newTestEngine(t, api, 8, 0)
+		req := newPayloadRequest(common.ExecutionHash{}, 0)
+		require.NoError(t, ee.VerifyAndNotifyNewPayload(ctx, req))
+		require.NoError(t, ee.VerifyAndNotifyNewPayload(ctx, req))
+		require.Equal(t, 1, api.newPayloads)
+	})
+
+	t.Run("SYNCING is not cached", func(t *testing.T) {
+		api := &engineAPI{status: engineprimitives.PayloadStatusSyncing}
+		ee := newTestEngine(t, api, 8, 0)
+		req := newPayloadRequest(common.ExecutionHash{}, 0)
+		require.Error(t, ee.VerifyAndNotifyNewPayload(ctx, req))
+		require.Error(t, ee.VerifyAndNotifyNewPayload(ctx, req))
+		require.Equal(t, 2, api.newPayloads)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		api := &engineAPI{status: engineprimitives.PayloadStatusValid}
+		ee := newTestEngine(t, api, 0, 0)
+		req := newPayloadRequest(common.ExecutionHash{}, 0)
+		require.NoError(t, ee.VerifyAndNotifyNewPayload(ctx, req))
+		require.NoError(t, ee.VerifyAndNotifyNewPayload(ctx, req))
+		require.Equal(t, 2, api.newPayloads)
+	})
+
+	t.Run("invalidated by forkchoice", func(t *testing.T) {
+		api := &engineAPI{status: engineprimitives.PayloadStatusValid}
+		ee := newTestEngine(t, api, 8, 0)
+		req := newPayloadRequest(common.ExecutionHash{}, 0)
+		require.NoError(t, ee.VerifyAndNotifyNewPayload(ctx, req))
+
+		api.status = engineprimitives.PayloadStatusInvalid
+		_, _, err := ee.NotifyForkchoiceUpdate(
+			ctx, engineprimitives.BuildForkchoiceUpdateRequest(
+				&engineprimitives.ForkchoiceStateV1{
+					HeadBlockHash: req.ExecutionPayload.GetBlockHash(),
+				},
+				nil,
+				version.Deneb,
+			),
+		)
+		require.ErrorIs(t, err, engine.ErrBadBlockProduced)
+
+		require.ErrorIs(t,
+			ee.VerifyAndNotifyNewPayload(ctx, req), engine.ErrBadBlockProduced,
+		)
+		require.Equal(t, 2, api.newPayloads)
+	})
+}
+
+func TestEngine_InvalidAncestor(t *testing.T) {
+	ctx := context.Background()
+
+	// The execution client finds the first payload of the chain INVALID,
+	// its descendants are failed without being sent to it.
+	api := &engineAPI{status: engineprimitives.PayloadStatusInvalid}
+	ee := newTestEngine(t, api, 8, 4)
+	invalid := newPayloadRequest(common.ExecutionHash{0x01}, 1)
+	require.ErrorIs(t,
+		ee.VerifyAndNotifyNewPayload(ctx, invalid), engine.ErrBadBlockProduced,
+	)
+
+	parent := invalid.ExecutionPayload
+	for number := uint64(2); number <= 4; number++ {
+		child := newPayloadRequest(parent.GetBlockHash(), number)
+		require.ErrorIs(t,
+			ee.VerifyAndNotifyNewPayload(ctx, child), engine.ErrInvalidAncestor,
+		)
+		parent = child.ExecutionPayload
+	}
+	require.Equal(t, 1, api.newPayloads)
+
+	// The invalid payload is forgotten once the chain is past its
+	// retention.
+	api.status = engineprimitives.PayloadStatusValid
+	require.NoError(t, ee.VerifyAndNotifyNewPayload(
+		ctx, newPayloadRequest(common.ExecutionHash{0x02}, 7),
+	))
+	require.NoError(t, ee.VerifyAndNotifyNewPayload(
+		ctx, newPayloadRequest(invalid.ExecutionPayload.GetBlockHash(), 2),
+	))
+	require.Equal(t, 3, api.newPayloads)
+}
+
+func TestEngine_InvalidParent(t *testing.T) {
+	ctx := context.Background()
+
+	// The latest valid hash is not the parent of the INVALID payload, the
+	// parent is INVALID too and so are its other children.
+	api := &engineAPI{
+		status:          engineprimitives.PayloadStatusInvalid,
+		latestValidHash: &common.ExecutionHash{0x01},
+	}
+	ee := newTestEngine(t, api, 0, 4)
+	parentHash := common.ExecutionHash{0x02}
+	require.ErrorIs(t,
+		ee.VerifyAndNotifyNewPayload(ctx, newPayloadRequest(parentHash, 2)),
+		engine.ErrBadBlockProduced,
+	)
+
+	require.ErrorIs(t,
+		ee.VerifyAndNotifyNewPayload(ctx, newPayloadRequest(parentHash, 3)),
+		engine.ErrInvalidAncestor,
+	)
+	require.Equal(t, 1, api.newPayloads)
+}
+
+func TestEngine_PayloadStatus(t *testing.T) {
+	ctx := context.Background()
+	latestValidHash := common.ExecutionHash{0x01}
+	validationError := "invalid state root"
+
+	t.Run("INVALID with latest valid hash", func(t *testing.T) {
+		api := &engineAPI{
+			status:          engineprimitives.PayloadStatusInvalid,
+			latestValidHash: &latestValidHash,
+			validationError: &validationError,
+		}
+		ee := newTestEngine(t, api, 8, 4)
+		invalid := newPayloadRequest(latestValidHash, 2)
+		err := ee.VerifyAndNotifyNewPayload(ctx, invalid)
+		require.ErrorIs(t, err, engine.ErrBadBlockProduced)
+		require.ErrorIs(t, err, engineerrors.ErrInvalidPayloadStatus)
+		var invalidErr *engineerrors.InvalidPayloadError
+		require.ErrorAs(t, err, &invalidErr)
+		require.Equal(t, &latestValidHash, invalidErr.LatestValidHash)
+		require.Equal(t, validationError, invalidErr.ValidationError)
+		hash, ok := engineerrors.LatestValidHash(err)
+		require.True(t, ok)
+		require.Equal(t, latestValidHash, hash)
+
+		// The descendants of the invalid payload share its latest valid
+		// hash.
+		err = ee.VerifyAndNotifyNewPayload(ctx, newPayloadRequest(
+			invalid.ExecutionPayload.GetBlockHash(), 3,
+		))
+		require.ErrorIs(t, err, engine.ErrInvalidAncestor)
+		hash, ok = engineerrors.LatestValidHash(err)
+		require.True(t, ok)
+		require.Equal(t, latestValidHash, hash)
+		require.Equal(t, 1, api.newPayloads)
+
+		// So does an invalid head of a forkchoice update.
+		payloadID, hashPtr, err := ee.NotifyForkchoiceUpdate(
+			ctx, engineprimitives.BuildForkchoiceUpdateRequest(
+				&engineprimitives.ForkchoiceStateV1{
+					HeadBlockHash: common.ExecutionHash{0x03},
+				},
+				nil,
+				version.Deneb,
+			),
+		)
+		require.ErrorIs(t, err, engine.ErrBadBlockProduced)
+		require.Nil(t, payloadID)
+		require.Equal(t, &latestValidHash, hashPtr)
+		hash, ok = engineerrors.LatestValidHash(err)
+		require.True(t, ok)
+		require.Equal(t, latestValidHash, hash)
+	})
+
+	t.Run("INVALID without latest valid hash", func(t *testing.T) {
+		api := &engineAPI{status: engineprimitives.PayloadStatusInvalid}
+		ee := newTestEngine(t, api, 8, 4)
+		err := ee.VerifyAndNotifyNewPayload(
+			ctx, newPayloadRequest(latestValidHash, 2),
+		)
+		require.ErrorIs(t, err, engine.ErrBadBlockProduced)
+		var invalidErr *engineerrors.InvalidPayloadError
+		require.ErrorAs(t, err, &invalidErr)
+		require.Nil(t, invalidErr.LatestValidHash)
+		require.Empty(t, invalidErr.ValidationError)
+		_, ok := engineerrors.LatestValidHash(err)
+		require.False(t, ok)
+	})
+
+	t.Run("VALID", func(t *testing.T) {
+		api := &engineAPI{
+			status:          engineprimitives.PayloadStatusValid,
+			latestValidHash: &latestValidHash,
+		}
+		ee := newTestEngine(t, api, 8, 4)
+		require.NoError(t, ee.VerifyAndNotifyNewPayload(
+			ctx, newPayloadRequest(latestValidHash, 2),
+		))
+		_, hashPtr, err := ee.NotifyForkchoiceUpdate(
+			ctx, engineprimitives.BuildForkchoiceUpdateRequest(
+				&engineprimitives.ForkchoiceStateV1{
+					HeadBlockHash: latestValidHash,
+				},
+				nil,
+				version.Deneb,
+			),
+		)
+		require.NoError(t, err)
+		require.Equal(t, &latestValidHash, hashPtr)
+	})
+}
+
+func TestEngine_InvalidForkchoiceUpdateRequest(t *testing.T) {
+	ctx := context.Background()
+	api := &engineAPI{status: engineprimitives.PayloadStatusValid}
+	sink := metricstesting.NewRecordingSink()
+	ee := newTestEngineWithSink(t, api, sink, 0, 0)
+
+	// A malformed request is failed without being sent.
+	_, _, err := ee.NotifyForkchoiceUpdate(
+		ctx, engineprimitives.BuildForkchoiceUpdateRequest(
+			&engineprimitives.ForkchoiceStateV1{}, nil, version.Deneb,
+		),
+	)
+	require.ErrorIs(t, err, engineprimitives.ErrZeroHeadBlockHash)
+	_, _, err = ee.NotifyForkchoiceUpdate(
+		ctx, engineprimitives.BuildForkchoiceUpdateRequest(
+			nil, nil, version.Deneb,
+		),
+	)
+	require.ErrorIs(t, err, engineprimitives.ErrNilForkchoiceState)
+	require.Equal(t, 0, api.forkchoiceUpdates)
+	require.Equal(t, 1, sink.CountFor(
+		"beacon_kit.execution.engine.forkchoice_update_request_invalid",
+		"reason", "zero_head",
+	))
+	require.Equal(t, 1, sink.CountFor(
+		"beacon_kit.execution.engine.forkchoice_update_request_invalid",
+		"reason", "nil_state",
+	))
+
+	// A valid one is.
+	_, _, err = ee.NotifyForkchoiceUpdate(
+		ctx, engineprimitives.BuildForkchoiceUpdateRequest(
+			&engineprimitives.ForkchoiceStateV1{
+				HeadBlockHash: common.ExecutionHash{0x01},
+			},
+			nil,
+			version.Deneb,
+		),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, api.forkchoiceUpdates)
+}
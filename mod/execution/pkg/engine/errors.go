@@ -33,6 +33,11 @@ var (
 	ErrAcceptedSyncingPayloadStatus = errors.New(
 		"payload status is SYNCING or ACCEPTED")
 
+	// ErrExecutionClientSyncing is returned by the sync status while the
+	// execution client is syncing.
+	ErrExecutionClientSyncing = errors.New(
+		"execution client is syncing")
+
 	// ErrInvalidPayloadStatus represents an error when the
 	// payload status is INVALID.
 	ErrInvalidPayloadStatus = errors.New(
@@ -49,4 +54,14 @@ var (
 	ErrNilPayloadOnValidResponse = errors.New(
 		"received nil payload ID on VALID engine response",
 	)
+
+	// ErrInvalidAncestor is returned when the parent of a payload is known
+	// to be INVALID, without sending the payload to the execution client.
+	ErrInvalidAncestor = errors.New("payload has an invalid ancestor")
+
+	// ErrMissingCapabilities is returned when the execution client does not
+	// advertise the engine API methods required by a fork.
+	ErrMissingCapabilities = errors.New(
+		"execution client is missing engine API capabilities",
+	)
 )
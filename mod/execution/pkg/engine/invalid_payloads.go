@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine
+
+import (
+	"sync/atomic"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// invalidPayloadsSize is the maximum number of invalid payloads tracked.
+const invalidPayloadsSize = 256
+
+// invalidPayloads holds the block hashes of the recent payloads known to be
+// INVALID, so that their descendants are failed without being sent to the
+// execution client. Each block of the chain has one payload, the block
+// numbers of the payloads advance with the slots: an entry expires once a
+// payload retention blocks above the one it was marked at is seen. A nil
+// invalidPayloads is disabled and holds no block hash.
+type invalidPayloads struct {
+	// retention is the number of slots an entry is kept for.
+	retention uint64
+	// latest is the highest block number of the payloads seen.
+	latest atomic.Uint64
+	// invalid maps the block hashes of the invalid payloads to their
+	// entries.
+	invalid *lru.Cache[common.ExecutionHash, invalidPayload]
+}
+
+// invalidPayload is the entry of an invalid payload.
+type invalidPayload struct {
+	// markedAt is the block number the payload was marked at.
+	markedAt uint64
+	// latestValidHash is the latest valid hash of the branch of the
+	// payload, nil if unknown.
+	latestValidHash *common.ExecutionHash
+}
+
+// newInvalidPayloads returns an invalidPayloads keeping its entries for
+// retention slots, nil if retention is 0.
+func newInvalidPayloads(retention uint64) *invalidPayloads {
+	if retention == 0 {
+		return nil
+	}
+	//#nosec:G703 // the size is positive.
+	invalid, _ := lru.New[common.ExecutionHash, invalidPayload](
+		invalidPayloadsSize,
+	)
+	return &invalidPayloads{retention: retention, invalid: invalid}
+}
+
+// see records that a payload of block number was seen.
+func (c *invalidPayloads) see(number uint64) {
+	if c == nil {
+		return
+	}
+	for latest := c.latest.Load(); number > latest; latest = c.latest.Load() {
+		if c.latest.CompareAndSwap(latest, number) {
+			return
+		}
+	}
+}
+
+// isInvalid returns whether the payload of blockHash is known to be
+// INVALID, with the latest valid hash of its branch, forgetting it if its
+// entry expired.
+func (c *invalidPayloads) isInvalid(
+	blockHash common.ExecutionHash,
+) (*common.ExecutionHash, bool) {
+	if c == nil {
+		return nil, false
+	}
+	entry, ok := c.invalid.Get(blockHash)
+	if !ok {
+		return nil, false
+	}
+	if c.latest.Load() > entry.markedAt+c.retention {
+		c.invalid.Remove(blockHash)
+		return nil, false
+	}
+	return entry.latestValidHash, true
+}
+
+// markInvalid records that the payload of blockHash is INVALID, at block
+// number, with the latest valid hash of its branch.
+func (c *invalidPayloads) markInvalid(
+	blockHash common.ExecutionHash,
+	number uint64,
+	latestValidHash *common.ExecutionHash,
+) {
+	if c == nil {
+		return
+	}
+	c.see(number)
+	c.invalid.Add(blockHash, invalidPayload{
+		markedAt:        number,
+		latestValidHash: latestValidHash,
+	})
+}
+
+// markHeadInvalid records that the payload of blockHash, whose block
+// number is not known, is INVALID at the highest block number seen, with
+// the latest valid hash of its branch.
+func (c *invalidPayloads) markHeadInvalid(
+	blockHash common.ExecutionHash,
+	latestValidHash *common.ExecutionHash,
+) {
+	if c == nil {
+		return
+	}
+	c.invalid.Add(blockHash, invalidPayload{
+		markedAt:        c.latest.Load(),
+		latestValidHash: latestValidHash,
+	})
+}
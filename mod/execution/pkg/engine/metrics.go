@@ -24,8 +24,11 @@ import (
 	"strconv"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 // engineMetrics is a struct that contains metrics for the engine.
@@ -47,6 +50,52 @@ func newEngineMetrics(
 	}
 }
 
+// setForkReadiness sets the readiness gauge of the fork of forkVersion to 1
+// if the execution client is ready for it, 0 otherwise.
+func (em *engineMetrics) setForkReadiness(forkVersion uint32, ready bool) {
+	var value int64
+	if ready {
+		value = 1
+	}
+	em.sink.SetGauge(
+		"beacon_kit.execution.engine.fork_ready", value,
+		"fork", version.Name(forkVersion),
+	)
+}
+
+// markNewPayloadCacheHit increments the counter for the payloads not sent
+// to the execution client since it already found them VALID.
+func (em *engineMetrics) markNewPayloadCacheHit() {
+	em.sink.IncrementCounter(
+		"beacon_kit.execution.engine.new_payload_cache_hit",
+	)
+}
+
+// markNewPayloadCacheMiss increments the counter for the payloads sent to
+// the execution client since they were not found in the cache.
+func (em *engineMetrics) markNewPayloadCacheMiss() {
+	em.sink.IncrementCounter(
+		"beacon_kit.execution.engine.new_payload_cache_miss",
+	)
+}
+
+// markNewPayloadInvalidAncestor increments the counter for the payloads
+// failed without being sent to the execution client, since they or their
+// parent are known to be INVALID.
+func (em *engineMetrics) markNewPayloadInvalidAncestor(
+	payloadHash common.ExecutionHash,
+	parentHash common.ExecutionHash,
+) {
+	em.logger.Error(
+		"payload descends from an invalid payload",
+		"payload_block_hash", payloadHash,
+		"payload_parent_block_hash", parentHash,
+	)
+	em.sink.IncrementCounter(
+		"beacon_kit.execution.engine.new_payload_invalid_ancestor",
+	)
+}
+
 // markNewPayloadCalled increments the counter for new payload calls.
 func (em *engineMetrics) markNewPayloadCalled(
 	payloadHash common.ExecutionHash,
@@ -127,7 +176,7 @@ func (em *engineMetrics) markNewPayloadJSONRPCError(
 	em.sink.IncrementCounter(
 		"beacon_kit.execution.engine.new_payload_json_rpc_error",
 		"is_optimistic", strconv.FormatBool(isOptimistic),
-		"error", err.Error(),
+		"error", engineerrors.MetricLabel(err),
 	)
 }
 
@@ -148,7 +197,7 @@ func (em *engineMetrics) markNewPayloadUndefinedError(
 	em.sink.IncrementCounter(
 		"beacon_kit.execution.engine.new_payload_undefined_error",
 		"is_optimistic", strconv.FormatBool(isOptimistic),
-		"error", err.Error(),
+		"error", engineerrors.MetricLabel(err),
 	)
 }
 
@@ -171,6 +220,42 @@ func (em *engineMetrics) markNotifyForkchoiceUpdateCalled(
 	)
 }
 
+// markForkchoiceUpdateRequestInvalid increments the counter for the
+// forkchoice update requests failed before being sent to the execution
+// client, labeled with the reason.
+func (em *engineMetrics) markForkchoiceUpdateRequestInvalid(err error) {
+	em.logger.Error(
+		"not sending invalid forkchoice update request",
+		"error", err,
+	)
+
+	em.sink.IncrementCounter(
+		"beacon_kit.execution.engine.forkchoice_update_request_invalid",
+		"reason", forkchoiceUpdateRequestReason(err),
+	)
+}
+
+// forkchoiceUpdateRequestReason returns the label of err, returned by the
+// validation of a forkchoice update request, for the metrics.
+func forkchoiceUpdateRequestReason(err error) string {
+	switch {
+	case errors.Is(err, engineprimitives.ErrNilForkchoiceState):
+		return "nil_state"
+	case errors.Is(err, engineprimitives.ErrZeroHeadBlockHash):
+		return "zero_head"
+	case errors.Is(err, engineprimitives.ErrFinalizedWithoutSafe):
+		return "finalized_without_safe"
+	case errors.Is(err, engineprimitives.ErrUnknownForkVersion):
+		return "unknown_fork_version"
+	case errors.Is(
+		err, engineprimitives.ErrPayloadAttributesVersionMismatch,
+	):
+		return "attributes_version_mismatch"
+	default:
+		return "invalid_attributes"
+	}
+}
+
 // markForkchoiceUpdateAcceptedSyncing increments
 // the counter for accepted syncing forkchoice updates.
 func (em *engineMetrics) markForkchoiceUpdateAcceptedSyncing(
@@ -192,7 +277,7 @@ func (em *engineMetrics) markForkchoiceUpdateAcceptedSyncing(
 	em.sink.IncrementCounter(
 		"beacon_kit.execution.engine.forkchoice_update_accepted_syncing",
 		"error",
-		err.Error(),
+		engineerrors.MetricLabel(err),
 	)
 }
 
@@ -213,7 +298,7 @@ func (em *engineMetrics) markForkchoiceUpdateInvalid(
 	em.sink.IncrementCounter(
 		"beacon_kit.execution.engine.forkchoice_update_invalid",
 		"error",
-		err.Error(),
+		engineerrors.MetricLabel(err),
 	)
 }
 
@@ -227,7 +312,7 @@ func (em *engineMetrics) markForkchoiceUpdateJSONRPCError(err error) {
 
 	em.sink.IncrementCounter(
 		"beacon_kit.execution.engine.forkchoice_update_json_rpc_error",
-		"error", err.Error(),
+		"error", engineerrors.MetricLabel(err),
 	)
 }
 
@@ -242,7 +327,7 @@ func (em *engineMetrics) markForkchoiceUpdateUndefinedError(err error) {
 
 	em.sink.IncrementCounter(
 		"beacon_kit.execution.engine.forkchoice_update_undefined_error",
-		"error", err.Error(),
+		"error", engineerrors.MetricLabel(err),
 	)
 }
 
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// payloadCache holds the block hashes of the recent payloads the execution
+// client found VALID, so that they are not sent to it again when the same
+// block is processed twice, e.g. in ProcessProposal then FinalizeBlock. A
+// nil payloadCache is disabled and holds no block hash.
+type payloadCache struct {
+	valid *lru.Cache[common.ExecutionHash, struct{}]
+}
+
+// newPayloadCache returns a payloadCache of size block hashes, nil if size
+// is not positive.
+func newPayloadCache(size int) *payloadCache {
+	valid, err := lru.New[common.ExecutionHash, struct{}](size)
+	if err != nil {
+		return nil
+	}
+	return &payloadCache{valid: valid}
+}
+
+// isValid returns whether the payload of blockHash was found VALID.
+func (c *payloadCache) isValid(blockHash common.ExecutionHash) bool {
+	return c != nil && c.valid.Contains(blockHash)
+}
+
+// markValid records that the payload of blockHash was found VALID.
+func (c *payloadCache) markValid(blockHash common.ExecutionHash) {
+	if c != nil {
+		c.valid.Add(blockHash, struct{}{})
+	}
+}
+
+// markInvalid forgets the payload of blockHash, which was found INVALID.
+func (c *payloadCache) markInvalid(blockHash common.ExecutionHash) {
+	if c != nil {
+		c.valid.Remove(blockHash)
+	}
+}
@@ -42,6 +42,10 @@ type Deposit[
 	) DepositT
 	// GetIndex returns the index of the deposit.
 	GetIndex() uint64
+	// GetPubkey returns the public key of the validator of the deposit.
+	GetPubkey() BLSPubkeyT
+	// GetAmount returns the amount of the deposit.
+	GetAmount() U64T
 }
 
 // Marshallable is an interface that combines the ssz.Marshaler and
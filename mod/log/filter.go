@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package log
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Level is the level of a log line.
+type Level int8
+
+const (
+	// LevelDebug is the level of the debug lines.
+	LevelDebug Level = iota
+	// LevelInfo is the level of the info lines.
+	LevelInfo
+	// LevelWarn is the level of the warning lines.
+	LevelWarn
+	// LevelError is the level of the error lines.
+	LevelError
+)
+
+// ErrUnknownLevel is returned when a level name is not known.
+var ErrUnknownLevel = errors.New("unknown log level")
+
+// ParseLevel returns the level of name, one of "debug", "info", "warn" and
+// "error".
+func ParseLevel(name string) (Level, error) {
+	switch strings.ToLower(name) {
+	case "debug":
+		return LevelDebug, nil
+	case "info":
+		return LevelInfo, nil
+	case "warn":
+		return LevelWarn, nil
+	case "error":
+		return LevelError, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", ErrUnknownLevel, name)
+	}
+}
+
+// Filter is a logger discarding the lines below its level, and passing the
+// others to the logger it wraps.
+type Filter[KeyValT any] struct {
+	logger Logger[KeyValT]
+	level  Level
+}
+
+// NewFilter returns a logger passing the lines of at least level to logger.
+func NewFilter[KeyValT any](
+	logger Logger[KeyValT],
+	level Level,
+) *Filter[KeyValT] {
+	return &Filter[KeyValT]{logger: logger, level: level}
+}
+
+// Enabled returns true if the lines of level are logged.
+func (f *Filter[KeyValT]) Enabled(level Level) bool {
+	return level >= f.level
+}
+
+// Info logs msg with level INFO if it is enabled.
+func (f *Filter[KeyValT]) Info(msg string, keyVals ...KeyValT) {
+	if f.Enabled(LevelInfo) {
+		f.logger.Info(msg, keyVals...)
+	}
+}
+
+// Warn logs msg with level WARN if it is enabled.
+func (f *Filter[KeyValT]) Warn(msg string, keyVals ...KeyValT) {
+	if f.Enabled(LevelWarn) {
+		f.logger.Warn(msg, keyVals...)
+	}
+}
+
+// Error logs msg with level ERR if it is enabled.
+func (f *Filter[KeyValT]) Error(msg string, keyVals ...KeyValT) {
+	if f.Enabled(LevelError) {
+		f.logger.Error(msg, keyVals...)
+	}
+}
+
+// Debug logs msg with level DEBUG if it is enabled.
+func (f *Filter[KeyValT]) Debug(msg string, keyVals ...KeyValT) {
+	if f.Enabled(LevelDebug) {
+		f.logger.Debug(msg, keyVals...)
+	}
+}
@@ -1,3 +1,14 @@
 module github.com/berachain/beacon-kit/mod/log
 
 go 1.22.4
+
+require cosmossdk.io/log v1.3.2-0.20240530141513-465410c75bce
+
+require (
+	cosmossdk.io/core v0.12.1-0.20240530104414-90cbb022d5f6 // indirect
+	github.com/mattn/go-colorable v0.1.13 // indirect
+	github.com/mattn/go-isatty v0.0.20 // indirect
+	github.com/pkg/errors v0.9.1 // indirect
+	github.com/rs/zerolog v1.33.0 // indirect
+	golang.org/x/sys v0.20.0 // indirect
+)
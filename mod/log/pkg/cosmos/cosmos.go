@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package cosmos adapts the loggers of the cosmos-sdk, also used by comet
+// through the cosmos server, to the beacon-kit logger interface and back.
+package cosmos
+
+import (
+	cosmoslog "cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/log"
+)
+
+// Logger is a beacon-kit logger logging to a cosmos logger, with its levels
+// and key/value pairs.
+type Logger struct {
+	logger cosmoslog.Logger
+}
+
+// FromCosmosLogger returns a beacon-kit logger logging to logger.
+func FromCosmosLogger(logger cosmoslog.Logger) *Logger {
+	return &Logger{logger: logger}
+}
+
+// Info logs a line with level INFO.
+func (l *Logger) Info(msg string, keyVals ...any) {
+	l.logger.Info(msg, keyVals...)
+}
+
+// Warn logs a line with level WARN.
+func (l *Logger) Warn(msg string, keyVals ...any) {
+	l.logger.Warn(msg, keyVals...)
+}
+
+// Error logs a line with level ERROR.
+func (l *Logger) Error(msg string, keyVals ...any) {
+	l.logger.Error(msg, keyVals...)
+}
+
+// Debug logs a line with level DEBUG.
+func (l *Logger) Debug(msg string, keyVals ...any) {
+	l.logger.Debug(msg, keyVals...)
+}
+
+// With returns a logger with keyVals added to its lines, including the
+// "module" key the cosmos loggers are filtered by.
+func (l *Logger) With(keyVals ...any) *Logger {
+	return &Logger{logger: l.logger.With(keyVals...)}
+}
+
+// Impl returns the cosmos logger.
+func (l *Logger) Impl() any {
+	return l.logger
+}
+
+// ToCosmosLogger returns a cosmos logger logging to logger. The cosmos
+// logger of a Logger, or logger itself if it is a cosmos logger, is returned
+// as is.
+func ToCosmosLogger(logger log.Logger[any]) cosmoslog.Logger {
+	switch logger := logger.(type) {
+	case *Logger:
+		return logger.logger
+	case cosmoslog.Logger:
+		return logger
+	default:
+		return &cosmosLogger{logger: logger}
+	}
+}
+
+// cosmosLogger is a cosmos logger logging to a beacon-kit logger. The
+// key/value pairs given to With are prepended to those of the lines.
+type cosmosLogger struct {
+	logger  log.Logger[any]
+	keyVals []any
+}
+
+// Info logs a line with level INFO.
+func (l *cosmosLogger) Info(msg string, keyVals ...any) {
+	l.logger.Info(msg, l.with(keyVals)...)
+}
+
+// Warn logs a line with level WARN.
+func (l *cosmosLogger) Warn(msg string, keyVals ...any) {
+	l.logger.Warn(msg, l.with(keyVals)...)
+}
+
+// Error logs a line with level ERROR.
+func (l *cosmosLogger) Error(msg string, keyVals ...any) {
+	l.logger.Error(msg, l.with(keyVals)...)
+}
+
+// Debug logs a line with level DEBUG.
+func (l *cosmosLogger) Debug(msg string, keyVals ...any) {
+	l.logger.Debug(msg, l.with(keyVals)...)
+}
+
+// With returns a logger with keyVals added to its lines.
+func (l *cosmosLogger) With(keyVals ...any) cosmoslog.Logger {
+	return &cosmosLogger{logger: l.logger, keyVals: l.with(keyVals)}
+}
+
+// Impl returns the beacon-kit logger.
+func (l *cosmosLogger) Impl() any {
+	return l.logger
+}
+
+// with returns the key/value pairs of the logger followed by keyVals.
+func (l *cosmosLogger) with(keyVals []any) []any {
+	if len(l.keyVals) == 0 {
+		return keyVals
+	}
+	all := make([]any, 0, len(l.keyVals)+len(keyVals))
+	return append(append(all, l.keyVals...), keyVals...)
+}
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cosmos_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	cosmoslog "cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/log/pkg/cosmos"
+)
+
+// jsonLines returns the JSON lines of buf.
+func jsonLines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var lines []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var fields map[string]any
+		if err := json.Unmarshal([]byte(line), &fields); err != nil {
+			t.Fatalf("invalid JSON line %q: %v", line, err)
+		}
+		lines = append(lines, fields)
+	}
+	return lines
+}
+
+func TestFromCosmosLogger(t *testing.T) {
+	var buf bytes.Buffer
+	filter, err := cosmoslog.ParseLogLevel("*:info,engine:debug")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var logger log.AdvancedLogger[any, *cosmos.Logger]
+	logger = cosmos.FromCosmosLogger(cosmoslog.NewLogger(
+		&buf, cosmoslog.OutputJSONOption(), cosmoslog.FilterOption(filter),
+	))
+
+	logger.Debug("discarded")
+	engine := logger.With(cosmoslog.ModuleKey, "engine").With("client", "geth")
+	engine.Debug("engine debug", "slot", 1)
+	logger.Warn("warning", "slot", 2)
+
+	lines := jsonLines(t, &buf)
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %v", lines)
+	}
+	for key, value := range map[string]any{
+		"level": "debug", "message": "engine debug",
+		"module": "engine", "client": "geth", "slot": float64(1),
+	} {
+		if lines[0][key] != value {
+			t.Errorf("expected %s=%v, got %v", key, value, lines[0][key])
+		}
+	}
+	if lines[1]["level"] != "warn" || lines[1]["slot"] != float64(2) {
+		t.Errorf("unexpected warning %v", lines[1])
+	}
+}
+
+// recordingLogger records the lines it logs.
+type recordingLogger struct {
+	lines []string
+}
+
+func (l *recordingLogger) record(level, msg string, keyVals []any) {
+	line := fmt.Sprintln(append([]any{level, msg}, keyVals...)...)
+	l.lines = append(l.lines, strings.TrimSuffix(line, "\n"))
+}
+
+func (l *recordingLogger) Info(msg string, keyVals ...any) {
+	l.record("info", msg, keyVals)
+}
+
+func (l *recordingLogger) Warn(msg string, keyVals ...any) {
+	l.record("warn", msg, keyVals)
+}
+
+func (l *recordingLogger) Error(msg string, keyVals ...any) {
+	l.record("error", msg, keyVals)
+}
+
+func (l *recordingLogger) Debug(msg string, keyVals ...any) {
+	l.record("debug", msg, keyVals)
+}
+
+func TestToCosmosLogger(t *testing.T) {
+	recorder := new(recordingLogger)
+	logger := cosmos.ToCosmosLogger(recorder)
+	if logger.Impl() != recorder {
+		t.Errorf("expected the beacon-kit logger as implementation")
+	}
+
+	child := logger.With("module", "engine")
+	child.With("client", "geth").Error("error", "slot", 1)
+	child.Debug("debug")
+	logger.Info("info", "slot", 2)
+
+	expected := []string{
+		"error error module engine client geth slot 1",
+		"debug debug module engine",
+		"info info slot 2",
+	}
+	if len(recorder.lines) != len(expected) {
+		t.Fatalf("expected lines %q, got %q", expected, recorder.lines)
+	}
+	for i := range expected {
+		if recorder.lines[i] != expected[i] {
+			t.Errorf("expected line %q, got %q", expected[i], recorder.lines[i])
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	cosmosLogger := cosmoslog.NewLogger(&buf, cosmoslog.OutputJSONOption())
+	if cosmos.ToCosmosLogger(cosmos.FromCosmosLogger(cosmosLogger)) !=
+		cosmosLogger {
+		t.Errorf("expected the cosmos logger back")
+	}
+
+	recorder := new(recordingLogger)
+	logger := cosmos.FromCosmosLogger(
+		cosmos.ToCosmosLogger(recorder).With("module", "engine"),
+	).With("client", "geth")
+	logger.Warn("warning", "slot", 1)
+	if len(recorder.lines) != 1 ||
+		recorder.lines[0] != "warn warning module engine client geth slot 1" {
+		t.Errorf("unexpected lines %q", recorder.lines)
+	}
+}
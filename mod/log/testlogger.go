@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package log
+
+import (
+	"fmt"
+	"strings"
+)
+
+// levelNames are the names of the levels in the lines of a TestLogger.
+//
+//nolint:gochecknoglobals // constant.
+var levelNames = map[Level]string{
+	LevelDebug: "DBG",
+	LevelInfo:  "INF",
+	LevelWarn:  "WRN",
+	LevelError: "ERR",
+}
+
+// TB is the part of testing.TB used by a TestLogger.
+type TB interface {
+	Helper()
+	Logf(format string, args ...any)
+}
+
+// TestLogger is a logger writing its lines to the log of a test, for them
+// to be shown with the failures of the test only.
+type TestLogger struct {
+	t       TB
+	level   Level
+	keyVals []any
+}
+
+// NewTestLogger returns a logger writing all its lines to the log of t.
+func NewTestLogger(t TB) *TestLogger {
+	return &TestLogger{t: t, level: LevelDebug}
+}
+
+// WithLevel returns the logger discarding the lines below level.
+func (l *TestLogger) WithLevel(level Level) *TestLogger {
+	return &TestLogger{t: l.t, level: level, keyVals: l.keyVals}
+}
+
+// Info logs a line with level INFO.
+func (l *TestLogger) Info(msg string, keyVals ...any) {
+	l.t.Helper()
+	l.log(LevelInfo, msg, keyVals)
+}
+
+// Warn logs a line with level WARN.
+func (l *TestLogger) Warn(msg string, keyVals ...any) {
+	l.t.Helper()
+	l.log(LevelWarn, msg, keyVals)
+}
+
+// Error logs a line with level ERROR.
+func (l *TestLogger) Error(msg string, keyVals ...any) {
+	l.t.Helper()
+	l.log(LevelError, msg, keyVals)
+}
+
+// Debug logs a line with level DEBUG.
+func (l *TestLogger) Debug(msg string, keyVals ...any) {
+	l.t.Helper()
+	l.log(LevelDebug, msg, keyVals)
+}
+
+// With returns a logger with keyVals added to its lines.
+func (l *TestLogger) With(keyVals ...any) *TestLogger {
+	all := make([]any, 0, len(l.keyVals)+len(keyVals))
+	return &TestLogger{
+		t:       l.t,
+		level:   l.level,
+		keyVals: append(append(all, l.keyVals...), keyVals...),
+	}
+}
+
+// Impl returns the test of the logger.
+func (l *TestLogger) Impl() any {
+	return l.t
+}
+
+// log writes the line of level if it is not below the level of the logger,
+// as the level followed by msg and the key/value pairs.
+func (l *TestLogger) log(level Level, msg string, keyVals []any) {
+	l.t.Helper()
+	if level < l.level {
+		return
+	}
+	var line strings.Builder
+	line.WriteString(levelNames[level])
+	line.WriteByte(' ')
+	line.WriteString(msg)
+	writeKeyVals(&line, l.keyVals)
+	writeKeyVals(&line, keyVals)
+	l.t.Logf("%s", line.String())
+}
+
+// writeKeyVals writes the key/value pairs to line, as key=value. A key
+// without a value is written with an empty value.
+func writeKeyVals(line *strings.Builder, keyVals []any) {
+	for i := 0; i < len(keyVals); i += 2 {
+		var value any = ""
+		if i+1 < len(keyVals) {
+			value = keyVals[i+1]
+		}
+		fmt.Fprintf(line, " %v=%v", keyVals[i], value)
+	}
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package log_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log"
+)
+
+// recordingTB records the lines logged to a test.
+type recordingTB struct {
+	lines []string
+}
+
+func (*recordingTB) Helper() {}
+
+func (tb *recordingTB) Logf(format string, args ...any) {
+	tb.lines = append(tb.lines, fmt.Sprintf(format, args...))
+}
+
+func TestTestLogger(t *testing.T) {
+	tb := new(recordingTB)
+	logger := log.NewTestLogger(tb)
+	logger.Debug("debug", "slot", 1)
+	logger.With("module", "engine").With("client", "geth").
+		Warn("warning", "slot", 2, "dangling")
+
+	filtered := logger.WithLevel(log.LevelWarn)
+	filtered.Info("info")
+	filtered.Error("error", "err", "unavailable")
+
+	expected := []string{
+		"DBG debug slot=1",
+		"WRN warning module=engine client=geth slot=2 dangling=",
+		"ERR error err=unavailable",
+	}
+	if len(tb.lines) != len(expected) {
+		t.Fatalf("expected lines %q, got %q", expected, tb.lines)
+	}
+	for i := range expected {
+		if tb.lines[i] != expected[i] {
+			t.Errorf("expected line %q, got %q", expected[i], tb.lines[i])
+		}
+	}
+}
+
+func TestTestLogger_Testing(t *testing.T) {
+	var logger log.AdvancedLogger[any, *log.TestLogger] = log.NewTestLogger(t)
+	logger.With("test", t.Name()).Info("logged to the test")
+}
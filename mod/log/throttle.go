@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package log
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// defaultThrottleKeys is the default number of keys tracked by a
+// ThrottledLogger.
+const defaultThrottleKeys = 1024
+
+// ThrottleOption configures a ThrottledLogger.
+type ThrottleOption func(*throttleConfig)
+
+// throttleConfig is the configuration of a ThrottledLogger.
+type throttleConfig struct {
+	now     func() time.Time
+	maxKeys int
+}
+
+// WithClock sets the clock of the throttle, time.Now by default.
+func WithClock(now func() time.Time) ThrottleOption {
+	return func(c *throttleConfig) {
+		c.now = now
+	}
+}
+
+// WithMaxKeys sets the number of keys tracked by the throttle, above which
+// the keys whose interval has elapsed, or else the oldest keys, are
+// forgotten.
+func WithMaxKeys(maxKeys int) ThrottleOption {
+	return func(c *throttleConfig) {
+		c.maxKeys = maxKeys
+	}
+}
+
+// throttleEntry is the state of a throttled key.
+type throttleEntry struct {
+	// emitted is the time the line of the key was last logged at.
+	emitted time.Time
+	// interval is the interval of the key.
+	interval time.Duration
+	// suppressed is the number of lines suppressed since.
+	suppressed int
+}
+
+// ThrottledLogger is a logger whose throttled lines are logged at most once
+// per interval for their key. The number of lines suppressed in between is
+// appended to the next line logged. It is safe for concurrent use.
+type ThrottledLogger[KeyValT any] struct {
+	Logger[KeyValT]
+	cfg throttleConfig
+
+	mu      sync.Mutex
+	entries map[string]*throttleEntry
+}
+
+// NewThrottledLogger returns a logger throttling the lines of logger.
+func NewThrottledLogger[KeyValT any](
+	logger Logger[KeyValT],
+	opts ...ThrottleOption,
+) *ThrottledLogger[KeyValT] {
+	cfg := throttleConfig{now: time.Now, maxKeys: defaultThrottleKeys}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &ThrottledLogger[KeyValT]{
+		Logger:  logger,
+		cfg:     cfg,
+		entries: make(map[string]*throttleEntry),
+	}
+}
+
+// WarnThrottled logs a warning, unless a warning of the same key was logged
+// less than interval ago.
+func (l *ThrottledLogger[KeyValT]) WarnThrottled(
+	key string,
+	interval time.Duration,
+	msg string,
+	keyVals ...KeyValT,
+) {
+	if msg, ok := l.allow(key, interval, msg); ok {
+		l.Warn(msg, keyVals...)
+	}
+}
+
+// allow returns whether the line of key is logged, and its message with the
+// number of lines suppressed since the last one.
+func (l *ThrottledLogger[KeyValT]) allow(
+	key string,
+	interval time.Duration,
+	msg string,
+) (string, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.cfg.now()
+	entry, ok := l.entries[key]
+	if ok && now.Sub(entry.emitted) < entry.interval {
+		entry.suppressed++
+		return "", false
+	}
+	if !ok {
+		l.evict(now)
+		entry = new(throttleEntry)
+		l.entries[key] = entry
+	}
+	if entry.suppressed > 0 {
+		msg = fmt.Sprintf("%s (suppressed %d times)", msg, entry.suppressed)
+	}
+	entry.emitted, entry.interval, entry.suppressed = now, interval, 0
+	return msg, true
+}
+
+// evict forgets keys until there is room for a new one: the keys whose
+// interval has elapsed, or else the key logged the longest ago. The lines
+// suppressed for them are not reported.
+func (l *ThrottledLogger[KeyValT]) evict(now time.Time) {
+	if len(l.entries) < l.cfg.maxKeys {
+		return
+	}
+	var (
+		oldestKey string
+		oldest    *throttleEntry
+	)
+	for key, entry := range l.entries {
+		if now.Sub(entry.emitted) >= entry.interval {
+			delete(l.entries, key)
+			continue
+		}
+		if oldest == nil || entry.emitted.Before(oldest.emitted) {
+			oldestKey, oldest = key, entry
+		}
+	}
+	if len(l.entries) >= l.cfg.maxKeys {
+		delete(l.entries, oldestKey)
+	}
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package log_test
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log"
+)
+
+// recordingLogger records the messages of the warnings it logs.
+type recordingLogger struct {
+	mu       sync.Mutex
+	warnings []string
+}
+
+func (*recordingLogger) Info(string, ...any)  {}
+func (*recordingLogger) Error(string, ...any) {}
+func (*recordingLogger) Debug(string, ...any) {}
+
+func (l *recordingLogger) Warn(msg string, _ ...any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.warnings = append(l.warnings, msg)
+}
+
+// fakeClock is a clock advanced by the tests.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func TestThrottledLogger_WarnThrottled(t *testing.T) {
+	var (
+		recorder = new(recordingLogger)
+		clock    = &fakeClock{now: time.Unix(0, 0)}
+		logger   = log.NewThrottledLogger[any](recorder, log.WithClock(clock.Now))
+	)
+
+	warn := func(key string) {
+		logger.WarnThrottled(key, time.Minute, key+" warning", "slot", 1)
+	}
+	warn("fee-recipient")
+	for range 3 {
+		clock.now = clock.now.Add(10 * time.Second)
+		warn("fee-recipient")
+	}
+	// Other keys are throttled on their own.
+	warn("other")
+	clock.now = clock.now.Add(30 * time.Second)
+	warn("fee-recipient")
+	warn("fee-recipient")
+	clock.now = clock.now.Add(time.Minute)
+	warn("fee-recipient")
+
+	expected := []string{
+		"fee-recipient warning",
+		"other warning",
+		"fee-recipient warning (suppressed 3 times)",
+		"fee-recipient warning (suppressed 1 times)",
+	}
+	if len(recorder.warnings) != len(expected) {
+		t.Fatalf("expected warnings %q, got %q", expected, recorder.warnings)
+	}
+	for i := range expected {
+		if recorder.warnings[i] != expected[i] {
+			t.Errorf("expected warning %q, got %q",
+				expected[i], recorder.warnings[i])
+		}
+	}
+}
+
+func TestThrottledLogger_MaxKeys(t *testing.T) {
+	var (
+		recorder = new(recordingLogger)
+		clock    = &fakeClock{now: time.Unix(0, 0)}
+		logger   = log.NewThrottledLogger[any](
+			recorder, log.WithClock(clock.Now), log.WithMaxKeys(2),
+		)
+	)
+
+	for i := range 3 {
+		clock.now = clock.now.Add(time.Second)
+		logger.WarnThrottled(strconv.Itoa(i), time.Hour, "warning")
+	}
+	// The oldest key was forgotten to make room for the third one.
+	logger.WarnThrottled("0", time.Hour, "warning")
+	logger.WarnThrottled("2", time.Hour, "warning")
+	if len(recorder.warnings) != 4 {
+		t.Fatalf("expected 4 warnings, got %q", recorder.warnings)
+	}
+}
+
+func TestThrottledLogger_Concurrent(t *testing.T) {
+	var (
+		recorder = new(recordingLogger)
+		logger   = log.NewThrottledLogger[any](recorder)
+		wg       sync.WaitGroup
+	)
+	for range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			logger.WarnThrottled("key", time.Hour, "warning")
+		}()
+	}
+	wg.Wait()
+	if len(recorder.warnings) != 1 {
+		t.Fatalf("expected a single warning, got %q", recorder.warnings)
+	}
+}
@@ -31,6 +31,7 @@ import (
 
 type Backend struct {
 	getNewStateDB func(context.Context, string) StateDB
+	pruners       Pruners
 }
 
 // TODO: need to add state_id resolver; possible values are: "head" (canonical
@@ -38,12 +39,24 @@ type Backend struct {
 // encoded stateRoot with 0x prefix>.
 func New(
 	getNewStateDB func(ctx context.Context, stateId string) StateDB,
+	pruners Pruners,
 ) *Backend {
 	return &Backend{
 		getNewStateDB: getNewStateDB,
+		pruners:       pruners,
 	}
 }
 
+// Pruners prunes the stores of the node on demand.
+type Pruners interface {
+	PruneNow(
+		ctx context.Context,
+		name string,
+		start, end uint64,
+		dryRun bool,
+	) (uint64, error)
+}
+
 type StateDB interface {
 	GetGenesisValidatorsRoot() (primitives.Root, error)
 	GetSlot() (math.Slot, error)
@@ -34,7 +34,7 @@ func TestGetGenesisValidatorsRoot(t *testing.T) {
 	sdb := &mocks.StateDB{}
 	b := backend.New(func(context.Context, string) backend.StateDB {
 		return sdb
-	})
+	}, nil)
 	sdb.EXPECT().GetGenesisValidatorsRoot().Return(primitives.Root{0x01}, nil)
 	root, err := b.GetGenesis(context.Background())
 	require.NoError(t, err)
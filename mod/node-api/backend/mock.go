@@ -34,11 +34,23 @@ func NewMockBackend() *Backend {
 	sdb := &mocks.StateDB{}
 	b := New(func(context.Context, string) StateDB {
 		return sdb
-	})
+	}, mockPruners{})
 	setReturnValues(sdb)
 	return b
 }
 
+// mockPruners reports every index of a pruned range as holding one entry.
+type mockPruners struct{}
+
+func (mockPruners) PruneNow(
+	_ context.Context,
+	_ string,
+	start, end uint64,
+	_ bool,
+) (uint64, error) {
+	return end - start, nil
+}
+
 func setReturnValues(sdb *mocks.StateDB) {
 	sdb.EXPECT().GetGenesisValidatorsRoot().Return(primitives.Root{0x01}, nil)
 	sdb.EXPECT().GetSlot().Return(1, nil)
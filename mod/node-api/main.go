@@ -38,10 +38,9 @@ func NewServer(corsConfig middleware.CORSConfig,
 	server.UseMiddlewares(e,
 		middleware.CORSWithConfig(corsConfig),
 		middleware.LoggerWithConfig(loggingConfig))
-	server.AssignRoutes(
-		e,
-		handlers.RouteHandlers{Backend: backend.NewMockBackend()},
-	)
+	h := handlers.RouteHandlers{Backend: backend.NewMockBackend()}
+	server.AssignRoutes(e, h)
+	server.AssignAdminRoutes(e, h)
 	return e
 }
 
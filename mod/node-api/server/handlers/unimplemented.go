@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package handlers
+
+import echo "github.com/labstack/echo/v4"
+
+// The routes below are not served by the backend handlers yet.
+
+func (rh RouteHandlers) GetBlock(c echo.Context) error {
+	return rh.NotImplemented(c)
+}
+
+func (rh RouteHandlers) GetBlobSidecars(c echo.Context) error {
+	return rh.NotImplemented(c)
+}
+
+func (rh RouteHandlers) GetFinalityCheckpoints(c echo.Context) error {
+	return rh.NotImplemented(c)
+}
+
+func (rh RouteHandlers) GetFork(c echo.Context) error {
+	return rh.NotImplemented(c)
+}
+
+func (rh RouteHandlers) GetSpec(c echo.Context) error {
+	return rh.NotImplemented(c)
+}
+
+func (rh RouteHandlers) GetForkSchedule(c echo.Context) error {
+	return rh.NotImplemented(c)
+}
+
+func (rh RouteHandlers) GetDepositContract(c echo.Context) error {
+	return rh.NotImplemented(c)
+}
+
+func (rh RouteHandlers) GetEvents(c echo.Context) error {
+	return rh.NotImplemented(c)
+}
+
+func (rh RouteHandlers) GetSyncing(c echo.Context) error {
+	return rh.NotImplemented(c)
+}
+
+func (rh RouteHandlers) GetHealth(c echo.Context) error {
+	return rh.NotImplemented(c)
+}
+
+func (rh RouteHandlers) GetProposerDuties(c echo.Context) error {
+	return rh.NotImplemented(c)
+}
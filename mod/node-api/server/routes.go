@@ -33,6 +33,24 @@ type Handlers interface {
 	GetStateValidatorBalances(c echo.Context) error
 	PostStateValidatorBalances(c echo.Context) error
 	GetBlockRewards(c echo.Context) error
+	GetBlock(c echo.Context) error
+	GetBlobSidecars(c echo.Context) error
+	GetFinalityCheckpoints(c echo.Context) error
+	GetFork(c echo.Context) error
+	GetSpec(c echo.Context) error
+	GetForkSchedule(c echo.Context) error
+	GetDepositContract(c echo.Context) error
+	GetEvents(c echo.Context) error
+	GetSyncing(c echo.Context) error
+	GetHealth(c echo.Context) error
+	GetProposerDuties(c echo.Context) error
+}
+
+// AdminHandlers handles the admin routes, which change the state of the node.
+// They are not assigned by AssignRoutes and must only be served on a listener
+// that is reachable by the operators of the node.
+type AdminHandlers interface {
+	PostPrune(c echo.Context) error
 }
 
 func UseMiddlewares(e *echo.Echo, middlewares ...echo.MiddlewareFunc) {
@@ -58,9 +76,9 @@ func assignBeaconRoutes(e *echo.Echo, h Handlers) {
 	e.GET("/eth/v1/beacon/states/:state_id/root",
 		h.GetStateRoot)
 	e.GET("/eth/v1/beacon/states/:state_id/fork",
-		h.NotImplemented)
+		h.GetFork)
 	e.GET("/eth/v1/beacon/states/:state_id/finality_checkpoints",
-		h.NotImplemented)
+		h.GetFinalityCheckpoints)
 	e.GET("/eth/v1/beacon/states/:state_id/validators",
 		h.GetStateValidators)
 	e.POST("/eth/v1/beacon/states/:state_id/validators",
@@ -90,13 +108,13 @@ func assignBeaconRoutes(e *echo.Echo, h Handlers) {
 	e.POST("/eth/v2/beacon/blocks",
 		h.NotImplemented)
 	e.GET("/eth/v2/beacon/blocks/:block_id",
-		h.NotImplemented)
+		h.GetBlock)
 	e.GET("/eth/v1/beacon/blocks/:block_id/root",
 		h.NotImplemented)
 	e.GET("/eth/v1/beacon/blocks/:block_id/attestations",
 		h.NotImplemented)
 	e.GET("/eth/v1/beacon/blob_sidecars/:block_id",
-		h.NotImplemented)
+		h.GetBlobSidecars)
 	e.POST("/eth/v1/beacon/rewards/sync_committee/:block_id",
 		h.NotImplemented)
 	e.GET("/eth/v1/beacon/deposit_snapshot",
@@ -144,11 +162,11 @@ func assignBuilderRoutes(e *echo.Echo, h Handlers) {
 
 func assignConfigRoutes(e *echo.Echo, h Handlers) {
 	e.GET("/eth/v1/config/fork_schedule",
-		h.NotImplemented)
+		h.GetForkSchedule)
 	e.GET("/eth/v1/config/spec",
-		h.NotImplemented)
+		h.GetSpec)
 	e.GET("/eth/v1/config/deposit_contract",
-		h.NotImplemented)
+		h.GetDepositContract)
 }
 
 func assignDebugRoutes(e *echo.Echo, h Handlers) {
@@ -162,7 +180,7 @@ func assignDebugRoutes(e *echo.Echo, h Handlers) {
 
 func assignEventsRoutes(e *echo.Echo, h Handlers) {
 	e.GET("/eth/v1/events",
-		h.NotImplemented)
+		h.GetEvents)
 }
 
 func aasignNodeRoutes(e *echo.Echo, h Handlers) {
@@ -177,16 +195,16 @@ func aasignNodeRoutes(e *echo.Echo, h Handlers) {
 	e.GET("/eth/v1/node/version",
 		h.NotImplemented)
 	e.GET("/eth/v1/node/syncing",
-		h.NotImplemented)
+		h.GetSyncing)
 	e.GET("/eth/v1/node/health",
-		h.NotImplemented)
+		h.GetHealth)
 }
 
 func assignValidatorRoutes(e *echo.Echo, h Handlers) {
 	e.POST("/eth/v1/validator/duties/attester/:epoch",
 		h.NotImplemented)
 	e.GET("/eth/v1/validator/duties/proposer/:epoch",
-		h.NotImplemented)
+		h.GetProposerDuties)
 	e.POST("/eth/v1/validator/duties/sync/:epoch",
 		h.NotImplemented)
 	e.GET("/eth/v3/validator/blocks/:slot",
@@ -225,3 +243,9 @@ func assignRewardsRoutes(e *echo.Echo, h Handlers) {
 	e.POST("/eth/v1/beacon/rewards/attestations/:epoch",
 		h.NotImplemented)
 }
+
+// AssignAdminRoutes assigns the admin routes to e.
+func AssignAdminRoutes(e *echo.Echo, h AdminHandlers) {
+	e.POST("/admin/v1/prune/:pruner",
+		h.PostPrune)
+}
@@ -52,4 +52,10 @@ type BackendHandlers interface {
 		ctx context.Context,
 		blockID string,
 	) (*BlockRewardsData, error)
+	PruneNow(
+		ctx context.Context,
+		pruner string,
+		start, end uint64,
+		dryRun bool,
+	) (uint64, error)
 }
@@ -92,3 +92,10 @@ type BlobSidecarRequest struct {
 	BlockIDRequest
 	Indices []string `query:"indices" validate:"dive,uint64"`
 }
+
+type PruneRequest struct {
+	Pruner string `param:"pruner" validate:"required"`
+	Start  uint64 `json:"start,string"`
+	End    uint64 `json:"end,string"   validate:"gtefield=Start"`
+	DryRun bool   `json:"dry_run"`
+}
@@ -76,3 +76,11 @@ type BlockRewardsData struct {
 	ProposerSlashings uint64 `json:"proposer_slashings,string"`
 	AttesterSlashings uint64 `json:"attester_slashings,string"`
 }
+
+type PruneData struct {
+	Pruner  string `json:"pruner"`
+	Start   uint64 `json:"start,string"`
+	End     uint64 `json:"end,string"`
+	Deleted uint64 `json:"deleted,string"`
+	DryRun  bool   `json:"dry_run"`
+}
@@ -446,6 +446,19 @@ func getTestcases() []testcase {
 			endpoint:       "/eth/v1/validator/liveness/:epoch",
 			expectedStatus: http.StatusNotImplemented,
 		},
+		{
+			method:         "POST",
+			endpoint:       "/admin/v1/prune/:pruner",
+			body:           "{\"start\":\"2\",\"end\":\"6\",\"dry_run\":true}",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"data\":{\"pruner\":\"blobs\",\"start\":\"2\",\"end\":\"6\",\"deleted\":\"4\",\"dry_run\":true}}\n",
+		},
+		{
+			method:         "POST",
+			endpoint:       "/admin/v1/prune/:pruner",
+			body:           "{\"start\":\"6\",\"end\":\"2\"}",
+			expectedStatus: http.StatusBadRequest,
+		},
 	}
 }
 
@@ -459,5 +472,6 @@ func remapParams(url string) string {
 	url = strings.ReplaceAll(url, ":block_root",
 		"0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2")
 	url = strings.ReplaceAll(url, ":validator_id", "1")
+	url = strings.ReplaceAll(url, ":pruner", "blobs")
 	return url
 }
@@ -24,6 +24,7 @@ import (
 	"context"
 	"io"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	bkcomponents "github.com/berachain/beacon-kit/mod/node-core/pkg/components"
 	beacon "github.com/berachain/beacon-kit/mod/node-core/pkg/components/module"
 	dbm "github.com/cosmos/cosmos-db"
@@ -68,16 +69,20 @@ func NewBeaconKitApp(
 	return app
 }
 
+// Close stops the services of the beacon module before closing the
+// underlying app, so that no service is interrupted while writing to the
+// stores. The services are given the shutdown timeout of the configuration
+// to stop.
+func (app *BeaconApp) Close() error {
+	return errors.Join(
+		app.beaconModule().StopServices(context.Background()),
+		app.App.Close(),
+	)
+}
+
 // TODO: Unhack this.
 func (app *BeaconApp) setupBeaconModule() {
-	// Get the beacon module.
-	//
-	// TODO: Cleanup.
-	beaconModule, ok := app.ModuleManager.
-		Modules[beacon.ModuleName].(beacon.AppModule)
-	if !ok {
-		panic("beacon module not found")
-	}
+	beaconModule := app.beaconModule()
 
 	// Set the beacon module's handlers.
 	app.SetPrepareProposal(
@@ -98,3 +103,15 @@ func (app *BeaconApp) setupBeaconModule() {
 		panic(err)
 	}
 }
+
+// beaconModule returns the beacon module of the app.
+//
+// TODO: Cleanup.
+func (app *BeaconApp) beaconModule() beacon.AppModule {
+	beaconModule, ok := app.ModuleManager.
+		Modules[beacon.ModuleName].(beacon.AppModule)
+	if !ok {
+		panic("beacon module not found")
+	}
+	return beaconModule
+}
@@ -22,6 +22,7 @@ package builder
 
 import (
 	"os"
+	"reflect"
 
 	"cosmossdk.io/client/v2/autocli"
 	"cosmossdk.io/depinject"
@@ -35,16 +36,22 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/flags"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/node"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	blockstore "github.com/berachain/beacon-kit/mod/storage/pkg/block"
 	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/config"
+	clientFlags "github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 )
 
@@ -55,9 +62,18 @@ type NodeBuilder[NodeT types.NodeI] struct {
 	description  string
 	depInjectCfg depinject.Config
 	chainSpec    primitives.ChainSpec
+	// logger is the logger given to the components, if set.
+	logger log.Logger
+	// loggingCfg configures the logger given to the components, if set.
+	loggingCfg *loggingConfig
 
 	// components is a list of components to provide.
 	components []any
+	// overrides are providers replacing the components outputting the same
+	// types.
+	overrides []any
+	// removed are the types whose providers are dropped from the components.
+	removed []reflect.Type
 }
 
 // New returns a new NodeBuilder.
@@ -88,8 +104,14 @@ func (nb *NodeBuilder[NodeT]) buildRootCmd() (*cobra.Command, error) {
 		autoCliOpts autocli.AppOptions
 		mm          *module.Manager
 		clientCtx   client.Context
+		chainSpec   primitives.ChainSpec
+		appOpts     servertypes.AppOptions
 	)
-	if err := depinject.Inject(
+	logger, err := nb.Logger(log.NewLogger(os.Stdout))
+	if err != nil {
+		return nil, err
+	}
+	if err = depinject.Inject(
 		depinject.Configs(
 			nb.depInjectCfg,
 			// TODO: the reason these all need to be supplied here is because
@@ -100,10 +122,11 @@ func (nb *NodeBuilder[NodeT]) buildRootCmd() (*cobra.Command, error) {
 			// the beacon module so that we don't need to define these empty
 			// placeholders to get the depinject framework to not freak out.
 			depinject.Supply(
-				log.NewLogger(os.Stdout),
+				logger,
 				viper.GetViper(),
-				nb.chainSpec,
+				components.DefaultChainSpec{ChainSpec: nb.chainSpec},
 				&depositdb.KVStore[*consensustypes.Deposit]{},
+				&blockstore.KVStore[*consensustypes.BeaconBlock]{},
 				&engineclient.EngineClient[*consensustypes.ExecutionPayload]{},
 				&gokzg4844.JSONTrustedSetup{},
 				&noop.Verifier{},
@@ -120,10 +143,16 @@ func (nb *NodeBuilder[NodeT]) buildRootCmd() (*cobra.Command, error) {
 				components.ProvideClientContext,
 				components.ProvideKeyring,
 				components.ProvideConfig,
+				components.ProvideChainSpec,
 				components.ProvideLocalBuilder,
+				components.ProvideBatchVerifier,
 				components.ProvideStateProcessor,
+				components.ProvideHealthRegistry,
 				components.ProvideExecutionEngine,
+				components.ProvideEventBus,
 				components.ProvideBlockFeed,
+				components.ProvideFinalizedBlockFeed,
+				components.ProvidePrunerCheckpoints,
 				components.ProvideDepositPruner,
 				components.ProvideAvailabilityPruner,
 				components.ProvideBlobProcessor,
@@ -134,6 +163,7 @@ func (nb *NodeBuilder[NodeT]) buildRootCmd() (*cobra.Command, error) {
 		&autoCliOpts,
 		&mm,
 		&clientCtx,
+		&chainSpec,
 	); err != nil {
 		return nil, err
 	}
@@ -146,7 +176,25 @@ func (nb *NodeBuilder[NodeT]) buildRootCmd() (*cobra.Command, error) {
 			cmd.SetOut(cmd.OutOrStdout())
 			cmd.SetErr(cmd.ErrOrStderr())
 
-			var err error
+			// Read the keyring backend from the flags set on the command the
+			// same way as from the app options.
+			flagOpts := viper.New()
+			cmd.Flags().Visit(func(f *pflag.Flag) {
+				flagOpts.Set(f.Name, f.Value.String())
+			})
+			keyringBackend, err := components.KeyringBackend(flagOpts)
+			if err != nil {
+				return err
+			}
+			// The client config is read before the flags are parsed, so a
+			// backend selected by the flags must take precedence over it.
+			if flagOpts.IsSet(clientFlags.FlagKeyringBackend) ||
+				flagOpts.IsSet(flags.DevMode) {
+				clientCtx.Viper.Set(
+					clientFlags.FlagKeyringBackend, keyringBackend,
+				)
+			}
+
 			clientCtx, err = client.ReadPersistentCommandFlags(
 				clientCtx,
 				cmd.Flags(),
@@ -155,7 +203,9 @@ func (nb *NodeBuilder[NodeT]) buildRootCmd() (*cobra.Command, error) {
 				return err
 			}
 
-			customClientTemplate, customClientConfig := components.InitClientConfig()
+			customClientTemplate, customClientConfig := components.InitClientConfig(
+				keyringBackend,
+			)
 			clientCtx, err = config.CreateClientConfig(
 				clientCtx,
 				customClientTemplate,
@@ -171,23 +221,37 @@ func (nb *NodeBuilder[NodeT]) buildRootCmd() (*cobra.Command, error) {
 				return err
 			}
 
-			return server.InterceptConfigsPreRunHandler(
+			if err = server.InterceptConfigsPreRunHandler(
 				cmd,
 				DefaultAppConfigTemplate(),
 				DefaultAppConfig(),
 				DefaultCometConfig(),
-			)
+			); err != nil {
+				return err
+			}
+
+			// Keep the app options for the database opener of the start
+			// command, which is only given the root directory.
+			appOpts = server.GetServerContextFromCmd(cmd).Viper
+			return nil
 		},
 	}
 
+	cmd.PersistentFlags().Bool(
+		flags.DevMode, false,
+		"default to the test keyring backend, only meant for development",
+	)
 	cmdlib.DefaultRootCommandSetup(
 		cmd,
 		mm,
 		nb.AppCreator,
-		nb.chainSpec,
+		chainSpec,
+		func(rootDir string, _ dbm.BackendType) (dbm.DB, error) {
+			return OpenDB(rootDir, appOpts)
+		},
 	)
 
-	if err := autoCliOpts.EnhanceRootCommand(cmd); err != nil {
+	if err = autoCliOpts.EnhanceRootCommand(cmd); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"reflect"
+	"slices"
+
+	"cosmossdk.io/depinject"
+)
+
+// Components returns the providers given to depinject when building the
+// application: the components of the NodeBuilder, minus those replaced by an
+// override or dropped with WithoutComponent, followed by the overrides.
+func (nb *NodeBuilder[NodeT]) Components() []any {
+	return nb.resolveComponents(nb.components)
+}
+
+// resolveComponents filters the given default providers against the
+// overrides and the dropped types of the NodeBuilder. A default provider
+// outputting several types is dropped as a whole as soon as one of them is
+// replaced, so an override must provide all the types it still needs.
+func (nb *NodeBuilder[NodeT]) resolveComponents(defaults []any) []any {
+	replaced := make(map[reflect.Type]struct{})
+	for _, t := range nb.removed {
+		replaced[t] = struct{}{}
+	}
+	for _, override := range nb.overrides {
+		for _, t := range providedTypes(override) {
+			replaced[t] = struct{}{}
+		}
+	}
+
+	resolved := make([]any, 0, len(defaults)+len(nb.overrides))
+	for _, provider := range defaults {
+		if !slices.ContainsFunc(
+			providedTypes(provider),
+			func(t reflect.Type) bool {
+				_, ok := replaced[t]
+				return ok
+			},
+		) {
+			resolved = append(resolved, provider)
+		}
+	}
+	return append(resolved, nb.overrides...)
+}
+
+// providedTypes returns the types output by a depinject provider. Errors are
+// skipped and the fields of a struct embedding depinject.Out are returned in
+// place of the struct itself.
+func providedTypes(provider any) []reflect.Type {
+	var (
+		fn       = reflect.TypeOf(provider)
+		errType  = reflect.TypeOf((*error)(nil)).Elem()
+		outType  = reflect.TypeOf(depinject.Out{})
+		provided []reflect.Type
+	)
+	if fn == nil || fn.Kind() != reflect.Func {
+		return nil
+	}
+
+	for i := range fn.NumOut() {
+		out := fn.Out(i)
+		switch {
+		case out == errType:
+			continue
+		case embedsOut(out, outType):
+			for j := range out.NumField() {
+				field := out.Field(j)
+				if field.Anonymous && field.Type == outType {
+					continue
+				}
+				provided = append(provided, field.Type)
+			}
+		default:
+			provided = append(provided, out)
+		}
+	}
+	return provided
+}
+
+// embedsOut reports whether t is a struct embedding depinject.Out.
+func embedsOut(t reflect.Type, outType reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for i := range t.NumField() {
+		if field := t.Field(i); field.Anonymous && field.Type == outType {
+			return true
+		}
+	}
+	return false
+}
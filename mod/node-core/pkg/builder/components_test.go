@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	nodetypes "github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+type availabilityStore = *dastore.Store[*types.BeaconBlockBody]
+
+//nolint:gochecknoglobals // returned by ProvideCustomAvailabilityStore.
+var customAvailabilityStore = &dastore.Store[*types.BeaconBlockBody]{}
+
+// ProvideCustomAvailabilityStore provides customAvailabilityStore, depinject
+// only accepts exported providers.
+func ProvideCustomAvailabilityStore() availabilityStore {
+	return customAvailabilityStore
+}
+
+func TestComponentOverride(t *testing.T) {
+	nb := builder.New(
+		builder.WithComponents[nodetypes.NodeI](
+			components.DefaultComponentsWithStandardTypes(),
+		),
+		builder.WithComponentOverride[nodetypes.NodeI](
+			ProvideCustomAvailabilityStore,
+		),
+	)
+
+	var store availabilityStore
+	require.NoError(t, depinject.Inject(
+		depinject.Provide(nb.Components()...), &store,
+	))
+	require.Same(t, customAvailabilityStore, store)
+}
+
+func TestComponentOverride_Duplicate(t *testing.T) {
+	// Without filtering, the default and the custom providers would both
+	// provide the availability store. The container is not dumped to the
+	// working directory on the expected error.
+	var store availabilityStore
+	require.ErrorContains(t, depinject.InjectDebug(
+		depinject.DebugOptions(),
+		depinject.Provide(
+			append(
+				components.DefaultComponentsWithStandardTypes(),
+				ProvideCustomAvailabilityStore,
+			)...,
+		),
+		&store,
+	), "duplicate")
+}
+
+func TestWithoutComponent(t *testing.T) {
+	nb := builder.New(
+		builder.WithComponents[nodetypes.NodeI](
+			components.DefaultComponentsWithStandardTypes(),
+		),
+		builder.WithoutComponent[nodetypes.NodeI, availabilityStore](),
+	)
+	for _, provider := range nb.Components() {
+		_, ok := provider.(func(
+			components.AvailabilityStoreInput,
+		) (availabilityStore, error))
+		require.False(t, ok)
+	}
+	require.Len(
+		t, nb.Components(),
+		len(components.DefaultComponentsWithStandardTypes())-1,
+	)
+}
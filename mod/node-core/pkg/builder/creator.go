@@ -22,10 +22,16 @@ package builder
 
 import (
 	"io"
+	"slices"
 
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/app"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/logging"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/nodeapi"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
+	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/comet"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -42,38 +48,82 @@ func (nb *NodeBuilder[NodeT]) AppCreator(
 	traceStore io.Writer,
 	appOpts servertypes.AppOptions,
 ) NodeT {
-	// Check for goleveldb cause bad project.
-	if appOpts.Get("app-db-backend") == "goleveldb" {
-		panic("goleveldb is not supported")
+	// Commands opening the database themselves, such as rollback, rely on
+	// the backend check here.
+	if _, err := DBBackend(appOpts); err != nil {
+		panic(err)
+	}
+
+	logger, err := nb.Logger(logger)
+	if err != nil {
+		panic(err)
+	}
+
+	// Fail before any service is built if the configuration is invalid.
+	cfg, err := config.ReadConfigFromAppOpts(appOpts)
+	if err != nil {
+		panic(err)
 	}
+	if err = cfg.Validate(); err != nil {
+		panic(err)
+	}
+	logger, err = nb.formatLogger(logger, appOpts, cfg.Logging.Format)
+	if err != nil {
+		panic(err)
+	}
+	moduleLogger, err := logging.NewLogger(logger, cfg.Logging)
+	if err != nil {
+		panic(err)
+	}
+	logger = moduleLogger
 
-	appBuilder := &runtime.AppBuilder{}
-	if err := depinject.Inject(
+	var (
+		appBuilder = &runtime.AppBuilder{}
+		chainSpec  primitives.ChainSpec
+		// queryContexts gives the node API the versions of the multistore
+		// of the application, which is built after the services.
+		queryContexts = &nodeapi.QueryContexts{}
+	)
+	if err = depinject.Inject(
 		depinject.Configs(
 			nb.depInjectCfg,
 			depinject.Provide(
-				nb.components...,
+				nb.resolveComponents(
+					append(
+						slices.Clone(nb.components),
+						components.ProvideChainSpec,
+					),
+				)...,
 			),
 			depinject.Supply(
 				appOpts,
 				logger,
-				nb.chainSpec,
+				components.DefaultChainSpec{ChainSpec: nb.chainSpec},
+				queryContexts,
 			),
 		),
 		&appBuilder,
+		&chainSpec,
 	); err != nil {
 		panic(err)
 	}
 
-	nb.node.SetApplication(
-		app.NewBeaconKitApp(
-			db, traceStore, true, appBuilder,
-			append(
-				server.DefaultBaseappOptions(appOpts),
-				func(bApp *baseapp.BaseApp) {
-					bApp.SetParamStore(
-						comet.NewConsensusParamsStore(nb.chainSpec))
-				})...,
-		))
+	beaconApp := app.NewBeaconKitApp(
+		db, traceStore, true, appBuilder,
+		append(
+			server.DefaultBaseappOptions(appOpts),
+			func(bApp *baseapp.BaseApp) {
+				bApp.SetParamStore(
+					comet.NewConsensusParamsStore(chainSpec, logger))
+			})...,
+	)
+	// The loggers of the modules are created along with them.
+	for _, module := range moduleLogger.UnknownModules() {
+		logger.Warn(
+			"unknown module in the logging configuration", "module", module,
+		)
+	}
+	queryContexts.SetProvider(beaconApp)
+	nb.node.SetApplication(beaconApp)
 	return nb.node
 }
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	dbm "github.com/cosmos/cosmos-db"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	// FlagAppDBBackend is the app option selecting the backend of the
+	// application database.
+	FlagAppDBBackend = "app-db-backend"
+	// FlagAppDBMaxOpenFiles is the app option limiting the number of files
+	// the application database keeps open, 0 keeps the default of the
+	// backend.
+	FlagAppDBMaxOpenFiles = "app-db-max-open-files"
+
+	// defaultDBBackend is the backend used when none is set.
+	defaultDBBackend = dbm.PebbleDBBackend
+	// appDBName is the name of the application database.
+	appDBName = "application"
+)
+
+// ErrUnsupportedDBBackend is returned when the backend of the application
+// database is not supported.
+var ErrUnsupportedDBBackend = errors.New("unsupported app-db-backend")
+
+// supportedDBBackends returns the backends the application database can be
+// stored in. memdb is only meant for tests.
+func supportedDBBackends() []dbm.BackendType {
+	return []dbm.BackendType{
+		dbm.PebbleDBBackend,
+		dbm.RocksDBBackend,
+		dbm.MemDBBackend,
+	}
+}
+
+// DBBackend returns the backend of the application database set in appOpts,
+// or pebbledb if none is set.
+func DBBackend(appOpts servertypes.AppOptions) (dbm.BackendType, error) {
+	backend := dbm.BackendType(cast.ToString(appOpts.Get(FlagAppDBBackend)))
+	if backend == "" {
+		return defaultDBBackend, nil
+	}
+	if slices.Contains(supportedDBBackends(), backend) {
+		return backend, nil
+	}
+
+	names := make([]string, 0, len(supportedDBBackends()))
+	for _, supported := range supportedDBBackends() {
+		names = append(names, string(supported))
+	}
+	return "", errors.Wrapf(
+		ErrUnsupportedDBBackend, "%q, set %s to one of: %s",
+		backend, FlagAppDBBackend, strings.Join(names, ", "),
+	)
+}
+
+// OpenDB opens the application database in the data directory of rootDir,
+// with the backend and the tuning options set in appOpts.
+func OpenDB(rootDir string, appOpts servertypes.AppOptions) (dbm.DB, error) {
+	backend, err := DBBackend(appOpts)
+	if err != nil {
+		return nil, err
+	}
+	return dbm.NewDBwithOptions(
+		appDBName, backend, filepath.Join(rootDir, "data"), dbOptions{
+			// Read by the pebbledb and rocksdb constructors of cosmos-db.
+			"maxopenfiles": cast.ToInt(appOpts.Get(FlagAppDBMaxOpenFiles)),
+		},
+	)
+}
+
+// dbOptions are the options given to the constructor of a database backend.
+type dbOptions map[string]any
+
+// Get implements dbm.Options.
+func (o dbOptions) Get(key string) any {
+	return o[key]
+}
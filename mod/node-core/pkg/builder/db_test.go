@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/builder"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDBBackend(t *testing.T) {
+	for _, tc := range []struct {
+		backend  string
+		expected dbm.BackendType
+		err      string
+	}{
+		{backend: "", expected: dbm.PebbleDBBackend},
+		{backend: "pebbledb", expected: dbm.PebbleDBBackend},
+		{backend: "rocksdb", expected: dbm.RocksDBBackend},
+		{backend: "memdb", expected: dbm.MemDBBackend},
+		{
+			backend: "goleveldb",
+			err: `"goleveldb", ` +
+				"set app-db-backend to one of: pebbledb, rocksdb, memdb",
+		},
+	} {
+		t.Run(tc.backend, func(t *testing.T) {
+			appOpts := viper.New()
+			appOpts.Set(builder.FlagAppDBBackend, tc.backend)
+
+			backend, err := builder.DBBackend(appOpts)
+			if tc.err != "" {
+				require.ErrorIs(t, err, builder.ErrUnsupportedDBBackend)
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, backend)
+		})
+	}
+}
+
+func TestOpenDB_MemDB(t *testing.T) {
+	appOpts := viper.New()
+	appOpts.Set(builder.FlagAppDBBackend, "memdb")
+	appOpts.Set(builder.FlagAppDBMaxOpenFiles, 64)
+
+	db, err := builder.OpenDB(t.TempDir(), appOpts)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, db.Close()) })
+
+	require.NoError(t, db.Set([]byte("key"), []byte("value")))
+	value, err := db.Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/logging"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	// LogFormatPlain formats log lines for humans.
+	LogFormatPlain = "plain"
+	// LogFormatJSON formats log lines as JSON objects.
+	LogFormatJSON = "json"
+
+	// defaultLogLevel is the log level of the modules without a level.
+	defaultLogLevel = "info"
+)
+
+// ErrUnsupportedLogFormat is returned when the log format is unknown.
+var ErrUnsupportedLogFormat = errors.New("unsupported log format")
+
+// loggingConfig is the configuration of the logger built by the NodeBuilder.
+type loggingConfig struct {
+	level        string
+	format       string
+	moduleLevels map[string]string
+}
+
+// NewLogger creates a logger writing to w in the given format, either "plain"
+// or "json". Lines are logged if their level is at least the level of their
+// module, as set by the "module" key, or level for the other modules. The
+// level may also be a filter of the cosmos server, e.g. "*:info,p2p:error".
+func NewLogger(
+	w io.Writer, level, format string, moduleLevels map[string]string,
+) (log.Logger, error) {
+	switch {
+	case level == "":
+		level = "*:" + defaultLogLevel
+	case !strings.Contains(level, ":"):
+		level = "*:" + level
+	}
+	levels := make([]string, 0, len(moduleLevels)+1)
+	levels = append(levels, level)
+	for module, moduleLevel := range moduleLevels {
+		levels = append(levels, module+":"+moduleLevel)
+	}
+	// Sorted so that errors do not depend on the iteration order.
+	slices.Sort(levels[1:])
+	filter, err := log.ParseLogLevel(strings.Join(levels, ","))
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []log.Option{log.FilterOption(filter)}
+	switch format {
+	case "", LogFormatPlain:
+	case LogFormatJSON:
+		opts = append(opts, log.OutputJSONOption())
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedLogFormat, "%s", format)
+	}
+	return log.NewLogger(w, opts...), nil
+}
+
+// Logger returns the logger given to the components: the logger set with
+// WithLogger, or a logger configured by WithLoggingConfig, or else base.
+func (nb *NodeBuilder[NodeT]) Logger(base log.Logger) (log.Logger, error) {
+	switch {
+	case nb.logger != nil:
+		return nb.logger, nil
+	case nb.loggingCfg != nil:
+		return NewLogger(
+			os.Stdout,
+			nb.loggingCfg.level,
+			nb.loggingCfg.format,
+			nb.loggingCfg.moduleLevels,
+		)
+	default:
+		return base, nil
+	}
+}
+
+// formatLogger returns the logger of the node in the format of its logging
+// configuration: base in the console format, or a logger at the level of the
+// cosmos server in the JSON format. The logger set by the options of the
+// NodeBuilder is kept in any format.
+func (nb *NodeBuilder[NodeT]) formatLogger(
+	base log.Logger, appOpts servertypes.AppOptions, format string,
+) (log.Logger, error) {
+	if format != logging.FormatJSON ||
+		nb.logger != nil || nb.loggingCfg != nil {
+		return base, nil
+	}
+	return NewLogger(
+		os.Stdout,
+		cast.ToString(appOpts.Get(flags.FlagLogLevel)),
+		LogFormatJSON,
+		nil,
+	)
+}
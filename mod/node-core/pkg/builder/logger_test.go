@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/hex"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLogger_Levels(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := builder.NewLogger(
+		&buf, "info", builder.LogFormatJSON,
+		map[string]string{"beacon-kit": "debug", "pruner": "error"},
+	)
+	require.NoError(t, err)
+
+	logger.Debug("node debug")
+	logger.Info("node info")
+	logger.With(log.ModuleKey, "beacon-kit").Debug("beacon-kit debug")
+	logger.With(log.ModuleKey, "pruner").Warn("pruner warn")
+	logger.With(log.ModuleKey, "pruner").Error("pruner error")
+
+	var msgs []string
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		require.True(t, strings.HasPrefix(line, "{"), line)
+		for _, msg := range []string{
+			"node debug", "node info", "beacon-kit debug",
+			"pruner warn", "pruner error",
+		} {
+			if strings.Contains(line, `"`+msg+`"`) {
+				msgs = append(msgs, msg)
+			}
+		}
+	}
+	require.Equal(t, []string{
+		"node info", "beacon-kit debug", "pruner error",
+	}, msgs)
+}
+
+func TestNewLogger_Formats(t *testing.T) {
+	hash := common.ExecutionHash{0xab, 0xcd}
+	logLine := func(format string) string {
+		var buf bytes.Buffer
+		logger, err := builder.NewLogger(&buf, "info", format, nil)
+		require.NoError(t, err)
+		logger.Info(
+			"received payload",
+			"slot", math.Slot(42),
+			"payload_block_hash", hash,
+			"extra_data", hex.FromBytes([]byte{0x01, 0x02}),
+		)
+		return buf.String()
+	}
+
+	var fields map[string]any
+	require.NoError(t,
+		json.Unmarshal([]byte(logLine(builder.LogFormatJSON)), &fields),
+	)
+	require.Equal(t, "received payload", fields["message"])
+	require.Equal(t, "0x2a", fields["slot"])
+	require.Equal(t, hash.Hex(), fields["payload_block_hash"])
+	require.Equal(t, "0x0102", fields["extra_data"])
+
+	plain := logLine(builder.LogFormatPlain)
+	require.Contains(t, plain, "received payload")
+	require.Contains(t, plain, "0x2a")
+	require.Contains(t, plain, hash.Hex())
+	require.Contains(t, plain, "0x0102")
+}
+
+func TestNewLogger_ServerLevel(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := builder.NewLogger(
+		&buf, "*:error,pruner:info", builder.LogFormatJSON, nil,
+	)
+	require.NoError(t, err)
+
+	logger.Info("node info")
+	logger.With(log.ModuleKey, "pruner").Info("pruner info")
+	require.NotContains(t, buf.String(), "node info")
+	require.Contains(t, buf.String(), "pruner info")
+}
+
+func TestNewLogger_Errors(t *testing.T) {
+	var buf bytes.Buffer
+	_, err := builder.NewLogger(&buf, "info", "xml", nil)
+	require.ErrorIs(t, err, builder.ErrUnsupportedLogFormat)
+
+	_, err = builder.NewLogger(&buf, "loud", builder.LogFormatPlain, nil)
+	require.Error(t, err)
+
+	_, err = builder.NewLogger(
+		&buf, "info", builder.LogFormatPlain,
+		map[string]string{"beacon-kit": "loud"},
+	)
+	require.Error(t, err)
+}
+
+func TestNodeBuilder_Logger(t *testing.T) {
+	var recorded, base bytes.Buffer
+	recorder := log.NewLogger(&recorded, log.OutputJSONOption())
+	baseLogger := log.NewLogger(&base, log.OutputJSONOption())
+
+	nb := builder.New(builder.WithLogger[types.NodeI](recorder))
+	logger, err := nb.Logger(baseLogger)
+	require.NoError(t, err)
+	logger.Info("recorded")
+	require.Contains(t, recorded.String(), "recorded")
+	require.Empty(t, base.String())
+
+	nb = builder.New[types.NodeI]()
+	logger, err = nb.Logger(baseLogger)
+	require.NoError(t, err)
+	require.Equal(t, baseLogger, logger)
+
+	nb = builder.New(builder.WithLoggingConfig[types.NodeI](
+		"info", "xml", nil,
+	))
+	_, err = nb.Logger(baseLogger)
+	require.ErrorIs(t, err, builder.ErrUnsupportedLogFormat)
+}
@@ -21,7 +21,10 @@
 package builder
 
 import (
+	"reflect"
+
 	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives"
 )
@@ -44,6 +47,24 @@ func WithComponents[NodeT types.NodeI](components []any) Opt[NodeT] {
 	}
 }
 
+// WithComponentOverride is a function that adds a provider to the components
+// of the NodeBuilder, replacing the default components providing any of the
+// same output types. As for any depinject provider, it must be an
+// exported function.
+func WithComponentOverride[NodeT types.NodeI](provider any) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.overrides = append(nb.overrides, provider)
+	}
+}
+
+// WithoutComponent is a function that drops the default components providing
+// T from the NodeBuilder.
+func WithoutComponent[NodeT types.NodeI, T any]() Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.removed = append(nb.removed, reflect.TypeOf((*T)(nil)).Elem())
+	}
+}
+
 // WithDepInjectConfig is a function that sets the dependency injection
 // configuration for the NodeBuilder.
 func WithDepInjectConfig[NodeT types.NodeI](cfg depinject.Config) Opt[NodeT] {
@@ -67,3 +88,27 @@ func WithName[NodeT types.NodeI](name string) Opt[NodeT] {
 		nb.name = name
 	}
 }
+
+// WithLogger is a function that sets the logger given to the components of
+// the NodeBuilder, instead of the logger of the cosmos server.
+func WithLogger[NodeT types.NodeI](logger log.Logger) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.logger = logger
+	}
+}
+
+// WithLoggingConfig is a function that configures the logger given to the
+// components of the NodeBuilder. Lines below the level of their module in
+// moduleLevels, or below level for the other modules, are discarded. The
+// format is either "plain" or "json".
+func WithLoggingConfig[NodeT types.NodeI](
+	level string, format string, moduleLevels map[string]string,
+) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.loggingCfg = &loggingConfig{
+			level:        level,
+			format:       format,
+			moduleLevels: moduleLevels,
+		}
+	}
+}
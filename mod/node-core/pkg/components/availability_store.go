@@ -25,26 +25,36 @@ import (
 
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	storev2 "cosmossdk.io/store/v2/db"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/rangedb"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/spf13/cast"
 )
 
+// The file database streams the sidecars it serves.
+var _ dastore.StreamingIndexDB = (*filedb.RangeDB)(nil)
+
 // AvailabilityStoreInput is the input for the ProviderAvailabilityStore
 // function for the depinject framework.
 type AvailabilityStoreInput struct {
 	depinject.In
-	AppOpts   servertypes.AppOptions
-	ChainSpec primitives.ChainSpec
-	Logger    log.Logger
+	AppOpts       servertypes.AppOptions
+	ChainSpec     primitives.ChainSpec
+	Config        *config.Config
+	Logger        log.Logger
+	TelemetrySink *metrics.TelemetrySink
 }
 
 // ProvideAvailibilityStore provides the availability store.
@@ -53,54 +63,123 @@ func ProvideAvailibilityStore[
 ](
 	in AvailabilityStoreInput,
 ) (*dastore.Store[BeaconBlockBodyT], error) {
+	backend, err := provideAvailabilityBackend(in)
+	if err != nil {
+		return nil, err
+	}
 	return dastore.New[BeaconBlockBodyT](
-		filedb.NewRangeDB(
-			filedb.NewDB(
-				filedb.WithRootDirectory(
-					cast.ToString(
-						in.AppOpts.Get(flags.FlagHome),
-					)+"/data/blobs",
-				),
-				filedb.WithFileExtension("ssz"),
-				filedb.WithDirectoryPermissions(os.ModePerm),
-				filedb.WithLogger(in.Logger),
-			),
-		),
+		backend,
 		in.Logger.With("service", "beacon-kit.da.store"),
 		in.ChainSpec,
 	), nil
 }
 
+// provideAvailabilityBackend opens the storage backend selected in the
+// availability store config.
+func provideAvailabilityBackend(
+	in AvailabilityStoreInput,
+) (rangedb.Backend, error) {
+	dir := cast.ToString(in.AppOpts.Get(flags.FlagHome)) + "/data"
+	cfg := in.Config.AvailabilityStore
+	switch backend := cfg.StorageBackend; backend {
+	case "", rangedb.BackendFileDB:
+		policy, err := filedb.ParseSyncPolicy(cfg.SyncPolicy)
+		if err != nil {
+			return nil, err
+		}
+		db, err := filedb.Open(
+			filedb.WithRootDirectory(dir+"/blobs"),
+			filedb.WithFileExtension("ssz"),
+			filedb.WithDirectoryPermissions(os.ModePerm),
+			filedb.WithLogger(in.Logger),
+			filedb.WithCompression(cfg.Compression),
+			filedb.WithSyncPolicy(policy, cfg.SyncInterval),
+			filedb.WithTelemetrySink(in.TelemetrySink),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return filedb.NewRangeDB(db), nil
+	case rangedb.BackendPebble:
+		kvp, err := storev2.NewDB(storev2.DBTypePebbleDB, "blobs", dir, nil)
+		if err != nil {
+			return nil, err
+		}
+		return rangedb.NewKVBackend(kvp), nil
+	default:
+		return nil, errors.Newf(
+			"unsupported availability store backend: %s", backend,
+		)
+	}
+}
+
 // AvailabilityPrunerInput is the input for the ProviderAvailabilityPruner
 // function for the depinject framework.
 type AvailabilityPrunerInput struct {
 	depinject.In
 	Logger            log.Logger
 	ChainSpec         primitives.ChainSpec
-	BlockFeed         *event.FeedOf[*feed.Event[*types.BeaconBlock]]
+	Config            *config.Config
+	EventBus          *feed.Bus
 	AvailabilityStore *dastore.Store[*types.BeaconBlockBody]
+	TelemetrySink     *metrics.TelemetrySink
+	PrunerCheckpoints *pruner.KVCheckpointStore
 }
 
 // ProvideAvailabilityPruner provides a availability pruner for the depinject
-// framework.
+// framework, or nil if pruning is disabled.
 func ProvideAvailabilityPruner(
 	in AvailabilityPrunerInput,
-) pruner.Pruner[*filedb.RangeDB] {
-	rangeDB, _ := in.AvailabilityStore.IndexDB.(*filedb.RangeDB)
+) (pruner.Pruner[rangedb.Backend], error) {
+	if !in.Config.Pruning.Enabled {
+		return nil, nil
+	}
+	finalizedFeed, err := finalizedBlockTopic(in.EventBus)
+	if err != nil {
+		return nil, err
+	}
+	backend, _ := in.AvailabilityStore.IndexDB.(rangedb.Backend)
+	logger := in.Logger.With("service", manager.AvailabilityPrunerName)
+
+	cfg := in.Config.AvailabilityStore
+	minimum := in.ChainSpec.MinEpochsForBlobsSidecarsRequest()
+	retention := dastore.RetentionEpochs(
+		in.ChainSpec, cfg.BlobRetentionEpochs, cfg.UnsafeBlobRetention,
+	)
+	switch {
+	case cfg.BlobRetentionEpochs != 0 && retention != cfg.BlobRetentionEpochs:
+		logger.Warn(
+			"blob retention is below the minimum of the chain spec, "+
+				"set unsafe-blob-retention to allow it",
+			"configured", cfg.BlobRetentionEpochs, "minimum", minimum,
+		)
+	case retention < minimum:
+		logger.Warn(
+			"unsafe blob retention below the minimum of the chain spec",
+			"minimum", minimum,
+		)
+	}
+	logger.Info("blob sidecar retention", "epochs", retention)
+
 	// build the availability pruner if IndexDB is available.
 	return pruner.NewPruner[
-		*types.BeaconBlock,
-		*feed.Event[*types.BeaconBlock],
-		*filedb.RangeDB,
+		*finalizedBlock,
+		*feed.Event[*finalizedBlock],
+		rangedb.Backend,
 		event.Subscription,
 	](
-		in.Logger.With("service", manager.AvailabilityPrunerName),
-		rangeDB,
+		logger,
+		backend,
 		manager.AvailabilityPrunerName,
-		in.BlockFeed,
+		// A stalled pruner must not hold up the finalized block feed.
+		finalizedFeed.Buffered(
+			feed.WithTelemetrySink(in.TelemetrySink, manager.AvailabilityPrunerName),
+		),
 		dastore.BuildPruneRangeFn[
-			*types.BeaconBlock,
-			*feed.Event[*types.BeaconBlock],
-		](in.ChainSpec),
-	)
+			*finalizedBlock,
+			*feed.Event[*finalizedBlock],
+		](in.ChainSpec, retention),
+		pruner.WithTelemetrySink(in.TelemetrySink),
+		pruner.WithCheckpointStore(in.PrunerCheckpoints),
+	), nil
 }
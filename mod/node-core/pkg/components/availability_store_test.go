@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/health"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/rangedb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/rangedb/rangedbtest"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+// providePebbleAvailabilityStore provides an availability store over pebble
+// in home.
+func providePebbleAvailabilityStore(
+	home string,
+) (*dastore.Store[*types.BeaconBlockBody], error) {
+	cfg := config.DefaultConfig()
+	cfg.AvailabilityStore.StorageBackend = rangedb.BackendPebble
+	appOpts := viper.New()
+	appOpts.Set(flags.FlagHome, home)
+	return components.ProvideAvailibilityStore[*types.BeaconBlockBody](
+		components.AvailabilityStoreInput{
+			AppOpts: appOpts,
+			Config:  cfg,
+			Logger:  log.NewNopLogger(),
+		},
+	)
+}
+
+func TestAvailabilityStore_PebbleConformance(t *testing.T) {
+	rangedbtest.RunConformance(t, func(t *testing.T) rangedb.Backend {
+		t.Helper()
+		store, err := providePebbleAvailabilityStore(t.TempDir())
+		require.NoError(t, err)
+		backend, ok := store.IndexDB.(*rangedb.KVBackend)
+		require.True(t, ok)
+		t.Cleanup(func() { require.NoError(t, backend.Close()) })
+		return backend
+	})
+}
+
+func TestAvailabilityStore_PebbleClosedOnStop(t *testing.T) {
+	home := t.TempDir()
+	store, err := providePebbleAvailabilityStore(home)
+	require.NoError(t, err)
+
+	cfg := config.DefaultConfig()
+	cfg.Pruning.Enabled = false
+	m, err := components.ProvideDBManager(components.DBManagerInput{
+		Logger:            log.NewNopLogger(),
+		Config:            cfg,
+		AvailabilityStore: store,
+		HealthRegistry:    health.NewRegistry(time.Second, nil),
+	})
+	require.NoError(t, err)
+	require.NoError(t, m.Start(context.Background()))
+
+	// The database is locked while it is open.
+	_, err = providePebbleAvailabilityStore(home)
+	require.Error(t, err)
+
+	require.NoError(t, m.Stop(context.Background()))
+	reopened, err := providePebbleAvailabilityStore(home)
+	require.NoError(t, err)
+	backend, ok := reopened.IndexDB.(*rangedb.KVBackend)
+	require.True(t, ok)
+	require.NoError(t, backend.Close())
+}
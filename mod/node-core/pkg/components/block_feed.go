@@ -21,12 +21,80 @@
 package components
 
 import (
+	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
-	"github.com/ethereum/go-ethereum/event"
 )
 
-// ProvideBlockFeed provides a block feed for the depinject framework.
-func ProvideBlockFeed() *event.FeedOf[*feed.Event[*types.BeaconBlock]] {
-	return &event.FeedOf[*feed.Event[*types.BeaconBlock]]{}
+// finalizedBlock is the data of the events of the finalized beacon blocks.
+type finalizedBlock = events.BeaconBlockFinalizedEvent[*types.BeaconBlock]
+
+// ProvideEventBus provides the event bus for the depinject framework, with
+// the topics of the node registered and their metrics recorded in the
+// telemetry sink.
+func ProvideEventBus(
+	cfg *config.Config,
+	telemetrySink *metrics.TelemetrySink,
+) (*feed.Bus, error) {
+	bus := feed.NewBus(feed.WithMetrics(telemetrySink))
+	if _, err := feed.Register[*feed.Event[*types.BeaconBlock]](
+		bus, events.BlockTopic, feed.WithReplay(cfg.EventBus.ReplaySize),
+	); err != nil {
+		return nil, err
+	}
+	if _, err := feed.Register[*feed.Event[*finalizedBlock]](
+		bus, events.FinalizedBlockTopic,
+		feed.WithReplay(cfg.EventBus.ReplaySize),
+	); err != nil {
+		return nil, err
+	}
+	if _, err := feed.Register[*feed.Event[*events.PayloadBuiltEvent]](
+		bus, events.PayloadTopic,
+	); err != nil {
+		return nil, err
+	}
+	if _, err := feed.Register[*feed.Event[*events.DepositProcessedEvent]](
+		bus, events.DepositTopic,
+	); err != nil {
+		return nil, err
+	}
+	return bus, nil
+}
+
+// ProvideBlockFeed provides the block topic of the event bus for the
+// depinject framework.
+func ProvideBlockFeed(
+	bus *feed.Bus,
+) (*feed.Topic[*feed.Event[*types.BeaconBlock]], error) {
+	return blockTopic(bus)
+}
+
+// blockTopic returns the topic of the events of the beacon blocks of bus.
+func blockTopic(
+	bus *feed.Bus,
+) (*feed.Topic[*feed.Event[*types.BeaconBlock]], error) {
+	return feed.TopicOf[*feed.Event[*types.BeaconBlock]](
+		bus, events.BlockTopic,
+	)
+}
+
+// ProvideFinalizedBlockFeed provides the finalized block topic of the event
+// bus to the blockchain service for the depinject framework.
+func ProvideFinalizedBlockFeed(
+	bus *feed.Bus,
+) (blockchain.FinalizedBlockFeed[*types.BeaconBlock], error) {
+	return finalizedBlockTopic(bus)
+}
+
+// finalizedBlockTopic returns the topic of the events of the finalized
+// beacon blocks of bus.
+func finalizedBlockTopic(
+	bus *feed.Bus,
+) (*feed.Topic[*feed.Event[*finalizedBlock]], error) {
+	return feed.TopicOf[*feed.Event[*finalizedBlock]](
+		bus, events.FinalizedBlockTopic,
+	)
 }
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"os"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/flags"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// ChainSpecEnvVar is the environment variable selecting the chain spec if
+// the flag is not set.
+const ChainSpecEnvVar = "CHAIN_SPEC"
+
+// DefaultChainSpec is the chain spec compiled into the binary, used if no
+// chain spec is selected at runtime. The chain spec is not embedded, as
+// depinject would otherwise resolve primitives.ChainSpec to it.
+type DefaultChainSpec struct {
+	ChainSpec primitives.ChainSpec
+}
+
+// ChainSpecInput is the input for the dep inject framework.
+type ChainSpecInput struct {
+	depinject.In
+	AppOpts servertypes.AppOptions
+	Default DefaultChainSpec
+}
+
+// ProvideChainSpec provides the chain spec selected by the chain spec flag,
+// or else by the CHAIN_SPEC environment variable, falling back to the
+// default chain spec.
+func ProvideChainSpec(in ChainSpecInput) (primitives.ChainSpec, error) {
+	selector := cast.ToString(in.AppOpts.Get(flags.ChainSpec))
+	if selector == "" {
+		selector = os.Getenv(ChainSpecEnvVar)
+	}
+	return spec.Resolve(selector, in.Default.ChainSpec)
+}
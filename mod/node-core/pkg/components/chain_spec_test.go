@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/flags"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvideChainSpec(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "spec.toml")
+	require.NoError(t, os.WriteFile(
+		path, []byte("deposit-eth1-chain-id = 1337\n"), 0o600,
+	))
+	compiled := spec.TestnetChainSpec()
+
+	tests := []struct {
+		name        string
+		flag        string
+		env         string
+		wantChainID uint64
+		wantErr     error
+	}{
+		{name: "CompiledIn", wantChainID: 80084},
+		{name: "Env", env: spec.Devnet, wantChainID: 80087},
+		{name: "Flag", flag: spec.Devnet, wantChainID: 80087},
+		{
+			name:        "FlagOverEnv",
+			flag:        "file:" + path,
+			env:         spec.Devnet,
+			wantChainID: 1337,
+		},
+		{
+			name:    "Unknown",
+			flag:    "mainnet",
+			env:     spec.Devnet,
+			wantErr: spec.ErrUnknownChainSpec,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(components.ChainSpecEnvVar, tt.env)
+			appOpts := viper.New()
+			appOpts.Set(flags.ChainSpec, tt.flag)
+
+			chainSpec, err := components.ProvideChainSpec(
+				components.ChainSpecInput{
+					AppOpts: appOpts,
+					Default: components.DefaultChainSpec{
+						ChainSpec: compiled,
+					},
+				},
+			)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantChainID, chainSpec.DepositEth1ChainID())
+			if tt.flag == "" && tt.env == "" {
+				require.Same(t, compiled, chainSpec)
+			}
+		})
+	}
+}
@@ -29,7 +29,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/config"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
-	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 )
 
 //nolint:gochecknoglobals // todo:fix from sdk.
@@ -56,8 +56,12 @@ func ProvideClientContext(
 	addressCodec address.Codec,
 	validatorAddressCodec address.ValidatorAddressCodec,
 	consensusAddressCodec address.ConsensusAddressCodec,
+	appOpts servertypes.AppOptions,
 ) (client.Context, error) {
-	var err error
+	keyringBackend, err := KeyringBackend(appOpts)
+	if err != nil {
+		return client.Context{}, err
+	}
 
 	clientCtx := client.Context{}.
 		WithCodec(appCodec).
@@ -71,7 +75,9 @@ func ProvideClientContext(
 
 	// Read the config to overwrite the default values with the values from the
 	// config file
-	customClientTemplate, customClientConfig := InitClientConfig()
+	customClientTemplate, customClientConfig := InitClientConfig(
+		keyringBackend,
+	)
 	clientCtx, err = config.ReadDefaultValuesFromDefaultClientConfig(
 		clientCtx,
 		customClientTemplate,
@@ -86,10 +92,10 @@ func ProvideClientContext(
 	return clientCtx, nil
 }
 
-// InitClientConfig sets up the default client configuration, allowing for
-// overrides.
-func InitClientConfig() (string, interface{}) {
+// InitClientConfig sets up the default client configuration with the given
+// keyring backend, allowing for overrides.
+func InitClientConfig(keyringBackend string) (string, interface{}) {
 	clientCfg := config.DefaultConfig()
-	clientCfg.KeyringBackend = keyring.BackendTest
+	clientCfg.KeyringBackend = keyringBackend
 	return config.DefaultClientConfigTemplate, clientCfg
 }
@@ -21,39 +21,110 @@
 package components
 
 import (
+	"io"
+
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	storev2 "cosmossdk.io/store/v2/db"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/health"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
 	dastore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/rangedb"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/ethereum/go-ethereum/event"
+	"github.com/spf13/cast"
 )
 
+// PrunerCheckpointsInput is the input for the dep inject framework.
+type PrunerCheckpointsInput struct {
+	depinject.In
+	AppOpts servertypes.AppOptions
+	Config  *config.Config
+}
+
+// ProvidePrunerCheckpoints provides the store in which the pruners persist
+// their progress across restarts, or nil if pruning is disabled so that the
+// store is not opened.
+func ProvidePrunerCheckpoints(
+	in PrunerCheckpointsInput,
+) (*pruner.KVCheckpointStore, error) {
+	if !in.Config.Pruning.Enabled {
+		return nil, nil
+	}
+	dir := cast.ToString(in.AppOpts.Get(flags.FlagHome)) + "/data"
+	kvp, err := storev2.NewDB(storev2.DBTypePebbleDB, "pruner", dir, nil)
+	if err != nil {
+		return nil, err
+	}
+	return pruner.NewKVCheckpointStore(kvp), nil
+}
+
 // DBManagerInput is the input for the dep inject framework.
 type DBManagerInput struct {
 	depinject.In
 	Logger             log.Logger
+	Config             *config.Config
+	AvailabilityStore  *store.Store[*types.BeaconBlockBody]
 	DepositPruner      pruner.Pruner[*dastore.KVStore[*types.Deposit]]
-	AvailabilityPruner pruner.Pruner[*filedb.RangeDB]
+	AvailabilityPruner pruner.Pruner[rangedb.Backend]
+	HealthRegistry     *health.Registry
 }
 
-// ProvideDBManager provides a DBManager for the depinject framework.
+// ProvideDBManager provides a DBManager for the depinject framework. The
+// manager runs no pruner if pruning is disabled.
 func ProvideDBManager(
 	in DBManagerInput,
 ) (*manager.DBManager[*types.BeaconBlock,
 	*feed.Event[*types.BeaconBlock],
 	event.Subscription,
 ], error) {
-	return manager.NewDBManager[
+	var pruners []pruner.Pruner[pruner.Prunable]
+	if in.Config.Pruning.Enabled {
+		pruners = append(pruners, in.DepositPruner, in.AvailabilityPruner)
+	} else {
+		in.Logger.Info("pruning disabled")
+	}
+
+	m, err := manager.NewDBManager[
 		*types.BeaconBlock,
 		*feed.Event[*types.BeaconBlock],
 		event.Subscription,
 	](
 		in.Logger.With("service", "db-manager"),
-		in.DepositPruner,
-		in.AvailabilityPruner,
+		pruners...,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = in.HealthRegistry.Register(m.Name(), m, true); err != nil {
+		return nil, err
+	}
+
+	// register the availability store for on demand corruption scans.
+	if rangeDB, ok := in.AvailabilityStore.IndexDB.(*filedb.RangeDB); ok {
+		if err = m.RegisterVerifier(
+			manager.AvailabilityStoreName, rangeDB,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	// close the availability store once its pruner has stopped, if its
+	// backend holds an open database.
+	if closer, ok := in.AvailabilityStore.IndexDB.(io.Closer); ok {
+		if err = m.RegisterCloser(
+			manager.AvailabilityStoreName, closer,
+		); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
 }
@@ -29,8 +29,10 @@ func DefaultComponentsWithStandardTypes() []any {
 	return []any{
 		ProvideAvailibilityStore[*types.BeaconBlockBody],
 		ProvideBlsSigner,
+		ProvideBatchVerifier,
 		ProvideTrustedSetup,
 		ProvideDepositStore[*types.Deposit],
+		ProvideBlockStore[*types.BeaconBlock],
 		ProvideConfig,
 		ProvideEngineClient,
 		ProvideJWTSecret,
@@ -46,10 +48,16 @@ func DefaultComponentsWithStandardTypes() []any {
 		],
 		ProvideLocalBuilder,
 		ProvideStateProcessor,
+		ProvideEventBus,
 		ProvideBlockFeed,
+		ProvideFinalizedBlockFeed,
+		ProvidePrunerCheckpoints,
 		ProvideDepositPruner,
 		ProvideAvailabilityPruner,
 		ProvideDBManager,
 		ProvideDepositService,
+		ProvideDiagnosticsServer,
+		ProvideHealthRegistry,
+		ProvideHealthServer,
 	}
 }
@@ -26,6 +26,7 @@ import (
 	engineclient "github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/interfaces"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
@@ -36,12 +37,13 @@ import (
 // for the dep inject framework.
 type BeaconDepositContractInput struct {
 	depinject.In
+	Config       *config.Config
 	ChainSpec    primitives.ChainSpec
 	EngineClient *engineclient.EngineClient[*types.ExecutionPayload]
 }
 
 // ProvideBeaconDepositContract provides a beacon deposit contract through the
-// dep inject framework.
+// dep inject framework, or nil if the deposit service is disabled.
 func ProvideBeaconDepositContract[
 	DepositT interfaces.Deposit[
 		crypto.BLSPubkey, crypto.BLSSignature,
@@ -60,6 +62,10 @@ func ProvideBeaconDepositContract[
 	DepositT,
 	WithdrawalCredentialsT,
 ], error) {
+	if !in.Config.Deposit.Enabled {
+		return nil, nil
+	}
+
 	// Build the deposit contract.
 	return deposit.NewWrappedBeaconDepositContract[
 		DepositT, WithdrawalCredentialsT,
@@ -26,8 +26,11 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	engineclient "github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/health"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
@@ -39,6 +42,7 @@ type DepositServiceIn struct {
 	depinject.In
 
 	Logger                log.Logger
+	Config                *config.Config
 	ChainSpec             primitives.ChainSpec
 	EngineClient          *engineclient.EngineClient[*types.ExecutionPayload]
 	TelemetrySink         *metrics.TelemetrySink
@@ -46,12 +50,13 @@ type DepositServiceIn struct {
 	BeaconDepositContract *deposit.WrappedBeaconDepositContract[
 		*types.Deposit, types.WithdrawalCredentials,
 	]
-	BlockFeed *event.FeedOf[*feed.Event[*types.BeaconBlock]]
+	EventBus       *feed.Bus
+	HealthRegistry *health.Registry
 }
 
 // ProvideDepositService provides the deposit service to the depinject
-// framework.
-func ProvideDepositService(in DepositServiceIn) *deposit.Service[
+// framework, or nil if the deposit service is disabled.
+func ProvideDepositService(in DepositServiceIn) (*deposit.Service[
 	*types.BeaconBlock,
 	*types.BeaconBlockBody,
 	*feed.Event[*types.BeaconBlock],
@@ -59,9 +64,25 @@ func ProvideDepositService(in DepositServiceIn) *deposit.Service[
 	*types.ExecutionPayload,
 	event.Subscription,
 	types.WithdrawalCredentials,
-] {
+], error) {
+	if !in.Config.Deposit.Enabled {
+		in.Logger.Info("deposit service disabled")
+		return nil, nil
+	}
+
+	blockFeed, err := blockTopic(in.EventBus)
+	if err != nil {
+		return nil, err
+	}
+	depositFeed, err := feed.TopicOf[*feed.Event[*events.DepositProcessedEvent]](
+		in.EventBus, events.DepositTopic,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build the deposit service.
-	return deposit.NewService[
+	svc := deposit.NewService[
 		*types.BeaconBlockBody,
 		*types.BeaconBlock,
 		*feed.Event[*types.BeaconBlock],
@@ -75,6 +96,12 @@ func ProvideDepositService(in DepositServiceIn) *deposit.Service[
 		in.TelemetrySink,
 		in.DepositStore,
 		in.BeaconDepositContract,
-		in.BlockFeed,
+		blockFeed,
+		depositFeed,
 	)
+	// Lagging deposits are reported, but do not make the node unready.
+	if err = in.HealthRegistry.Register("deposits", svc, false); err != nil {
+		return nil, err
+	}
+	return svc, nil
 }
@@ -27,7 +27,8 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/interfaces"
-	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
 	depositstore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
@@ -70,33 +71,48 @@ func ProvideDepositStore[
 // DepositPrunerInput is the input for the deposit pruner.
 type DepositPrunerInput struct {
 	depinject.In
-	Logger       log.Logger
-	ChainSpec    primitives.ChainSpec
-	BlockFeed    *event.FeedOf[*feed.Event[*types.BeaconBlock]]
-	DepositStore *depositstore.KVStore[*types.Deposit]
+	Logger            log.Logger
+	Config            *config.Config
+	EventBus          *feed.Bus
+	DepositStore      *depositstore.KVStore[*types.Deposit]
+	TelemetrySink     *metrics.TelemetrySink
+	PrunerCheckpoints *pruner.KVCheckpointStore
 }
 
-// ProvideDepositPruner provides a deposit pruner for the depinject framework.
+// ProvideDepositPruner provides a deposit pruner for the depinject framework,
+// or nil if pruning is disabled.
 func ProvideDepositPruner(
 	in DepositPrunerInput,
-) pruner.Pruner[*depositstore.KVStore[*types.Deposit]] {
+) (pruner.Pruner[*depositstore.KVStore[*types.Deposit]], error) {
+	if !in.Config.Pruning.Enabled {
+		return nil, nil
+	}
+	finalizedFeed, err := finalizedBlockTopic(in.EventBus)
+	if err != nil {
+		return nil, err
+	}
 	return pruner.NewPruner[
-		*types.BeaconBlock,
-		*feed.Event[*types.BeaconBlock],
+		*finalizedBlock,
+		*feed.Event[*finalizedBlock],
 		*depositstore.KVStore[*types.Deposit],
 		event.Subscription,
 	](
 		in.Logger.With("service", manager.DepositPrunerName),
 		in.DepositStore,
 		manager.DepositPrunerName,
-		in.BlockFeed,
+		// A stalled pruner must not hold up the finalized block feed.
+		finalizedFeed.Buffered(
+			feed.WithTelemetrySink(in.TelemetrySink, manager.DepositPrunerName),
+		),
 		deposit.BuildPruneRangeFn[
 			*types.BeaconBlockBody,
 			*types.BeaconBlock,
-			*feed.Event[*types.BeaconBlock],
+			*feed.Event[*finalizedBlock],
 			*types.Deposit,
 			*types.ExecutionPayload,
 			types.WithdrawalCredentials,
-		](in.ChainSpec),
-	)
+		](in.Config.DepositStore.PruneSafetyMargin),
+		pruner.WithTelemetrySink(in.TelemetrySink),
+		pruner.WithCheckpointStore(in.PrunerCheckpoints),
+	), nil
 }
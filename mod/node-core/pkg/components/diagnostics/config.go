@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package diagnostics
+
+const defaultListenAddress = "127.0.0.1:6060"
+
+// Config is the configuration of the diagnostics of the node.
+type Config struct {
+	// Enabled starts the diagnostics server.
+	Enabled bool `mapstructure:"enabled"`
+	// ListenAddress is the address the diagnostics server listens on. The
+	// profiles expose the internals of the node, so it should not be
+	// reachable from outside the host.
+	ListenAddress string `mapstructure:"listen-address"`
+	// StateRootFields reports the roots of the fields of the state when the
+	// state root of a block does not match the computed one. It merkleizes
+	// the state again, so it is independent of the server.
+	StateRootFields bool `mapstructure:"state-root-fields"`
+}
+
+// DefaultConfig returns the default configuration of the diagnostics.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:         false,
+		ListenAddress:   defaultListenAddress,
+		StateRootFields: false,
+	}
+}
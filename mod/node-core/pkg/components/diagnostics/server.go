@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package diagnostics
+
+import (
+	"encoding/json"
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/httpserver"
+)
+
+// RuntimeSummary is a summary of the goroutines and heap of the node.
+type RuntimeSummary struct {
+	// Goroutines is the number of goroutines.
+	Goroutines int `json:"goroutines"`
+	// HeapAlloc is the number of bytes of allocated heap objects.
+	HeapAlloc uint64 `json:"heap_alloc"`
+	// HeapInuse is the number of bytes in in-use heap spans.
+	HeapInuse uint64 `json:"heap_inuse"`
+	// HeapObjects is the number of allocated heap objects.
+	HeapObjects uint64 `json:"heap_objects"`
+	// Sys is the number of bytes obtained from the OS.
+	Sys uint64 `json:"sys"`
+	// NumGC is the number of completed GC cycles.
+	NumGC uint32 `json:"num_gc"`
+	// LastGCPause is the duration of the latest GC pause.
+	LastGCPause time.Duration `json:"last_gc_pause"`
+}
+
+// Server is a service serving the pprof profiles, the expvar variables and a
+// runtime summary of the node.
+type Server struct {
+	*httpserver.Server
+}
+
+// NewServer creates a diagnostics server listening on addr.
+func NewServer(addr string, logger log.Logger[any]) *Server {
+	return &Server{
+		Server: httpserver.New("diagnostics-server", addr, Handler(), logger),
+	}
+}
+
+// Handler returns the handler of the diagnostics endpoints:
+//   - /debug/pprof/ serves the pprof profiles,
+//   - /debug/vars serves the expvar variables,
+//   - /debug/runtime serves a RuntimeSummary as JSON.
+func Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+	mux.HandleFunc("/debug/runtime", serveRuntimeSummary)
+	return mux
+}
+
+// ReadRuntimeSummary returns the current runtime summary of the node.
+func ReadRuntimeSummary() RuntimeSummary {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	summary := RuntimeSummary{
+		Goroutines:  runtime.NumGoroutine(),
+		HeapAlloc:   stats.HeapAlloc,
+		HeapInuse:   stats.HeapInuse,
+		HeapObjects: stats.HeapObjects,
+		Sys:         stats.Sys,
+		NumGC:       stats.NumGC,
+	}
+	if stats.NumGC > 0 {
+		// PauseNs is a circular buffer of the latest pauses.
+		latest := (int(stats.NumGC) - 1) % len(stats.PauseNs)
+		//#nosec:G115 // the duration of a GC pause fits in an int64.
+		summary.LastGCPause = time.Duration(stats.PauseNs[latest])
+	}
+	return summary
+}
+
+// serveRuntimeSummary writes the runtime summary of the node as JSON.
+func serveRuntimeSummary(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	//nolint:errcheck // the client has gone away.
+	json.NewEncoder(w).Encode(ReadRuntimeSummary())
+}
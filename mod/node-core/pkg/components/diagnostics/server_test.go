@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package diagnostics_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"runtime"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/diagnostics"
+	"github.com/stretchr/testify/require"
+)
+
+func get(t *testing.T, url string) []byte {
+	t.Helper()
+	//#nosec:G107 // test server.
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode, url)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return body
+}
+
+func TestServer_Endpoints(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server := diagnostics.NewServer("127.0.0.1:0", noop.NewLogger())
+	require.NoError(t, server.Start(ctx))
+	base := "http://" + server.Addr().String()
+
+	require.Contains(t, string(get(t, base+"/debug/pprof/")), "goroutine")
+	require.NotEmpty(t, get(t, base+"/debug/pprof/heap"))
+	require.NotEmpty(t, get(t, base+"/debug/pprof/goroutine?debug=1"))
+	require.NotEmpty(t, get(t, base+"/debug/pprof/profile?seconds=1"))
+	require.Contains(t, string(get(t, base+"/debug/vars")), "memstats")
+
+	runtime.GC()
+	var summary diagnostics.RuntimeSummary
+	require.NoError(t, json.Unmarshal(get(t, base+"/debug/runtime"), &summary))
+	require.Positive(t, summary.Goroutines)
+	require.Positive(t, summary.HeapAlloc)
+	require.Positive(t, summary.NumGC)
+
+	require.NoError(t, server.Stop(context.Background()))
+	require.NoError(t, server.Status())
+}
+
+func TestServer_AddressInUse(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	server := diagnostics.NewServer(
+		listener.Addr().String(), noop.NewLogger(),
+	)
+	err = server.Start(context.Background())
+	require.ErrorContains(t, err, "diagnostics-server cannot listen on")
+	require.ErrorContains(t, err, listener.Addr().String())
+}
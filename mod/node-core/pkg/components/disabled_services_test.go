@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components_test
+
+import (
+	"context"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/health"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisabledDepositService(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Deposit.Enabled = false
+
+	// The other inputs are left unset, as they must not be used.
+	svc, err := components.ProvideDepositService(components.DepositServiceIn{
+		Logger: log.NewNopLogger(),
+		Config: cfg,
+	})
+	require.NoError(t, err)
+	require.Nil(t, svc)
+
+	contract, err := components.ProvideBeaconDepositContract[
+		*types.Deposit,
+		*types.ExecutionPayload,
+		*engineprimitives.Withdrawal,
+		types.WithdrawalCredentials,
+	](components.BeaconDepositContractInput{Config: cfg})
+	require.NoError(t, err)
+	require.Nil(t, contract)
+}
+
+func TestDisabledPruning(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Pruning.Enabled = false
+	home := t.TempDir()
+	appOpts := viper.New()
+	appOpts.Set(flags.FlagHome, home)
+
+	checkpoints, err := components.ProvidePrunerCheckpoints(
+		components.PrunerCheckpointsInput{AppOpts: appOpts, Config: cfg},
+	)
+	require.NoError(t, err)
+	require.Nil(t, checkpoints)
+	require.NoDirExists(t, filepath.Join(home, "data", "pruner.db"))
+
+	depositPruner, err := components.ProvideDepositPruner(
+		components.DepositPrunerInput{Config: cfg},
+	)
+	require.NoError(t, err)
+	require.Nil(t, depositPruner)
+	availabilityPruner, err := components.ProvideAvailabilityPruner(
+		components.AvailabilityPrunerInput{Config: cfg},
+	)
+	require.NoError(t, err)
+	require.Nil(t, availabilityPruner)
+
+	m, err := components.ProvideDBManager(components.DBManagerInput{
+		Logger:             log.NewNopLogger(),
+		Config:             cfg,
+		AvailabilityStore:  &dastore.Store[*types.BeaconBlockBody]{},
+		DepositPruner:      depositPruner,
+		AvailabilityPruner: availabilityPruner,
+		HealthRegistry:     health.NewRegistry(time.Second, nil),
+	})
+	require.NoError(t, err)
+
+	// The manager starts no goroutine without pruners.
+	goroutines := runtime.NumGoroutine()
+	require.NoError(t, m.Start(context.Background()))
+	require.Equal(t, goroutines, runtime.NumGoroutine())
+	require.NoError(t, m.Stop(context.Background()))
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	engineclient "github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	execution "github.com/berachain/beacon-kit/mod/execution/pkg/engine"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/health"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
 	"github.com/berachain/beacon-kit/mod/primitives"
@@ -41,6 +42,8 @@ type EngineClientInputs struct {
 	ChainSpec primitives.ChainSpec
 	// Config is the BeaconKit configuration.
 	Config *config.Config
+	// HealthRegistry is the registry the client registers its check to.
+	HealthRegistry *health.Registry
 	// Logger is the logger.
 	Logger log.Logger
 	// TelemetrySink is the telemetry sink.
@@ -54,33 +57,51 @@ type EngineClientInputs struct {
 // ProvideEngineClient creates a new EngineClient.
 func ProvideEngineClient(
 	in EngineClientInputs,
-) *engineclient.EngineClient[*types.ExecutionPayload] {
-	return engineclient.New[*types.ExecutionPayload](
+) (*engineclient.EngineClient[*types.ExecutionPayload], error) {
+	client := engineclient.New[*types.ExecutionPayload](
 		&in.Config.Engine,
 		in.Logger.With("service", "engine.client"),
 		in.JWTSecret,
 		in.TelemetrySink,
 		new(big.Int).SetUint64(in.ChainSpec.DepositEth1ChainID()),
 	)
+	// The node is not ready while the execution client is unreachable.
+	if err := in.HealthRegistry.Register(
+		"execution-client", client, true,
+	); err != nil {
+		return nil, err
+	}
+	return client, nil
 }
 
 // ExecutionEngineInput is the input for the execution engine for the depinject
 // framework.
 type ExecutionEngineInput struct {
 	depinject.In
-	EngineClient  *engineclient.EngineClient[*types.ExecutionPayload]
-	Logger        log.Logger
-	TelemetrySink *metrics.TelemetrySink
+	Config         *config.Config
+	EngineClient   *engineclient.EngineClient[*types.ExecutionPayload]
+	HealthRegistry *health.Registry
+	Logger         log.Logger
+	TelemetrySink  *metrics.TelemetrySink
 }
 
 // ProvideExecutionEngine provides the execution engine to the depinject
 // framework.
 func ProvideExecutionEngine(
 	in ExecutionEngineInput,
-) *execution.Engine[*types.ExecutionPayload] {
-	return execution.New[*types.ExecutionPayload](
+) (*execution.Engine[*types.ExecutionPayload], error) {
+	engine := execution.New[*types.ExecutionPayload](
 		in.EngineClient,
 		in.Logger.With("service", "execution-engine"),
 		in.TelemetrySink,
+		in.Config.Engine.PayloadCacheSize,
+		in.Config.Engine.InvalidPayloadRetention,
 	)
+	// The node is not ready while the execution client is syncing.
+	if err := in.HealthRegistry.Register(
+		"execution-sync", health.CheckerFunc(engine.SyncStatus), true,
+	); err != nil {
+		return nil, err
+	}
+	return engine, nil
 }
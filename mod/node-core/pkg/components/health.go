@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/health"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
+)
+
+// HealthRegistryInput is the input for the dep inject framework.
+type HealthRegistryInput struct {
+	depinject.In
+	Config        *config.Config
+	TelemetrySink *metrics.TelemetrySink
+}
+
+// ProvideHealthRegistry provides the registry the health checks of the
+// services are registered to.
+func ProvideHealthRegistry(in HealthRegistryInput) *health.Registry {
+	return health.NewRegistry(in.Config.Health.CheckTimeout, in.TelemetrySink)
+}
+
+// HealthServerInput is the input for the dep inject framework.
+type HealthServerInput struct {
+	depinject.In
+	Config         *config.Config
+	HealthRegistry *health.Registry
+	Logger         log.Logger
+}
+
+// ProvideHealthServer provides the health server, or nil if it is disabled.
+func ProvideHealthServer(in HealthServerInput) *health.Server {
+	if !in.Config.Health.Enabled {
+		return nil
+	}
+	return health.NewServer(
+		in.Config.Health.ListenAddress,
+		in.Config.Health.CheckInterval,
+		in.HealthRegistry,
+		in.Logger.With("service", "health-server"),
+	)
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package health
+
+import "time"
+
+const (
+	defaultListenAddress = "127.0.0.1:8081"
+	defaultCheckInterval = 10 * time.Second
+	defaultCheckTimeout  = 5 * time.Second
+)
+
+// Config is the configuration of the health server.
+type Config struct {
+	// Enabled starts the health server.
+	Enabled bool `mapstructure:"enabled"`
+	// ListenAddress is the address /healthz and /readyz are served on.
+	ListenAddress string `mapstructure:"listen-address"`
+	// CheckInterval is the interval at which the checks are run to update
+	// their gauges.
+	CheckInterval time.Duration `mapstructure:"check-interval"`
+	// CheckTimeout bounds the time a check may take before it is reported
+	// as failed.
+	CheckTimeout time.Duration `mapstructure:"check-timeout"`
+}
+
+// DefaultConfig returns the default configuration of the health server.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:       false,
+		ListenAddress: defaultListenAddress,
+		CheckInterval: defaultCheckInterval,
+		CheckTimeout:  defaultCheckTimeout,
+	}
+}
@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package health
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// Checker is a component whose health can be checked, e.g. a service.
+type Checker interface {
+	// Status returns an error if the component is unhealthy.
+	Status() error
+}
+
+// CheckerFunc adapts a function to a Checker.
+type CheckerFunc func() error
+
+// Status returns the result of f.
+func (f CheckerFunc) Status() error {
+	return f()
+}
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
+}
+
+// Result is the result of a check.
+type Result struct {
+	// Name is the name the check is registered under.
+	Name string `json:"name"`
+	// Critical is true if the node is not ready while the check fails.
+	Critical bool `json:"critical"`
+	// Healthy is true if the check passed.
+	Healthy bool `json:"healthy"`
+	// Error is the error the check failed with.
+	Error string `json:"error,omitempty"`
+}
+
+// Report is the result of all the checks of a registry.
+type Report struct {
+	// Ready is true if all the critical checks passed.
+	Ready bool `json:"ready"`
+	// Checks are the results of the checks, in registration order.
+	Checks []Result `json:"checks"`
+}
+
+// check is a checker registered under a name.
+type check struct {
+	name     string
+	checker  Checker
+	critical bool
+}
+
+// Registry holds the health checks of the node.
+type Registry struct {
+	timeout time.Duration
+	sink    TelemetrySink
+
+	mu     sync.RWMutex
+	checks []check
+}
+
+// NewRegistry creates a registry reporting a check as failed if it takes
+// longer than timeout.
+func NewRegistry(timeout time.Duration, sink TelemetrySink) *Registry {
+	return &Registry{timeout: timeout, sink: sink}
+}
+
+// Register registers a checker under name. The node is not ready while a
+// critical check fails, other checks are only reported.
+func (r *Registry) Register(
+	name string, checker Checker, critical bool,
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if slices.ContainsFunc(r.checks, func(c check) bool {
+		return c.name == name
+	}) {
+		return errors.Wrapf(ErrDuplicateCheck, "%s", name)
+	}
+	r.checks = append(r.checks, check{
+		name: name, checker: checker, critical: critical,
+	})
+	return nil
+}
+
+// Check runs all the checks concurrently and updates their gauges.
+func (r *Registry) Check() Report {
+	r.mu.RLock()
+	checks := slices.Clone(r.checks)
+	r.mu.RUnlock()
+
+	done := make([]chan error, len(checks))
+	for i, c := range checks {
+		done[i] = make(chan error, 1)
+		go func() {
+			done[i] <- c.checker.Status()
+		}()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+	report := Report{Ready: true, Checks: make([]Result, len(checks))}
+	for i, c := range checks {
+		err := wait(ctx, done[i])
+		report.Checks[i] = Result{
+			Name:     c.name,
+			Critical: c.critical,
+			Healthy:  err == nil,
+		}
+		if err != nil {
+			report.Checks[i].Error = err.Error()
+			report.Ready = report.Ready && !c.critical
+		}
+		r.sink.SetGauge(
+			"beacon_kit.health.check", boolToGauge(err == nil),
+			"check", c.name,
+		)
+	}
+	r.sink.SetGauge("beacon_kit.health.ready", boolToGauge(report.Ready))
+	return report
+}
+
+// wait returns the result of a check, or ErrCheckTimeout if it is not
+// available before ctx is done.
+func wait(ctx context.Context, done <-chan error) error {
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		select {
+		case err := <-done:
+			return err
+		default:
+			return ErrCheckTimeout
+		}
+	}
+}
+
+func boolToGauge(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
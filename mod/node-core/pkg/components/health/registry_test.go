@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package health_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/health"
+	"github.com/stretchr/testify/require"
+)
+
+// gaugeSink records the last value of every gauge.
+type gaugeSink struct {
+	mu     sync.Mutex
+	gauges map[string]int64
+}
+
+func newGaugeSink() *gaugeSink {
+	return &gaugeSink{gauges: make(map[string]int64)}
+}
+
+func (s *gaugeSink) SetGauge(key string, value int64, args ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, arg := range args {
+		key += "/" + arg
+	}
+	s.gauges[key] = value
+}
+
+func (s *gaugeSink) get(key string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.gauges[key]
+}
+
+// toggle is a check whose result is set by the test.
+type toggle struct {
+	mu  sync.Mutex
+	err error
+}
+
+func (t *toggle) set(err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.err = err
+}
+
+func (t *toggle) Status() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.err
+}
+
+func TestRegistry_Check(t *testing.T) {
+	sink := newGaugeSink()
+	registry := health.NewRegistry(time.Second, sink)
+	critical, optional := new(toggle), new(toggle)
+	require.NoError(t, registry.Register("critical", critical, true))
+	require.NoError(t, registry.Register("optional", optional, false))
+	require.ErrorIs(t,
+		registry.Register("critical", critical, true),
+		health.ErrDuplicateCheck,
+	)
+
+	report := registry.Check()
+	require.True(t, report.Ready)
+	require.Len(t, report.Checks, 2)
+	require.Equal(t, int64(1), sink.get("beacon_kit.health.check/check/critical"))
+	require.Equal(t, int64(1), sink.get("beacon_kit.health.ready"))
+
+	// A failing optional check is reported without affecting readiness.
+	optional.set(errors.New("lagging"))
+	report = registry.Check()
+	require.True(t, report.Ready)
+	require.Equal(t, health.Result{
+		Name: "optional", Healthy: false, Error: "lagging",
+	}, report.Checks[1])
+	require.Equal(t, int64(0), sink.get("beacon_kit.health.check/check/optional"))
+
+	critical.set(errors.New("down"))
+	report = registry.Check()
+	require.False(t, report.Ready)
+	require.Equal(t, int64(0), sink.get("beacon_kit.health.check/check/critical"))
+	require.Equal(t, int64(0), sink.get("beacon_kit.health.ready"))
+}
+
+func TestRegistry_CheckTimeout(t *testing.T) {
+	registry := health.NewRegistry(10*time.Millisecond, newGaugeSink())
+	release := make(chan struct{})
+	defer close(release)
+	require.NoError(t, registry.Register(
+		"stuck", health.CheckerFunc(func() error {
+			<-release
+			return nil
+		}), true,
+	))
+	require.NoError(t, registry.Register(
+		"fine", health.CheckerFunc(func() error { return nil }), true,
+	))
+
+	report := registry.Check()
+	require.False(t, report.Ready)
+	require.Equal(t, health.ErrCheckTimeout.Error(), report.Checks[0].Error)
+	require.True(t, report.Checks[1].Healthy)
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/httpserver"
+)
+
+// Server is a service serving the liveness and readiness of the node, and
+// periodically updating the gauges of its checks.
+type Server struct {
+	*httpserver.Server
+	interval time.Duration
+	registry *Registry
+}
+
+// NewServer creates a health server listening on addr, running the checks
+// of registry every interval.
+func NewServer(
+	addr string,
+	interval time.Duration,
+	registry *Registry,
+	logger log.Logger[any],
+) *Server {
+	return &Server{
+		Server: httpserver.New(
+			"health-server", addr, Handler(registry), logger,
+		),
+		interval: interval,
+		registry: registry,
+	}
+}
+
+// Start starts serving until ctx is cancelled, running the checks every
+// interval meanwhile.
+func (s *Server) Start(ctx context.Context) error {
+	if err := s.Server.Start(ctx); err != nil {
+		return err
+	}
+	go s.checkLoop(ctx)
+	return nil
+}
+
+// checkLoop runs the checks every interval, so that their gauges are kept
+// up to date without probes.
+func (s *Server) checkLoop(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.registry.Check()
+		}
+	}
+}
+
+// Handler returns the handler of the health endpoints:
+//   - /healthz answers 200 while the process is alive,
+//   - /readyz runs the checks of registry and answers their Report as JSON,
+//     with 200 if the node is ready and 503 otherwise.
+func Handler(registry *Registry) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		//nolint:errcheck // the client has gone away.
+		w.Write([]byte("ok\n"))
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, _ *http.Request) {
+		report := registry.Check()
+		w.Header().Set("Content-Type", "application/json")
+		if !report.Ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		//nolint:errcheck // the client has gone away.
+		json.NewEncoder(w).Encode(report)
+	})
+	return mux
+}
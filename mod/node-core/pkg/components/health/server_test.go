@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/health"
+	"github.com/stretchr/testify/require"
+)
+
+// get returns the status code and body of a request to url.
+func get(t *testing.T, url string) (int, []byte) {
+	t.Helper()
+	//#nosec:G107 // test server.
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var body json.RawMessage
+	if resp.Header.Get("Content-Type") == "application/json" {
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	}
+	return resp.StatusCode, body
+}
+
+func TestServer_ReadinessFlip(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	registry := health.NewRegistry(time.Second, newGaugeSink())
+	engine := new(toggle)
+	require.NoError(t, registry.Register("execution-client", engine, true))
+
+	server := health.NewServer(
+		"127.0.0.1:0", time.Hour, registry, noop.NewLogger(),
+	)
+	require.NoError(t, server.Start(ctx))
+	base := "http://" + server.Addr().String()
+
+	code, _ := get(t, base+"/readyz")
+	require.Equal(t, http.StatusOK, code)
+
+	engine.set(errors.New("connection refused"))
+	code, body := get(t, base+"/readyz")
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	var report health.Report
+	require.NoError(t, json.Unmarshal(body, &report))
+	require.False(t, report.Ready)
+	require.Equal(t, []health.Result{{
+		Name:     "execution-client",
+		Critical: true,
+		Error:    "connection refused",
+	}}, report.Checks)
+
+	// The process is alive while it is not ready.
+	code, _ = get(t, base+"/healthz")
+	require.Equal(t, http.StatusOK, code)
+
+	engine.set(nil)
+	code, _ = get(t, base+"/readyz")
+	require.Equal(t, http.StatusOK, code)
+
+	require.NoError(t, server.Stop(context.Background()))
+	require.NoError(t, server.Status())
+}
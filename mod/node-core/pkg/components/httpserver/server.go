@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package httpserver
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+)
+
+// readHeaderTimeout bounds the time to read the headers of a request. The
+// body of the requests is not bounded, since event streams, CPU profiles and
+// traces last as long as requested.
+const readHeaderTimeout = 5 * time.Second
+
+// Server is a service serving a handler on a TCP address. The requests in
+// flight are cancelled when the server is shut down.
+type Server struct {
+	name    string
+	addr    string
+	handler http.Handler
+	logger  log.Logger[any]
+
+	mu       sync.Mutex
+	srv      *http.Server
+	listener net.Listener
+	err      error
+}
+
+// New creates a server, named name, serving handler on addr.
+func New(
+	name string,
+	addr string,
+	handler http.Handler,
+	logger log.Logger[any],
+) *Server {
+	return &Server{name: name, addr: addr, handler: handler, logger: logger}
+}
+
+// Name returns the name of the service.
+func (s *Server) Name() string {
+	return s.name
+}
+
+// Start starts serving until ctx is cancelled. It fails if the address
+// cannot be listened on, e.g. because it is in use.
+func (s *Server) Start(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return errors.Wrapf(err, "%s cannot listen on %s", s.name, s.addr)
+	}
+	baseCtx, cancel := context.WithCancel(ctx)
+	srv := &http.Server{
+		Handler:           s.handler,
+		ReadHeaderTimeout: readHeaderTimeout,
+		BaseContext:       func(net.Listener) context.Context { return baseCtx },
+	}
+	srv.RegisterOnShutdown(cancel)
+
+	s.mu.Lock()
+	s.srv, s.listener = srv, listener
+	s.mu.Unlock()
+
+	go func() {
+		serveErr := srv.Serve(listener)
+		if errors.Is(serveErr, http.ErrServerClosed) {
+			return
+		}
+		s.logger.Error("server failed", "service", s.name, "error", serveErr)
+		s.mu.Lock()
+		s.err = serveErr
+		s.mu.Unlock()
+	}()
+	go func() {
+		<-ctx.Done()
+		cancel()
+		//nolint:errcheck // the server is closed on shutdown.
+		srv.Close()
+	}()
+	s.logger.Info(
+		"serving", "service", s.name, "address", listener.Addr().String(),
+	)
+	return nil
+}
+
+// Stop stops the server, waiting for the requests in flight until ctx is
+// done.
+func (s *Server) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	srv := s.srv
+	s.mu.Unlock()
+	if srv == nil {
+		return nil
+	}
+	return srv.Shutdown(ctx)
+}
+
+// Addr returns the address the server listens on, or nil if it has not
+// been started.
+func (s *Server) Addr() net.Addr {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.listener == nil {
+		return nil
+	}
+	return s.listener.Addr()
+}
+
+// Status returns the error the server failed with, if any.
+func (s *Server) Status() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// WaitForHealthy does nothing, the server is healthy once started.
+func (*Server) WaitForHealthy(context.Context) {}
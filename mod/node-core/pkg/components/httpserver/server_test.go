@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package httpserver_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/httpserver"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_CancelsRequestsOnShutdown(t *testing.T) {
+	started := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		//nolint:errcheck // test handler.
+		http.NewResponseController(w).Flush()
+		close(started)
+		// The request lasts until the server shuts down.
+		<-r.Context().Done()
+	})
+	server := httpserver.New(
+		"test-server", "127.0.0.1:0", handler, noop.NewLogger(),
+	)
+	require.Equal(t, "test-server", server.Name())
+	require.Nil(t, server.Addr())
+	require.NoError(t, server.Start(context.Background()))
+
+	//#nosec:G107 // test server.
+	resp, err := http.Get("http://" + server.Addr().String())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, server.Stop(ctx))
+	require.NoError(t, server.Status())
+}
+
+func TestServer_AddressInUse(t *testing.T) {
+	first := httpserver.New(
+		"first", "127.0.0.1:0", http.NotFoundHandler(), noop.NewLogger(),
+	)
+	require.NoError(t, first.Start(context.Background()))
+	defer func() {
+		require.NoError(t, first.Stop(context.Background()))
+	}()
+
+	second := httpserver.New(
+		"second", first.Addr().String(), http.NotFoundHandler(),
+		noop.NewLogger(),
+	)
+	err := second.Start(context.Background())
+	require.ErrorContains(t, err, "second cannot listen on")
+}
@@ -21,21 +21,117 @@
 package components
 
 import (
+	"slices"
+	"strings"
+
 	clientv2keyring "cosmossdk.io/client/v2/autocli/keyring"
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/flags"
 	"github.com/cosmos/cosmos-sdk/client"
+	clientFlags "github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
 )
 
-// ProvideKeyring provides a keyring for the client.
-func ProvideKeyring(
-	clientCtx client.Context,
-	_ address.Codec,
-) (clientv2keyring.Keyring, error) {
-	kb, err := client.NewKeyringFromBackend(
-		clientCtx,
-		clientCtx.Keyring.Backend(),
+// DefaultKeyringBackend is the keyring backend used when none is set outside
+// of dev mode.
+const DefaultKeyringBackend = keyring.BackendFile
+
+// ErrUnsupportedKeyringBackend is returned when the keyring backend is not
+// supported.
+var ErrUnsupportedKeyringBackend = errors.New("unsupported keyring-backend")
+
+// supportedKeyringBackends returns the backends the keyring can be stored
+// in. test and memory are only meant for development.
+func supportedKeyringBackends() []string {
+	return []string{
+		keyring.BackendOS,
+		keyring.BackendFile,
+		keyring.BackendTest,
+		keyring.BackendMemory,
+	}
+}
+
+// devnetChainIDs are the substrings of the chain ids of the networks on which
+// the test keyring backend is expected.
+func devnetChainIDs() []string {
+	return []string{"devnet", "localnet", "local", "beacond-"}
+}
+
+// KeyringBackend returns the keyring backend set in appOpts. If none is set,
+// it is the test backend in dev mode and the file backend otherwise.
+func KeyringBackend(appOpts servertypes.AppOptions) (string, error) {
+	backend := cast.ToString(appOpts.Get(clientFlags.FlagKeyringBackend))
+	if backend == "" {
+		if cast.ToBool(appOpts.Get(flags.DevMode)) {
+			return keyring.BackendTest, nil
+		}
+		return DefaultKeyringBackend, nil
+	}
+	return backend, ValidateKeyringBackend(backend)
+}
+
+// ValidateKeyringBackend returns an error if the keyring backend is not
+// supported.
+func ValidateKeyringBackend(backend string) error {
+	if slices.Contains(supportedKeyringBackends(), backend) {
+		return nil
+	}
+	return errors.Wrapf(
+		ErrUnsupportedKeyringBackend, "%q, set %s to one of: %s",
+		backend, clientFlags.FlagKeyringBackend,
+		strings.Join(supportedKeyringBackends(), ", "),
+	)
+}
+
+// IsDevnetChainID returns true if the chain id looks like the one of a local
+// or development network.
+func IsDevnetChainID(chainID string) bool {
+	chainID = strings.ToLower(chainID)
+	return slices.ContainsFunc(devnetChainIDs(), func(s string) bool {
+		return strings.Contains(chainID, s)
+	})
+}
+
+// KeyringInput is the input for the dep inject framework.
+type KeyringInput struct {
+	depinject.In
+	AddressCodec address.Codec
+	AppOpts      servertypes.AppOptions
+	ClientCtx    client.Context
+	Logger       log.Logger
+}
+
+// ProvideKeyring provides a keyring for the client, with the backend of the
+// client context or else the one set in the app options.
+func ProvideKeyring(in KeyringInput) (clientv2keyring.Keyring, error) {
+	var (
+		backend string
+		err     error
 	)
+	if in.ClientCtx.Keyring != nil {
+		backend = in.ClientCtx.Keyring.Backend()
+	} else if backend, err = KeyringBackend(in.AppOpts); err != nil {
+		return nil, err
+	}
+	if err = ValidateKeyringBackend(backend); err != nil {
+		return nil, err
+	}
+
+	if backend == keyring.BackendTest &&
+		!IsDevnetChainID(in.ClientCtx.ChainID) {
+		in.Logger.Warn(
+			"test keyring backend stores keys unencrypted, "+
+				"do not use it outside of a devnet",
+			"chain_id", in.ClientCtx.ChainID,
+		)
+	}
+
+	kb, err := client.NewKeyringFromBackend(in.ClientCtx, backend)
 	if err != nil {
 		return nil, err
 	}
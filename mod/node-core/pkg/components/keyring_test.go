@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components_test
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/flags"
+	"github.com/cosmos/cosmos-sdk/client"
+	clientFlags "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyringBackend(t *testing.T) {
+	tests := []struct {
+		name    string
+		backend string
+		devMode bool
+		want    string
+		wantErr error
+	}{
+		{name: "default", want: keyring.BackendFile},
+		{name: "dev mode", devMode: true, want: keyring.BackendTest},
+		{name: "os", backend: keyring.BackendOS, want: keyring.BackendOS},
+		{name: "file", backend: keyring.BackendFile, want: keyring.BackendFile},
+		{name: "test", backend: keyring.BackendTest, want: keyring.BackendTest},
+		{
+			name:    "memory",
+			backend: keyring.BackendMemory,
+			want:    keyring.BackendMemory,
+		},
+		{
+			name:    "set in dev mode",
+			backend: keyring.BackendOS,
+			devMode: true,
+			want:    keyring.BackendOS,
+		},
+		{
+			name:    "unsupported",
+			backend: keyring.BackendKWallet,
+			wantErr: components.ErrUnsupportedKeyringBackend,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			appOpts := viper.New()
+			appOpts.Set(clientFlags.FlagKeyringBackend, tt.backend)
+			appOpts.Set(flags.DevMode, tt.devMode)
+
+			backend, err := components.KeyringBackend(appOpts)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, backend)
+		})
+	}
+}
+
+func TestProvideKeyring_TestBackendWarning(t *testing.T) {
+	tests := []struct {
+		name     string
+		backend  string
+		chainID  string
+		wantWarn bool
+	}{
+		{
+			name:     "test backend on a public chain",
+			backend:  keyring.BackendTest,
+			chainID:  "bartio-beacon-80084",
+			wantWarn: true,
+		},
+		{
+			name:    "test backend on a devnet",
+			backend: keyring.BackendTest,
+			chainID: "beacond-2061",
+		},
+		{
+			name:    "memory backend on a public chain",
+			backend: keyring.BackendMemory,
+			chainID: "bartio-beacon-80084",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			appOpts := viper.New()
+			appOpts.Set(clientFlags.FlagKeyringBackend, tt.backend)
+
+			kr, err := components.ProvideKeyring(components.KeyringInput{
+				AppOpts: appOpts,
+				ClientCtx: client.Context{}.
+					WithChainID(tt.chainID).
+					WithKeyringDir(t.TempDir()),
+				Logger: log.NewLogger(&buf),
+			})
+			require.NoError(t, err)
+			require.NotNil(t, kr)
+			require.Equal(
+				t, tt.wantWarn,
+				bytes.Contains(buf.Bytes(), []byte("test keyring backend")),
+			)
+		})
+	}
+}
+
+func TestProvideKeyring_UnsupportedBackend(t *testing.T) {
+	appOpts := viper.New()
+	appOpts.Set(clientFlags.FlagKeyringBackend, "plaintext")
+
+	_, err := components.ProvideKeyring(components.KeyringInput{
+		AppOpts: appOpts,
+		Logger:  log.NewNopLogger(),
+	})
+	require.ErrorIs(t, err, components.ErrUnsupportedKeyringBackend)
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package logging
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+)
+
+const (
+	// FormatConsole formats the lines of the node for humans.
+	FormatConsole = "console"
+	// FormatJSON formats the lines of the node as JSON objects, their keys
+	// and values being the fields of the objects.
+	FormatJSON = "json"
+)
+
+// ErrUnknownFormat is returned when the log format is not a known format.
+var ErrUnknownFormat = errors.New("unknown log format")
+
+// Config is the configuration of the logging of the node.
+type Config struct {
+	// Format is the format of the lines of the node, either "console" or
+	// "json". The console format is used if it is not set, as in the
+	// configurations written before it.
+	Format string `mapstructure:"format"`
+	// Modules are the levels of the modules, by the name their loggers are
+	// created with under the "module" or "service" key. The lines of a
+	// module below its level are discarded, and the other modules log at
+	// the level of the node.
+	Modules map[string]string `mapstructure:"modules"`
+}
+
+// DefaultConfig returns the default configuration of the logging, in the
+// console format and without module levels.
+func DefaultConfig() Config {
+	return Config{
+		Format:  FormatConsole,
+		Modules: make(map[string]string),
+	}
+}
+
+// Validate returns the format and the module levels that are not known.
+func (c Config) Validate() error {
+	var errs errors.FieldErrors
+	switch c.Format {
+	case "", FormatConsole, FormatJSON:
+	default:
+		errs.Add("format", errors.Wrapf(ErrUnknownFormat, "%s", c.Format))
+	}
+	for module, level := range c.Modules {
+		if _, err := log.ParseLevel(level); err != nil {
+			errs.Add("modules."+module, err)
+		}
+	}
+	return errs.Err()
+}
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package logging
+
+import (
+	"slices"
+	"sync"
+
+	"cosmossdk.io/log"
+	beaconlog "github.com/berachain/beacon-kit/mod/log"
+)
+
+// moduleKeys are the keys naming the module of the loggers created with
+// With.
+//
+//nolint:gochecknoglobals // constant.
+var moduleKeys = []string{log.ModuleKey, "service"}
+
+// Logger is a logger whose lines are discarded below the level of their
+// module, the module being set by the "module" or "service" key of With.
+type Logger struct {
+	*beaconlog.Filter[any]
+	logger log.Logger
+	// level is the level of the lines of the logger.
+	level   beaconlog.Level
+	modules *moduleLevels
+}
+
+// NewLogger returns a logger discarding the lines of the modules of cfg
+// below their level. The lines of the other modules are passed to logger as
+// they are.
+func NewLogger(logger log.Logger, cfg Config) (*Logger, error) {
+	modules := &moduleLevels{
+		levels: make(map[string]beaconlog.Level, len(cfg.Modules)),
+		seen:   make(map[string]struct{}),
+	}
+	for module, name := range cfg.Modules {
+		level, err := beaconlog.ParseLevel(name)
+		if err != nil {
+			return nil, err
+		}
+		modules.levels[module] = level
+	}
+	return modules.wrap(logger, beaconlog.LevelDebug), nil
+}
+
+// With returns a logger with keyVals added to its lines. Its lines are
+// filtered with the level of the module named in keyVals, if any.
+func (l *Logger) With(keyVals ...any) log.Logger {
+	level := l.level
+	for i := 0; i+1 < len(keyVals); i += 2 {
+		key, ok := keyVals[i].(string)
+		if !ok || !slices.Contains(moduleKeys, key) {
+			continue
+		}
+		if module, isString := keyVals[i+1].(string); isString {
+			level = l.modules.levelOf(module, level)
+		}
+	}
+	return l.modules.wrap(l.logger.With(keyVals...), level)
+}
+
+// Impl returns the logger wrapped.
+func (l *Logger) Impl() any {
+	return l.logger.Impl()
+}
+
+// UnknownModules returns the modules configured with a level that no
+// logger has been created for, sorted by name.
+func (l *Logger) UnknownModules() []string {
+	return l.modules.unknown()
+}
+
+// moduleLevels are the levels of the modules, shared by the loggers created
+// from the same logger.
+type moduleLevels struct {
+	levels map[string]beaconlog.Level
+	// mu protects seen.
+	mu sync.Mutex
+	// seen are the modules loggers have been created for.
+	seen map[string]struct{}
+}
+
+// wrap returns logger filtered with level.
+func (m *moduleLevels) wrap(logger log.Logger, level beaconlog.Level) *Logger {
+	return &Logger{
+		Filter:  beaconlog.NewFilter[any](logger, level),
+		logger:  logger,
+		level:   level,
+		modules: m,
+	}
+}
+
+// levelOf returns the level of module, or level if it has none.
+func (m *moduleLevels) levelOf(
+	module string,
+	level beaconlog.Level,
+) beaconlog.Level {
+	m.mu.Lock()
+	m.seen[module] = struct{}{}
+	m.mu.Unlock()
+	if moduleLevel, ok := m.levels[module]; ok {
+		return moduleLevel
+	}
+	return level
+}
+
+// unknown returns the modules with a level that have not been seen.
+func (m *moduleLevels) unknown() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var unknown []string
+	for module := range m.levels {
+		if _, ok := m.seen[module]; !ok {
+			unknown = append(unknown, module)
+		}
+	}
+	slices.Sort(unknown)
+	return unknown
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package logging_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/log"
+	beaconlog "github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/logging"
+	"github.com/stretchr/testify/require"
+)
+
+// messages returns the messages of the JSON lines of buf.
+func messages(t *testing.T, buf *bytes.Buffer) []string {
+	t.Helper()
+	var msgs []string
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var fields struct {
+			Message string `json:"message"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(line), &fields), line)
+		msgs = append(msgs, fields.Message)
+	}
+	return msgs
+}
+
+func TestLogger_ModuleLevels(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := logging.NewLogger(
+		log.NewLogger(&buf, log.OutputJSONOption()),
+		logging.Config{Modules: map[string]string{
+			"engine.client": "warn",
+			"deposit":       "debug",
+			"pruner":        "error",
+		}},
+	)
+	require.NoError(t, err)
+
+	engine := logger.With("service", "engine.client")
+	engine.Info("engine info")
+	engine.Warn("engine warn")
+	deposit := logger.With(log.ModuleKey, "deposit")
+	deposit.Debug("deposit debug")
+	// The sub-loggers of a module keep its level, unless they are of another
+	// module.
+	engine.With("method", "forkchoiceUpdated").Info("engine method info")
+	deposit.With("service", "pruner").Warn("pruner warn")
+	// The other modules are not filtered.
+	logger.With("service", "validator").Debug("validator debug")
+	logger.Info("node info")
+
+	require.Equal(t, []string{
+		"engine warn", "deposit debug", "validator debug", "node info",
+	}, messages(t, &buf))
+}
+
+func TestLogger_UnknownModules(t *testing.T) {
+	logger, err := logging.NewLogger(
+		log.NewNopLogger(),
+		logging.Config{Modules: map[string]string{
+			"engine.client": "warn",
+			"engine-client": "warn",
+			"deposit":       "debug",
+		}},
+	)
+	require.NoError(t, err)
+	logger.With("service", "engine.client").With(log.ModuleKey, "deposit")
+	require.Equal(t, []string{"engine-client"}, logger.UnknownModules())
+}
+
+func TestNewLogger_UnknownLevel(t *testing.T) {
+	cfg := logging.Config{Modules: map[string]string{"deposit": "loud"}}
+	_, err := logging.NewLogger(log.NewNopLogger(), cfg)
+	require.ErrorIs(t, err, beaconlog.ErrUnknownLevel)
+	require.ErrorContains(t, cfg.Validate(), "modules.deposit")
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metrics
+
+import "errors"
+
+// ErrUnsupportedBackend is returned when the telemetry backend is unknown.
+var ErrUnsupportedBackend = errors.New("unsupported telemetry backend")
+
+const (
+	// BackendCosmos records metrics through the cosmos telemetry.
+	BackendCosmos = "cosmos"
+	// BackendPrometheus records metrics to a Prometheus registry served by
+	// the node.
+	BackendPrometheus = "prometheus"
+	// BackendNoop discards metrics.
+	BackendNoop = "noop"
+
+	defaultListenAddress      = "127.0.0.1:9464"
+	defaultMaxSeriesPerMetric = 1000
+)
+
+// Config is the configuration of the telemetry sink.
+type Config struct {
+	// Backend is the backend of the sink. Options are "cosmos",
+	// "prometheus" or "noop".
+	Backend string `mapstructure:"backend"`
+	// ListenAddress is the address the Prometheus metrics are served on.
+	ListenAddress string `mapstructure:"listen-address"`
+	// MaxSeriesPerMetric is the number of label combinations above which the
+	// Prometheus backend drops new series of a metric.
+	MaxSeriesPerMetric int `mapstructure:"max-series-per-metric"`
+	// StateStoreOperations enables the count and duration metrics of the
+	// operations made on the beacon state store, labelled with the
+	// collection and the operation.
+	StateStoreOperations bool `mapstructure:"state-store-operations"`
+}
+
+// DefaultConfig returns the default configuration of the telemetry sink.
+func DefaultConfig() Config {
+	return Config{
+		Backend:              BackendCosmos,
+		ListenAddress:        defaultListenAddress,
+		MaxSeriesPerMetric:   defaultMaxSeriesPerMetric,
+		StateStoreOperations: true,
+	}
+}
@@ -0,0 +1,244 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metrics
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// kind is the Prometheus type of a metric.
+type kind int
+
+const (
+	kindCounter kind = iota
+	kindGauge
+	kindHistogram
+)
+
+// droppedMetric counts the observations dropped by the Prometheus backend.
+const droppedMetric = "beacon_kit_telemetry_dropped"
+
+// promMetric is a metric registered by the Prometheus backend.
+type promMetric struct {
+	kind      kind
+	labels    []string
+	series    map[string]struct{}
+	counter   *prometheus.CounterVec
+	gauge     *prometheus.GaugeVec
+	histogram *prometheus.HistogramVec
+}
+
+// PrometheusBackend records metrics to a Prometheus registry. Metrics are
+// registered on first use, with the label names of that use. Observations
+// with other label names, or adding a series to a metric that has reached
+// the maximum number of series, are dropped and counted.
+type PrometheusBackend struct {
+	registry  *prometheus.Registry
+	maxSeries int
+	dropped   *prometheus.CounterVec
+
+	mu      sync.Mutex
+	metrics map[string]*promMetric
+}
+
+// NewPrometheusBackend creates a Prometheus backend, allowing up to
+// maxSeries label combinations per metric.
+func NewPrometheusBackend(maxSeries int) *PrometheusBackend {
+	b := &PrometheusBackend{
+		registry:  prometheus.NewRegistry(),
+		maxSeries: maxSeries,
+		dropped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: droppedMetric,
+			Help: "Observations dropped by the telemetry sink.",
+		}, []string{"metric"}),
+		metrics: make(map[string]*promMetric),
+	}
+	b.registry.MustRegister(
+		b.dropped,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return b
+}
+
+// Registry returns the registry of the backend.
+func (b *PrometheusBackend) Registry() *prometheus.Registry {
+	return b.registry
+}
+
+// Handler returns a handler serving the metrics of the registry.
+func (b *PrometheusBackend) Handler() http.Handler {
+	return promhttp.HandlerFor(b.registry, promhttp.HandlerOpts{})
+}
+
+// IncrementCounter increments a counter metric identified by the provided
+// keys.
+func (b *PrometheusBackend) IncrementCounter(key string, args ...string) {
+	if m, values := b.metric(kindCounter, sanitizeName(key), args); m != nil {
+		m.counter.WithLabelValues(values...).Inc()
+	}
+}
+
+// IncrementCounterBy increments a counter metric identified by the provided
+// keys by the given value.
+func (b *PrometheusBackend) IncrementCounterBy(
+	key string, value int64, args ...string,
+) {
+	if m, values := b.metric(kindCounter, sanitizeName(key), args); m != nil {
+		m.counter.WithLabelValues(values...).Add(float64(value))
+	}
+}
+
+// SetGauge sets a gauge metric to the specified value, identified by the
+// provided keys.
+func (b *PrometheusBackend) SetGauge(key string, value int64, args ...string) {
+	if m, values := b.metric(kindGauge, sanitizeName(key), args); m != nil {
+		m.gauge.WithLabelValues(values...).Set(float64(value))
+	}
+}
+
+// MeasureSince records the time since the provided start time, in seconds,
+// to a histogram identified by the provided key.
+func (b *PrometheusBackend) MeasureSince(
+	key string, start time.Time, args ...string,
+) {
+	m, values := b.metric(kindHistogram, sanitizeName(key)+"_seconds", args)
+	if m != nil {
+		m.histogram.WithLabelValues(values...).Observe(
+			time.Since(start).Seconds(),
+		)
+	}
+}
+
+// ObserveHistogram records the value to a histogram identified by the
+// provided key.
+func (b *PrometheusBackend) ObserveHistogram(
+	key string, value float64, args ...string,
+) {
+	m, values := b.metric(kindHistogram, sanitizeName(key), args)
+	if m != nil {
+		m.histogram.WithLabelValues(values...).Observe(value)
+	}
+}
+
+// metric returns the metric of the given name and the label values of args,
+// registering the metric on first use. It returns nil if the observation
+// must be dropped.
+func (b *PrometheusBackend) metric(
+	k kind, name string, args []string,
+) (*promMetric, []string) {
+	labels, values := splitLabels(args)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	m, ok := b.metrics[name]
+	if !ok {
+		var err error
+		if m, err = b.register(k, name, labels); err != nil {
+			b.dropped.WithLabelValues(name).Inc()
+			return nil, nil
+		}
+		b.metrics[name] = m
+	}
+	if m.kind != k || !slices.Equal(m.labels, labels) {
+		b.dropped.WithLabelValues(name).Inc()
+		return nil, nil
+	}
+
+	id := strings.Join(values, "\xff")
+	if _, ok = m.series[id]; !ok {
+		if len(m.series) >= b.maxSeries {
+			b.dropped.WithLabelValues(name).Inc()
+			return nil, nil
+		}
+		m.series[id] = struct{}{}
+	}
+	return m, values
+}
+
+// register registers a metric of the given kind, name and label names.
+func (b *PrometheusBackend) register(
+	k kind, name string, labels []string,
+) (*promMetric, error) {
+	m := &promMetric{
+		kind:   k,
+		labels: labels,
+		series: make(map[string]struct{}),
+	}
+	var collector prometheus.Collector
+	switch k {
+	case kindCounter:
+		m.counter = prometheus.NewCounterVec(
+			prometheus.CounterOpts{Name: name, Help: name}, labels,
+		)
+		collector = m.counter
+	case kindGauge:
+		m.gauge = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{Name: name, Help: name}, labels,
+		)
+		collector = m.gauge
+	case kindHistogram:
+		m.histogram = prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{Name: name, Help: name}, labels,
+		)
+		collector = m.histogram
+	}
+	return m, b.registry.Register(collector)
+}
+
+// splitLabels splits key-value pairs into label names and values. A trailing
+// key without a value gets an empty value.
+//
+//nolint:mnd // key-value pairs.
+func splitLabels(args []string) ([]string, []string) {
+	n := (len(args) + 1) / 2
+	labels, values := make([]string, n), make([]string, n)
+	for i := range n {
+		labels[i] = sanitizeName(args[2*i])
+		if 2*i+1 < len(args) {
+			values[i] = args[2*i+1]
+		}
+	}
+	return labels, values
+}
+
+// sanitizeName replaces the characters of a metric or label name that are
+// not allowed by Prometheus with underscores.
+func sanitizeName(name string) string {
+	bz := []byte(name)
+	for i, c := range bz {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_':
+		case c >= '0' && c <= '9' && i > 0:
+		default:
+			bz[i] = '_'
+		}
+	}
+	return string(bz)
+}
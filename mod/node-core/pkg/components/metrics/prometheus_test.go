@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metrics_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func scrape(t *testing.T, url string) string {
+	t.Helper()
+	//#nosec:G107 // test server.
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestServer_ScrapeCounter(t *testing.T) {
+	backend := metrics.NewPrometheusBackend(10)
+	sink := metrics.NewTelemetrySinkWithBackend(backend)
+	require.NotNil(t, sink.Handler())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server := metrics.NewServer("127.0.0.1:0", sink.Handler(), noop.NewLogger())
+	require.NoError(t, server.Start(ctx))
+	url := "http://" + server.Addr().String() + "/metrics"
+
+	sink.IncrementCounter("beacon_kit.pruner.dropped", "pruner", "deposits")
+	sink.IncrementCounter("beacon_kit.pruner.dropped", "pruner", "deposits")
+	sink.IncrementCounterBy("beacon_kit.filedb.bytes_read", 512)
+	sink.IncrementCounterBy("beacon_kit.filedb.bytes_read", 256)
+	sink.SetGauge("beacon_kit.pruner.entries_deleted", 7, "pruner", "blobs")
+	sink.MeasureSince("beacon_kit.pruner.prune_duration", time.Now())
+	sink.ObserveHistogram("beacon_kit.pruner.batch_size", 3, "pruner", "blobs")
+	sink.ObserveHistogram("beacon_kit.pruner.batch_size", 4, "pruner", "blobs")
+
+	body := scrape(t, url)
+	require.Contains(t, body,
+		`beacon_kit_pruner_dropped{pruner="deposits"} 2`)
+	require.Contains(t, body, "beacon_kit_filedb_bytes_read 768")
+	require.Contains(t, body,
+		`beacon_kit_pruner_entries_deleted{pruner="blobs"} 7`)
+	require.Contains(t, body, "beacon_kit_pruner_prune_duration_seconds_count 1")
+	require.Contains(t, body,
+		`beacon_kit_pruner_batch_size_sum{pruner="blobs"} 7`)
+	require.Contains(t, body,
+		`beacon_kit_pruner_batch_size_count{pruner="blobs"} 2`)
+
+	require.NoError(t, server.Stop(context.Background()))
+	require.NoError(t, server.Status())
+}
+
+func TestPrometheusBackend_Guards(t *testing.T) {
+	backend := metrics.NewPrometheusBackend(2)
+	for _, value := range []string{"a", "b", "c", "a"} {
+		backend.IncrementCounter("requests", "route", value)
+	}
+	// Other label names, or another type, are dropped as well.
+	backend.IncrementCounter("requests", "method", "GET")
+	backend.SetGauge("requests", 1, "route", "a")
+
+	expected := `
+# HELP beacon_kit_telemetry_dropped Observations dropped by the telemetry sink.
+# TYPE beacon_kit_telemetry_dropped counter
+beacon_kit_telemetry_dropped{metric="requests"} 3
+# HELP requests requests
+# TYPE requests counter
+requests{route="a"} 2
+requests{route="b"} 1
+`
+	require.NoError(t, testutil.GatherAndCompare(
+		backend.Registry(), strings.NewReader(expected),
+		"requests", "beacon_kit_telemetry_dropped",
+	))
+}
+
+func TestTelemetrySink_Noop(t *testing.T) {
+	sink := metrics.NewTelemetrySinkWithBackend(metrics.NoopBackend{})
+	sink.IncrementCounter("requests")
+	sink.SetGauge("requests", 1)
+	sink.MeasureSince("requests", time.Now())
+	sink.ObserveHistogram("requests", 1)
+	require.Nil(t, sink.Handler())
+	require.Nil(t, metrics.NewTelemetrySink().Handler())
+}
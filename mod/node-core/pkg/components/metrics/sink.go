@@ -21,60 +21,95 @@
 package metrics
 
 import (
+	"net/http"
 	"time"
-
-	"github.com/cosmos/cosmos-sdk/telemetry"
-	"github.com/hashicorp/go-metrics"
 )
 
-type TelemetrySink struct{}
+// Backend records the metrics of a TelemetrySink.
+type Backend interface {
+	// IncrementCounter increments a counter metric identified by the
+	// provided keys.
+	IncrementCounter(key string, args ...string)
+	// IncrementCounterBy increments a counter metric identified by the
+	// provided keys by the given value.
+	IncrementCounterBy(key string, value int64, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
+	// MeasureSince measures the time since the provided start time and
+	// records the duration in a metric identified by the provided key.
+	MeasureSince(key string, start time.Time, args ...string)
+	// ObserveHistogram records the value in the distribution of a histogram
+	// metric identified by the provided key.
+	ObserveHistogram(key string, value float64, args ...string)
+}
+
+// TelemetrySink sends metrics to its backend. The zero value sends them to
+// the cosmos telemetry.
+type TelemetrySink struct {
+	backend Backend
+}
 
 // NewTelemetrySink creates a new TelemetrySink.
 func NewTelemetrySink() TelemetrySink {
 	return TelemetrySink{}
 }
 
+// NewTelemetrySinkWithBackend creates a TelemetrySink sending metrics to the
+// given backend.
+func NewTelemetrySinkWithBackend(backend Backend) TelemetrySink {
+	return TelemetrySink{backend: backend}
+}
+
 // IncrementCounter increments a counter metric identified by the provided
 // keys.
-func (TelemetrySink) IncrementCounter(key string, args ...string) {
-	telemetry.IncrCounterWithLabels([]string{key}, 1, argsToLabels(args...))
+func (s TelemetrySink) IncrementCounter(key string, args ...string) {
+	s.getBackend().IncrementCounter(key, args...)
+}
+
+// IncrementCounterBy increments a counter metric identified by the provided
+// keys by the given value.
+func (s TelemetrySink) IncrementCounterBy(
+	key string, value int64, args ...string,
+) {
+	s.getBackend().IncrementCounterBy(key, value, args...)
 }
 
 // SetGauge sets a gauge metric to the specified value, identified by the
 // provided keys.
-func (TelemetrySink) SetGauge(key string, value int64, args ...string) {
-	telemetry.SetGaugeWithLabels(
-		[]string{key},
-		float32(value),
-		argsToLabels(args...),
-	)
+func (s TelemetrySink) SetGauge(key string, value int64, args ...string) {
+	s.getBackend().SetGauge(key, value, args...)
 }
 
 // MeasureSince measures the time since the provided start time and records
 // the duration in a metric identified by the provided key.
-func (TelemetrySink) MeasureSince(key string, start time.Time, args ...string) {
-	if !telemetry.IsTelemetryEnabled() {
-		return
-	}
+func (s TelemetrySink) MeasureSince(
+	key string, start time.Time, args ...string,
+) {
+	s.getBackend().MeasureSince(key, start, args...)
+}
 
-	// TODO: Make PR to SDK, currently this will not have any globalLabels.
-	metrics.MeasureSinceWithLabels(
-		[]string{key},
-		start.UTC(),
-		argsToLabels(args...),
-	)
+// ObserveHistogram records the value in the distribution of a histogram
+// metric identified by the provided key.
+func (s TelemetrySink) ObserveHistogram(
+	key string, value float64, args ...string,
+) {
+	s.getBackend().ObserveHistogram(key, value, args...)
 }
 
-// argsToLabels converts a list of key-value pairs to a list of metrics labels.
-//
-//nolint:mnd // its okay.
-func argsToLabels(args ...string) []metrics.Label {
-	labels := make([]metrics.Label, len(args)/2)
-	for i := 0; i < len(args); i += 2 {
-		labels[i/2] = metrics.Label{
-			Name:  args[i],
-			Value: args[i+1],
-		}
+// Handler returns the handler serving the metrics of the backend, or nil if
+// the backend is not served by the node.
+func (s TelemetrySink) Handler() http.Handler {
+	if served, ok := s.backend.(interface{ Handler() http.Handler }); ok {
+		return served.Handler()
+	}
+	return nil
+}
+
+// getBackend returns the backend of the sink.
+func (s TelemetrySink) getBackend() Backend {
+	if s.backend == nil {
+		return cosmosBackend{}
 	}
-	return labels
+	return s.backend
 }
@@ -34,8 +34,11 @@ import (
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	execution "github.com/berachain/beacon-kit/mod/execution/pkg/engine"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/diagnostics"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/health"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	modulev1alpha1 "github.com/berachain/beacon-kit/mod/node-core/pkg/components/module/api/module/v1alpha1"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/nodeapi"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
 	payloadbuilder "github.com/berachain/beacon-kit/mod/payload/pkg/builder"
@@ -46,23 +49,38 @@ import (
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
+	blockstore "github.com/berachain/beacon-kit/mod/storage/pkg/block"
 	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/ethereum/go-ethereum/event"
 )
 
-// TODO: we don't allow generics here? Why? Is it fixable?
+// The module is registered with the default types, as depinject can only
+// call concrete functions. Other type sets are wired by providing an exported
+// function calling ProvideModule with them.
 //
 //nolint:gochecknoinits // required by sdk.
 func init() {
 	appconfig.RegisterModule(&modulev1alpha1.Module{},
-		appconfig.Provide(ProvideModule),
+		appconfig.Provide(ProvideDefaultModule),
 	)
 }
 
+// DefaultDepInjectInput is the input for the dep inject framework with the
+// default types.
+type DefaultDepInjectInput = DepInjectInput[
+	*dastore.Store[*types.BeaconBlockBody],
+	*types.BeaconBlock,
+	*types.BeaconBlockBody,
+]
+
 // DepInjectInput is the input for the dep inject framework.
-type DepInjectInput struct {
+type DepInjectInput[
+	AvailabilityStoreT components.AvailabilityStore[BeaconBlockBodyT],
+	BeaconBlockT components.BeaconBlock[BeaconBlockT, BeaconBlockBodyT],
+	BeaconBlockBodyT components.BeaconBlockBody,
+] struct {
 	depinject.In
 
 	// Cosmos components
@@ -70,42 +88,48 @@ type DepInjectInput struct {
 	Environment appmodule.Environment
 
 	// BeaconKit components
-	AvailabilityStore     *dastore.Store[*types.BeaconBlockBody]
+	AvailabilityStore     AvailabilityStoreT
 	BeaconConfig          *config.Config
 	BeaconDepositContract *deposit.WrappedBeaconDepositContract[
 		*types.Deposit, types.WithdrawalCredentials,
 	]
-	BlockFeed     *event.FeedOf[*feed.Event[*types.BeaconBlock]]
-	BlobProcessor *dablobs.Processor[
-		*dastore.Store[*types.BeaconBlockBody],
-		*types.BeaconBlockBody,
+	BlockFeed          *feed.Topic[*feed.Event[BeaconBlockT]]
+	FinalizedBlockFeed blockchain.FinalizedBlockFeed[BeaconBlockT]
+	BlockStore         *blockstore.KVStore[BeaconBlockT]
+	BlobProcessor      *dablobs.Processor[
+		AvailabilityStoreT,
+		BeaconBlockBodyT,
 	]
 	ChainSpec primitives.ChainSpec
 	DBManager *manager.DBManager[
-		*types.BeaconBlock,
-		*feed.Event[*types.BeaconBlock],
+		BeaconBlockT,
+		*feed.Event[BeaconBlockT],
 		event.Subscription,
 	]
 	DepositStore   *depositdb.KVStore[*types.Deposit]
 	DepositService *deposit.Service[
-		*types.BeaconBlock,
-		*types.BeaconBlockBody,
-		*feed.Event[*types.BeaconBlock],
+		BeaconBlockT,
+		BeaconBlockBodyT,
+		*feed.Event[BeaconBlockT],
 		*types.Deposit,
 		*types.ExecutionPayload,
 		event.Subscription,
 		types.WithdrawalCredentials,
 	]
-	ExecutionEngine *execution.Engine[*types.ExecutionPayload]
-	EngineClient    *engineclient.EngineClient[*types.ExecutionPayload]
-	LocalBuilder    *payloadbuilder.PayloadBuilder[
+	DiagnosticsServer *diagnostics.Server `optional:"true"`
+	ExecutionEngine   *execution.Engine[*types.ExecutionPayload]
+	EngineClient      *engineclient.EngineClient[*types.ExecutionPayload]
+	EventBus          *feed.Bus
+	HealthServer      *health.Server `optional:"true"`
+	LocalBuilder      *payloadbuilder.PayloadBuilder[
 		components.BeaconState,
 		*types.ExecutionPayload,
 		*types.ExecutionPayloadHeader,
 	]
+	QueryContexts  *nodeapi.QueryContexts `optional:"true"`
 	Signer         crypto.BLSSigner
 	StateProcessor blockchain.StateProcessor[
-		*types.BeaconBlock,
+		BeaconBlockT,
 		components.BeaconState,
 		*datypes.BlobSidecars,
 		*transition.Context,
@@ -120,14 +144,27 @@ type DepInjectOutput struct {
 	Module appmodule.AppModule
 }
 
-// ProvideModule is a function that provides the module to the application.
-func ProvideModule(in DepInjectInput) (DepInjectOutput, error) {
+// ProvideDefaultModule provides the module to the application with the
+// default types.
+func ProvideDefaultModule(in DefaultDepInjectInput) (DepInjectOutput, error) {
+	return ProvideModule(in)
+}
+
+// ProvideModule is a function that provides the module to the application,
+// built with the given availability store and beacon block types.
+func ProvideModule[
+	AvailabilityStoreT components.AvailabilityStore[BeaconBlockBodyT],
+	BeaconBlockT components.BeaconBlock[BeaconBlockT, BeaconBlockBodyT],
+	BeaconBlockBodyT components.BeaconBlockBody,
+](
+	in DepInjectInput[AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT],
+) (DepInjectOutput, error) {
 	payloadCodec := &encoding.
 		SSZInterfaceCodec[*types.ExecutionPayloadHeader]{}
 	storageBackend := storage.NewBackend[
-		*dastore.Store[*types.BeaconBlockBody],
-		*types.BeaconBlock,
-		*types.BeaconBlockBody,
+		AvailabilityStoreT,
+		BeaconBlockT,
+		BeaconBlockBodyT,
 		core.BeaconState[
 			*types.BeaconBlockHeader, *types.Eth1Data,
 			*types.ExecutionPayloadHeader, *types.Fork,
@@ -142,7 +179,21 @@ func ProvideModule(in DepInjectInput) (DepInjectOutput, error) {
 			*types.ExecutionPayloadHeader,
 			*types.Eth1Data,
 			*types.Validator,
-		](in.Environment.KVStoreService, payloadCodec),
+		](
+			in.Environment.KVStoreService,
+			payloadCodec,
+			beacondb.WithSlotsPerHistoricalRoot(
+				in.ChainSpec.SlotsPerHistoricalRoot(),
+			),
+			beacondb.WithEpochsPerHistoricalVector(
+				in.ChainSpec.EpochsPerHistoricalVector(),
+			),
+			beacondb.WithReadCache(),
+			beacondb.WithTelemetrySink(in.TelemetrySink),
+			beacondb.WithOperationMetrics(
+				in.BeaconConfig.Telemetry.StateStoreOperations,
+			),
+		),
 		in.DepositStore,
 	)
 
@@ -151,13 +202,40 @@ func ProvideModule(in DepInjectInput) (DepInjectOutput, error) {
 		in.BeaconConfig.KZG.Implementation = "crate-crypto/go-kzg-4844"
 	}
 
+	nodeAPIServer := components.ProvideNodeAPIServer[
+		components.BeaconState, BeaconBlockT, BeaconBlockBodyT,
+	](
+		in.BeaconConfig,
+		in.AppOpts,
+		in.ChainSpec,
+		in.QueryContexts,
+		storageBackend,
+		in.BlockStore,
+		in.AvailabilityStore,
+		// The events of the blocks processed before the server starts are
+		// replayed to it.
+		in.BlockFeed.Replayed(),
+		in.EngineClient,
+		in.Environment.Logger,
+	)
+	nodeAPIAdminServer := components.ProvideNodeAPIAdminServer(
+		in.BeaconConfig, in.DBManager, in.Environment.Logger,
+	)
+
 	runtime, err := components.ProvideRuntime(
 		in.BeaconConfig,
 		in.BlobProcessor,
+		in.EventBus,
 		in.BlockFeed,
+		in.FinalizedBlockFeed,
+		in.BlockStore,
 		in.ChainSpec,
 		in.DBManager,
 		in.DepositService,
+		in.DiagnosticsServer,
+		in.HealthServer,
+		nodeAPIServer,
+		nodeAPIAdminServer,
 		in.Signer,
 		in.EngineClient,
 		in.ExecutionEngine,
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon_test
+
+import (
+	"testing"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	beacon "github.com/berachain/beacon-kit/mod/node-core/pkg/components/module"
+	"github.com/stretchr/testify/require"
+)
+
+// testAvailabilityStore is an availability store other than the default one,
+// used to instantiate the module with a different type set.
+type testAvailabilityStore struct {
+	*dastore.Store[*types.BeaconBlockBody]
+}
+
+var (
+	_ appmodulev2.AppModule = beacon.Module[
+		testAvailabilityStore, *types.BeaconBlock, *types.BeaconBlockBody,
+	]{}
+	_ = beacon.ProvideModule[
+		testAvailabilityStore, *types.BeaconBlock, *types.BeaconBlockBody,
+	]
+)
+
+func TestProvideModule_TypeSets(t *testing.T) {
+	var (
+		defaults func(beacon.DefaultDepInjectInput) (
+			beacon.DepInjectOutput, error,
+		) = beacon.ProvideModule
+		custom func(beacon.DepInjectInput[
+			testAvailabilityStore, *types.BeaconBlock, *types.BeaconBlockBody,
+		]) (beacon.DepInjectOutput, error) = beacon.ProvideModule
+	)
+	require.NotNil(t, defaults)
+	require.NotNil(t, custom)
+	require.NotNil(t, beacon.ProvideDefaultModule)
+}
@@ -26,9 +26,14 @@ import (
 
 	appmodulev2 "cosmossdk.io/core/appmodule/v2"
 	"cosmossdk.io/core/registry"
+	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/genesis"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/runtime"
+	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
@@ -45,42 +50,103 @@ var (
 	_ module.HasABCIEndBlock = AppModule{}
 )
 
-// AppModule implements an application module for the evm module.
-type AppModule struct {
-	*components.BeaconKitRuntime
+// AppModule is the beacon module built with the default types.
+type AppModule = Module[
+	*dastore.Store[*types.BeaconBlockBody],
+	*types.BeaconBlock,
+	*types.BeaconBlockBody,
+]
+
+// Module implements an application module for the evm module, generic over
+// the availability store and beacon block types of its runtime.
+type Module[
+	AvailabilityStoreT components.AvailabilityStore[BeaconBlockBodyT],
+	BeaconBlockT components.BeaconBlock[BeaconBlockT, BeaconBlockBodyT],
+	BeaconBlockBodyT components.BeaconBlockBody,
+] struct {
+	*runtime.BeaconKitRuntime[
+		AvailabilityStoreT,
+		BeaconBlockT,
+		BeaconBlockBodyT,
+		components.BeaconState,
+		*datypes.BlobSidecars,
+		*depositdb.KVStore[*types.Deposit],
+		blockchain.StorageBackend[
+			AvailabilityStoreT,
+			BeaconBlockBodyT,
+			components.BeaconState,
+			*datypes.BlobSidecars,
+			*types.Deposit,
+			*depositdb.KVStore[*types.Deposit],
+		],
+	]
 }
 
-// NewAppModule creates a new AppModule object.
-func NewAppModule(
-	runtime *components.BeaconKitRuntime,
-) AppModule {
-	return AppModule{
+// NewAppModule creates a new Module object.
+func NewAppModule[
+	AvailabilityStoreT components.AvailabilityStore[BeaconBlockBodyT],
+	BeaconBlockT components.BeaconBlock[BeaconBlockT, BeaconBlockBodyT],
+	BeaconBlockBodyT components.BeaconBlockBody,
+](
+	runtime *runtime.BeaconKitRuntime[
+		AvailabilityStoreT,
+		BeaconBlockT,
+		BeaconBlockBodyT,
+		components.BeaconState,
+		*datypes.BlobSidecars,
+		*depositdb.KVStore[*types.Deposit],
+		blockchain.StorageBackend[
+			AvailabilityStoreT,
+			BeaconBlockBodyT,
+			components.BeaconState,
+			*datypes.BlobSidecars,
+			*types.Deposit,
+			*depositdb.KVStore[*types.Deposit],
+		],
+	],
+) Module[AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT] {
+	return Module[AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT]{
 		BeaconKitRuntime: runtime,
 	}
 }
 
 // Name is the name of this module.
-func (am AppModule) Name() string {
+func (am Module[
+	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT,
+]) Name() string {
 	return ModuleName
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 {
+func (Module[
+	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT,
+]) ConsensusVersion() uint64 {
 	return ConsensusVersion
 }
 
 // RegisterInterfaces registers the module's interface types.
-func (am AppModule) RegisterInterfaces(registry.InterfaceRegistrar) {}
+func (am Module[
+	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT,
+]) RegisterInterfaces(registry.InterfaceRegistrar) {
+}
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
-func (am AppModule) IsOnePerModuleType() {}
+func (am Module[
+	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT,
+]) IsOnePerModuleType() {
+}
 
 // IsAppModule implements the appmodule.AppModule interface.
-func (am AppModule) IsAppModule() {}
+func (am Module[
+	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT,
+]) IsAppModule() {
+}
 
 // DefaultGenesis returns default genesis state as raw bytes
 // for the beacon module.
-func (AppModule) DefaultGenesis() json.RawMessage {
+func (Module[
+	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT,
+]) DefaultGenesis() json.RawMessage {
 	bz, err := json.Marshal(
 		genesis.DefaultGenesisDeneb(),
 	)
@@ -91,7 +157,9 @@ func (AppModule) DefaultGenesis() json.RawMessage {
 }
 
 // ValidateGenesis performs genesis state validation for the evm module.
-func (AppModule) ValidateGenesis(
+func (Module[
+	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT,
+]) ValidateGenesis(
 	_ json.RawMessage,
 ) error {
 	return nil
@@ -99,7 +167,9 @@ func (AppModule) ValidateGenesis(
 
 // ExportGenesis returns the exported genesis state as raw bytes for the evm
 // module.
-func (am AppModule) ExportGenesis(
+func (am Module[
+	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT,
+]) ExportGenesis(
 	_ context.Context,
 ) (json.RawMessage, error) {
 	return json.Marshal(
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/nodeapi"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// ProvideNodeAPIServer provides the beacon node API server serving the
+// state read through storageBackend, the blocks of blockStore, the blob
+// sidecars of blobStore, the events of blockFeed and the sync status of
+// executionClient, or nil if it is disabled. The query contexts are set
+// once the application is built.
+func ProvideNodeAPIServer[
+	BeaconStateT nodeapi.BeaconState,
+	BeaconBlockT nodeapi.BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT nodeapi.BeaconBlockBody,
+](
+	cfg *config.Config,
+	appOpts servertypes.AppOptions,
+	chainSpec primitives.ChainSpec,
+	queryContexts *nodeapi.QueryContexts,
+	storageBackend nodeapi.StorageBackend[BeaconStateT],
+	blockStore nodeapi.BlockStore[BeaconBlockT],
+	blobStore nodeapi.BlobStore,
+	blockFeed nodeapi.BlockFeed[BeaconBlockT],
+	executionClient nodeapi.ExecutionClient,
+	logger log.Logger,
+) *nodeapi.Server {
+	if !cfg.NodeAPI.Enabled {
+		return nil
+	}
+	// The application is not built when only the commands are.
+	if queryContexts == nil {
+		queryContexts = &nodeapi.QueryContexts{}
+	}
+	genesisFile := filepath.Join(
+		cast.ToString(appOpts.Get(flags.FlagHome)), "config", "genesis.json",
+	)
+	logger = logger.With("service", "node-api-server")
+	return nodeapi.NewServer(
+		cfg.NodeAPI.ListenAddress,
+		nodeapi.NewHandler(
+			nodeapi.NewStateBackend[
+				BeaconStateT, BeaconBlockT, BeaconBlockBodyT,
+			](
+				chainSpec,
+				queryContexts,
+				storageBackend,
+				blockStore,
+				blobStore,
+				executionClient,
+				genesisFile,
+			),
+			nodeapi.NewBlockEvents[BeaconBlockT, BeaconBlockBodyT](
+				chainSpec, blockFeed, logger,
+			),
+			logger,
+		),
+		logger,
+	)
+}
+
+// ProvideNodeAPIAdminServer provides the server of the admin routes of the
+// node API, pruning the stores of the node with pruners, or nil if they are
+// not served.
+func ProvideNodeAPIAdminServer(
+	cfg *config.Config,
+	pruners backend.Pruners,
+	logger log.Logger,
+) *nodeapi.Server {
+	if cfg.NodeAPI.AdminListenAddress == "" {
+		return nil
+	}
+	return nodeapi.NewAdminServer(
+		cfg.NodeAPI.AdminListenAddress,
+		pruners,
+		logger.With("service", "node-api-admin-server"),
+	)
+}
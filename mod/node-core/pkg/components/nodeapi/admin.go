@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/node-api/server"
+	"github.com/berachain/beacon-kit/mod/node-api/server/handlers"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/labstack/echo/v4"
+)
+
+// NewAdminServer creates a server serving the admin routes of the node API
+// on addr, pruning the stores of the node with pruners. The admin routes
+// change the state of the node and are not authenticated, so addr must only
+// be reachable by the operators of the node.
+func NewAdminServer(
+	addr string,
+	pruners backend.Pruners,
+	logger log.Logger[any],
+) *Server {
+	e := newEcho(logger)
+	server.AssignAdminRoutes(e, handlers.RouteHandlers{
+		Backend: backend.New(nil, adminPruners{pruners: pruners}),
+	})
+	return newServer("node-api-admin-server", addr, e, logger)
+}
+
+// adminPruners reports the errors of the prune requests that are the
+// caller's with their status code.
+type adminPruners struct {
+	pruners backend.Pruners
+}
+
+// PruneNow prunes the range [start, end) of the named store.
+func (p adminPruners) PruneNow(
+	ctx context.Context,
+	name string,
+	start, end uint64,
+	dryRun bool,
+) (uint64, error) {
+	deleted, err := p.pruners.PruneNow(ctx, name, start, end, dryRun)
+	switch {
+	case errors.Is(err, manager.ErrPrunerNotFound):
+		return 0, echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, pruner.ErrInvalidRange),
+		errors.Is(err, pruner.ErrUnsafeRange),
+		errors.Is(err, pruner.ErrDryRunNotSupported):
+		return 0, echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return deleted, err
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/nodeapi"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/stretchr/testify/require"
+)
+
+// testPruners prunes the "blobs" store only, deleting one entry per index
+// of the range.
+type testPruners struct{}
+
+func (testPruners) PruneNow(
+	_ context.Context, name string, start, end uint64, _ bool,
+) (uint64, error) {
+	switch {
+	case name != "blobs":
+		return 0, manager.ErrPrunerNotFound
+	case start >= end:
+		return 0, pruner.ErrInvalidRange
+	case end > 10:
+		return 0, pruner.ErrUnsafeRange
+	case start == 0:
+		return 0, errors.New("store failed")
+	}
+	return end - start, nil
+}
+
+func TestAdminServer_Prune(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server := nodeapi.NewAdminServer(
+		"127.0.0.1:0", testPruners{}, noop.NewLogger(),
+	)
+	require.Equal(t, "node-api-admin-server", server.Name())
+	require.NoError(t, server.Start(ctx))
+	defer func() {
+		require.NoError(t, server.Stop(context.Background()))
+	}()
+
+	prune := func(pruner, body string) (int, string) {
+		//#nosec:G107 // test server.
+		resp, err := http.Post(
+			"http://"+server.Addr().String()+"/admin/v1/prune/"+pruner,
+			"application/json", strings.NewReader(body),
+		)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		bz, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(bz)
+	}
+
+	code, body := prune("blobs", `{"start":"2","end":"6","dry_run":true}`)
+	require.Equal(t, http.StatusOK, code)
+	require.JSONEq(t, `{"data":{"pruner":"blobs","start":"2","end":"6",`+
+		`"deleted":"4","dry_run":true}}`, body)
+
+	code, body = prune("deposits", `{"start":"2","end":"6"}`)
+	require.Equal(t, http.StatusNotFound, code)
+	require.Contains(t, body, manager.ErrPrunerNotFound.Error())
+
+	code, _ = prune("blobs", `{"start":"3","end":"3"}`)
+	require.Equal(t, http.StatusBadRequest, code)
+	code, _ = prune("blobs", `{"start":"2","end":"12"}`)
+	require.Equal(t, http.StatusBadRequest, code)
+	code, body = prune("blobs", `{"start":"0","end":"2"}`)
+	require.Equal(t, http.StatusInternalServerError, code)
+	require.NotContains(t, body, "store failed")
+}
+
+func TestServer_NoAdminRoutes(t *testing.T) {
+	node := newTestNode(t)
+	code, _ := node.get(t, "/admin/v1/prune/blobs")
+	require.Equal(t, http.StatusNotFound, code)
+}
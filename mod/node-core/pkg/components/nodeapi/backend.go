@@ -0,0 +1,349 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// QueryContextProvider provides contexts over committed versions of the
+// multistore.
+type QueryContextProvider interface {
+	CreateQueryContext(height int64, prove bool) (sdk.Context, error)
+}
+
+// QueryContexts holds the query context provider, which is only known once
+// the application is built, after the services are.
+type QueryContexts struct {
+	mu       sync.RWMutex
+	provider QueryContextProvider
+}
+
+// SetProvider sets the query context provider.
+func (q *QueryContexts) SetProvider(provider QueryContextProvider) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.provider = provider
+}
+
+// Create returns a context over the multistore committed at height, the
+// latest one if height is 0.
+func (q *QueryContexts) Create(
+	ctx context.Context,
+	height int64,
+) (context.Context, error) {
+	q.mu.RLock()
+	provider := q.provider
+	q.mu.RUnlock()
+	if provider == nil {
+		return nil, ErrNotReady
+	}
+	qctx, err := provider.CreateQueryContext(height, false)
+	if err != nil {
+		return nil, errors.Join(ErrStateNotAvailable, err)
+	}
+	return qctx.WithContext(ctx), nil
+}
+
+// BeaconState is the beacon state read by the node API.
+type BeaconState interface {
+	GetGenesisValidatorsRoot() (common.Root, error)
+	GetLatestExecutionPayloadHeader() (*types.ExecutionPayloadHeader, error)
+	GetSlot() (math.Slot, error)
+	GetFork() (*types.Fork, error)
+	StateRootAtIndex(index uint64) (common.Root, error)
+	GetRandaoMixAtIndex(index uint64) (primitives.Bytes32, error)
+	GetBalance(index math.ValidatorIndex) (math.Gwei, error)
+	IterateValidators(
+		fn func(index math.ValidatorIndex, val *types.Validator) (bool, error),
+	) error
+	ValidatorByIndex(index math.ValidatorIndex) (*types.Validator, error)
+	ValidatorIndexByPubkey(pubkey crypto.BLSPubkey) (math.ValidatorIndex, error)
+}
+
+// ExecutionClient reports the sync status of the execution client.
+type ExecutionClient interface {
+	IsSyncing(ctx context.Context) (bool, error)
+}
+
+// StorageBackend returns the beacon state read through a context.
+type StorageBackend[BeaconStateT any] interface {
+	StateFromContext(ctx context.Context) BeaconStateT
+}
+
+// BlockStore holds the finalized blocks.
+type BlockStore[BeaconBlockT any] interface {
+	GetBySlot(slot math.Slot) (BeaconBlockT, error)
+	GetByRoot(root common.Root) (BeaconBlockT, error)
+	GetLatest() (BeaconBlockT, error)
+}
+
+// BlobStore holds the blob sidecars of the blocks within the data
+// availability period.
+type BlobStore interface {
+	GetBlobSidecars(slot math.Slot) (*datypes.BlobSidecars, error)
+	GetBlobSidecarReader(
+		slot math.Slot, index uint64,
+	) (io.ReadCloser, int64, error)
+}
+
+// Block is a block with its version and root, in the node API encoding
+// and as the message encoded in SSZ.
+type Block struct {
+	Version uint32
+	Root    common.Root
+	Data    SignedBeaconBlock
+	Message SSZMarshaler
+}
+
+// Backend is the source of the data served by the node API.
+type Backend interface {
+	// BlockAtSlot returns the block at slot.
+	BlockAtSlot(slot math.Slot) (*Block, error)
+	// BlockByRoot returns the block of the given root.
+	BlockByRoot(root common.Root) (*Block, error)
+	// BlobSidecars returns the blob sidecars stored for the block at slot,
+	// ordered by index.
+	BlobSidecars(slot math.Slot) ([]*datypes.BlobSidecar, error)
+	// BlobSidecarReader returns a reader of the SSZ encoding of the blob
+	// sidecar of the given index stored for the block at slot, with its
+	// length. The reader must be closed.
+	BlobSidecarReader(
+		slot math.Slot, index uint64,
+	) (io.ReadCloser, int64, error)
+	// ChainSpec returns the chain spec of the node.
+	ChainSpec() primitives.ChainSpec
+	// ExecutionSyncing returns whether the execution client is syncing. It
+	// fails if the execution client cannot be reached.
+	ExecutionSyncing(ctx context.Context) (bool, error)
+	// GenesisTime returns the genesis time of the chain.
+	GenesisTime() (time.Time, error)
+	// HeadBlock returns the latest finalized block.
+	HeadBlock() (*Block, error)
+	// HeadState returns the latest committed beacon state.
+	HeadState(ctx context.Context) (BeaconState, error)
+	// StateAtSlot returns the beacon state committed at slot.
+	StateAtSlot(ctx context.Context, slot math.Slot) (BeaconState, error)
+}
+
+// StateBackend is the backend reading the beacon state from the committed
+// versions of the multistore, and the blocks from the block store.
+type StateBackend[
+	BeaconStateT BeaconState,
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody,
+] struct {
+	chainSpec       primitives.ChainSpec
+	queryContexts   *QueryContexts
+	storage         StorageBackend[BeaconStateT]
+	blocks          BlockStore[BeaconBlockT]
+	blobs           BlobStore
+	executionClient ExecutionClient
+	genesisFile     string
+
+	genesisMu   sync.Mutex
+	genesisTime *time.Time
+}
+
+// NewStateBackend creates a backend reading the beacon state from storage
+// at the versions of the multistore given by queryContexts, the blocks from
+// blocks, the blob sidecars from blobs, the sync status of the execution
+// client from executionClient and the genesis time from genesisFile.
+func NewStateBackend[
+	BeaconStateT BeaconState,
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody,
+](
+	chainSpec primitives.ChainSpec,
+	queryContexts *QueryContexts,
+	storage StorageBackend[BeaconStateT],
+	blocks BlockStore[BeaconBlockT],
+	blobs BlobStore,
+	executionClient ExecutionClient,
+	genesisFile string,
+) *StateBackend[BeaconStateT, BeaconBlockT, BeaconBlockBodyT] {
+	return &StateBackend[BeaconStateT, BeaconBlockT, BeaconBlockBodyT]{
+		chainSpec:       chainSpec,
+		queryContexts:   queryContexts,
+		storage:         storage,
+		blocks:          blocks,
+		blobs:           blobs,
+		executionClient: executionClient,
+		genesisFile:     genesisFile,
+	}
+}
+
+// ChainSpec returns the chain spec of the node.
+func (b *StateBackend[
+	BeaconStateT, BeaconBlockT, BeaconBlockBodyT,
+]) ChainSpec() primitives.ChainSpec {
+	return b.chainSpec
+}
+
+// ExecutionSyncing returns whether the execution client is syncing.
+func (b *StateBackend[
+	BeaconStateT, BeaconBlockT, BeaconBlockBodyT,
+]) ExecutionSyncing(
+	ctx context.Context,
+) (bool, error) {
+	return b.executionClient.IsSyncing(ctx)
+}
+
+// GenesisTime returns the genesis time of the genesis file, read once.
+func (b *StateBackend[
+	BeaconStateT, BeaconBlockT, BeaconBlockBodyT,
+]) GenesisTime() (time.Time, error) {
+	b.genesisMu.Lock()
+	defer b.genesisMu.Unlock()
+	if b.genesisTime != nil {
+		return *b.genesisTime, nil
+	}
+	appGenesis, err := genutiltypes.AppGenesisFromFile(b.genesisFile)
+	if err != nil {
+		return time.Time{}, err
+	}
+	b.genesisTime = &appGenesis.GenesisTime
+	return appGenesis.GenesisTime, nil
+}
+
+// HeadState returns the latest committed beacon state.
+func (b *StateBackend[
+	BeaconStateT, BeaconBlockT, BeaconBlockBodyT,
+]) HeadState(
+	ctx context.Context,
+) (BeaconState, error) {
+	qctx, err := b.queryContexts.Create(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	return b.storage.StateFromContext(qctx), nil
+}
+
+// StateAtSlot returns the beacon state committed at slot. A block is
+// committed at each height, the state of a slot is the version of the
+// multistore at the same height.
+func (b *StateBackend[
+	BeaconStateT, BeaconBlockT, BeaconBlockBodyT,
+]) StateAtSlot(
+	ctx context.Context,
+	slot math.Slot,
+) (BeaconState, error) {
+	//#nosec:G115 // slots are heights, which fit in an int64.
+	qctx, err := b.queryContexts.Create(ctx, int64(slot))
+	if err != nil {
+		return nil, err
+	}
+	return b.storage.StateFromContext(qctx), nil
+}
+
+// BlockAtSlot returns the block at slot.
+func (b *StateBackend[
+	BeaconStateT, BeaconBlockT, BeaconBlockBodyT,
+]) BlockAtSlot(slot math.Slot) (*Block, error) {
+	return b.newBlock(b.blocks.GetBySlot(slot))
+}
+
+// BlockByRoot returns the block of the given root.
+func (b *StateBackend[
+	BeaconStateT, BeaconBlockT, BeaconBlockBodyT,
+]) BlockByRoot(root common.Root) (*Block, error) {
+	return b.newBlock(b.blocks.GetByRoot(root))
+}
+
+// HeadBlock returns the latest finalized block.
+func (b *StateBackend[
+	BeaconStateT, BeaconBlockT, BeaconBlockBodyT,
+]) HeadBlock() (*Block, error) {
+	return b.newBlock(b.blocks.GetLatest())
+}
+
+// BlobSidecars returns the blob sidecars stored for the block at slot.
+func (b *StateBackend[
+	BeaconStateT, BeaconBlockT, BeaconBlockBodyT,
+]) BlobSidecars(slot math.Slot) ([]*datypes.BlobSidecar, error) {
+	sidecars, err := b.blobs.GetBlobSidecars(slot)
+	if err != nil {
+		return nil, err
+	}
+	return sidecars.Sidecars, nil
+}
+
+// BlobSidecarReader returns a reader of the SSZ encoding of the blob sidecar
+// of the given index stored for the block at slot, streamed from the
+// availability store.
+func (b *StateBackend[
+	BeaconStateT, BeaconBlockT, BeaconBlockBodyT,
+]) BlobSidecarReader(
+	slot math.Slot, index uint64,
+) (io.ReadCloser, int64, error) {
+	r, size, err := b.blobs.GetBlobSidecarReader(slot, index)
+	if errors.Is(err, dastore.ErrSidecarNotFound) {
+		return nil, 0, errors.Join(ErrBlobsNotAvailable, err)
+	}
+	return r, size, err
+}
+
+// newBlock returns blk in the node API encoding, or err if it is not nil.
+func (b *StateBackend[
+	BeaconStateT, BeaconBlockT, BeaconBlockBodyT,
+]) newBlock(blk BeaconBlockT, err error) (*Block, error) {
+	if errors.Is(err, block.ErrNotFound) {
+		return nil, errors.Join(ErrBlockNotFound, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	root, err := blk.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	return &Block{
+		Version: blk.Version(),
+		Root:    root,
+		Data:    newSignedBeaconBlock[BeaconBlockT, BeaconBlockBodyT](blk),
+		Message: blk,
+	}, nil
+}
+
+// stateError marks the errors of values missing from the state as the state
+// not being available.
+func stateError(err error) error {
+	if errors.Is(err, collections.ErrNotFound) {
+		return errors.Join(ErrStateNotAvailable, err)
+	}
+	return err
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/labstack/echo/v4"
+)
+
+// genesisNotKnown is the message of the genesis requests made before
+// genesis.
+const genesisNotKnown = "Chain genesis info is not yet known"
+
+// Genesis is the genesis of the chain.
+type Genesis struct {
+	GenesisTime           decimal        `json:"genesis_time"`
+	GenesisValidatorsRoot common.Root    `json:"genesis_validators_root"`
+	GenesisForkVersion    common.Version `json:"genesis_fork_version"`
+}
+
+// GetGenesis serves GET /eth/v1/beacon/genesis.
+func (h *Handler) GetGenesis(c echo.Context) error {
+	st, err := h.backend.HeadState(c.Request().Context())
+	if err != nil {
+		return apiError(err, genesisNotKnown)
+	}
+	root, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return apiError(stateError(err), genesisNotKnown)
+	}
+	genesisTime, err := h.backend.GenesisTime()
+	if err != nil {
+		return apiError(err, genesisNotKnown)
+	}
+	return writeData(c, Genesis{
+		//#nosec:G115 // the genesis is after the epoch.
+		GenesisTime:           decimal(genesisTime.Unix()),
+		GenesisValidatorsRoot: root,
+		GenesisForkVersion: version.FromUint32[common.Version](
+			h.backend.ChainSpec().ActiveForkVersionForEpoch(0),
+		),
+	})
+}
@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/labstack/echo/v4"
+)
+
+// blobsNotFound is the message of the requests for the blob sidecars of a
+// block that are no longer stored.
+const blobsNotFound = "Blob sidecars not found, they may have been pruned"
+
+// BlobSidecar is a blob sidecar in the node API encoding. The inclusion
+// proof is the proof of the commitment in the body of the block on the
+// beacon chain of the node, which is shorter than on Ethereum.
+//
+//nolint:lll // struct tags.
+type BlobSidecar struct {
+	Index                       decimal                 `json:"index"`
+	Blob                        eip4844.Blob            `json:"blob"`
+	KZGCommitment               eip4844.KZGCommitment   `json:"kzg_commitment"`
+	KZGProof                    eip4844.KZGProof        `json:"kzg_proof"`
+	SignedBlockHeader           SignedBeaconBlockHeader `json:"signed_block_header"`
+	KZGCommitmentInclusionProof []bytes.B32             `json:"kzg_commitment_inclusion_proof"`
+}
+
+// SignedBeaconBlockHeader is a block header in the node API encoding. As
+// for the blocks, the signature of the header of a blob sidecar is always
+// zero.
+type SignedBeaconBlockHeader struct {
+	Message   BeaconBlockHeader   `json:"message"`
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// BeaconBlockHeader is the message of a signed block header.
+type BeaconBlockHeader struct {
+	Slot          decimal     `json:"slot"`
+	ProposerIndex decimal     `json:"proposer_index"`
+	ParentRoot    common.Root `json:"parent_root"`
+	StateRoot     common.Root `json:"state_root"`
+	BodyRoot      common.Root `json:"body_root"`
+}
+
+// parseBlobIndices parses the indices of the blob sidecars requested by r,
+// or returns nil if all are.
+func parseBlobIndices(
+	r *http.Request,
+	maxBlobsPerBlock uint64,
+) (map[uint64]struct{}, error) {
+	values := queryValues(r, "indices")
+	if len(values) == 0 {
+		return nil, nil
+	}
+	indices := make(map[uint64]struct{}, len(values))
+	for _, value := range values {
+		index, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, errors.Newf("invalid blob index: %s", value)
+		}
+		if index >= maxBlobsPerBlock {
+			return nil, errors.Newf(
+				"blob index %d is not below the maximum of %d blobs per block",
+				index, maxBlobsPerBlock,
+			)
+		}
+		indices[index] = struct{}{}
+	}
+	return indices, nil
+}
+
+// GetBlobSidecars serves GET /eth/v1/beacon/blob_sidecars/:block_id, as
+// JSON or SSZ. The sidecars of a block are only kept for the data
+// availability period, the request fails with 404 if they have been pruned
+// from the availability store.
+func (h *Handler) GetBlobSidecars(c echo.Context) error {
+	id, err := parseBlockID(c.Param("block_id"))
+	if err != nil {
+		return badRequest(err.Error())
+	}
+	blk, err := h.block(id)
+	if err != nil {
+		return apiError(err, blockNotFound)
+	}
+	// The indices are bounded by the maximum number of blobs at the fork of
+	// the block.
+	chainSpec := h.backend.ChainSpec()
+	indices, err := parseBlobIndices(
+		c.Request(),
+		chainSpec.MaxBlobsPerBlock(chainSpec.ActiveForkVersionForSlot(
+			math.Slot(blk.Data.Message.Slot),
+		)),
+	)
+	if err != nil {
+		return badRequest(err.Error())
+	}
+	if mediaType, ok := negotiate(c.Request()); ok &&
+		mediaType == mediaTypeSSZ {
+		return h.streamBlobSidecars(c, blk, indices)
+	}
+
+	sidecars, err := h.backend.BlobSidecars(
+		math.Slot(blk.Data.Message.Slot),
+	)
+	if err != nil {
+		return apiError(err, blobsNotFound)
+	}
+	if len(sidecars) < len(blk.Data.Message.Body.BlobKZGCommitments) {
+		return apiError(ErrBlobsNotAvailable, blobsNotFound)
+	}
+
+	selected := make([]*datypes.BlobSidecar, 0, len(sidecars))
+	for _, sidecar := range sidecars {
+		if _, ok := indices[sidecar.Index]; indices == nil || ok {
+			selected = append(selected, sidecar)
+		}
+	}
+
+	data := make([]BlobSidecar, 0, len(selected))
+	for _, sidecar := range selected {
+		data = append(data, newBlobSidecar(sidecar))
+	}
+	return writeVersioned(c, blk.Version, data, encodeList(selected))
+}
+
+// streamBlobSidecars writes the SSZ encoding of the blob sidecars of blk of
+// the given indices, or all if nil, streamed from the availability store.
+// As for the JSON encoding, every sidecar of the block must be stored.
+func (h *Handler) streamBlobSidecars(
+	c echo.Context,
+	blk *Block,
+	indices map[uint64]struct{},
+) error {
+	var (
+		slot    = math.Slot(blk.Data.Message.Slot)
+		count   = uint64(len(blk.Data.Message.Body.BlobKZGCommitments))
+		readers = make([]io.Reader, 0, count)
+		size    int64
+	)
+	closers := make([]io.Closer, 0, count)
+	defer func() {
+		for _, closer := range closers {
+			if err := closer.Close(); err != nil {
+				h.logger.Error("failed to close blob sidecar", "error", err)
+			}
+		}
+	}()
+
+	for index := range count {
+		reader, n, err := h.backend.BlobSidecarReader(slot, index)
+		if err != nil {
+			return apiError(err, blobsNotFound)
+		}
+		if _, ok := indices[index]; indices != nil && !ok {
+			if err = reader.Close(); err != nil {
+				return apiError(err, blobsNotFound)
+			}
+			continue
+		}
+		closers = append(closers, reader)
+		readers = append(readers, reader)
+		size += n
+	}
+	return h.writeSSZStream(c, blk.Version, size, readers)
+}
+
+// newBlobSidecar returns sidecar in the node API encoding.
+func newBlobSidecar(sidecar *datypes.BlobSidecar) BlobSidecar {
+	proof := make([]bytes.B32, 0, len(sidecar.InclusionProof))
+	for _, node := range sidecar.InclusionProof {
+		proof = append(proof, node)
+	}
+	res := BlobSidecar{
+		Index:                       decimal(sidecar.Index),
+		Blob:                        sidecar.Blob,
+		KZGCommitment:               sidecar.KzgCommitment,
+		KZGProof:                    sidecar.KzgProof,
+		KZGCommitmentInclusionProof: proof,
+	}
+	if header := sidecar.BeaconBlockHeader; header != nil {
+		res.SignedBlockHeader.Message = newBeaconBlockHeader(header)
+	}
+	return res
+}
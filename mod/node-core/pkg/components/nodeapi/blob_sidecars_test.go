@@ -0,0 +1,239 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"syscall"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// setBlobs stores the block at slot with a commitment for each of the given
+// sidecar indices, and the sidecars of the stored indices.
+func (n *testNode) setBlobs(
+	t *testing.T, slot uint64, commitments int, stored ...uint64,
+) {
+	t.Helper()
+	blk := newTestBlock(t, slot)
+	body := blk.RawBeaconBlock.(*types.BeaconBlockDeneb).Body
+	body.BlobKzgCommitments = make([]eip4844.KZGCommitment, commitments)
+	for i := range body.BlobKzgCommitments {
+		body.BlobKzgCommitments[i] = eip4844.KZGCommitment{byte(i)}
+	}
+	require.NoError(t, n.blocks.Set(blk))
+
+	sidecars := make([]*datypes.BlobSidecar, 0, len(stored))
+	for _, index := range stored {
+		sidecar := &datypes.BlobSidecar{
+			Index:             index,
+			BeaconBlockHeader: blk.GetHeader(),
+			InclusionProof:    make([][32]byte, 8),
+		}
+		sidecar.KzgCommitment[0] = byte(index)
+		sidecar.Blob[0] = byte(slot)
+		sidecar.InclusionProof[0][0] = byte(index)
+		sidecars = append(sidecars, sidecar)
+	}
+	n.blobs.set(math.Slot(slot), sidecars...)
+}
+
+// blobSidecar is a sidecar of the response to a blob sidecars request.
+type blobSidecar struct {
+	Index             string `json:"index"`
+	KZGCommitment     string `json:"kzg_commitment"`
+	SignedBlockHeader struct {
+		Message struct {
+			Slot string `json:"slot"`
+		} `json:"message"`
+	} `json:"signed_block_header"`
+	KZGCommitmentInclusionProof []string `json:"kzg_commitment_inclusion_proof"`
+}
+
+func TestGetBlobSidecars(t *testing.T) {
+	node := newTestNode(t)
+	node.setBlobs(t, 1, 3, 0, 1, 2)
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{name: "All", want: []string{"0", "1", "2"}},
+		{name: "CommaSeparated", query: "?indices=2,0", want: []string{"0", "2"}},
+		{name: "Repeated", query: "?indices=1&indices=2", want: []string{"1", "2"}},
+		{name: "NotInBlock", query: "?indices=5", want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp struct {
+				Version   string        `json:"version"`
+				Finalized bool          `json:"finalized"`
+				Data      []blobSidecar `json:"data"`
+			}
+			node.getJSON(t, "/eth/v1/beacon/blob_sidecars/1"+tt.query, &resp)
+			require.Equal(t, "deneb", resp.Version)
+			require.True(t, resp.Finalized)
+			indices := make([]string, 0, len(resp.Data))
+			for _, sidecar := range resp.Data {
+				indices = append(indices, sidecar.Index)
+				require.Equal(t, "1", sidecar.SignedBlockHeader.Message.Slot)
+				require.Len(t, sidecar.KZGCommitmentInclusionProof, 8)
+			}
+			require.Equal(t, tt.want, indices)
+		})
+	}
+
+	var sidecars []blobSidecar
+	node.getData(t, "/eth/v1/beacon/blob_sidecars/head?indices=1", &sidecars)
+	require.Len(t, sidecars, 1)
+	commitment, err := eip4844.KZGCommitment{0x01}.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, string(commitment), sidecars[0].KZGCommitment)
+}
+
+func TestGetBlobSidecars_Errors(t *testing.T) {
+	node := newTestNode(t)
+	// The sidecars of slot 1 have been pruned, the block at slot 2 has no
+	// blobs and slot 3 only holds some of its sidecars.
+	node.setBlobs(t, 1, 2)
+	node.setBlobs(t, 2, 0)
+	node.setBlobs(t, 3, 2, 1)
+
+	var sidecars []blobSidecar
+	node.getData(t, "/eth/v1/beacon/blob_sidecars/2", &sidecars)
+	require.Empty(t, sidecars)
+
+	tests := []struct {
+		path string
+		code int
+	}{
+		{path: "1", code: http.StatusNotFound},
+		{path: "3", code: http.StatusNotFound},
+		{path: "4", code: http.StatusNotFound},
+		{path: "genesis", code: http.StatusBadRequest},
+		{path: "2?indices=6", code: http.StatusBadRequest},
+		{path: "2?indices=0,x", code: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			node.requireError(
+				t, "/eth/v1/beacon/blob_sidecars/"+tt.path, tt.code,
+			)
+		})
+	}
+}
+
+func TestGetBlobSidecars_SSZ(t *testing.T) {
+	node := newTestNode(t)
+	node.setBlobs(t, 1, 3, 0, 1, 2)
+
+	resp, body := node.getAccept(
+		t,
+		"/eth/v1/beacon/blob_sidecars/1?indices=0,2",
+		"application/octet-stream;q=1, application/json",
+	)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	require.Equal(t,
+		"application/octet-stream", resp.Header.Get("Content-Type"),
+	)
+	require.Equal(t, "deneb", resp.Header.Get("Eth-Consensus-Version"))
+
+	stored, err := node.blobs.GetBlobSidecars(1)
+	require.NoError(t, err)
+	size := stored.Sidecars[0].SizeSSZ()
+	require.Len(t, body, 2*size)
+	require.Equal(t, strconv.Itoa(2*size), resp.Header.Get("Content-Length"))
+	var want []byte
+	for i, index := range []int{0, 2} {
+		sidecar := new(datypes.BlobSidecar)
+		require.NoError(t, sidecar.UnmarshalSSZ(body[i*size:(i+1)*size]))
+		require.Equal(t, stored.Sidecars[index], sidecar)
+		want, err = stored.Sidecars[index].MarshalSSZTo(want)
+		require.NoError(t, err)
+	}
+	// The streamed sidecars are the sidecars read in memory.
+	require.Equal(t, want, body)
+	require.Zero(t, node.blobs.open.Load())
+
+	// As in JSON, every sidecar of the block must be stored.
+	node.setBlobs(t, 3, 2, 1)
+	resp, body = node.getAccept(
+		t,
+		"/eth/v1/beacon/blob_sidecars/3?indices=1",
+		"application/octet-stream",
+	)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode, string(body))
+	require.Zero(t, node.blobs.open.Load())
+}
+
+// goneWriter is a response writer whose client is gone once it has written
+// limit bytes.
+type goneWriter struct {
+	header  http.Header
+	written int
+	limit   int
+}
+
+func (w *goneWriter) Header() http.Header { return w.header }
+
+func (w *goneWriter) WriteHeader(int) {}
+
+func (w *goneWriter) Write(p []byte) (int, error) {
+	n := min(len(p), w.limit-w.written)
+	w.written += n
+	if n < len(p) {
+		return n, syscall.EPIPE
+	}
+	return n, nil
+}
+
+func TestGetBlobSidecars_SSZ_ClientGone(t *testing.T) {
+	node := newTestNode(t)
+	node.setBlobs(t, 1, 3, 0, 1, 2)
+
+	req := httptest.NewRequest(
+		http.MethodGet, "/eth/v1/beacon/blob_sidecars/1", nil,
+	)
+	req.Header.Set("Accept", "application/octet-stream")
+
+	// The client disconnects in the middle of the first sidecar.
+	w := &goneWriter{header: make(http.Header), limit: 1024}
+	node.handler.ServeHTTP(w, req)
+	require.Equal(t, 1024, w.written)
+	require.Zero(t, node.blobs.open.Load())
+
+	// The client is gone before the response is written.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	w = &goneWriter{header: make(http.Header), limit: 1 << 20}
+	node.handler.ServeHTTP(w, req.WithContext(ctx))
+	require.Zero(t, w.written)
+	require.Zero(t, node.blobs.open.Load())
+}
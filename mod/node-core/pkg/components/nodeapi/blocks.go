@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/labstack/echo/v4"
+)
+
+// blockNotFound is the message of the requests for unknown blocks.
+const blockNotFound = "Block not found"
+
+// blockID identifies a block by slot or by root, or the head if neither is
+// set.
+type blockID struct {
+	slot *math.Slot
+	root *common.Root
+}
+
+// parseBlockID parses a block ID of the node API. Blocks are final once
+// committed, so the finalized block is the head. The genesis has no block,
+// the first block is at slot 1.
+func parseBlockID(id string) (blockID, error) {
+	switch id {
+	case "head", "finalized":
+		return blockID{}, nil
+	case "genesis":
+		return blockID{}, errors.New("the genesis has no block")
+	}
+	if strings.HasPrefix(id, "0x") {
+		var root common.Root
+		if err := root.UnmarshalText([]byte(id)); err != nil {
+			return blockID{}, errors.Newf("invalid block root: %s", id)
+		}
+		return blockID{root: &root}, nil
+	}
+	slot, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return blockID{}, errors.Newf("invalid block ID: %s", id)
+	}
+	if slot == 0 {
+		return blockID{}, errors.New(
+			"slot 0 is the genesis, which has no block",
+		)
+	}
+	return blockID{slot: (*math.Slot)(&slot)}, nil
+}
+
+// GetBlock serves GET /eth/v2/beacon/blocks/:block_id, as JSON or SSZ.
+func (h *Handler) GetBlock(c echo.Context) error {
+	id, err := parseBlockID(c.Param("block_id"))
+	if err != nil {
+		return badRequest(err.Error())
+	}
+	blk, err := h.block(id)
+	if err != nil {
+		return apiError(err, blockNotFound)
+	}
+	return writeVersioned(
+		c, blk.Version, blk.Data, encodeSigned(blk.Message),
+	)
+}
+
+// block returns the block identified by id.
+func (h *Handler) block(id blockID) (*Block, error) {
+	switch {
+	case id.root != nil:
+		return h.backend.BlockByRoot(*id.root)
+	case id.slot != nil:
+		return h.backend.BlockAtSlot(*id.slot)
+	default:
+		return h.backend.HeadBlock()
+	}
+}
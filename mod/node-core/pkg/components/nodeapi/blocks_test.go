@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi_test
+
+import (
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestSignedHeader returns a header signed by the validator of index 2
+// at slot 1.
+func newTestSignedHeader(bodyRoot common.Root) *types.SignedBeaconBlockHeader {
+	header := &types.BeaconBlockHeader{BodyRoot: bodyRoot}
+	header.Slot, header.ProposerIndex = 1, 2
+	return &types.SignedBeaconBlockHeader{
+		Header:    header,
+		Signature: crypto.BLSSignature{0x0a},
+	}
+}
+
+// newTestBlock returns a deneb block at slot with a payload holding a
+// transaction and a withdrawal.
+func newTestBlock(t *testing.T, slot uint64) *types.BeaconBlock {
+	t.Helper()
+	return &types.BeaconBlock{
+		RawBeaconBlock: &types.BeaconBlockDeneb{
+			BeaconBlockHeaderBase: types.BeaconBlockHeaderBase{
+				Slot:            slot,
+				ProposerIndex:   3,
+				ParentBlockRoot: common.Root{0xaa},
+				StateRoot:       common.Root{0xbb},
+			},
+			Body: &types.BeaconBlockBodyDeneb{
+				BeaconBlockBodyBase: types.BeaconBlockBodyBase{
+					Eth1Data: &types.Eth1Data{DepositCount: 2},
+					Deposits: []*types.Deposit{{Amount: 32, Index: 1}},
+				},
+				ExecutionPayload: &types.ExecutableDataDeneb{
+					LogsBloom: make([]byte, 256),
+					Number:    math.U64(slot),
+					Timestamp: 1717200000,
+					BaseFeePerGas: math.MustNewU256LFromBigInt(
+						big.NewInt(7),
+					),
+					Transactions: [][]byte{{0x01, 0x02}},
+					Withdrawals: []*engineprimitives.Withdrawal{
+						{Index: 4, Validator: 5, Amount: 6},
+					},
+				},
+				BlobKzgCommitments: []eip4844.KZGCommitment{{0x0c}},
+			},
+		},
+	}
+}
+
+// newTestElectraBlock returns the block of newTestBlock in Electra, which
+// includes a proposer slashing.
+func newTestElectraBlock(t *testing.T, slot uint64) *types.BeaconBlock {
+	t.Helper()
+	deneb, ok := newTestBlock(t, slot).RawBeaconBlock.(*types.BeaconBlockDeneb)
+	require.True(t, ok)
+	return &types.BeaconBlock{
+		RawBeaconBlock: &types.BeaconBlockElectra{
+			BeaconBlockHeaderBase: deneb.BeaconBlockHeaderBase,
+			Body: &types.BeaconBlockBodyElectra{
+				BeaconBlockBodyBase: deneb.Body.BeaconBlockBodyBase,
+				ProposerSlashings: []*types.ProposerSlashing{{
+					SignedHeader1: newTestSignedHeader(common.Root{0x01}),
+					SignedHeader2: newTestSignedHeader(common.Root{0x02}),
+				}},
+				ExecutionPayload:   deneb.Body.ExecutionPayload,
+				BlobKzgCommitments: deneb.Body.BlobKzgCommitments,
+			},
+		},
+	}
+}
+
+// versionedBlock is the response to a block request.
+type versionedBlock struct {
+	Version             string `json:"version"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+	Finalized           bool   `json:"finalized"`
+	Data                struct {
+		Message struct {
+			Slot string `json:"slot"`
+			Body struct {
+				ExecutionPayload map[string]any `json:"execution_payload"`
+			} `json:"body"`
+		} `json:"message"`
+	} `json:"data"`
+}
+
+func TestGetBlock(t *testing.T) {
+	node := newTestNode(t)
+	for _, slot := range []uint64{1, 2, 3} {
+		require.NoError(t, node.blocks.Set(newTestBlock(t, slot)))
+	}
+	root, err := newTestBlock(t, 2).HashTreeRoot()
+	require.NoError(t, err)
+
+	tests := []struct {
+		id       string
+		wantSlot string
+	}{
+		{id: "head", wantSlot: "3"},
+		{id: "finalized", wantSlot: "3"},
+		{id: "1", wantSlot: "1"},
+		{id: common.Root(root).String(), wantSlot: "2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			var blk versionedBlock
+			node.getJSON(t, "/eth/v2/beacon/blocks/"+tt.id, &blk)
+			require.Equal(t, "deneb", blk.Version)
+			require.False(t, blk.ExecutionOptimistic)
+			require.True(t, blk.Finalized)
+			require.Equal(t, tt.wantSlot, blk.Data.Message.Slot)
+		})
+	}
+}
+
+func TestGetBlock_Encoding(t *testing.T) {
+	node := newTestNode(t)
+	require.NoError(t, node.blocks.Set(newTestElectraBlock(t, 1)))
+
+	var blk map[string]any
+	node.getJSON(t, "/eth/v2/beacon/blocks/1", &blk)
+	require.Equal(t, "electra", blk["version"])
+	msg := blk["data"].(map[string]any)["message"].(map[string]any)
+	require.Equal(t, "3", msg["proposer_index"])
+	require.Equal(t, common.Root{0xaa}.String(), msg["parent_root"])
+
+	body := msg["body"].(map[string]any)
+	require.Equal(t, "2", body["eth1_data"].(map[string]any)["deposit_count"])
+	require.Equal(t, "32", body["deposits"].([]any)[0].(map[string]any)["amount"])
+	slashing := body["proposer_slashings"].([]any)[0].(map[string]any)
+	header := slashing["signed_header_2"].(map[string]any)
+	require.Equal(t, "2", header["message"].(map[string]any)["proposer_index"])
+	require.Equal(
+		t, common.Root{0x02}.String(),
+		header["message"].(map[string]any)["body_root"],
+	)
+	require.Len(t, body["blob_kzg_commitments"], 1)
+
+	payload := body["execution_payload"].(map[string]any)
+	require.Equal(t, "1", payload["block_number"])
+	require.Equal(t, "1717200000", payload["timestamp"])
+	require.Equal(t, "7", payload["base_fee_per_gas"])
+	require.Equal(t, []any{"0x0102"}, payload["transactions"])
+	require.Equal(t, []any{map[string]any{
+		"index":           "4",
+		"validator_index": "5",
+		"address":         "0x0000000000000000000000000000000000000000",
+		"amount":          "6",
+	}}, payload["withdrawals"])
+}
+
+func TestGetBlock_Errors(t *testing.T) {
+	node := newTestNode(t)
+
+	// No block is stored yet.
+	node.requireError(t, "/eth/v2/beacon/blocks/head", http.StatusNotFound)
+
+	require.NoError(t, node.blocks.Set(newTestBlock(t, 1)))
+	tests := []struct {
+		id   string
+		code int
+	}{
+		{id: "2", code: http.StatusNotFound},
+		{id: common.Root{0x01}.String(), code: http.StatusNotFound},
+		{id: "0", code: http.StatusBadRequest},
+		{id: "genesis", code: http.StatusBadRequest},
+		{id: "0x01", code: http.StatusBadRequest},
+		{id: "latest", code: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			node.requireError(t, "/eth/v2/beacon/blocks/"+tt.id, tt.code)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi
+
+const defaultListenAddress = "127.0.0.1:3500"
+
+// Config is the configuration of the node API server.
+type Config struct {
+	// Enabled starts the node API server.
+	Enabled bool `mapstructure:"enabled"`
+	// ListenAddress is the address the node API server listens on.
+	ListenAddress string `mapstructure:"listen-address"`
+	// AdminListenAddress is the address the admin routes of the node API,
+	// e.g. /admin/v1/prune/:pruner, are served on. They are not served if
+	// it is empty. The admin routes are not authenticated, so the address
+	// must only be reachable by the operators of the node.
+	AdminListenAddress string `mapstructure:"admin-listen-address"`
+}
+
+// DefaultConfig returns the default configuration of the node API server.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:       false,
+		ListenAddress: defaultListenAddress,
+	}
+}
@@ -0,0 +1,193 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi
+
+import (
+	"encoding/binary"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/labstack/echo/v4"
+)
+
+// Media types of the node API.
+const (
+	mediaTypeJSON = "application/json"
+	mediaTypeSSZ  = "application/octet-stream"
+)
+
+// SSZMarshaler is an object with an SSZ encoding.
+type SSZMarshaler interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+// sszEncoder returns the SSZ encoding of a response.
+type sszEncoder func() ([]byte, error)
+
+// negotiate returns the media type of the response to r, JSON or SSZ, as
+// preferred by its Accept header. The media type with the highest quality
+// is chosen, the first listed on ties, and JSON if the header is missing.
+// It returns false if r accepts neither.
+func negotiate(r *http.Request) (string, bool) {
+	accepts := r.Header.Values("Accept")
+	if len(accepts) == 0 {
+		return mediaTypeJSON, true
+	}
+	var (
+		best    string
+		quality float64
+	)
+	for _, accept := range accepts {
+		for _, mediaRange := range strings.Split(accept, ",") {
+			mediaType, params, _ := strings.Cut(mediaRange, ";")
+			var candidate string
+			switch strings.ToLower(strings.TrimSpace(mediaType)) {
+			case mediaTypeJSON, "application/*", "*/*":
+				candidate = mediaTypeJSON
+			case mediaTypeSSZ:
+				candidate = mediaTypeSSZ
+			default:
+				continue
+			}
+			if q := parseQuality(params); q > quality {
+				best, quality = candidate, q
+			}
+		}
+	}
+	return best, best != ""
+}
+
+// parseQuality returns the quality of the parameters of a media range, 1
+// if it is not set and 0 if it is invalid.
+func parseQuality(params string) float64 {
+	for _, param := range strings.Split(params, ";") {
+		key, value, _ := strings.Cut(param, "=")
+		if strings.TrimSpace(key) != "q" {
+			continue
+		}
+		q, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || q < 0 || q > 1 {
+			return 0
+		}
+		return q
+	}
+	return 1
+}
+
+// writeVersioned writes data of the fork version v in the media type
+// negotiated with the request, encoded by encodeSSZ if it is SSZ. Blocks are
+// final once committed, the data is always finalized.
+func writeVersioned(
+	c echo.Context,
+	v uint32,
+	data any,
+	encodeSSZ sszEncoder,
+) error {
+	mediaType, ok := negotiate(c.Request())
+	if !ok {
+		return echo.NewHTTPError(
+			http.StatusNotAcceptable,
+			"Accepted media types are not supported",
+		)
+	}
+	if mediaType == mediaTypeJSON {
+		c.Response().Header().Set("Eth-Consensus-Version", versionName(v))
+		return c.JSON(http.StatusOK, versionedResponse{
+			Version:   versionName(v),
+			Finalized: true,
+			Data:      data,
+		})
+	}
+
+	bz, err := encodeSSZ()
+	if err != nil {
+		return err
+	}
+	c.Response().Header().Set("Eth-Consensus-Version", versionName(v))
+	return c.Blob(http.StatusOK, mediaTypeSSZ, bz)
+}
+
+// writeSSZStream writes the SSZ encoding of data of the fork version v, of
+// the given length, copied from readers one after the other as they are
+// read, so the data is never held whole in memory. The copy stops as soon
+// as the client is gone.
+func (h *Handler) writeSSZStream(
+	c echo.Context,
+	v uint32,
+	size int64,
+	readers []io.Reader,
+) error {
+	w := c.Response()
+	w.Header().Set("Eth-Consensus-Version", versionName(v))
+	w.Header().Set("Content-Type", mediaTypeSSZ)
+	w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+	w.WriteHeader(http.StatusOK)
+	for _, reader := range readers {
+		if err := c.Request().Context().Err(); err != nil {
+			h.logger.Debug("node API client is gone", "error", err)
+			return nil
+		}
+		if _, err := io.Copy(w, reader); err != nil {
+			h.logger.Error("failed to write node API response", "error", err)
+			return nil
+		}
+	}
+	return nil
+}
+
+// encodeSigned returns an encoder of the SSZ encoding of a signed container
+// of msg. As in the JSON encoding, the signature is zero.
+func encodeSigned(msg SSZMarshaler) sszEncoder {
+	return func() ([]byte, error) {
+		bz, err := msg.MarshalSSZ()
+		if err != nil {
+			return nil, err
+		}
+		// The message is variable-size, the container starts with its
+		// offset followed by the signature.
+		const offset = 4 + len(crypto.BLSSignature{})
+		res := make([]byte, offset, offset+len(bz))
+		binary.LittleEndian.PutUint32(res, uint32(offset))
+		return append(res, bz...), nil
+	}
+}
+
+// encodeList returns an encoder of the SSZ encoding of a list of the
+// fixed-size items, which is their concatenation.
+func encodeList[T interface {
+	MarshalSSZTo(dst []byte) ([]byte, error)
+}](items []T) sszEncoder {
+	return func() ([]byte, error) {
+		var (
+			bz  []byte
+			err error
+		)
+		for _, item := range items {
+			if bz, err = item.MarshalSSZTo(bz); err != nil {
+				return nil, err
+			}
+		}
+		return bz, nil
+	}
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi_test
+
+import (
+	"encoding/binary"
+	"net/http"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentNegotiation(t *testing.T) {
+	node := newTestNode(t)
+	require.NoError(t, node.blocks.Set(newTestBlock(t, 1)))
+
+	const (
+		json = "application/json"
+		ssz  = "application/octet-stream"
+	)
+	tests := []struct {
+		accept string
+		want   string
+	}{
+		{accept: "", want: json},
+		{accept: json, want: json},
+		{accept: ssz, want: ssz},
+		{accept: "*/*", want: json},
+		{accept: "application/json;q=0.9, application/octet-stream", want: ssz},
+		{accept: "application/octet-stream;q=0.5, application/json", want: json},
+		{accept: "application/octet-stream, */*", want: ssz},
+		{accept: "text/html, application/*;q=0.1", want: json},
+		{accept: "text/html"},
+		{accept: "text/html, application/octet-stream;q=0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.accept, func(t *testing.T) {
+			resp, body := node.getAccept(t, "/eth/v2/beacon/blocks/1", tt.accept)
+			if tt.want == "" {
+				require.Equal(t,
+					http.StatusNotAcceptable, resp.StatusCode, string(body),
+				)
+				return
+			}
+			require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+			require.Equal(t, tt.want, resp.Header.Get("Content-Type"))
+			require.Equal(t, "deneb", resp.Header.Get("Eth-Consensus-Version"))
+		})
+	}
+}
+
+func TestGetBlock_SSZ(t *testing.T) {
+	node := newTestNode(t)
+	require.NoError(t, node.blocks.Set(newTestBlock(t, 1)))
+	const path = "/eth/v2/beacon/blocks/1"
+
+	resp, body := node.getAccept(t, path, "application/octet-stream")
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+
+	// The block is a signed container of the message, with a zero
+	// signature.
+	require.Greater(t, len(body), 100)
+	require.Equal(t, uint32(100), binary.LittleEndian.Uint32(body))
+	require.Equal(t, make([]byte, 96), body[4:100])
+	blk, err := new(types.BeaconBlock).NewFromSSZ(body[100:], version.Deneb)
+	require.NoError(t, err)
+
+	// The decoded block is served as the same JSON as the original one.
+	decoded := newTestNode(t)
+	require.NoError(t, decoded.blocks.Set(blk))
+	status, want := node.get(t, path)
+	require.Equal(t, http.StatusOK, status)
+	status, got := decoded.get(t, path)
+	require.Equal(t, http.StatusOK, status)
+	require.JSONEq(t, string(want), string(got))
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/labstack/echo/v4"
+)
+
+// ProposerDuty is the duty of a validator to propose the block of a slot.
+type ProposerDuty struct {
+	Pubkey         crypto.BLSPubkey `json:"pubkey"`
+	ValidatorIndex decimal          `json:"validator_index"`
+	Slot           decimal          `json:"slot"`
+}
+
+// proposerDutiesResponse is the response of a proposer duties request.
+// There are no reorgs on the chain of the node, the duties never depend on
+// a block and the dependent root is always zero.
+type proposerDutiesResponse struct {
+	DependentRoot       common.Root    `json:"dependent_root"`
+	ExecutionOptimistic bool           `json:"execution_optimistic"`
+	Data                []ProposerDuty `json:"data"`
+}
+
+// GetProposerDuties serves GET /eth/v1/validator/duties/proposer/:epoch.
+// The duties of the current and next epochs are computed from the head
+// state, those of past epochs from the state of their first block.
+func (h *Handler) GetProposerDuties(c echo.Context) error {
+	e, err := strconv.ParseUint(c.Param("epoch"), 10, 64)
+	if err != nil {
+		return badRequest("invalid epoch: " + c.Param("epoch"))
+	}
+	epoch := math.Epoch(e)
+
+	st, err := h.backend.HeadState(c.Request().Context())
+	if err != nil {
+		return apiError(notReady(err), "")
+	}
+	slot, err := st.GetSlot()
+	if err != nil {
+		return apiError(notReady(stateError(err)), "")
+	}
+	chainSpec := h.backend.ChainSpec()
+	current := chainSpec.SlotToEpoch(slot)
+	if epoch > current+1 {
+		return badRequest("epoch is more than one ahead of the current")
+	}
+	if epoch < current {
+		// The genesis has no state of its own, it is part of slot 1.
+		first := max(math.Slot(uint64(epoch)*chainSpec.SlotsPerEpoch()), 1)
+		if st, err = h.backend.StateAtSlot(
+			c.Request().Context(), first,
+		); err != nil {
+			return apiError(err, stateNotFound)
+		}
+	}
+
+	proposers, err := core.GetBeaconProposerIndices[*types.Validator](
+		st, chainSpec, epoch,
+	)
+	if err != nil {
+		return apiError(stateError(err), stateNotFound)
+	}
+	duties := make([]ProposerDuty, 0, len(proposers))
+	for i, index := range proposers {
+		dutySlot := uint64(epoch)*chainSpec.SlotsPerEpoch() + uint64(i)
+		// The genesis slot has no block to propose.
+		if dutySlot == 0 {
+			continue
+		}
+		val, err := st.ValidatorByIndex(index)
+		if err != nil {
+			return apiError(stateError(err), stateNotFound)
+		}
+		duties = append(duties, ProposerDuty{
+			Pubkey:         val.Pubkey,
+			ValidatorIndex: decimal(index),
+			Slot:           decimal(dutySlot),
+		})
+	}
+	return c.JSON(http.StatusOK, proposerDutiesResponse{Data: duties})
+}
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi_test
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/stretchr/testify/require"
+)
+
+// setProposers sets a state at slot 325 of epoch 10 with validators of
+// different effective balances, and fixed randao mixes. The validator at
+// index 3 is not active.
+func (n *testNode) setProposers(t *testing.T) {
+	t.Helper()
+	n.setHead(t, 10*32+5, time.Now())
+	for i := range uint64(8) {
+		require.NoError(t, n.kv.UpdateRandaoMixAtIndex(
+			i, bytes.B32{byte(i + 1)},
+		))
+	}
+	for i := range 6 {
+		val := &types.Validator{
+			Pubkey:            testPubkey(i),
+			EffectiveBalance:  32e9 / (1 << i),
+			ExitEpoch:         farFuture,
+			WithdrawableEpoch: farFuture,
+		}
+		if i == 3 {
+			val.ActivationEpoch = farFuture
+		}
+		require.NoError(t, n.kv.AddValidator(val))
+	}
+}
+
+// proposerDuties is the response to a proposer duties request.
+type proposerDuties struct {
+	DependentRoot string `json:"dependent_root"`
+	Data          []struct {
+		Pubkey         string `json:"pubkey"`
+		ValidatorIndex string `json:"validator_index"`
+		Slot           string `json:"slot"`
+	} `json:"data"`
+}
+
+func TestGetProposerDuties(t *testing.T) {
+	node := newTestNode(t)
+	node.setProposers(t)
+	node.ready()
+
+	tests := []struct {
+		epoch uint64
+		want  []string
+	}{
+		{
+			epoch: 10,
+			want: []string{
+				"1", "0", "0", "2", "0", "0", "1", "0",
+				"2", "0", "0", "0", "0", "0", "1", "2",
+				"0", "1", "0", "0", "0", "1", "2", "0",
+				"0", "0", "1", "0", "0", "0", "0", "0",
+			},
+		},
+		{
+			epoch: 11,
+			want: []string{
+				"0", "0", "0", "2", "0", "1", "0", "0",
+				"0", "1", "0", "0", "0", "1", "4", "0",
+				"2", "1", "2", "0", "0", "2", "0", "0",
+				"4", "1", "0", "2", "4", "0", "2", "1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(strconv.FormatUint(tt.epoch, 10), func(t *testing.T) {
+			var duties proposerDuties
+			node.getJSON(
+				t,
+				"/eth/v1/validator/duties/proposer/"+
+					strconv.FormatUint(tt.epoch, 10),
+				&duties,
+			)
+			require.Equal(t, common.Root{}.String(), duties.DependentRoot)
+			require.Len(t, duties.Data, len(tt.want))
+			for i, duty := range duties.Data {
+				require.Equal(t, tt.want[i], duty.ValidatorIndex)
+				require.Equal(
+					t, strconv.FormatUint(tt.epoch*32+uint64(i), 10), duty.Slot,
+				)
+				index, err := strconv.Atoi(duty.ValidatorIndex)
+				require.NoError(t, err)
+				require.Equal(t, testPubkey(index).String(), duty.Pubkey)
+			}
+		})
+	}
+}
+
+func TestGetProposerDuties_Errors(t *testing.T) {
+	node := newTestNode(t)
+	node.setProposers(t)
+
+	node.requireError(
+		t, "/eth/v1/validator/duties/proposer/10",
+		http.StatusServiceUnavailable,
+	)
+
+	node.ready()
+	node.requireError(
+		t, "/eth/v1/validator/duties/proposer/12", http.StatusBadRequest,
+	)
+	node.requireError(
+		t, "/eth/v1/validator/duties/proposer/ten", http.StatusBadRequest,
+	)
+}
@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrNotReady is returned when the state is read before the application
+	// is set on the query contexts.
+	ErrNotReady = errors.New("node is not ready")
+	// ErrStateNotAvailable is returned when the requested state has not been
+	// committed yet or has been pruned.
+	ErrStateNotAvailable = errors.New("state not available")
+	// ErrBlockNotFound is returned when the requested block is not in the
+	// block store.
+	ErrBlockNotFound = errors.New("block not found")
+	// ErrBlobsNotAvailable is returned when the blob sidecars of a block are
+	// not in the availability store.
+	ErrBlobsNotAvailable = errors.New("blob sidecars not available")
+)
@@ -0,0 +1,314 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/labstack/echo/v4"
+)
+
+// Topics of the events of the node API.
+const (
+	TopicHead                = "head"
+	TopicBlock               = "block"
+	TopicFinalizedCheckpoint = "finalized_checkpoint"
+)
+
+const (
+	// eventBufferSize is the number of events buffered for an event
+	// stream. The events published to a stream whose buffer is full are
+	// dropped.
+	eventBufferSize = 64
+	// heartbeatInterval is the interval at which a comment is sent on idle
+	// event streams, to keep the connections open through proxies.
+	heartbeatInterval = 10 * time.Second
+)
+
+//nolint:gochecknoglobals // lookup table.
+var eventTopics = map[string]bool{
+	TopicHead:                true,
+	TopicBlock:               true,
+	TopicFinalizedCheckpoint: true,
+}
+
+// Event is an event published on a topic.
+type Event struct {
+	Topic string
+	Data  any
+}
+
+// HeadEvent is the event of a new head. There are no reorgs on the chain of
+// the node, the duty dependent roots are always zero.
+type HeadEvent struct {
+	Slot                      decimal     `json:"slot"`
+	Block                     common.Root `json:"block"`
+	State                     common.Root `json:"state"`
+	EpochTransition           bool        `json:"epoch_transition"`
+	PreviousDutyDependentRoot common.Root `json:"previous_duty_dependent_root"`
+	CurrentDutyDependentRoot  common.Root `json:"current_duty_dependent_root"`
+	ExecutionOptimistic       bool        `json:"execution_optimistic"`
+}
+
+// BlockEvent is the event of a new block.
+type BlockEvent struct {
+	Slot                decimal     `json:"slot"`
+	Block               common.Root `json:"block"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+}
+
+// FinalizedCheckpointEvent is the event of a new finalized checkpoint.
+type FinalizedCheckpointEvent struct {
+	Block               common.Root `json:"block"`
+	State               common.Root `json:"state"`
+	Epoch               decimal     `json:"epoch"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+}
+
+// BlockFeed is the feed of the blocks of the node.
+type BlockFeed[BeaconBlockT any] interface {
+	Subscribe(ch chan<- *feed.Event[BeaconBlockT]) event.Subscription
+}
+
+// Events fans the events of the node out to the event streams. Publishing
+// never blocks, the events are dropped for the streams that lag behind.
+type Events struct {
+	// source publishes the events of the node until ctx is done.
+	source func(ctx context.Context, publish func(Event))
+
+	mu      sync.RWMutex
+	streams map[*eventStream]struct{}
+}
+
+// eventStream is the subscription of an event stream to its topics.
+type eventStream struct {
+	topics map[string]bool
+	ch     chan Event
+	// dropped is the number of events dropped since the last event read.
+	dropped atomic.Uint64
+}
+
+// NewEvents creates events without a source, only published to through
+// Publish.
+func NewEvents() *Events {
+	return &Events{streams: make(map[*eventStream]struct{})}
+}
+
+// NewBlockEvents creates the events of the finalized blocks of blockFeed.
+// Blocks are final once committed, each block is the new head and the new
+// finalized checkpoint.
+func NewBlockEvents[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody,
+](
+	chainSpec primitives.ChainSpec,
+	blockFeed BlockFeed[BeaconBlockT],
+	logger log.Logger[any],
+) *Events {
+	e := NewEvents()
+	e.source = func(ctx context.Context, publish func(Event)) {
+		ch := make(chan *feed.Event[BeaconBlockT])
+		sub := blockFeed.Subscribe(ch)
+		go func() {
+			defer sub.Unsubscribe()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case ev := <-ch:
+					if !ev.Is(events.BeaconBlockFinalized) {
+						continue
+					}
+					if err := publishBlock(
+						chainSpec, ev.Data(), publish,
+					); err != nil {
+						logger.Error(
+							"failed to publish block events", "error", err,
+						)
+					}
+				}
+			}
+		}()
+	}
+	return e
+}
+
+// publishBlock publishes the events of the finalized block blk.
+func publishBlock[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody,
+](
+	chainSpec primitives.ChainSpec,
+	blk BeaconBlockT,
+	publish func(Event),
+) error {
+	root, err := blk.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	slot := blk.GetSlot()
+	publish(Event{Topic: TopicBlock, Data: BlockEvent{
+		Slot:  decimal(slot),
+		Block: root,
+	}})
+	publish(Event{Topic: TopicHead, Data: HeadEvent{
+		Slot:            decimal(slot),
+		Block:           root,
+		State:           blk.GetStateRoot(),
+		EpochTransition: uint64(slot)%chainSpec.SlotsPerEpoch() == 0,
+	}})
+	publish(Event{Topic: TopicFinalizedCheckpoint, Data: FinalizedCheckpointEvent{
+		Block: root,
+		State: blk.GetStateRoot(),
+		Epoch: decimal(chainSpec.SlotToEpoch(slot)),
+	}})
+	return nil
+}
+
+// Start starts publishing the events of the source until ctx is done.
+func (e *Events) Start(ctx context.Context) {
+	if e.source != nil {
+		e.source(ctx, e.Publish)
+	}
+}
+
+// Publish publishes ev to the streams subscribed to its topic. The event is
+// dropped for the streams whose buffer is full.
+func (e *Events) Publish(ev Event) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	for stream := range e.streams {
+		if !stream.topics[ev.Topic] {
+			continue
+		}
+		select {
+		case stream.ch <- ev:
+		default:
+			stream.dropped.Add(1)
+		}
+	}
+}
+
+// subscribe subscribes a new stream to topics.
+func (e *Events) subscribe(topics map[string]bool) *eventStream {
+	stream := &eventStream{
+		topics: topics,
+		ch:     make(chan Event, eventBufferSize),
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.streams[stream] = struct{}{}
+	return stream
+}
+
+// unsubscribe unsubscribes stream.
+func (e *Events) unsubscribe(stream *eventStream) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.streams, stream)
+}
+
+// Streams returns the number of open event streams.
+func (e *Events) Streams() int {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return len(e.streams)
+}
+
+// GetEvents serves GET /eth/v1/events, streaming the events of the
+// requested topics as server-sent events until the client disconnects. The
+// number of events dropped because the client lagged behind is sent as a
+// comment before the next event.
+func (h *Handler) GetEvents(c echo.Context) error {
+	topics := make(map[string]bool)
+	for _, topic := range queryValues(c.Request(), "topics") {
+		if !eventTopics[topic] {
+			return badRequest("invalid topic: " + topic)
+		}
+		topics[topic] = true
+	}
+	if len(topics) == 0 {
+		return badRequest("no topics requested")
+	}
+	w := c.Response()
+	flusher, ok := w.Writer.(http.Flusher)
+	if !ok {
+		return errors.New("streaming not supported")
+	}
+
+	stream := h.events.subscribe(topics)
+	defer h.events.unsubscribe(stream)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		var err error
+		select {
+		case <-c.Request().Context().Done():
+			return nil
+		case <-heartbeat.C:
+			_, err = fmt.Fprint(w, ":\n\n")
+		case ev := <-stream.ch:
+			if dropped := stream.dropped.Swap(0); dropped > 0 {
+				if _, err = fmt.Fprintf(
+					w, ": lagged, %d events dropped\n\n", dropped,
+				); err != nil {
+					return nil
+				}
+			}
+			err = writeEvent(w, ev)
+			heartbeat.Reset(heartbeatInterval)
+		}
+		if err != nil {
+			return nil
+		}
+		flusher.Flush()
+	}
+}
+
+// writeEvent writes ev as a server-sent event.
+func writeEvent(w http.ResponseWriter, ev Event) error {
+	data, err := json.Marshal(ev.Data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", ev.Topic, data)
+	return err
+}
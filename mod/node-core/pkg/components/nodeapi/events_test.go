@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/nodeapi"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	"github.com/stretchr/testify/require"
+)
+
+// sseEvent is an event read from an event stream, with the comments sent
+// before it.
+type sseEvent struct {
+	topic    string
+	data     string
+	comments []string
+}
+
+// eventStream is an open event stream.
+type eventStream struct {
+	resp   *http.Response
+	reader *bufio.Reader
+}
+
+// openEvents opens an event stream of topics, waiting until it is
+// subscribed.
+func (n *testNode) openEvents(t *testing.T, topics string) *eventStream {
+	t.Helper()
+	streams := n.events.Streams()
+	//#nosec:G107 // test server.
+	resp, err := http.Get(n.base + "/eth/v1/events?topics=" + topics)
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	require.Eventually(t, func() bool {
+		return n.events.Streams() == streams+1
+	}, time.Second, time.Millisecond)
+	return &eventStream{resp: resp, reader: bufio.NewReader(resp.Body)}
+}
+
+// next reads the next event of the stream.
+func (s *eventStream) next(t *testing.T) sseEvent {
+	t.Helper()
+	var ev sseEvent
+	for {
+		line, err := s.reader.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "" && ev.topic != "":
+			return ev
+		case strings.HasPrefix(line, ":"):
+			ev.comments = append(ev.comments, line)
+		case strings.HasPrefix(line, "event: "):
+			ev.topic = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			ev.data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+}
+
+// finalize sends the block at slot on the block feed.
+func (n *testNode) finalize(t *testing.T, slot uint64) {
+	t.Helper()
+	n.blockFeed.Send(feed.NewEvent(
+		context.Background(),
+		events.BeaconBlockFinalized,
+		newTestBlock(t, slot),
+	))
+}
+
+func TestGetEvents(t *testing.T) {
+	node := newTestNode(t)
+	stream := node.openEvents(t, "head,block")
+
+	blk := newTestBlock(t, 64)
+	root, err := blk.HashTreeRoot()
+	require.NoError(t, err)
+	node.finalize(t, 64)
+
+	ev := stream.next(t)
+	require.Equal(t, nodeapi.TopicBlock, ev.topic)
+	var block map[string]any
+	require.NoError(t, json.Unmarshal([]byte(ev.data), &block))
+	require.Equal(t, map[string]any{
+		"slot":                 "64",
+		"block":                common.Root(root).String(),
+		"execution_optimistic": false,
+	}, block)
+
+	ev = stream.next(t)
+	require.Equal(t, nodeapi.TopicHead, ev.topic)
+	var head map[string]any
+	require.NoError(t, json.Unmarshal([]byte(ev.data), &head))
+	require.Equal(t, "64", head["slot"])
+	require.Equal(t, blk.GetStateRoot().String(), head["state"])
+	require.Equal(t, true, head["epoch_transition"])
+
+	// The finalized checkpoint of slot 64 is not streamed, the next event
+	// is the block of slot 65.
+	node.finalize(t, 65)
+	ev = stream.next(t)
+	require.Equal(t, nodeapi.TopicBlock, ev.topic)
+	require.Contains(t, ev.data, `"slot":"65"`)
+}
+
+func TestGetEvents_FinalizedCheckpoint(t *testing.T) {
+	node := newTestNode(t)
+	stream := node.openEvents(t, "finalized_checkpoint")
+
+	node.finalize(t, 70)
+	ev := stream.next(t)
+	require.Equal(t, nodeapi.TopicFinalizedCheckpoint, ev.topic)
+	var checkpoint map[string]any
+	require.NoError(t, json.Unmarshal([]byte(ev.data), &checkpoint))
+	require.Equal(t, "2", checkpoint["epoch"])
+}
+
+func TestGetEvents_Lagged(t *testing.T) {
+	node := newTestNode(t)
+	stream := node.openEvents(t, "block")
+
+	// Publishing does not wait for the stream, the events it cannot keep up
+	// with are dropped.
+	const published = 100_000
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range published {
+			node.events.Publish(nodeapi.Event{
+				Topic: nodeapi.TopicBlock,
+				Data:  nodeapi.BlockEvent{},
+			})
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("publishing blocked on the event stream")
+	}
+
+	for range published {
+		ev := stream.next(t)
+		if len(ev.comments) > 0 {
+			require.Contains(t, ev.comments[0], "events dropped")
+			return
+		}
+	}
+	t.Fatal("no events were dropped")
+}
+
+func TestGetEvents_Unsubscribe(t *testing.T) {
+	node := newTestNode(t)
+	stream := node.openEvents(t, "head")
+	require.Equal(t, 1, node.events.Streams())
+
+	require.NoError(t, stream.resp.Body.Close())
+	require.Eventually(t, func() bool {
+		return node.events.Streams() == 0
+	}, time.Second, time.Millisecond)
+
+	// The feed is not blocked by the closed stream.
+	node.finalize(t, 1)
+}
+
+func TestGetEvents_Errors(t *testing.T) {
+	node := newTestNode(t)
+	node.requireError(t, "/eth/v1/events", http.StatusBadRequest)
+	node.requireError(
+		t, "/eth/v1/events?topics=head,attestation", http.StatusBadRequest,
+	)
+	require.Equal(t, 0, node.events.Streams())
+}
@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-api/server"
+	"github.com/berachain/beacon-kit/mod/node-api/server/handlers"
+	"github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/labstack/echo/v4"
+)
+
+// Handler serves the routes of the node API, as assigned by the node-api
+// server.
+type Handler struct {
+	backend Backend
+	events  *Events
+	logger  log.Logger[any]
+	echo    *echo.Echo
+}
+
+// NewHandler creates the handler serving the node API from backend, and the
+// events of events.
+func NewHandler(
+	backend Backend,
+	events *Events,
+	logger log.Logger[any],
+) *Handler {
+	h := &Handler{
+		backend: backend,
+		events:  events,
+		logger:  logger,
+		echo:    newEcho(logger),
+	}
+	server.AssignRoutes(h.echo, h)
+	return h
+}
+
+// newEcho returns the echo instance of a node API server, reporting the
+// errors of its handlers with their status code and the others as internal
+// server errors.
+func newEcho(logger log.Logger[any]) *echo.Echo {
+	e := echo.New()
+	e.HideBanner, e.HidePort = true, true
+	e.Validator = &handlers.CustomValidator{
+		Validator: server.ConstructValidator(),
+	}
+	e.HTTPErrorHandler = func(err error, c echo.Context) {
+		if c.Response().Committed {
+			return
+		}
+		httpErr := &echo.HTTPError{}
+		if !errors.As(err, &httpErr) {
+			logger.Error(
+				"node API request failed",
+				"code", errors.GetCode(err),
+				"error", err,
+			)
+			httpErr = echo.NewHTTPError(
+				http.StatusInternalServerError, "Internal server error",
+			)
+		}
+		if err = c.JSON(httpErr.Code, types.ErrorResponse{
+			Code:    httpErr.Code,
+			Message: httpErr.Message,
+		}); err != nil {
+			logger.Error("failed to write node API response", "error", err)
+		}
+	}
+	return e
+}
+
+// Start starts publishing the events served by the handler until ctx is
+// done.
+func (h *Handler) Start(ctx context.Context) {
+	h.events.Start(ctx)
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.echo.ServeHTTP(w, r)
+}
+
+// NotImplemented serves the routes of the node API that are not
+// implemented.
+func (*Handler) NotImplemented(echo.Context) error {
+	return echo.ErrNotImplemented
+}
+
+// GetStateRoot serves GET /eth/v1/beacon/states/:state_id/root.
+func (h *Handler) GetStateRoot(c echo.Context) error {
+	return h.NotImplemented(c)
+}
+
+// PostStateValidators serves POST
+// /eth/v1/beacon/states/:state_id/validators.
+func (h *Handler) PostStateValidators(c echo.Context) error {
+	return h.NotImplemented(c)
+}
+
+// GetStateValidatorBalances serves GET
+// /eth/v1/beacon/states/:state_id/validator_balances.
+func (h *Handler) GetStateValidatorBalances(c echo.Context) error {
+	return h.NotImplemented(c)
+}
+
+// PostStateValidatorBalances serves POST
+// /eth/v1/beacon/states/:state_id/validator_balances.
+func (h *Handler) PostStateValidatorBalances(c echo.Context) error {
+	return h.NotImplemented(c)
+}
+
+// GetBlockRewards serves GET /eth/v1/beacon/rewards/blocks/:block_id.
+func (h *Handler) GetBlockRewards(c echo.Context) error {
+	return h.NotImplemented(c)
+}
+
+// decimal is an integer encoded as a decimal string, as the node API
+// encodes integers.
+type decimal uint64
+
+// MarshalText implements encoding.TextMarshaler.
+func (d decimal) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(d), 10), nil
+}
+
+// versionedResponse is the envelope of the data of a fork version.
+type versionedResponse struct {
+	Version             string `json:"version"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+	Finalized           bool   `json:"finalized"`
+	Data                any    `json:"data"`
+}
+
+// writeData writes data in the response envelope.
+func writeData(c echo.Context, data any) error {
+	return c.JSON(http.StatusOK, handlers.WrapData(data))
+}
+
+// badRequest returns the error response of an invalid request.
+func badRequest(message string) error {
+	return echo.NewHTTPError(http.StatusBadRequest, message)
+}
+
+// apiError returns the error response of err, reporting missing state with
+// notFound. The other errors are internal server errors.
+func apiError(err error, notFound string) error {
+	switch {
+	case errors.Is(err, ErrNotReady):
+		return echo.NewHTTPError(
+			http.StatusServiceUnavailable, "Beacon node is not ready",
+		)
+	case errors.Is(err, ErrStateNotAvailable),
+		errors.Is(err, ErrBlockNotFound),
+		errors.Is(err, ErrBlobsNotAvailable):
+		return echo.NewHTTPError(http.StatusNotFound, notFound)
+	default:
+		return err
+	}
+}
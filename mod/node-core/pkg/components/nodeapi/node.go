@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// executionStatusTimeout bounds the time to get the sync status of the
+	// execution client, so that the node status is served when it hangs.
+	executionStatusTimeout = 2 * time.Second
+	// syncTolerance is the number of slots the head may be behind the wall
+	// clock before the node is reported as syncing, as the block times vary
+	// around the target.
+	syncTolerance = 2
+)
+
+// SyncingStatus is the sync status of the node.
+type SyncingStatus struct {
+	HeadSlot     decimal `json:"head_slot"`
+	SyncDistance decimal `json:"sync_distance"`
+	IsSyncing    bool    `json:"is_syncing"`
+	IsOptimistic bool    `json:"is_optimistic"`
+	ELOffline    bool    `json:"el_offline"`
+}
+
+// GetSyncing serves GET /eth/v1/node/syncing.
+func (h *Handler) GetSyncing(c echo.Context) error {
+	status, err := h.syncingStatus(c.Request().Context())
+	if err != nil {
+		return apiError(err, "")
+	}
+	return writeData(c, status)
+}
+
+// GetHealth serves GET /eth/v1/node/health. The node is healthy if it
+// follows the head with the execution client reachable, and partially
+// healthy while syncing, reported with the syncing_status query parameter
+// if set.
+func (h *Handler) GetHealth(c echo.Context) error {
+	syncingCode := http.StatusPartialContent
+	if param := c.QueryParam("syncing_status"); param != "" {
+		code, err := strconv.Atoi(param)
+		if err != nil || code < http.StatusContinue ||
+			code > http.StatusNetworkAuthenticationRequired {
+			return badRequest("Invalid syncing status code: " + param)
+		}
+		syncingCode = code
+	}
+
+	status, err := h.syncingStatus(c.Request().Context())
+	switch {
+	case err != nil:
+		if !errors.Is(err, ErrNotReady) {
+			h.logger.Error("node health check failed", "error", err)
+		}
+		return c.NoContent(http.StatusServiceUnavailable)
+	case status.ELOffline:
+		return c.NoContent(http.StatusServiceUnavailable)
+	case status.IsSyncing:
+		return c.NoContent(syncingCode)
+	default:
+		return c.NoContent(http.StatusOK)
+	}
+}
+
+// syncingStatus returns the sync status of the node. The wall clock slot is
+// estimated from the time of the head, which is that of its execution
+// payload, as slots have no fixed duration. The node is reported as not
+// ready until the head state is available.
+func (h *Handler) syncingStatus(ctx context.Context) (SyncingStatus, error) {
+	st, err := h.backend.HeadState(ctx)
+	if err != nil {
+		return SyncingStatus{}, notReady(err)
+	}
+	slot, err := st.GetSlot()
+	if err != nil {
+		return SyncingStatus{}, notReady(stateError(err))
+	}
+	header, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return SyncingStatus{}, notReady(stateError(err))
+	}
+
+	var (
+		distance     uint64
+		slotDuration = time.Duration(
+			h.backend.ChainSpec().TargetSecondsPerEth1Block(),
+		) * time.Second
+		//#nosec:G115 // timestamps never exceed the max int64.
+		headTime = time.Unix(int64(header.GetTimestamp().Unwrap()), 0)
+	)
+	if elapsed := time.Since(headTime); elapsed > 0 && slotDuration > 0 {
+		distance = uint64(elapsed / slotDuration)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, executionStatusTimeout)
+	defer cancel()
+	elSyncing, err := h.backend.ExecutionSyncing(ctx)
+	return SyncingStatus{
+		HeadSlot:     decimal(slot.Unwrap()),
+		SyncDistance: decimal(distance),
+		IsSyncing:    distance > syncTolerance,
+		IsOptimistic: elSyncing,
+		ELOffline:    err != nil,
+	}, nil
+}
+
+// notReady reports the state not being available as the node not being
+// ready.
+func notReady(err error) error {
+	if errors.Is(err, ErrStateNotAvailable) {
+		return errors.Join(ErrNotReady, err)
+	}
+	return err
+}
@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// setHead sets the head of the store to slot, with a payload built at the
+// given time.
+func (n *testNode) setHead(t *testing.T, slot uint64, at time.Time) {
+	t.Helper()
+	require.NoError(t, n.kv.SetSlot(math.Slot(slot)))
+	require.NoError(t, n.kv.SetLatestExecutionPayloadHeader(
+		&types.ExecutionPayloadHeader{
+			InnerExecutionPayloadHeader: &types.ExecutionPayloadHeaderDeneb{
+				LogsBloom: make([]byte, 256),
+				//#nosec:G115 // test times are after the epoch.
+				Timestamp: math.U64(at.Unix()),
+			},
+		},
+	))
+}
+
+func TestGetSyncing(t *testing.T) {
+	slotDuration := time.Duration(
+		spec.TestnetChainSpec().TargetSecondsPerEth1Block(),
+	) * time.Second
+
+	tests := []struct {
+		name     string
+		headAge  time.Duration
+		elErr    error
+		elSync   bool
+		want     map[string]any
+		wantCode int
+	}{
+		{
+			name:    "Synced",
+			headAge: 0,
+			want: map[string]any{
+				"head_slot":     "10",
+				"sync_distance": "0",
+				"is_syncing":    false,
+				"is_optimistic": false,
+				"el_offline":    false,
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:    "Syncing",
+			headAge: 20*slotDuration + slotDuration/2,
+			want: map[string]any{
+				"head_slot":     "10",
+				"sync_distance": "20",
+				"is_syncing":    true,
+				"is_optimistic": false,
+				"el_offline":    false,
+			},
+			wantCode: http.StatusPartialContent,
+		},
+		{
+			name:    "ExecutionSyncing",
+			headAge: 0,
+			elSync:  true,
+			want: map[string]any{
+				"head_slot":     "10",
+				"sync_distance": "0",
+				"is_syncing":    false,
+				"is_optimistic": true,
+				"el_offline":    false,
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:    "ExecutionOffline",
+			headAge: 0,
+			elErr:   errors.New("connection refused"),
+			want: map[string]any{
+				"head_slot":     "10",
+				"sync_distance": "0",
+				"is_syncing":    false,
+				"is_optimistic": false,
+				"el_offline":    true,
+			},
+			wantCode: http.StatusServiceUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := newTestNode(t)
+			node.ready()
+			node.setHead(t, 10, time.Now().Add(-tt.headAge))
+			node.el.set(tt.elSync, tt.elErr)
+
+			var status map[string]any
+			node.getData(t, "/eth/v1/node/syncing", &status)
+			require.Equal(t, tt.want, status)
+
+			code, body := node.get(t, "/eth/v1/node/health")
+			require.Equal(t, tt.wantCode, code)
+			require.Empty(t, body)
+		})
+	}
+}
+
+func TestGetHealth_SyncingStatus(t *testing.T) {
+	node := newTestNode(t)
+	node.ready()
+	node.setHead(t, 10, time.Now().Add(-time.Hour))
+
+	code, _ := node.get(t, "/eth/v1/node/health?syncing_status=200")
+	require.Equal(t, http.StatusOK, code)
+
+	node.requireError(
+		t, "/eth/v1/node/health?syncing_status=abc", http.StatusBadRequest,
+	)
+	node.requireError(
+		t, "/eth/v1/node/health?syncing_status=600", http.StatusBadRequest,
+	)
+}
+
+func TestGetSyncing_NotReady(t *testing.T) {
+	node := newTestNode(t)
+
+	// The application is not built yet.
+	node.requireError(t, "/eth/v1/node/syncing", http.StatusServiceUnavailable)
+	code, _ := node.get(t, "/eth/v1/node/health")
+	require.Equal(t, http.StatusServiceUnavailable, code)
+
+	// The store is empty before genesis.
+	node.ready()
+	node.requireError(t, "/eth/v1/node/syncing", http.StatusServiceUnavailable)
+	code, _ = node.get(t, "/eth/v1/node/health")
+	require.Equal(t, http.StatusServiceUnavailable, code)
+}
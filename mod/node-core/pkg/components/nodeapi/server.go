@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/httpserver"
+)
+
+// Server is a service serving the beacon node API.
+type Server struct {
+	*httpserver.Server
+	handler http.Handler
+}
+
+// NewServer creates a node API server serving handler on addr.
+func NewServer(
+	addr string,
+	handler *Handler,
+	logger log.Logger[any],
+) *Server {
+	return newServer("node-api-server", addr, handler, logger)
+}
+
+// newServer creates a server, named name, serving handler on addr.
+func newServer(
+	name string,
+	addr string,
+	handler http.Handler,
+	logger log.Logger[any],
+) *Server {
+	return &Server{
+		Server:  httpserver.New(name, addr, handler, logger),
+		handler: handler,
+	}
+}
+
+// Start starts publishing the events served by the handler, if any, and
+// serving until ctx is cancelled.
+func (s *Server) Start(ctx context.Context) error {
+	if h, ok := s.handler.(interface{ Start(context.Context) }); ok {
+		h.Start(ctx)
+	}
+	return s.Server.Start(ctx)
+}
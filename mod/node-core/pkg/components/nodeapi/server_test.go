@@ -0,0 +1,370 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/nodeapi"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testGenesisTime = "2024-06-01T00:00:00Z"
+
+// testProvider provides the context of the in-memory store, or of the store
+// of the height if it has one of its own, or err.
+type testProvider struct {
+	ctx     sdk.Context
+	heights map[int64]sdk.Context
+	err     error
+}
+
+func (p testProvider) CreateQueryContext(
+	height int64, _ bool,
+) (sdk.Context, error) {
+	if ctx, ok := p.heights[height]; ok {
+		return ctx, p.err
+	}
+	return p.ctx, p.err
+}
+
+// testStorage reads the beacon state from the in-memory store.
+type testStorage struct {
+	kv *storage.KVStore
+}
+
+func (s testStorage) StateFromContext(ctx context.Context) *storage.KVStore {
+	return s.kv.WithContext(ctx)
+}
+
+// testKVStoreProvider opens the in-memory store of ctx.
+type testKVStoreProvider struct {
+	key *storetypes.KVStoreKey
+	ctx sdk.Context
+}
+
+func (p testKVStoreProvider) OpenKVStore(context.Context) store.KVStore {
+	return runtime.NewKVStoreService(p.key).OpenKVStore(p.ctx)
+}
+
+// testExecutionClient reports the sync status it is set to, or err.
+type testExecutionClient struct {
+	mu      sync.Mutex
+	syncing bool
+	err     error
+}
+
+func (c *testExecutionClient) IsSyncing(context.Context) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.syncing, c.err
+}
+
+func (c *testExecutionClient) set(syncing bool, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.syncing, c.err = syncing, err
+}
+
+// testBlobStore holds the blob sidecars of the slots, counting the readers
+// of sidecars left open.
+type testBlobStore struct {
+	mu       sync.Mutex
+	sidecars map[math.Slot][]*datypes.BlobSidecar
+	open     atomic.Int64
+}
+
+// testBlobReader is a reader of a sidecar of a testBlobStore.
+type testBlobReader struct {
+	io.Reader
+	store *testBlobStore
+}
+
+func (r *testBlobReader) Close() error {
+	r.store.open.Add(-1)
+	return nil
+}
+
+func (s *testBlobStore) GetBlobSidecars(
+	slot math.Slot,
+) (*datypes.BlobSidecars, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &datypes.BlobSidecars{Sidecars: s.sidecars[slot]}, nil
+}
+
+func (s *testBlobStore) GetBlobSidecarReader(
+	slot math.Slot, index uint64,
+) (io.ReadCloser, int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sidecar := range s.sidecars[slot] {
+		if sidecar.Index != index {
+			continue
+		}
+		bz, err := sidecar.MarshalSSZ()
+		if err != nil {
+			return nil, 0, err
+		}
+		s.open.Add(1)
+		return &testBlobReader{Reader: bytes.NewReader(bz), store: s},
+			int64(len(bz)), nil
+	}
+	return nil, 0, dastore.ErrSidecarNotFound
+}
+
+func (s *testBlobStore) set(slot math.Slot, sidecars ...*datypes.BlobSidecar) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sidecars[slot] = sidecars
+}
+
+// testNode is a node API server over an in-memory store.
+type testNode struct {
+	base          string
+	handler       *nodeapi.Handler
+	kv            *storage.KVStore
+	blocks        *block.KVStore[*types.BeaconBlock]
+	blobs         *testBlobStore
+	blockFeed     *feed.Topic[*feed.Event[*types.BeaconBlock]]
+	events        *nodeapi.Events
+	key           *storetypes.KVStoreKey
+	ctx           sdk.Context
+	heights       map[int64]sdk.Context
+	queryContexts *nodeapi.QueryContexts
+	el            *testExecutionClient
+}
+
+// newTestNode starts a node API server over an empty in-memory store. The
+// query contexts are left unset.
+func newTestNode(t *testing.T) *testNode {
+	t.Helper()
+	key := storetypes.NewKVStoreKey("beacon")
+	ctx := testutil.DefaultContext(
+		key, storetypes.NewTransientStoreKey("transient"),
+	)
+	kv := beacondb.New[
+		*types.Fork,
+		*types.BeaconBlockHeader,
+		*types.ExecutionPayloadHeader,
+		*types.Eth1Data,
+		*types.Validator,
+	](
+		runtime.NewKVStoreService(key),
+		&encoding.SSZInterfaceCodec[*types.ExecutionPayloadHeader]{},
+	)
+
+	blocksKey := storetypes.NewKVStoreKey("blocks")
+	blocks := block.NewStore[*types.BeaconBlock](testKVStoreProvider{
+		key: blocksKey,
+		ctx: testutil.DefaultContext(
+			blocksKey, storetypes.NewTransientStoreKey("transient_blocks"),
+		),
+	})
+
+	genesisFile := filepath.Join(t.TempDir(), "genesis.json")
+	require.NoError(t, os.WriteFile(
+		genesisFile,
+		[]byte(`{"genesis_time":"`+testGenesisTime+`","chain_id":"test"}`),
+		0o600,
+	))
+
+	queryContexts := &nodeapi.QueryContexts{}
+	blobs := &testBlobStore{
+		sidecars: make(map[math.Slot][]*datypes.BlobSidecar),
+	}
+	el := &testExecutionClient{}
+	blockFeed := &feed.Topic[*feed.Event[*types.BeaconBlock]]{}
+	events := nodeapi.NewBlockEvents[*types.BeaconBlock, *types.BeaconBlockBody](
+		spec.TestnetChainSpec(), blockFeed, noop.NewLogger(),
+	)
+	handler := nodeapi.NewHandler(
+		nodeapi.NewStateBackend[
+			*storage.KVStore, *types.BeaconBlock, *types.BeaconBlockBody,
+		](
+			spec.TestnetChainSpec(),
+			queryContexts,
+			testStorage{kv: kv},
+			blocks,
+			blobs,
+			el,
+			genesisFile,
+		),
+		events,
+		noop.NewLogger(),
+	)
+	server := nodeapi.NewServer("127.0.0.1:0", handler, noop.NewLogger())
+	ctxt, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	require.NoError(t, server.Start(ctxt))
+	t.Cleanup(func() {
+		require.NoError(t, server.Stop(context.Background()))
+	})
+	return &testNode{
+		base:          "http://" + server.Addr().String(),
+		handler:       handler,
+		kv:            kv.WithContext(ctx),
+		blocks:        blocks,
+		blobs:         blobs,
+		blockFeed:     blockFeed,
+		events:        events,
+		key:           key,
+		ctx:           ctx,
+		heights:       make(map[int64]sdk.Context),
+		queryContexts: queryContexts,
+		el:            el,
+	}
+}
+
+// ready sets the query contexts to the in-memory store.
+func (n *testNode) ready() {
+	n.queryContexts.SetProvider(testProvider{ctx: n.ctx, heights: n.heights})
+}
+
+// stateAt returns the state committed at slot, in a store of its own rather
+// than the one of the head state.
+func (n *testNode) stateAt(slot uint64) *storage.KVStore {
+	ctx := testutil.DefaultContext(
+		n.key, storetypes.NewTransientStoreKey("transient"),
+	)
+	//#nosec:G115 // test slots fit in an int64.
+	n.heights[int64(slot)] = ctx
+	return n.kv.WithContext(ctx)
+}
+
+// get returns the status and the body of the response to GET path.
+func (n *testNode) get(t *testing.T, path string) (int, []byte) {
+	t.Helper()
+	resp, body := n.getAccept(t, path, "")
+	return resp.StatusCode, body
+}
+
+// getAccept returns the response to GET path accepting the media types of
+// accept, if set, and its body.
+func (n *testNode) getAccept(
+	t *testing.T, path, accept string,
+) (*http.Response, []byte) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, n.base+path, nil)
+	require.NoError(t, err)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, body
+}
+
+// getJSON decodes the response to GET path into resp.
+func (n *testNode) getJSON(t *testing.T, path string, resp any) {
+	t.Helper()
+	status, body := n.get(t, path)
+	require.Equal(t, http.StatusOK, status, string(body))
+	require.NoError(t, json.Unmarshal(body, resp))
+}
+
+// getData decodes the data of the response to GET path into data.
+func (n *testNode) getData(t *testing.T, path string, data any) {
+	t.Helper()
+	n.getJSON(t, path, &struct {
+		Data any `json:"data"`
+	}{Data: data})
+}
+
+// requireError requires GET path to fail with code.
+func (n *testNode) requireError(t *testing.T, path string, code int) {
+	t.Helper()
+	status, body := n.get(t, path)
+	require.Equal(t, code, status, string(body))
+	var resp struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	require.NoError(t, json.Unmarshal(body, &resp))
+	require.Equal(t, code, resp.Code)
+	require.NotEmpty(t, resp.Message)
+}
+
+func TestGetGenesis(t *testing.T) {
+	node := newTestNode(t)
+	const path = "/eth/v1/beacon/genesis"
+
+	// The application is not built yet.
+	node.requireError(t, path, http.StatusServiceUnavailable)
+
+	// The store is empty before genesis.
+	node.ready()
+	node.requireError(t, path, http.StatusNotFound)
+
+	root := common.Root{0x01, 0x02, 0x03}
+	require.NoError(t, node.kv.SetGenesisValidatorsRoot(root))
+
+	var genesis map[string]string
+	node.getData(t, path, &genesis)
+	require.Equal(t, map[string]string{
+		"genesis_time":            "1717200000",
+		"genesis_validators_root": root.String(),
+		"genesis_fork_version": version.FromUint32[common.Version](
+			spec.TestnetChainSpec().ActiveForkVersionForEpoch(0),
+		).String(),
+	}, genesis)
+}
+
+func TestGetGenesis_StateNotCommitted(t *testing.T) {
+	node := newTestNode(t)
+	node.queryContexts.SetProvider(testProvider{
+		err: errors.New("not ready; please wait for first block"),
+	})
+	node.requireError(
+		t, "/eth/v1/beacon/genesis", http.StatusNotFound,
+	)
+}
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/labstack/echo/v4"
+)
+
+// DepositContract is the deposit contract of the chain.
+type DepositContract struct {
+	ChainID decimal                 `json:"chain_id"`
+	Address common.ExecutionAddress `json:"address"`
+}
+
+// specValues returns the values of chainSpec under their names in the
+// consensus specs. The values that are zero are not set in the chain spec
+// and are left out, the domain types are always set. The per-fork values
+// are those of the genesis fork, followed by those of the later forks that
+// change them under their names suffixed with that of the fork, e.g.
+// MAX_BLOBS_PER_BLOCK_ELECTRA.
+//
+//nolint:lll // names of the consensus specs.
+func specValues(chainSpec primitives.ChainSpec) map[string]string {
+	genesisVersion := chainSpec.ActiveForkVersionForEpoch(0)
+	values := map[string]string{
+		"GENESIS_FORK_VERSION": version.FromUint32[common.Version](
+			genesisVersion,
+		).String(),
+		"DOMAIN_BEACON_PROPOSER":     chainSpec.DomainTypeProposer().String(),
+		"DOMAIN_BEACON_ATTESTER":     chainSpec.DomainTypeAttester().String(),
+		"DOMAIN_RANDAO":              chainSpec.DomainTypeRandao().String(),
+		"DOMAIN_DEPOSIT":             chainSpec.DomainTypeDeposit().String(),
+		"DOMAIN_VOLUNTARY_EXIT":      chainSpec.DomainTypeVoluntaryExit().String(),
+		"DOMAIN_SELECTION_PROOF":     chainSpec.DomainTypeSelectionProof().String(),
+		"DOMAIN_AGGREGATE_AND_PROOF": chainSpec.DomainTypeAggregateAndProof().String(),
+		"DOMAIN_APPLICATION_MASK":    chainSpec.DomainTypeApplicationMask().String(),
+	}
+	if address, err := chainSpec.DepositContractAddress().
+		MarshalText(); err == nil {
+		values["DEPOSIT_CONTRACT_ADDRESS"] = string(address)
+	}
+
+	for name, value := range map[string]uint64{
+		"MIN_DEPOSIT_AMOUNT":                   chainSpec.MinDepositAmount(),
+		"MAX_EFFECTIVE_BALANCE":                chainSpec.MaxEffectiveBalance(),
+		"EJECTION_BALANCE":                     chainSpec.EjectionBalance(),
+		"EFFECTIVE_BALANCE_INCREMENT":          chainSpec.EffectiveBalanceIncrement(),
+		"HYSTERESIS_QUOTIENT":                  chainSpec.HysteresisQuotient(),
+		"HYSTERESIS_DOWNWARD_MULTIPLIER":       chainSpec.HysteresisDownwardMultiplier(),
+		"HYSTERESIS_UPWARD_MULTIPLIER":         chainSpec.HysteresisUpwardMultiplier(),
+		"SLOTS_PER_EPOCH":                      chainSpec.SlotsPerEpoch(),
+		"SLOTS_PER_HISTORICAL_ROOT":            chainSpec.SlotsPerHistoricalRoot(),
+		"MIN_EPOCHS_TO_INACTIVITY_PENALTY":     chainSpec.MinEpochsToInactivityPenalty(),
+		"MAX_SEED_LOOKAHEAD":                   chainSpec.MaxSeedLookahead(),
+		"MIN_VALIDATOR_WITHDRAWABILITY_DELAY":  chainSpec.MinValidatorWithdrawabilityDelay(),
+		"MIN_PER_EPOCH_CHURN_LIMIT":            chainSpec.MinPerEpochChurnLimit(),
+		"CHURN_LIMIT_QUOTIENT":                 chainSpec.ChurnLimitQuotient(),
+		"MAX_PER_EPOCH_ACTIVATION_CHURN_LIMIT": chainSpec.MaxPerEpochActivationChurnLimit(),
+		"MAX_DEPOSITS":                         chainSpec.MaxDepositsPerBlock(),
+		"DEPOSIT_CHAIN_ID":                     chainSpec.DepositEth1ChainID(),
+		"ETH1_FOLLOW_DISTANCE":                 chainSpec.Eth1FollowDistance(),
+		"SECONDS_PER_ETH1_BLOCK":               chainSpec.TargetSecondsPerEth1Block(),
+		// A block is built for each block of the execution layer.
+		"SECONDS_PER_SLOT":                      chainSpec.TargetSecondsPerEth1Block(),
+		"ELECTRA_FORK_EPOCH":                    uint64(chainSpec.ElectraForkEpoch()),
+		"EPOCHS_PER_HISTORICAL_VECTOR":          chainSpec.EpochsPerHistoricalVector(),
+		"EPOCHS_PER_SLASHINGS_VECTOR":           chainSpec.EpochsPerSlashingsVector(),
+		"HISTORICAL_ROOTS_LIMIT":                chainSpec.HistoricalRootsLimit(),
+		"VALIDATOR_REGISTRY_LIMIT":              chainSpec.ValidatorRegistryLimit(),
+		"INACTIVITY_PENALTY_QUOTIENT":           chainSpec.InactivityPenaltyQuotient(),
+		"PROPORTIONAL_SLASHING_MULTIPLIER":      chainSpec.ProportionalSlashingMultiplier(),
+		"MIN_SLASHING_PENALTY_QUOTIENT":         chainSpec.MinSlashingPenaltyQuotient(),
+		"WHISTLEBLOWER_REWARD_QUOTIENT":         chainSpec.WhistleblowerRewardQuotient(),
+		"MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP":  chainSpec.MaxValidatorsPerWithdrawalsSweep(),
+		"MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS": chainSpec.MinEpochsForBlobsSidecarsRequest(),
+		"MAX_BLOB_COMMITMENTS_PER_BLOCK":        chainSpec.MaxBlobCommitmentsPerBlock(),
+		"FIELD_ELEMENTS_PER_BLOB":               chainSpec.FieldElementsPerBlob(),
+		"BYTES_PER_BLOB":                        chainSpec.BytesPerBlob(),
+	} {
+		if value != 0 {
+			values[name] = strconv.FormatUint(value, 10)
+		}
+	}
+
+	for name, value := range map[string]func(uint32) uint64{
+		"MAX_WITHDRAWALS_PER_PAYLOAD": chainSpec.MaxWithdrawalsPerPayload,
+		"MAX_BLOBS_PER_BLOCK":         chainSpec.MaxBlobsPerBlock,
+	} {
+		prev := value(genesisVersion)
+		if prev != 0 {
+			values[name] = strconv.FormatUint(prev, 10)
+		}
+		for _, fork := range chainSpec.ForkSchedule() {
+			if forkValue := value(fork.Version); forkValue != prev {
+				values[name+"_"+strings.ToUpper(version.Name(fork.Version))] =
+					strconv.FormatUint(forkValue, 10)
+				prev = forkValue
+			}
+		}
+	}
+	return values
+}
+
+// GetSpec serves GET /eth/v1/config/spec.
+func (h *Handler) GetSpec(c echo.Context) error {
+	return writeData(c, specValues(h.backend.ChainSpec()))
+}
+
+// GetForkSchedule serves GET /eth/v1/config/fork_schedule, the forks of the
+// fork schedule of the chain spec in order.
+func (h *Handler) GetForkSchedule(c echo.Context) error {
+	schedule := h.backend.ChainSpec().ForkSchedule()
+	forks := make([]Fork, 0, len(schedule))
+	for i, fork := range schedule {
+		previous := fork.Version
+		if i > 0 {
+			previous = schedule[i-1].Version
+		}
+		forks = append(forks, Fork{
+			PreviousVersion: version.FromUint32[common.Version](previous),
+			CurrentVersion:  version.FromUint32[common.Version](fork.Version),
+			Epoch:           decimal(fork.Epoch),
+		})
+	}
+	return writeData(c, forks)
+}
+
+// GetDepositContract serves GET /eth/v1/config/deposit_contract.
+func (h *Handler) GetDepositContract(c echo.Context) error {
+	chainSpec := h.backend.ChainSpec()
+	return writeData(c, DepositContract{
+		ChainID: decimal(chainSpec.DepositEth1ChainID()),
+		Address: chainSpec.DepositContractAddress(),
+	})
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSpec(t *testing.T) {
+	node := newTestNode(t)
+	_, body := node.get(t, "/eth/v1/config/spec")
+
+	golden, err := os.ReadFile(filepath.Join("testdata", "spec.json"))
+	require.NoError(t, err)
+	require.JSONEq(t, string(golden), string(body))
+}
+
+func TestGetDepositContract(t *testing.T) {
+	node := newTestNode(t)
+
+	var contract map[string]string
+	node.getData(t, "/eth/v1/config/deposit_contract", &contract)
+	require.Equal(t, map[string]string{
+		"chain_id": "80084",
+		"address":  "0x4242424242424242424242424242424242424242",
+	}, contract)
+}
+
+func TestGetForkSchedule(t *testing.T) {
+	node := newTestNode(t)
+
+	var forks []map[string]string
+	node.getData(t, "/eth/v1/config/fork_schedule", &forks)
+	require.Equal(t, []map[string]string{
+		{
+			"previous_version": "0x04000000",
+			"current_version":  "0x04000000",
+			"epoch":            "0",
+		},
+		{
+			"previous_version": "0x04000000",
+			"current_version":  "0x05000000",
+			"epoch":            "9999999999999999",
+		},
+	}, forks)
+}
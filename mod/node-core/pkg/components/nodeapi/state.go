@@ -0,0 +1,230 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/labstack/echo/v4"
+)
+
+// stateNotFound is the message of the requests for unknown states.
+const stateNotFound = "State not found"
+
+// stateID identifies a state by slot or by root, or the head if neither is
+// set.
+type stateID struct {
+	slot *math.Slot
+	root *common.Root
+}
+
+// parseStateID parses a state ID of the node API. Blocks are final once
+// committed, so the finalized and justified states are the head. The
+// genesis state is not committed on its own, it is part of the state of
+// slot 1.
+func parseStateID(id string) (stateID, error) {
+	switch id {
+	case "head", "finalized", "justified":
+		return stateID{}, nil
+	case "genesis":
+		return stateID{}, ErrStateNotAvailable
+	}
+	if strings.HasPrefix(id, "0x") {
+		var root common.Root
+		if err := root.UnmarshalText([]byte(id)); err != nil {
+			return stateID{}, errors.Newf("invalid state root: %s", id)
+		}
+		return stateID{root: &root}, nil
+	}
+	slot, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return stateID{}, errors.Newf("invalid state ID: %s", id)
+	}
+	if slot == 0 {
+		return stateID{}, ErrStateNotAvailable
+	}
+	return stateID{slot: (*math.Slot)(&slot)}, nil
+}
+
+// requestState returns the state identified by the state_id of the
+// request, or the error response if it cannot be read.
+func (h *Handler) requestState(c echo.Context) (BeaconState, error) {
+	id, err := parseStateID(c.Param("state_id"))
+	if errors.Is(err, ErrStateNotAvailable) {
+		return nil, apiError(err, stateNotFound)
+	} else if err != nil {
+		return nil, badRequest(err.Error())
+	}
+
+	var (
+		ctx = c.Request().Context()
+		st  BeaconState
+	)
+	switch {
+	case id.root != nil:
+		st, err = h.stateByRoot(ctx, *id.root)
+	case id.slot != nil:
+		st, err = h.backend.StateAtSlot(ctx, *id.slot)
+	default:
+		st, err = h.backend.HeadState(ctx)
+	}
+	if err != nil {
+		return nil, apiError(err, stateNotFound)
+	}
+	return st, nil
+}
+
+// stateByRoot returns the state of the given root. States are not indexed
+// by root: the root of the head state is the state root of the head block,
+// and the roots of the states of the previous SlotsPerHistoricalRoot slots
+// are in the head state.
+func (h *Handler) stateByRoot(
+	ctx context.Context,
+	root common.Root,
+) (BeaconState, error) {
+	head, err := h.backend.HeadState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := head.GetSlot()
+	if err != nil {
+		return nil, stateError(err)
+	}
+	blk, err := h.backend.BlockAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	if blk.Data.Message.StateRoot == root {
+		return head, nil
+	}
+
+	// The genesis state is not available, the history is only searched
+	// down to slot 1.
+	n := h.backend.ChainSpec().SlotsPerHistoricalRoot()
+	for i := uint64(1); i <= n && i < slot.Unwrap(); i++ {
+		prev := slot - math.Slot(i)
+		stateRoot, err := head.StateRootAtIndex(prev.Unwrap() % n)
+		if err != nil {
+			return nil, stateError(err)
+		}
+		if stateRoot == root {
+			return h.backend.StateAtSlot(ctx, prev)
+		}
+	}
+	return nil, ErrStateNotAvailable
+}
+
+// stateResponse is the envelope of the data of a state.
+type stateResponse struct {
+	ExecutionOptimistic bool `json:"execution_optimistic"`
+	Finalized           bool `json:"finalized"`
+	Data                any  `json:"data"`
+}
+
+// Checkpoint is a checkpoint in the node API encoding.
+type Checkpoint struct {
+	Epoch decimal     `json:"epoch"`
+	Root  common.Root `json:"root"`
+}
+
+// FinalityCheckpoints are the checkpoints of a state.
+//
+//nolint:lll // struct tags.
+type FinalityCheckpoints struct {
+	PreviousJustified Checkpoint `json:"previous_justified"`
+	CurrentJustified  Checkpoint `json:"current_justified"`
+	Finalized         Checkpoint `json:"finalized"`
+}
+
+// Fork is the fork of a state in the node API encoding.
+type Fork struct {
+	PreviousVersion common.Version `json:"previous_version"`
+	CurrentVersion  common.Version `json:"current_version"`
+	Epoch           decimal        `json:"epoch"`
+}
+
+// GetFinalityCheckpoints serves GET
+// /eth/v1/beacon/states/:state_id/finality_checkpoints.
+//
+// Blocks are final once committed, there is no justification by votes of
+// the validators. Each block justifies and finalizes itself as soon as it
+// is committed, so the current justified and the finalized checkpoints of
+// the state of a slot are the block of the slot, at its epoch, as in the
+// finalized_checkpoint events. The previous justified checkpoint is the
+// one of the state before, the parent block at the epoch of the previous
+// slot.
+func (h *Handler) GetFinalityCheckpoints(c echo.Context) error {
+	st, err := h.requestState(c)
+	if err != nil {
+		return err
+	}
+	slot, err := st.GetSlot()
+	if err != nil {
+		return apiError(stateError(err), stateNotFound)
+	}
+	blk, err := h.backend.BlockAtSlot(slot)
+	if err != nil {
+		return apiError(err, stateNotFound)
+	}
+
+	chainSpec := h.backend.ChainSpec()
+	current := Checkpoint{
+		Epoch: decimal(chainSpec.SlotToEpoch(slot)),
+		Root:  blk.Root,
+	}
+	return c.JSON(http.StatusOK, stateResponse{
+		Finalized: true,
+		Data: FinalityCheckpoints{
+			PreviousJustified: Checkpoint{
+				Epoch: decimal(chainSpec.SlotToEpoch(slot - 1)),
+				Root:  blk.Data.Message.ParentRoot,
+			},
+			CurrentJustified: current,
+			Finalized:        current,
+		},
+	})
+}
+
+// GetFork serves GET /eth/v1/beacon/states/:state_id/fork.
+func (h *Handler) GetFork(c echo.Context) error {
+	st, err := h.requestState(c)
+	if err != nil {
+		return err
+	}
+	fork, err := st.GetFork()
+	if err != nil {
+		return apiError(stateError(err), stateNotFound)
+	}
+	return c.JSON(http.StatusOK, stateResponse{
+		Finalized: true,
+		Data: Fork{
+			PreviousVersion: fork.PreviousVersion,
+			CurrentVersion:  fork.CurrentVersion,
+			Epoch:           decimal(fork.Epoch),
+		},
+	})
+}
@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi_test
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/stretchr/testify/require"
+)
+
+// setStates stores the blocks of slots 31 and 32, the head state at slot 32
+// with the given fork and the state of slot 31 with its own fork. The state
+// of a slot has root {slot}.
+func (n *testNode) setStates(
+	t *testing.T, head, prev *types.Fork,
+) map[uint64]common.Root {
+	t.Helper()
+	roots := make(map[uint64]common.Root)
+	for _, slot := range []uint64{31, 32} {
+		blk := newTestBlock(t, slot)
+		blk.SetStateRoot(common.Root{byte(slot)})
+		require.NoError(t, n.blocks.Set(blk))
+		root, err := blk.HashTreeRoot()
+		require.NoError(t, err)
+		roots[slot] = root
+	}
+
+	st := n.stateAt(31)
+	require.NoError(t, st.SetSlot(31))
+	require.NoError(t, st.SetFork(prev))
+
+	n.setHead(t, 32, time.Now())
+	require.NoError(t, n.kv.SetFork(head))
+	require.NoError(t, n.kv.UpdateStateRootAtIndex(
+		31%spec.TestnetChainSpec().SlotsPerHistoricalRoot(),
+		common.Root{31},
+	))
+	n.ready()
+	return roots
+}
+
+func TestGetFork(t *testing.T) {
+	node := newTestNode(t)
+	head := &types.Fork{
+		PreviousVersion: common.Version{0x04},
+		CurrentVersion:  common.Version{0x05},
+		Epoch:           1,
+	}
+	prev := &types.Fork{
+		PreviousVersion: common.Version{0x03},
+		CurrentVersion:  common.Version{0x04},
+	}
+	node.setStates(t, head, prev)
+
+	tests := []struct {
+		id   string
+		want *types.Fork
+	}{
+		{id: "head", want: head},
+		{id: "finalized", want: head},
+		{id: "32", want: head},
+		{id: "31", want: prev},
+		{id: common.Root{32}.String(), want: head},
+		{id: common.Root{31}.String(), want: prev},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			var fork map[string]string
+			node.getData(t, "/eth/v1/beacon/states/"+tt.id+"/fork", &fork)
+			require.Equal(t, map[string]string{
+				"previous_version": tt.want.PreviousVersion.String(),
+				"current_version":  tt.want.CurrentVersion.String(),
+				"epoch":            strconv.FormatUint(tt.want.Epoch.Unwrap(), 10),
+			}, fork)
+		})
+	}
+}
+
+func TestGetFinalityCheckpoints(t *testing.T) {
+	node := newTestNode(t)
+	roots := node.setStates(t, &types.Fork{}, &types.Fork{})
+
+	checkpoint := func(epoch string, root common.Root) map[string]string {
+		return map[string]string{"epoch": epoch, "root": root.String()}
+	}
+	tests := []struct {
+		id   string
+		want map[string]map[string]string
+	}{
+		{
+			id: "head",
+			want: map[string]map[string]string{
+				"previous_justified": checkpoint("0", common.Root{0xaa}),
+				"current_justified":  checkpoint("1", roots[32]),
+				"finalized":          checkpoint("1", roots[32]),
+			},
+		},
+		{
+			id: common.Root{31}.String(),
+			want: map[string]map[string]string{
+				"previous_justified": checkpoint("0", common.Root{0xaa}),
+				"current_justified":  checkpoint("0", roots[31]),
+				"finalized":          checkpoint("0", roots[31]),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			var checkpoints map[string]map[string]string
+			node.getData(
+				t,
+				"/eth/v1/beacon/states/"+tt.id+"/finality_checkpoints",
+				&checkpoints,
+			)
+			require.Equal(t, tt.want, checkpoints)
+		})
+	}
+}
+
+func TestGetStateByRoot_Errors(t *testing.T) {
+	node := newTestNode(t)
+	node.setStates(t, &types.Fork{}, &types.Fork{})
+
+	tests := []struct {
+		id   string
+		code int
+	}{
+		{id: common.Root{0xff}.String(), code: http.StatusNotFound},
+		{id: "0x01", code: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		for _, endpoint := range []string{"fork", "finality_checkpoints"} {
+			t.Run(tt.id+"/"+endpoint, func(t *testing.T) {
+				node.requireError(
+					t, "/eth/v1/beacon/states/"+tt.id+"/"+endpoint, tt.code,
+				)
+			})
+		}
+	}
+}
+
+func TestGetStateByRoot_Genesis(t *testing.T) {
+	node := newTestNode(t)
+	blk := newTestBlock(t, 1)
+	blk.SetStateRoot(common.Root{1})
+	require.NoError(t, node.blocks.Set(blk))
+	node.setHead(t, 1, time.Now())
+	require.NoError(t, node.kv.SetFork(&types.Fork{}))
+	require.NoError(t, node.kv.UpdateStateRootAtIndex(0, common.Root{0}))
+	node.ready()
+
+	// The root of the genesis state is in the history of the state of slot
+	// 1, but the genesis state is not available.
+	node.requireError(
+		t,
+		"/eth/v1/beacon/states/"+common.Root{0}.String()+"/fork",
+		http.StatusNotFound,
+	)
+	status, body := node.get(
+		t, "/eth/v1/beacon/states/"+common.Root{1}.String()+"/fork",
+	)
+	require.Equal(t, http.StatusOK, status, string(body))
+}
@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi
+
+import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// versionNames are the names of the fork versions in the node API.
+//
+//nolint:gochecknoglobals // lookup table.
+var versionNames = map[uint32]string{
+	version.Phase0:    "phase0",
+	version.Altair:    "altair",
+	version.Bellatrix: "bellatrix",
+	version.Capella:   "capella",
+	version.Deneb:     "deneb",
+	version.Electra:   "electra",
+}
+
+// versionName returns the name of the fork version v.
+func versionName(v uint32) string {
+	if name, ok := versionNames[v]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// BeaconBlockBody is the body of the blocks served by the node API.
+type BeaconBlockBody interface {
+	types.RawBeaconBlockBody
+}
+
+// BeaconBlock is a block served by the node API.
+type BeaconBlock[BeaconBlockBodyT BeaconBlockBody] interface {
+	types.RawBeaconBlock[BeaconBlockBodyT]
+}
+
+// SignedBeaconBlock is a block in the node API encoding. Blocks are not
+// signed by their proposer on the beacon chain of the node, the signature is
+// always zero.
+type SignedBeaconBlock struct {
+	Message   BeaconBlockMessage  `json:"message"`
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// BeaconBlockMessage is the message of a signed block.
+type BeaconBlockMessage struct {
+	Slot          decimal              `json:"slot"`
+	ProposerIndex decimal              `json:"proposer_index"`
+	ParentRoot    common.Root          `json:"parent_root"`
+	StateRoot     common.Root          `json:"state_root"`
+	Body          BeaconBlockBodyValue `json:"body"`
+}
+
+// BeaconBlockBodyValue is the body of a block.
+type BeaconBlockBodyValue struct {
+	RandaoReveal       crypto.BLSSignature     `json:"randao_reveal"`
+	Eth1Data           Eth1Data                `json:"eth1_data"`
+	Graffiti           bytes.B32               `json:"graffiti"`
+	ProposerSlashings  []ProposerSlashing      `json:"proposer_slashings"`
+	Deposits           []Deposit               `json:"deposits"`
+	ExecutionPayload   ExecutionPayload        `json:"execution_payload"`
+	BlobKZGCommitments []eip4844.KZGCommitment `json:"blob_kzg_commitments"`
+}
+
+// Eth1Data is the eth1 data of a block.
+type Eth1Data struct {
+	DepositRoot  common.Root          `json:"deposit_root"`
+	DepositCount decimal              `json:"deposit_count"`
+	BlockHash    common.ExecutionHash `json:"block_hash"`
+}
+
+// ProposerSlashing is a proposer slashing of a block.
+type ProposerSlashing struct {
+	SignedHeader1 SignedBeaconBlockHeader `json:"signed_header_1"`
+	SignedHeader2 SignedBeaconBlockHeader `json:"signed_header_2"`
+}
+
+// Deposit is a deposit of a block.
+type Deposit struct {
+	Pubkey                crypto.BLSPubkey    `json:"pubkey"`
+	WithdrawalCredentials bytes.B32           `json:"withdrawal_credentials"`
+	Amount                decimal             `json:"amount"`
+	Signature             crypto.BLSSignature `json:"signature"`
+	Index                 decimal             `json:"index"`
+}
+
+// ExecutionPayload is the execution payload of a block.
+type ExecutionPayload struct {
+	ParentHash    common.ExecutionHash    `json:"parent_hash"`
+	FeeRecipient  common.ExecutionAddress `json:"fee_recipient"`
+	StateRoot     bytes.B32               `json:"state_root"`
+	ReceiptsRoot  bytes.B32               `json:"receipts_root"`
+	LogsBloom     bytes.Bytes             `json:"logs_bloom"`
+	PrevRandao    bytes.B32               `json:"prev_randao"`
+	BlockNumber   decimal                 `json:"block_number"`
+	GasLimit      decimal                 `json:"gas_limit"`
+	GasUsed       decimal                 `json:"gas_used"`
+	Timestamp     decimal                 `json:"timestamp"`
+	ExtraData     bytes.Bytes             `json:"extra_data"`
+	BaseFeePerGas string                  `json:"base_fee_per_gas"`
+	BlockHash     common.ExecutionHash    `json:"block_hash"`
+	Transactions  []bytes.Bytes           `json:"transactions"`
+	Withdrawals   []Withdrawal            `json:"withdrawals"`
+	BlobGasUsed   decimal                 `json:"blob_gas_used"`
+	ExcessBlobGas decimal                 `json:"excess_blob_gas"`
+}
+
+// Withdrawal is a withdrawal of an execution payload.
+type Withdrawal struct {
+	Index          decimal                 `json:"index"`
+	ValidatorIndex decimal                 `json:"validator_index"`
+	Address        common.ExecutionAddress `json:"address"`
+	Amount         decimal                 `json:"amount"`
+}
+
+// newSignedBeaconBlock returns blk in the node API encoding.
+func newSignedBeaconBlock[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody,
+](blk BeaconBlockT) SignedBeaconBlock {
+	body := blk.GetBody()
+	return SignedBeaconBlock{
+		Message: BeaconBlockMessage{
+			Slot:          decimal(blk.GetSlot()),
+			ProposerIndex: decimal(blk.GetProposerIndex()),
+			ParentRoot:    blk.GetParentBlockRoot(),
+			StateRoot:     blk.GetStateRoot(),
+			Body: BeaconBlockBodyValue{
+				RandaoReveal: body.GetRandaoReveal(),
+				Eth1Data:     newEth1Data(body.GetEth1Data()),
+				Graffiti:     body.GetGraffiti(),
+				ProposerSlashings: newProposerSlashings(
+					body.GetProposerSlashings(),
+				),
+				Deposits:         newDeposits(body.GetDeposits()),
+				ExecutionPayload: newExecutionPayload(body.GetExecutionPayload()),
+				BlobKZGCommitments: append(
+					[]eip4844.KZGCommitment{}, body.GetBlobKzgCommitments()...,
+				),
+			},
+		},
+	}
+}
+
+// newEth1Data returns data in the node API encoding.
+func newEth1Data(data *types.Eth1Data) Eth1Data {
+	if data == nil {
+		return Eth1Data{}
+	}
+	return Eth1Data{
+		DepositRoot:  data.DepositRoot,
+		DepositCount: decimal(data.DepositCount),
+		BlockHash:    data.BlockHash,
+	}
+}
+
+// newProposerSlashings returns slashings in the node API encoding.
+func newProposerSlashings(
+	slashings []*types.ProposerSlashing,
+) []ProposerSlashing {
+	res := make([]ProposerSlashing, 0, len(slashings))
+	for _, slashing := range slashings {
+		res = append(res, ProposerSlashing{
+			SignedHeader1: newSignedBeaconBlockHeader(slashing.SignedHeader1),
+			SignedHeader2: newSignedBeaconBlockHeader(slashing.SignedHeader2),
+		})
+	}
+	return res
+}
+
+// newSignedBeaconBlockHeader returns signed in the node API encoding.
+func newSignedBeaconBlockHeader(
+	signed *types.SignedBeaconBlockHeader,
+) SignedBeaconBlockHeader {
+	if signed == nil {
+		return SignedBeaconBlockHeader{}
+	}
+	res := SignedBeaconBlockHeader{Signature: signed.Signature}
+	if signed.Header != nil {
+		res.Message = newBeaconBlockHeader(signed.Header)
+	}
+	return res
+}
+
+// newBeaconBlockHeader returns header in the node API encoding.
+func newBeaconBlockHeader(header *types.BeaconBlockHeader) BeaconBlockHeader {
+	return BeaconBlockHeader{
+		Slot:          decimal(header.Slot),
+		ProposerIndex: decimal(header.ProposerIndex),
+		ParentRoot:    header.ParentBlockRoot,
+		StateRoot:     header.StateRoot,
+		BodyRoot:      header.BodyRoot,
+	}
+}
+
+// newDeposits returns deposits in the node API encoding.
+func newDeposits(deposits []*types.Deposit) []Deposit {
+	res := make([]Deposit, 0, len(deposits))
+	for _, deposit := range deposits {
+		res = append(res, Deposit{
+			Pubkey:                deposit.Pubkey,
+			WithdrawalCredentials: bytes.B32(deposit.Credentials),
+			Amount:                decimal(deposit.Amount),
+			Signature:             deposit.Signature,
+			Index:                 decimal(deposit.Index),
+		})
+	}
+	return res
+}
+
+// newExecutionPayload returns payload in the node API encoding.
+func newExecutionPayload(payload *types.ExecutionPayload) ExecutionPayload {
+	if payload == nil || payload.InnerExecutionPayload == nil ||
+		payload.IsNil() {
+		return ExecutionPayload{}
+	}
+	txs := make([]bytes.Bytes, 0, len(payload.GetTransactions()))
+	for _, tx := range payload.GetTransactions() {
+		txs = append(txs, tx)
+	}
+	withdrawals := make([]Withdrawal, 0, len(payload.GetWithdrawals()))
+	for _, withdrawal := range payload.GetWithdrawals() {
+		withdrawals = append(withdrawals, Withdrawal{
+			Index:          decimal(withdrawal.Index),
+			ValidatorIndex: decimal(withdrawal.Validator),
+			Address:        withdrawal.Address,
+			Amount:         decimal(withdrawal.Amount),
+		})
+	}
+	return ExecutionPayload{
+		ParentHash:    payload.GetParentHash(),
+		FeeRecipient:  payload.GetFeeRecipient(),
+		StateRoot:     payload.GetStateRoot(),
+		ReceiptsRoot:  payload.GetReceiptsRoot(),
+		LogsBloom:     payload.GetLogsBloom(),
+		PrevRandao:    payload.GetPrevRandao(),
+		BlockNumber:   decimal(payload.GetNumber()),
+		GasLimit:      decimal(payload.GetGasLimit()),
+		GasUsed:       decimal(payload.GetGasUsed()),
+		Timestamp:     decimal(payload.GetTimestamp()),
+		ExtraData:     payload.GetExtraData(),
+		BaseFeePerGas: payload.GetBaseFeePerGas().UnwrapBig().String(),
+		BlockHash:     payload.GetBlockHash(),
+		Transactions:  txs,
+		Withdrawals:   withdrawals,
+		BlobGasUsed:   decimal(payload.GetBlobGasUsed()),
+		ExcessBlobGas: decimal(payload.GetExcessBlobGas()),
+	}
+}
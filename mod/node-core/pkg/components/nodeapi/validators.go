@@ -0,0 +1,302 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/labstack/echo/v4"
+)
+
+// maxValidatorsPerPage is the largest number of validators returned by a
+// request, which bounds the size of a response to a few megabytes.
+const maxValidatorsPerPage = 10_000
+
+// validatorStatuses are the statuses validators are filtered by, each
+// general status matching the statuses it prefixes.
+//
+//nolint:gochecknoglobals // lookup table.
+var validatorStatuses = map[string]bool{
+	types.ValidatorStatusPendingInitialized: true,
+	types.ValidatorStatusPendingQueued:      true,
+	types.ValidatorStatusActiveOngoing:      true,
+	types.ValidatorStatusActiveExiting:      true,
+	types.ValidatorStatusActiveSlashed:      true,
+	types.ValidatorStatusExitedUnslashed:    true,
+	types.ValidatorStatusExitedSlashed:      true,
+	types.ValidatorStatusWithdrawalPossible: true,
+	types.ValidatorStatusWithdrawalDone:     true,
+	"pending":                               true,
+	"active":                                true,
+	"exited":                                true,
+	"withdrawal":                            true,
+}
+
+// ValidatorData is a validator of a state with its balance and status.
+type ValidatorData struct {
+	Index     decimal   `json:"index"`
+	Balance   decimal   `json:"balance"`
+	Status    string    `json:"status"`
+	Validator Validator `json:"validator"`
+}
+
+// Validator is a validator in the node API encoding.
+//
+//nolint:lll // struct tags.
+type Validator struct {
+	Pubkey                     crypto.BLSPubkey `json:"pubkey"`
+	WithdrawalCredentials      bytes.B32        `json:"withdrawal_credentials"`
+	EffectiveBalance           decimal          `json:"effective_balance"`
+	Slashed                    bool             `json:"slashed"`
+	ActivationEligibilityEpoch decimal          `json:"activation_eligibility_epoch"`
+	ActivationEpoch            decimal          `json:"activation_epoch"`
+	ExitEpoch                  decimal          `json:"exit_epoch"`
+	WithdrawableEpoch          decimal          `json:"withdrawable_epoch"`
+}
+
+// validatorsResponse is the response of a validators request. The
+// validators are paginated, NextPageToken is set to the token of the next
+// page if there is one.
+type validatorsResponse struct {
+	ExecutionOptimistic bool            `json:"execution_optimistic"`
+	Finalized           bool            `json:"finalized"`
+	Data                []ValidatorData `json:"data"`
+	NextPageToken       string          `json:"next_page_token,omitempty"`
+}
+
+// validatorsQuery are the filters and the page of a validators request.
+type validatorsQuery struct {
+	// indices are the indices of the requested validators, in ascending
+	// order, all validators if nil.
+	indices []math.ValidatorIndex
+	// pubkeys are the public keys of the requested validators.
+	pubkeys []crypto.BLSPubkey
+	// statuses are the requested statuses, all statuses if empty.
+	statuses []string
+	// start is the index validators are returned from.
+	start math.ValidatorIndex
+	// pageSize is the largest number of validators returned.
+	pageSize int
+}
+
+// parseValidatorsQuery parses the query of a validators request. Values of
+// the id and status filters may be repeated or separated by commas.
+func parseValidatorsQuery(r *http.Request) (*validatorsQuery, error) {
+	q := &validatorsQuery{pageSize: maxValidatorsPerPage}
+	for _, id := range queryValues(r, "id") {
+		if strings.HasPrefix(id, "0x") {
+			var pubkey crypto.BLSPubkey
+			if err := pubkey.UnmarshalText([]byte(id)); err != nil {
+				return nil, errors.Newf("invalid validator ID: %s", id)
+			}
+			q.pubkeys = append(q.pubkeys, pubkey)
+			continue
+		}
+		index, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			return nil, errors.Newf("invalid validator ID: %s", id)
+		}
+		q.indices = append(q.indices, math.ValidatorIndex(index))
+	}
+	for _, status := range queryValues(r, "status") {
+		if !validatorStatuses[status] {
+			return nil, errors.Newf("invalid validator status: %s", status)
+		}
+		q.statuses = append(q.statuses, status)
+	}
+	if size := r.URL.Query().Get("page_size"); size != "" {
+		pageSize, err := strconv.Atoi(size)
+		if err != nil || pageSize < 1 || pageSize > maxValidatorsPerPage {
+			return nil, errors.Newf(
+				"page_size must be between 1 and %d", maxValidatorsPerPage,
+			)
+		}
+		q.pageSize = pageSize
+	}
+	if token := r.URL.Query().Get("page_token"); token != "" {
+		start, err := strconv.ParseUint(token, 10, 64)
+		if err != nil {
+			return nil, errors.Newf("invalid page_token: %s", token)
+		}
+		q.start = math.ValidatorIndex(start)
+	}
+	return q, nil
+}
+
+// resolve replaces the public keys of q by the indices of the validators of
+// st, ignoring the unknown ones.
+func (q *validatorsQuery) resolve(st BeaconState) error {
+	if q.pubkeys == nil {
+		return nil
+	}
+	for _, pubkey := range q.pubkeys {
+		index, err := st.ValidatorIndexByPubkey(pubkey)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		q.indices = append(q.indices, index)
+	}
+	q.pubkeys = nil
+	// An empty set of indices requests no validators.
+	if q.indices == nil {
+		q.indices = []math.ValidatorIndex{}
+	}
+	return nil
+}
+
+// matches returns whether status is one of the requested statuses.
+func (q *validatorsQuery) matches(status string) bool {
+	if len(q.statuses) == 0 {
+		return true
+	}
+	for _, s := range q.statuses {
+		if status == s || strings.HasPrefix(status, s+"_") {
+			return true
+		}
+	}
+	return false
+}
+
+// GetStateValidators serves GET
+// /eth/v1/beacon/states/:state_id/validators.
+func (h *Handler) GetStateValidators(c echo.Context) error {
+	query, err := parseValidatorsQuery(c.Request())
+	if err != nil {
+		return badRequest(err.Error())
+	}
+	st, err := h.requestState(c)
+	if err != nil {
+		return err
+	}
+	resp, err := h.validators(st, query)
+	if err != nil {
+		return apiError(stateError(err), stateNotFound)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// validators returns the page of the validators of st requested by q.
+func (h *Handler) validators(
+	st BeaconState,
+	q *validatorsQuery,
+) (*validatorsResponse, error) {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	if err = q.resolve(st); err != nil {
+		return nil, err
+	}
+	var (
+		epoch = h.backend.ChainSpec().SlotToEpoch(slot)
+		resp  = &validatorsResponse{
+			Finalized: true,
+			Data:      []ValidatorData{},
+		}
+	)
+	// add adds the validator to the page if it matches q, or sets the token
+	// of the next page if the page is full.
+	add := func(
+		index math.ValidatorIndex, val *types.Validator,
+	) (bool, error) {
+		if index < q.start || !q.matches(val.Status(epoch)) {
+			return false, nil
+		}
+		if len(resp.Data) == q.pageSize {
+			resp.NextPageToken = strconv.FormatUint(uint64(index), 10)
+			return true, nil
+		}
+		balance, err := st.GetBalance(index)
+		if err != nil {
+			return false, err
+		}
+		resp.Data = append(resp.Data, newValidatorData(
+			index, balance, val.Status(epoch), val,
+		))
+		return false, nil
+	}
+
+	if q.indices == nil {
+		return resp, st.IterateValidators(add)
+	}
+	slices.Sort(q.indices)
+	for _, index := range slices.Compact(q.indices) {
+		val, err := st.ValidatorByIndex(index)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if stop, err := add(index, val); err != nil || stop {
+			return resp, err
+		}
+	}
+	return resp, nil
+}
+
+// newValidatorData returns val in the node API encoding.
+func newValidatorData(
+	index math.ValidatorIndex,
+	balance math.Gwei,
+	status string,
+	val *types.Validator,
+) ValidatorData {
+	return ValidatorData{
+		Index:   decimal(index),
+		Balance: decimal(balance),
+		Status:  status,
+		Validator: Validator{
+			Pubkey:                     val.Pubkey,
+			WithdrawalCredentials:      bytes.B32(val.WithdrawalCredentials),
+			EffectiveBalance:           decimal(val.EffectiveBalance),
+			Slashed:                    val.Slashed,
+			ActivationEligibilityEpoch: decimal(val.ActivationEligibilityEpoch),
+			ActivationEpoch:            decimal(val.ActivationEpoch),
+			ExitEpoch:                  decimal(val.ExitEpoch),
+			WithdrawableEpoch:          decimal(val.WithdrawableEpoch),
+		},
+	}
+}
+
+// queryValues returns the values of the query parameter key, split at
+// commas.
+func queryValues(r *http.Request, key string) []string {
+	var values []string
+	for _, value := range r.URL.Query()[key] {
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
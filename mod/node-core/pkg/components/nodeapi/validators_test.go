@@ -0,0 +1,250 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodeapi_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+const farFuture = math.Epoch(constants.FarFutureEpoch)
+
+// testValidators are validators of each status at epoch 10, in the order
+// of their index.
+//
+//nolint:gochecknoglobals // test data.
+var testValidators = []struct {
+	status string
+	val    types.Validator
+}{
+	{types.ValidatorStatusPendingInitialized, types.Validator{
+		ActivationEligibilityEpoch: farFuture, ActivationEpoch: farFuture,
+		ExitEpoch: farFuture, WithdrawableEpoch: farFuture,
+	}},
+	{types.ValidatorStatusPendingQueued, types.Validator{
+		ActivationEligibilityEpoch: 9, ActivationEpoch: farFuture,
+		ExitEpoch: farFuture, WithdrawableEpoch: farFuture,
+	}},
+	{types.ValidatorStatusActiveOngoing, types.Validator{
+		ActivationEpoch: 1, ExitEpoch: farFuture, WithdrawableEpoch: farFuture,
+	}},
+	{types.ValidatorStatusActiveExiting, types.Validator{
+		ActivationEpoch: 1, ExitEpoch: 12, WithdrawableEpoch: 20,
+	}},
+	{types.ValidatorStatusActiveSlashed, types.Validator{
+		Slashed: true, ActivationEpoch: 1, ExitEpoch: 12, WithdrawableEpoch: 20,
+	}},
+	{types.ValidatorStatusExitedUnslashed, types.Validator{
+		ActivationEpoch: 1, ExitEpoch: 5, WithdrawableEpoch: 20,
+	}},
+	{types.ValidatorStatusExitedSlashed, types.Validator{
+		Slashed: true, ActivationEpoch: 1, ExitEpoch: 5, WithdrawableEpoch: 20,
+	}},
+	{types.ValidatorStatusWithdrawalPossible, types.Validator{
+		ActivationEpoch: 1, ExitEpoch: 5, WithdrawableEpoch: 8,
+	}},
+	{types.ValidatorStatusWithdrawalDone, types.Validator{
+		ActivationEpoch: 1, ExitEpoch: 5, WithdrawableEpoch: 8,
+	}},
+}
+
+// testPubkey returns the public key of the test validator at index.
+func testPubkey(index int) crypto.BLSPubkey {
+	return crypto.BLSPubkey{byte(index + 1)}
+}
+
+// setValidators adds the test validators to the state at epoch 10. The
+// validators with the withdrawal_done status have no balance left.
+func (n *testNode) setValidators(t *testing.T) {
+	t.Helper()
+	n.setHead(t, 10*32, time.Now())
+	for i, tv := range testValidators {
+		val := tv.val
+		val.Pubkey = testPubkey(i)
+		if tv.status != types.ValidatorStatusWithdrawalDone {
+			val.EffectiveBalance = 32e9
+		}
+		require.NoError(t, n.kv.AddValidator(&val))
+	}
+}
+
+// validatorsPage is the response to a validators request.
+type validatorsPage struct {
+	Finalized bool `json:"finalized"`
+	Data      []struct {
+		Index     string `json:"index"`
+		Balance   string `json:"balance"`
+		Status    string `json:"status"`
+		Validator struct {
+			Pubkey  string `json:"pubkey"`
+			Slashed bool   `json:"slashed"`
+		} `json:"validator"`
+	} `json:"data"`
+	NextPageToken string `json:"next_page_token"`
+}
+
+// indices returns the indices of the validators of p.
+func (p *validatorsPage) indices() []string {
+	indices := make([]string, 0, len(p.Data))
+	for _, d := range p.Data {
+		indices = append(indices, d.Index)
+	}
+	return indices
+}
+
+func TestGetValidators_Status(t *testing.T) {
+	node := newTestNode(t)
+	node.setValidators(t)
+	node.ready()
+
+	var page validatorsPage
+	node.getJSON(t, "/eth/v1/beacon/states/head/validators", &page)
+	require.True(t, page.Finalized)
+	require.Empty(t, page.NextPageToken)
+	require.Len(t, page.Data, len(testValidators))
+	for i, tv := range testValidators {
+		require.Equal(t, tv.status, page.Data[i].Status)
+		require.Equal(t, testPubkey(i).String(), page.Data[i].Validator.Pubkey)
+		require.Equal(t, tv.val.Slashed, page.Data[i].Validator.Slashed)
+	}
+	require.Equal(t, "32000000000", page.Data[2].Balance)
+}
+
+func TestGetValidators_Filters(t *testing.T) {
+	node := newTestNode(t)
+	node.setValidators(t)
+	node.ready()
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{
+			name:  "Index",
+			query: "id=2&id=0",
+			want:  []string{"0", "2"},
+		},
+		{
+			name:  "Pubkey",
+			query: "id=" + testPubkey(3).String(),
+			want:  []string{"3"},
+		},
+		{
+			name:  "IndexAndPubkey",
+			query: "id=4," + testPubkey(1).String() + ",4",
+			want:  []string{"1", "4"},
+		},
+		{
+			name:  "Unknown",
+			query: "id=100&id=" + testPubkey(100).String(),
+			want:  []string{},
+		},
+		{
+			name:  "Status",
+			query: "status=active_ongoing,withdrawal_done",
+			want:  []string{"2", "8"},
+		},
+		{
+			name:  "GeneralStatus",
+			query: "status=exited",
+			want:  []string{"5", "6"},
+		},
+		{
+			name:  "IndexAndStatus",
+			query: "id=0,1,2&status=pending",
+			want:  []string{"0", "1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var page validatorsPage
+			node.getJSON(
+				t, "/eth/v1/beacon/states/head/validators?"+tt.query, &page,
+			)
+			require.Equal(t, tt.want, page.indices())
+		})
+	}
+}
+
+func TestGetValidators_Pages(t *testing.T) {
+	node := newTestNode(t)
+	node.setValidators(t)
+	node.ready()
+
+	var (
+		indices []string
+		token   = "0"
+	)
+	for token != "" {
+		var page validatorsPage
+		node.getJSON(
+			t,
+			"/eth/v1/beacon/states/head/validators?status=active,exited"+
+				"&page_size=2&page_token="+token,
+			&page,
+		)
+		require.LessOrEqual(t, len(page.Data), 2)
+		indices = append(indices, page.indices()...)
+		token = page.NextPageToken
+	}
+	require.Equal(t, []string{"2", "3", "4", "5", "6"}, indices)
+}
+
+func TestGetValidators_Errors(t *testing.T) {
+	node := newTestNode(t)
+	node.setValidators(t)
+
+	// The state is not readable yet.
+	node.requireError(
+		t, "/eth/v1/beacon/states/head/validators",
+		http.StatusServiceUnavailable,
+	)
+
+	node.ready()
+	tests := []struct {
+		name string
+		path string
+		code int
+	}{
+		{"Genesis", "genesis/validators", http.StatusNotFound},
+		{"SlotZero", "0/validators", http.StatusNotFound},
+		{"InvalidState", "latest/validators", http.StatusBadRequest},
+		{"InvalidStateRoot", "0x01/validators", http.StatusBadRequest},
+		{"InvalidID", "head/validators?id=one", http.StatusBadRequest},
+		{"InvalidPubkey", "head/validators?id=0x01", http.StatusBadRequest},
+		{"InvalidStatus", "head/validators?status=live", http.StatusBadRequest},
+		{"PageSize", "head/validators?page_size=0", http.StatusBadRequest},
+		{"PageToken", "head/validators?page_token=x", http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node.requireError(t, "/eth/v1/beacon/states/"+tt.path, tt.code)
+		})
+	}
+}
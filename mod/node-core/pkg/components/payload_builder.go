@@ -26,10 +26,13 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	execution "github.com/berachain/beacon-kit/mod/execution/pkg/engine"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
 	payloadbuilder "github.com/berachain/beacon-kit/mod/payload/pkg/builder"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/cache"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -39,13 +42,26 @@ type LocalBuilderInput struct {
 	ChainSpec       primitives.ChainSpec
 	Logger          log.Logger
 	ExecutionEngine *execution.Engine[*types.ExecutionPayload]
+	EventBus        *feed.Bus
+	TelemetrySink   *metrics.TelemetrySink
 }
 
 func ProvideLocalBuilder(
 	in LocalBuilderInput,
-) *payloadbuilder.PayloadBuilder[
+) (*payloadbuilder.PayloadBuilder[
 	BeaconState, *types.ExecutionPayload, *types.ExecutionPayloadHeader,
-] {
+], error) {
+	payloadFeed, err := feed.TopicOf[*feed.Event[*events.PayloadBuiltEvent]](
+		in.EventBus, events.PayloadTopic,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !in.Cfg.PayloadBuilder.Enabled {
+		in.Logger.Info(
+			"local payload builder disabled, the node cannot propose blocks",
+		)
+	}
 	return payloadbuilder.New[
 		BeaconState, *types.ExecutionPayload, *types.ExecutionPayloadHeader,
 	](
@@ -54,5 +70,7 @@ func ProvideLocalBuilder(
 		in.Logger.With("service", "payload-builder"),
 		in.ExecutionEngine,
 		cache.NewPayloadIDCache[engineprimitives.PayloadID, [32]byte, math.Slot](),
-	)
+		payloadFeed,
+		in.TelemetrySink,
+	), nil
 }
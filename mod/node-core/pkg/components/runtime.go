@@ -32,7 +32,10 @@ import (
 	engineclient "github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	execution "github.com/berachain/beacon-kit/mod/execution/pkg/engine"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/diagnostics"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/health"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/nodeapi"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/version"
 	payloadbuilder "github.com/berachain/beacon-kit/mod/payload/pkg/builder"
@@ -43,6 +46,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/runtime"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	blockstore "github.com/berachain/beacon-kit/mod/storage/pkg/block"
 	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	sdkversion "github.com/cosmos/cosmos-sdk/version"
@@ -73,45 +77,56 @@ type BeaconKitRuntime = runtime.BeaconKitRuntime[
 	],
 ]
 
-// NewDefaultBeaconKitRuntime creates a new BeaconKitRuntime with the default
-// services.
+// ProvideRuntime creates a new BeaconKitRuntime with the default services
+// for the given availability store and beacon block types.
 //
 //nolint:funlen // bullish.
-func ProvideRuntime(
+func ProvideRuntime[
+	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT],
+	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody,
+](
 	cfg *config.Config,
 	blobProcessor *dablob.Processor[
-		*dastore.Store[*types.BeaconBlockBody],
-		*types.BeaconBlockBody,
+		AvailabilityStoreT,
+		BeaconBlockBodyT,
 	],
-	blockFeed *event.FeedOf[*feed.Event[*types.BeaconBlock]],
+	eventBus *feed.Bus,
+	blockFeed *feed.Topic[*feed.Event[BeaconBlockT]],
+	finalizedBlockFeed blockchain.FinalizedBlockFeed[BeaconBlockT],
+	blockStore *blockstore.KVStore[BeaconBlockT],
 	chainSpec primitives.ChainSpec,
 	dbManagerService *manager.DBManager[
-		*types.BeaconBlock,
-		*feed.Event[*types.BeaconBlock],
+		BeaconBlockT,
+		*feed.Event[BeaconBlockT],
 		event.Subscription,
 	],
 	depositService *deposit.Service[
-		*types.BeaconBlock,
-		*types.BeaconBlockBody,
-		*feed.Event[*types.BeaconBlock],
+		BeaconBlockT,
+		BeaconBlockBodyT,
+		*feed.Event[BeaconBlockT],
 		*types.Deposit,
 		*types.ExecutionPayload,
 		event.Subscription,
 		types.WithdrawalCredentials,
 	],
+	diagnosticsServer *diagnostics.Server,
+	healthServer *health.Server,
+	nodeAPIServer *nodeapi.Server,
+	nodeAPIAdminServer *nodeapi.Server,
 	signer crypto.BLSSigner,
 	engineClient *engineclient.EngineClient[*types.ExecutionPayload],
 	executionEngine *execution.Engine[*types.ExecutionPayload],
 	stateProcessor blockchain.StateProcessor[
-		*types.BeaconBlock,
+		BeaconBlockT,
 		BeaconState,
 		*datypes.BlobSidecars,
 		*transition.Context,
 		*types.Deposit,
 	],
 	storageBackend blockchain.StorageBackend[
-		*dastore.Store[*types.BeaconBlockBody],
-		*types.BeaconBlockBody,
+		AvailabilityStoreT,
+		BeaconBlockBodyT,
 		BeaconState,
 		*datypes.BlobSidecars,
 		*types.Deposit,
@@ -122,11 +137,30 @@ func ProvideRuntime(
 	],
 	telemetrySink *metrics.TelemetrySink,
 	logger log.Logger,
-) (*BeaconKitRuntime, error) {
+) (*runtime.BeaconKitRuntime[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconState,
+	*datypes.BlobSidecars,
+	*depositdb.KVStore[*types.Deposit],
+	blockchain.StorageBackend[
+		AvailabilityStoreT,
+		BeaconBlockBodyT,
+		BeaconState,
+		*datypes.BlobSidecars,
+		*types.Deposit,
+		*depositdb.KVStore[*types.Deposit],
+	],
+], error) {
+	// Build the forkchoice state shared by the validator and blockchain
+	// services.
+	forkchoiceState := blockchain.NewForkchoiceState()
+
 	// Build the builder service.
 	validatorService := validator.NewService[
-		*types.BeaconBlock,
-		*types.BeaconBlockBody,
+		BeaconBlockT,
+		BeaconBlockBodyT,
 		BeaconState,
 		*datypes.BlobSidecars,
 		*depositdb.KVStore[*types.Deposit],
@@ -140,25 +174,25 @@ func ProvideRuntime(
 		stateProcessor,
 		signer,
 		dablob.NewSidecarFactory[
-			*types.BeaconBlock,
-			*types.BeaconBlockBody,
+			BeaconBlockT,
+			BeaconBlockBodyT,
 		](
 			chainSpec,
-			types.KZGPositionDeneb,
 			telemetrySink,
 		),
 		localBuilder,
 		[]validator.PayloadBuilder[BeaconState, *types.ExecutionPayload]{
 			localBuilder,
 		},
+		forkchoiceState,
 		telemetrySink,
 	)
 
 	// Build the blockchain service.
 	chainService := blockchain.NewService[
-		*dastore.Store[*types.BeaconBlockBody],
-		*types.BeaconBlock,
-		*types.BeaconBlockBody,
+		AvailabilityStoreT,
+		BeaconBlockT,
+		BeaconBlockBodyT,
 		BeaconState,
 		*datypes.BlobSidecars,
 		*depositdb.KVStore[*types.Deposit],
@@ -168,39 +202,83 @@ func ProvideRuntime(
 		chainSpec,
 		executionEngine,
 		localBuilder,
+		forkchoiceState,
 		blobProcessor,
 		stateProcessor,
 		telemetrySink,
 		blockFeed,
+		finalizedBlockFeed,
 		// If optimistic is enabled, we want to skip post finalization FCUs.
 		cfg.Validator.EnableOptimisticPayloadBuilds,
 	)
-	// Build the service registry.
-	svcRegistry := service.NewRegistry(
+	// Build the service registry. Services are stopped in the reverse order
+	// of registration, so each service must be registered after the
+	// services it depends on.
+	svcOpts := []service.RegistryOption{
 		service.WithLogger(logger.With("service", "service-registry")),
-		service.WithService(validatorService),
-		service.WithService(chainService),
-		service.WithService(depositService),
+		service.WithStopTimeout(cfg.ShutdownTimeout),
+		// The subscriptions to the event bus are ended once the services
+		// publishing and consuming its events are stopped.
+		service.WithStopHook(eventBus.Close),
 		service.WithService(engineClient),
+		service.WithService(dbManagerService),
+		service.WithService(blockstore.NewService[
+			BeaconBlockT,
+			*feed.Event[BeaconBlockT],
+			event.Subscription,
+		](
+			logger.With("service", "block-store"),
+			blockStore,
+			blockFeed,
+		)),
+	}
+	// The deposit service is nil if it is disabled.
+	if depositService != nil {
+		svcOpts = append(svcOpts, service.WithService(depositService))
+	}
+	svcOpts = append(svcOpts,
+		service.WithService(chainService),
+		service.WithService(validatorService),
 		service.WithService(version.NewReportingService(
 			logger,
 			telemetrySink,
 			sdkversion.Version,
 		)),
-		service.WithService(dbManagerService),
 	)
+	if handler := telemetrySink.Handler(); handler != nil {
+		svcOpts = append(svcOpts, service.WithService(metrics.NewServer(
+			cfg.Telemetry.ListenAddress,
+			handler,
+			logger.With("service", "metrics-server"),
+		)))
+	}
+	if diagnosticsServer != nil {
+		svcOpts = append(svcOpts, service.WithService(diagnosticsServer))
+	}
+	if healthServer != nil {
+		svcOpts = append(svcOpts, service.WithService(healthServer))
+	}
+	if nodeAPIServer != nil {
+		svcOpts = append(svcOpts, service.WithService(nodeAPIServer))
+	}
+	if nodeAPIAdminServer != nil {
+		svcOpts = append(
+			svcOpts, service.WithService(nodeAPIAdminServer),
+		)
+	}
+	svcRegistry := service.NewRegistry(svcOpts...)
 
 	// Pass all the services and options into the BeaconKitRuntime.
 	return runtime.NewBeaconKitRuntime[
-		*dastore.Store[*types.BeaconBlockBody],
-		*types.BeaconBlock,
-		*types.BeaconBlockBody,
+		AvailabilityStoreT,
+		BeaconBlockT,
+		BeaconBlockBodyT,
 		BeaconState,
 		*datypes.BlobSidecars,
 		*depositdb.KVStore[*types.Deposit],
 		blockchain.StorageBackend[
-			*dastore.Store[*types.BeaconBlockBody],
-			*types.BeaconBlockBody,
+			AvailabilityStoreT,
+			BeaconBlockBodyT,
 			BeaconState,
 			*datypes.BlobSidecars,
 			*types.Deposit,
@@ -21,8 +21,17 @@
 package components
 
 import (
+	"context"
+	"path/filepath"
+
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer/audit"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer/remotesigner"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer/slashing"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	clientFlags "github.com/cosmos/cosmos-sdk/client/flags"
@@ -33,18 +42,91 @@ import (
 // BlsSignerInput is the input for the dep inject framework.
 type BlsSignerInput struct {
 	depinject.In
-	AppOpts servertypes.AppOptions
-	PrivKey LegacyKey `optional:"true"`
+	AppOpts       servertypes.AppOptions
+	Config        *config.Config `optional:"true"`
+	PrivKey       LegacyKey      `optional:"true"`
+	TelemetrySink *metrics.TelemetrySink
 }
 
 // type alias to LegacyKey used for LegacySinger construction.
 type LegacyKey = signer.LegacyKey
 
 // ProvideBlsSigner is a function that provides the module to the application.
+// The signer is checked to sign with its public key before it is provided,
+// its signatures made for a slot are checked against the slashing protection
+// store and its signing requests are audited, if enabled.
 func ProvideBlsSigner(in BlsSignerInput) (crypto.BLSSigner, error) {
+	blsSigner, err := newBlsSigner(in)
+	if err != nil {
+		return nil, err
+	}
+	if err = signer.SelfCheck(blsSigner); err != nil {
+		return nil, err
+	}
+	if in.Config == nil {
+		return blsSigner, nil
+	}
+
+	homeDir := cast.ToString(in.AppOpts.Get(clientFlags.FlagHome))
+	if in.Config.Signer.SlashingProtection {
+		var store *slashing.Store
+		store, err = slashing.Open(filepath.Join(
+			homeDir, signer.DefaultSlashingProtectionPath,
+		))
+		if err != nil {
+			return nil, err
+		}
+		if blsSigner, err = slashing.NewSigner(blsSigner, store); err != nil {
+			return nil, err
+		}
+	}
+	if !in.Config.Signer.Audit.Enabled {
+		return blsSigner, nil
+	}
+
+	auditCfg := in.Config.Signer.Audit
+	log, err := audit.OpenLog(auditCfg.LogPath(homeDir), auditCfg)
+	if err != nil {
+		return nil, err
+	}
+	auditSigner, err := audit.NewSigner(blsSigner, log, in.TelemetrySink)
+	if err != nil {
+		return nil, errors.Join(err, log.Close())
+	}
+	return auditSigner, nil
+}
+
+// ProvideBatchVerifier provides the verifier of batched and aggregated BLS
+// signatures.
+func ProvideBatchVerifier() crypto.BatchVerifier {
+	return signer.NewBatchVerifier()
+}
+
+// newBlsSigner creates the signer selected by the configuration.
+func newBlsSigner(in BlsSignerInput) (crypto.BLSSigner, error) {
+	homeDir := cast.ToString(in.AppOpts.Get(clientFlags.FlagHome))
+	if in.Config != nil {
+		switch signerType := in.Config.Signer.Type; signerType {
+		case "", signer.TypeLocal:
+		case signer.TypeWeb3Signer:
+			return remotesigner.New(
+				context.Background(), in.Config.Signer.Web3Signer,
+			)
+		case signer.TypeKeystore:
+			return signer.NewKeystoreSigner(
+				in.Config.Signer.Keystore, homeDir,
+			)
+		case signer.TypeMulti:
+			return signer.NewMultiSignerFromConfig(in.Config.Signer.Multi)
+		default:
+			return nil, errors.Wrapf(
+				signer.ErrUnsupportedSignerType, "%s", signerType,
+			)
+		}
+	}
+
 	if in.PrivKey == [constants.BLSSecretKeyLength]byte{} {
 		// if no private key is provided, use privval signer
-		homeDir := cast.ToString(in.AppOpts.Get(clientFlags.FlagHome))
 		return signer.NewBLSSigner(
 			homeDir+"/config/priv_validator_key.json",
 			homeDir+"/data/priv_validator_state.json",
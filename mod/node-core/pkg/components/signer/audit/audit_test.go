@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package audit_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer/audit"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// sink counts the signatures recorded per domain and result.
+type sink map[string]int
+
+func (s sink) IncrementCounter(_ string, args ...string) {
+	s[args[1]+"/"+args[3]]++
+}
+
+func (sink) MeasureSince(string, time.Time, ...string) {}
+
+// slotSigner is a signer refusing to sign twice for a slot.
+type slotSigner struct {
+	*mocks.BLSSigner
+	signed map[math.Slot]bool
+}
+
+var errSlashable = errors.New("slashable")
+
+func (s *slotSigner) SignForSlot(
+	slot math.Slot, signingRoot []byte,
+) (crypto.BLSSignature, error) {
+	if s.signed[slot] {
+		return crypto.BLSSignature{}, errSlashable
+	}
+	s.signed[slot] = true
+	return s.Sign(signingRoot)
+}
+
+func newTestSigner(
+	t *testing.T, path string, cfg audit.Config,
+) (*audit.Signer, sink) {
+	t.Helper()
+	m := mocks.NewBLSSigner(t)
+	m.EXPECT().PublicKey().Return(crypto.BLSPubkey{0x01}, nil)
+	m.EXPECT().Sign(mock.Anything).
+		Return(crypto.BLSSignature{0x02}, nil).Maybe()
+
+	log, err := audit.OpenLog(path, cfg)
+	require.NoError(t, err)
+	counters := sink{}
+	s, err := audit.NewSigner(&slotSigner{
+		BLSSigner: m, signed: make(map[math.Slot]bool),
+	}, log, counters)
+	require.NoError(t, err)
+	return s, counters
+}
+
+func readEntries(t *testing.T, path string) []audit.Entry {
+	t.Helper()
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	entries, err := audit.ReadEntries(bytes.NewReader(bz))
+	require.NoError(t, err)
+	return entries
+}
+
+func TestSigner(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	s, counters := newTestSigner(t, path, audit.DefaultConfig())
+
+	root := common.Root{0x03}
+	domain := common.Domain{0x04, 0x00, 0x00, 0x00, 0x05}
+	_, err := s.SignForSlot(7, root[:])
+	require.NoError(t, err)
+	_, err = s.SignForSlot(7, root[:])
+	require.ErrorIs(t, err, errSlashable)
+	_, err = s.SignInDomain(domain, root[:])
+	require.NoError(t, err)
+	_, err = s.Sign([]byte("not a signing root"))
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 4)
+	for _, entry := range entries {
+		require.Equal(t, crypto.BLSPubkey{0x01}, entry.Pubkey)
+	}
+
+	slot := math.Slot(7)
+	require.Equal(t, &slot, entries[0].Slot)
+	require.Equal(t, audit.ResultSigned, entries[0].Result)
+	require.Equal(t, root, common.Root(entries[0].SigningRoot))
+	require.Equal(t, audit.ResultRefused, entries[1].Result)
+	require.Equal(t, errSlashable.Error(), entries[1].Error)
+	require.Equal(t, &domain, entries[2].Domain)
+	require.Nil(t, entries[2].Slot)
+	require.True(t, entries[3].Redacted)
+	require.NotContains(t, readFile(t, path), "not a signing root")
+
+	require.Equal(t, sink{
+		"unknown/signed":    2,
+		"unknown/refused":   1,
+		"0x04000000/signed": 1,
+	}, counters)
+}
+
+func TestLog_ChainAcrossRestarts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	root := common.Root{0x03}
+
+	for range 2 {
+		s, _ := newTestSigner(t, path, audit.DefaultConfig())
+		_, err := s.Sign(root[:])
+		require.NoError(t, err)
+		require.NoError(t, s.Close())
+	}
+	require.Len(t, readEntries(t, path), 2)
+}
+
+func TestLog_Rotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	cfg := audit.DefaultConfig()
+	cfg.MaxSizeMB = 1
+	cfg.MaxBackups = 1
+	log, err := audit.OpenLog(path, cfg)
+	require.NoError(t, err)
+
+	// Append until the log is rotated, then once more.
+	for {
+		require.NoError(t, log.Append(audit.Entry{Result: audit.ResultSigned}))
+		if _, err = os.Stat(path + ".1"); err == nil {
+			break
+		}
+	}
+	require.NoError(t, log.Close())
+
+	rotated := readEntries(t, path+".1")
+	current := readEntries(t, path)
+	require.Len(t, current, 1)
+	lines := strings.Split(strings.TrimSpace(readFile(t, path+".1")), "\n")
+	require.Len(t, lines, len(rotated))
+	require.Equal(t,
+		sha256.Sum256([]byte(lines[len(lines)-1])),
+		[32]byte(current[0].Prev),
+	)
+}
+
+func TestReadEntries_Tampered(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	s, _ := newTestSigner(t, path, audit.DefaultConfig())
+	for range 3 {
+		//nolint:errcheck // signing for the slot again is refused.
+		s.SignForSlot(1, make([]byte, 32))
+	}
+	require.NoError(t, s.Close())
+
+	entries := readEntries(t, path)
+	require.Equal(t, audit.ResultRefused, entries[1].Result)
+	bz := bytes.Replace(
+		[]byte(readFile(t, path)), []byte(`"refused"`), []byte(`"signed"`), 1,
+	)
+	_, err := audit.ReadEntries(bytes.NewReader(bz))
+	require.ErrorIs(t, err, audit.ErrBrokenChain)
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(bz)
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package audit
+
+import (
+	"path/filepath"
+	"time"
+)
+
+const (
+	// DefaultPath is the path, relative to the home directory, of the audit
+	// log.
+	DefaultPath = "data/signing_audit.log"
+
+	defaultMaxSizeMB     = 100
+	defaultMaxBackups    = 10
+	defaultFlushInterval = time.Second
+)
+
+// Config is the configuration of the signing audit log.
+type Config struct {
+	// Enabled enables the audit log.
+	Enabled bool `mapstructure:"enabled"`
+	// Path is the path of the audit log. The log in the data directory is
+	// used if empty.
+	Path string `mapstructure:"path"`
+	// MaxSizeMB is the size in megabytes above which the log is rotated.
+	MaxSizeMB int64 `mapstructure:"max-size-mb"`
+	// MaxBackups is the number of rotated logs kept.
+	MaxBackups int `mapstructure:"max-backups"`
+	// FlushInterval is the interval at which the log is written and synced
+	// to disk. Entries not yet synced are lost if the node crashes.
+	FlushInterval time.Duration `mapstructure:"flush-interval"`
+}
+
+// DefaultConfig returns the default configuration of the audit log.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:       false,
+		MaxSizeMB:     defaultMaxSizeMB,
+		MaxBackups:    defaultMaxBackups,
+		FlushInterval: defaultFlushInterval,
+	}
+}
+
+// LogPath returns the path of the audit log for the given home directory.
+func (c Config) LogPath(homeDir string) string {
+	if c.Path != "" {
+		return c.Path
+	}
+	return filepath.Join(homeDir, DefaultPath)
+}
@@ -0,0 +1,287 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package audit
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// ResultSigned is the result of a signing request that was served.
+	ResultSigned = "signed"
+	// ResultRefused is the result of a signing request that failed or was
+	// refused, e.g. by the slashing protection.
+	ResultRefused = "refused"
+
+	bytesPerMB = 1 << 20
+)
+
+// Entry is a line of the audit log.
+type Entry struct {
+	// Time is the time of the signing request.
+	Time time.Time `json:"time"`
+	// Pubkey is the public key of the signer.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+	// SigningRoot is the signed root. Messages other than signing roots are
+	// not logged, their SHA-256 digest is logged instead.
+	SigningRoot bytes.B32 `json:"signing_root"`
+	// Redacted is set if SigningRoot is the digest of the message.
+	Redacted bool `json:"redacted,omitempty"`
+	// Domain is the domain of the signing root, if known.
+	Domain *common.Domain `json:"domain,omitempty"`
+	// Slot is the slot signed for, if known.
+	Slot *math.Slot `json:"slot,omitempty"`
+	// Result is either "signed" or "refused".
+	Result string `json:"result"`
+	// Error is the reason a signing request was refused.
+	Error string `json:"error,omitempty"`
+	// Prev is the SHA-256 digest of the previous line of the log, chaining
+	// the entries so that altering one of them is detectable.
+	Prev bytes.B32 `json:"prev"`
+}
+
+// Log is an append-only file of JSON entries, chained by digest. Entries are
+// buffered and written to disk every flush interval, and the file is rotated
+// once it grows past the configured size.
+type Log struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	mu   sync.Mutex
+	file *os.File
+	buf  *bufio.Writer
+	size int64
+	prev bytes.B32
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// OpenLog opens the audit log at the given path, continuing the chain of
+// its existing entries.
+func OpenLog(path string, cfg Config) (*Log, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+	l := &Log{
+		path:       path,
+		maxSize:    cfg.MaxSizeMB * bytesPerMB,
+		maxBackups: cfg.MaxBackups,
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	// The latest entry is in the rotated log if the log has just been
+	// rotated.
+	for _, p := range []string{path, backupPath(path, 1)} {
+		last, err := lastLine(p)
+		if err != nil {
+			return nil, err
+		}
+		if last != nil {
+			l.prev = sha256.Sum256(last)
+			break
+		}
+	}
+	if err := l.openFile(); err != nil {
+		return nil, err
+	}
+
+	go l.flushLoop(cfg.FlushInterval)
+	return l, nil
+}
+
+// Append appends an entry to the log, chaining it to the previous one.
+func (l *Log) Append(entry Entry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry.Prev = l.prev
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if l.maxSize > 0 && l.size > 0 &&
+		l.size+int64(len(bz))+1 > l.maxSize {
+		if err = l.rotate(); err != nil {
+			return err
+		}
+	}
+	if _, err = l.buf.Write(append(bz, '\n')); err != nil {
+		return err
+	}
+	l.size += int64(len(bz)) + 1
+	l.prev = sha256.Sum256(bz)
+	return nil
+}
+
+// Sync writes the buffered entries to disk.
+func (l *Log) Sync() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.sync()
+}
+
+// Close writes the buffered entries to disk and closes the log.
+func (l *Log) Close() error {
+	close(l.stop)
+	<-l.done
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return errors.Join(l.sync(), l.file.Close())
+}
+
+// flushLoop syncs the log every interval until it is closed.
+func (l *Log) flushLoop(interval time.Duration) {
+	defer close(l.done)
+	if interval <= 0 {
+		interval = defaultFlushInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+			//nolint:errcheck // retried on the next tick and on close.
+			l.Sync()
+		}
+	}
+}
+
+// sync writes the buffered entries to disk, it must be called with mu held.
+func (l *Log) sync() error {
+	if err := l.buf.Flush(); err != nil {
+		return err
+	}
+	return l.file.Sync()
+}
+
+// rotate moves the log to its first backup, shifting the older backups, and
+// starts a new log. It must be called with mu held.
+func (l *Log) rotate() error {
+	if err := errors.Join(l.sync(), l.file.Close()); err != nil {
+		return err
+	}
+	if l.maxBackups <= 0 {
+		if err := os.Remove(l.path); err != nil {
+			return err
+		}
+		return l.openFile()
+	}
+
+	for i := l.maxBackups - 1; i > 0; i-- {
+		err := os.Rename(backupPath(l.path, i), backupPath(l.path, i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(l.path, backupPath(l.path, 1)); err != nil {
+		return err
+	}
+	return l.openFile()
+}
+
+// openFile opens the log file for appending. It must be called with mu held
+// or before the log is shared.
+func (l *Log) openFile() error {
+	//#nosec:G304 // the path is set by the operator.
+	file, err := os.OpenFile(
+		l.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600,
+	)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return errors.Join(err, file.Close())
+	}
+	l.file = file
+	l.buf = bufio.NewWriter(file)
+	l.size = info.Size()
+	return nil
+}
+
+// ReadEntries reads the entries of an audit log and checks that each of
+// them chains to the previous one. The first entry may chain to an entry of
+// a rotated log, so it is not checked.
+func ReadEntries(r io.Reader) ([]Entry, error) {
+	var (
+		entries []Entry
+		prev    bytes.B32
+	)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, bytesPerMB)
+	for line := 1; scanner.Scan(); line++ {
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, errors.Wrapf(err, "line %d", line)
+		}
+		if line > 1 && entry.Prev != prev {
+			return nil, errors.Wrapf(ErrBrokenChain, "line %d", line)
+		}
+		entries = append(entries, entry)
+		prev = sha256.Sum256(scanner.Bytes())
+	}
+	return entries, scanner.Err()
+}
+
+// lastLine returns the last line of the file at the given path, or nil if
+// the file is missing or empty.
+func lastLine(path string) ([]byte, error) {
+	//#nosec:G304 // the path is set by the operator.
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var last []byte
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, bytesPerMB)
+	for scanner.Scan() {
+		last = append(last[:0], scanner.Bytes()...)
+	}
+	return last, scanner.Err()
+}
+
+// backupPath returns the path of the i-th rotated log.
+func backupPath(path string, i int) string {
+	return path + "." + strconv.Itoa(i)
+}
@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package audit
+
+import (
+	"crypto/sha256"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// unknownDomain labels the metrics of signatures of an unknown domain.
+const unknownDomain = "unknown"
+
+// slotSigner is a signer that signs for a given slot.
+type slotSigner interface {
+	SignForSlot(
+		slot math.Slot, signingRoot []byte,
+	) (crypto.BLSSignature, error)
+}
+
+// domainSigner is a signer that is told the domain it signs in.
+type domainSigner interface {
+	SignInDomain(
+		domain common.Domain, signingRoot []byte,
+	) (crypto.BLSSignature, error)
+}
+
+// Signer is a crypto.BLSSigner that records every signing request of the
+// signer it wraps to an audit log.
+type Signer struct {
+	crypto.BLSSigner
+	pubkey crypto.BLSPubkey
+	log    *Log
+	sink   TelemetrySink
+}
+
+// NewSigner wraps signer to record its signing requests to log.
+func NewSigner(
+	signer crypto.BLSSigner, log *Log, sink TelemetrySink,
+) (*Signer, error) {
+	pubkey, err := signer.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	return &Signer{
+		BLSSigner: signer,
+		pubkey:    pubkey,
+		log:       log,
+		sink:      sink,
+	}, nil
+}
+
+// Sign signs msg with the wrapped signer.
+func (s *Signer) Sign(msg []byte) (crypto.BLSSignature, error) {
+	return s.audit(msg, nil, nil, func() (crypto.BLSSignature, error) {
+		return s.BLSSigner.Sign(msg)
+	})
+}
+
+// SignForSlot signs the signing root of a message made for the given slot,
+// through the slashing protection of the wrapped signer if it has one.
+func (s *Signer) SignForSlot(
+	slot math.Slot, signingRoot []byte,
+) (crypto.BLSSignature, error) {
+	return s.audit(signingRoot, nil, &slot, func() (
+		crypto.BLSSignature, error,
+	) {
+		if signer, ok := s.BLSSigner.(slotSigner); ok {
+			return signer.SignForSlot(slot, signingRoot)
+		}
+		return s.BLSSigner.Sign(signingRoot)
+	})
+}
+
+// SignInDomain signs a signing root computed in the given domain.
+func (s *Signer) SignInDomain(
+	domain common.Domain, signingRoot []byte,
+) (crypto.BLSSignature, error) {
+	return s.audit(signingRoot, &domain, nil, func() (
+		crypto.BLSSignature, error,
+	) {
+		if signer, ok := s.BLSSigner.(domainSigner); ok {
+			return signer.SignInDomain(domain, signingRoot)
+		}
+		return s.BLSSigner.Sign(signingRoot)
+	})
+}
+
+// Close writes the pending entries of the audit log to disk.
+func (s *Signer) Close() error {
+	return s.log.Close()
+}
+
+// audit records the outcome of the signing request served by sign. The
+// signature is withheld if it cannot be recorded.
+func (s *Signer) audit(
+	msg []byte,
+	domain *common.Domain,
+	slot *math.Slot,
+	sign func() (crypto.BLSSignature, error),
+) (crypto.BLSSignature, error) {
+	start := time.Now()
+	signature, err := sign()
+
+	entry := Entry{
+		Time:   start.UTC(),
+		Pubkey: s.pubkey,
+		Domain: domain,
+		Slot:   slot,
+		Result: ResultSigned,
+	}
+	if len(msg) == len(entry.SigningRoot) {
+		entry.SigningRoot = bytes.B32(msg)
+	} else {
+		entry.SigningRoot = sha256.Sum256(msg)
+		entry.Redacted = true
+	}
+	if err != nil {
+		entry.Result = ResultRefused
+		entry.Error = err.Error()
+	}
+	s.markSign(start, domain, entry.Result)
+
+	if appendErr := s.log.Append(entry); appendErr != nil {
+		return crypto.BLSSignature{}, errors.Join(
+			err, errors.Wrap(appendErr, "failed to audit signing request"),
+		)
+	}
+	return signature, err
+}
+
+// markSign records the outcome of a signing request that started at the
+// given time.
+func (s *Signer) markSign(
+	start time.Time, domain *common.Domain, result string,
+) {
+	if s.sink == nil {
+		return
+	}
+	label := unknownDomain
+	if domain != nil {
+		label = common.DomainType(domain[:len(common.DomainType{})]).String()
+	}
+	s.sink.MeasureSince(
+		"beacon_kit.signer.sign_duration", start, "domain", label,
+	)
+	s.sink.IncrementCounter(
+		"beacon_kit.signer.signatures", "domain", label, "result", result,
+	)
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"crypto/rand"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+const (
+	// randBits is the number of bits of the random scalars weighting the
+	// signatures of a batch.
+	randBits = 64
+	// scalarLen is the length of a BLS scalar in bytes.
+	scalarLen = 32
+)
+
+// BatchVerifier is a crypto.BatchVerifier backed by blst.
+type BatchVerifier struct{}
+
+// NewBatchVerifier returns the batch verifier of the blst backend.
+func NewBatchVerifier() crypto.BatchVerifier {
+	return BatchVerifier{}
+}
+
+// VerifySignatureBatch verifies that sigs[i] is a signature of msgs[i] by
+// pubkeys[i] for every i, weighting each signature by a random scalar. If the
+// batch does not verify, the index of the first invalid signature is
+// reported.
+func (BatchVerifier) VerifySignatureBatch(
+	pubkeys [][48]byte, msgs [][]byte, sigs [][96]byte,
+) error {
+	n := len(pubkeys)
+	if n != len(msgs) || n != len(sigs) {
+		return crypto.ErrBatchLengthMismatch
+	}
+	if n == 0 {
+		return nil
+	}
+
+	pks, err := uncompressPubkeys(pubkeys)
+	if err != nil {
+		return err
+	}
+	blstSigs := make([]*blst.P2Affine, n)
+	blstMsgs := make([]blst.Message, n)
+	for i := range n {
+		if blstSigs[i] = new(blst.P2Affine).Uncompress(
+			sigs[i][:],
+		); blstSigs[i] == nil {
+			return errors.Wrapf(crypto.ErrInvalidSignature, "index %d", i)
+		}
+		blstMsgs[i] = msgs[i]
+	}
+
+	if new(blst.P2Affine).MultipleAggregateVerify(
+		blstSigs, true, pks, true, blstMsgs,
+		[]byte(crypto.BLSSignatureDST), randScalar, randBits,
+	) {
+		return nil
+	}
+	for i := range n {
+		if !blstSigs[i].Verify(
+			false, pks[i], false, blstMsgs[i],
+			[]byte(crypto.BLSSignatureDST),
+		) {
+			return errors.Wrapf(crypto.ErrInvalidSignature, "index %d", i)
+		}
+	}
+	return crypto.ErrInvalidSignature
+}
+
+// AggregateVerify verifies that sig is the aggregate of the signatures of
+// msgs[i] by pubkeys[i].
+func (BatchVerifier) AggregateVerify(
+	pubkeys [][48]byte, msgs [][]byte, sig [96]byte,
+) error {
+	n := len(pubkeys)
+	if n != len(msgs) {
+		return crypto.ErrBatchLengthMismatch
+	}
+	if n == 0 {
+		return crypto.ErrEmptyBatch
+	}
+
+	pks, err := uncompressPubkeys(pubkeys)
+	if err != nil {
+		return err
+	}
+	aggregate := new(blst.P2Affine).Uncompress(sig[:])
+	if aggregate == nil {
+		return crypto.ErrInvalidSignature
+	}
+	blstMsgs := make([]blst.Message, n)
+	for i := range n {
+		blstMsgs[i] = msgs[i]
+	}
+
+	if !aggregate.AggregateVerify(
+		true, pks, true, blstMsgs, []byte(crypto.BLSSignatureDST),
+	) {
+		return crypto.ErrInvalidSignature
+	}
+	return nil
+}
+
+// uncompressPubkeys decodes compressed public keys. Their validity is
+// checked during verification.
+func uncompressPubkeys(pubkeys [][48]byte) ([]*blst.P1Affine, error) {
+	pks := make([]*blst.P1Affine, len(pubkeys))
+	for i := range pubkeys {
+		if pks[i] = new(blst.P1Affine).Uncompress(
+			pubkeys[i][:],
+		); pks[i] == nil {
+			return nil, errors.Wrapf(crypto.ErrInvalidPubkey, "index %d", i)
+		}
+	}
+	return pks, nil
+}
+
+// randScalar sets the scalar to random bytes, of which blst only uses the
+// randBits lowest bits.
+func randScalar(scalar *blst.Scalar) {
+	var bz [scalarLen]byte
+	//nolint:errcheck // crypto/rand does not fail on supported platforms.
+	rand.Read(bz[:])
+	scalar.FromBEndian(bz[:])
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer/audit"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer/remotesigner"
+)
+
+const (
+	// TypeLocal signs with the key of the comet privval files.
+	TypeLocal = "local"
+	// TypeWeb3Signer signs with a key held by a remote Web3Signer.
+	TypeWeb3Signer = "web3signer"
+	// TypeKeystore signs with the key of an EIP-2335 keystore.
+	TypeKeystore = "keystore"
+	// TypeMulti signs with the keys of several validators.
+	TypeMulti = "multi"
+
+	// DefaultKeystorePath is the path, relative to the home directory, of
+	// the keystore imported by the keys import-keystore command.
+	DefaultKeystorePath = "config/validator_keystore.json"
+	// DefaultSlashingProtectionPath is the path, relative to the home
+	// directory, of the slashing protection store.
+	DefaultSlashingProtectionPath = "data/slashing_protection.json"
+)
+
+// Config is the configuration of the BLS signer.
+type Config struct {
+	// Type is the kind of signer. Options are "local", "web3signer",
+	// "keystore" or "multi".
+	Type string `mapstructure:"type"`
+	// Web3Signer is the configuration of the remote signer, it is only used
+	// by the "web3signer" type.
+	Web3Signer remotesigner.Config `mapstructure:"web3signer"`
+	// Keystore is the configuration of the keystore signer, it is only used
+	// by the "keystore" type.
+	Keystore KeystoreConfig `mapstructure:"keystore"`
+	// Multi is the configuration of the multi-key signer, it is only used by
+	// the "multi" type.
+	Multi MultiConfig `mapstructure:"multi"`
+	// SlashingProtection enables the slashing protection store, whatever
+	// the type of the signer.
+	SlashingProtection bool `mapstructure:"slashing-protection"`
+	// Audit is the configuration of the log of the signing requests.
+	Audit audit.Config `mapstructure:"audit"`
+}
+
+// KeystoreConfig is the configuration of the EIP-2335 keystore signer.
+type KeystoreConfig struct {
+	// Path is the path of the keystore. The imported keystore in the home
+	// directory is used if empty.
+	Path string `mapstructure:"path"`
+	// PasswordFile is the path of the file holding the keystore password.
+	// The password is prompted for if empty.
+	PasswordFile string `mapstructure:"password-file"`
+}
+
+// MultiConfig is the configuration of the multi-key signer.
+type MultiConfig struct {
+	// Keystores are the paths of the EIP-2335 keystores to load.
+	Keystores []string `mapstructure:"keystores"`
+	// PasswordFile is the path of the file holding the password shared by
+	// the keystores. The password is prompted for if empty.
+	PasswordFile string `mapstructure:"password-file"`
+	// PrivValidatorKeyFiles are the paths of the comet privval key files to
+	// load.
+	PrivValidatorKeyFiles []string `mapstructure:"priv-validator-key-files"`
+	// PrivValidatorStateFiles are the paths of the privval state files of
+	// each key file, in the same order.
+	PrivValidatorStateFiles []string `mapstructure:"priv-validator-state-files"`
+}
+
+// KeystorePath returns the path of the keystore for the given home
+// directory.
+func (c KeystoreConfig) KeystorePath(homeDir string) string {
+	if c.Path != "" {
+		return c.Path
+	}
+	return filepath.Join(homeDir, DefaultKeystorePath)
+}
+
+// DefaultConfig returns the default configuration of the BLS signer.
+func DefaultConfig() Config {
+	return Config{
+		Type:               TypeLocal,
+		Web3Signer:         remotesigner.DefaultConfig(),
+		SlashingProtection: true,
+		Audit:              audit.DefaultConfig(),
+	}
+}
@@ -37,4 +37,21 @@ var (
 	ErrInvalidValidatorPrivateKeyLength = errors.New(
 		"invalid validator private key length",
 	)
+	// ErrUnsupportedSignerType is returned when the configured signer type
+	// is unknown.
+	ErrUnsupportedSignerType = errors.New("unsupported signer type")
+	// ErrNoSigningKeys is returned when a multi-key signer is configured
+	// without any key.
+	ErrNoSigningKeys = errors.New("no signing keys")
+	// ErrDuplicateSigningKey is returned when a key is loaded twice by a
+	// multi-key signer.
+	ErrDuplicateSigningKey = errors.New("duplicate signing key")
+	// ErrUnknownSigningKey is returned when signing is requested for a
+	// public key the signer does not hold.
+	ErrUnknownSigningKey = errors.New("unknown signing key")
+	// ErrMismatchedPrivValidatorFiles is returned when the numbers of privval
+	// key and state files of a multi-key signer differ.
+	ErrMismatchedPrivValidatorFiles = errors.New(
+		"mismatched privval key and state files",
+	)
 )
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer/keystore"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+)
+
+// NewKeystoreSigner creates a signer from the key of the configured EIP-2335
+// keystore.
+func NewKeystoreSigner(
+	cfg KeystoreConfig, homeDir string,
+) (*LegacySigner, error) {
+	ks, err := keystore.Load(cfg.KeystorePath(homeDir))
+	if err != nil {
+		return nil, err
+	}
+	password, err := keystore.ReadPassword(cfg.PasswordFile)
+	if err != nil {
+		return nil, err
+	}
+	return SignerFromKeystore(ks, password)
+}
+
+// SignerFromKeystore decrypts the keystore with the given password and
+// creates a signer from its key. The key must match the public key of the
+// keystore, if it has one.
+func SignerFromKeystore(
+	ks *keystore.Keystore, password string,
+) (*LegacySigner, error) {
+	secret, err := ks.Decrypt(password)
+	if err != nil {
+		return nil, err
+	}
+	if len(secret) != constants.BLSSecretKeyLength {
+		return nil, ErrInvalidValidatorPrivateKeyLength
+	}
+	legacy, err := NewLegacySigner(LegacyKey(secret))
+	if err != nil {
+		return nil, err
+	}
+
+	if ks.Pubkey == "" {
+		return legacy, nil
+	}
+	pubkey, err := hex.DecodeString(ks.Pubkey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid keystore public key")
+	}
+	got, err := legacy.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(got[:], pubkey) {
+		return nil, errors.Wrapf(
+			keystore.ErrPubkeyMismatch, "got %x, want %s", got, ks.Pubkey,
+		)
+	}
+	return legacy, nil
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keystore
+
+import "errors"
+
+var (
+	// ErrUnsupportedVersion is returned when the keystore is not an EIP-2335
+	// version 4 keystore.
+	ErrUnsupportedVersion = errors.New("unsupported keystore version")
+	// ErrUnsupportedFunction is returned when the keystore uses a KDF,
+	// checksum or cipher that is not supported.
+	ErrUnsupportedFunction = errors.New("unsupported keystore function")
+	// ErrInvalidParams is returned when the parameters of a keystore
+	// function are invalid.
+	ErrInvalidParams = errors.New("invalid keystore params")
+	// ErrInvalidPassword is returned when the checksum of the keystore does
+	// not match the decryption key derived from the password.
+	ErrInvalidPassword = errors.New("invalid keystore password")
+	// ErrPubkeyMismatch is returned when the decrypted secret key does not
+	// match the public key of the keystore.
+	ErrPubkeyMismatch = errors.New("keystore public key mismatch")
+)
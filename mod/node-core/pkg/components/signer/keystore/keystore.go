@@ -0,0 +1,244 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// version is the EIP-2335 keystore version.
+	version = 4
+	// minKeyLen is the minimum length of the derived decryption key, whose
+	// first half is the cipher key and second half enters the checksum.
+	minKeyLen = 32
+	// cipherKeyLen is the length of the aes-128-ctr key.
+	cipherKeyLen = 16
+)
+
+// Keystore is an EIP-2335 keystore holding an encrypted BLS secret key.
+type Keystore struct {
+	Crypto      Crypto `json:"crypto"`
+	Description string `json:"description"`
+	Pubkey      string `json:"pubkey"`
+	Path        string `json:"path"`
+	UUID        string `json:"uuid"`
+	Version     uint   `json:"version"`
+}
+
+// Crypto holds the modules used to encrypt the secret key of a keystore.
+type Crypto struct {
+	KDF      Module `json:"kdf"`
+	Checksum Module `json:"checksum"`
+	Cipher   Module `json:"cipher"`
+}
+
+// Module is a function of a keystore along with its parameters and message.
+type Module struct {
+	Function string          `json:"function"`
+	Params   json.RawMessage `json:"params"`
+	Message  string          `json:"message"`
+}
+
+// scryptParams are the parameters of the scrypt KDF.
+type scryptParams struct {
+	DKLen int    `json:"dklen"`
+	N     int    `json:"n"`
+	P     int    `json:"p"`
+	R     int    `json:"r"`
+	Salt  string `json:"salt"`
+}
+
+// pbkdf2Params are the parameters of the pbkdf2 KDF.
+type pbkdf2Params struct {
+	DKLen int    `json:"dklen"`
+	C     int    `json:"c"`
+	PRF   string `json:"prf"`
+	Salt  string `json:"salt"`
+}
+
+// cipherParams are the parameters of the aes-128-ctr cipher.
+type cipherParams struct {
+	IV string `json:"iv"`
+}
+
+// Load reads the keystore at the given path.
+func Load(path string) (*Keystore, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(bz)
+}
+
+// Parse decodes a keystore from its JSON encoding.
+func Parse(bz []byte) (*Keystore, error) {
+	ks := new(Keystore)
+	if err := json.Unmarshal(bz, ks); err != nil {
+		return nil, errors.Wrap(err, "failed to decode keystore")
+	}
+	if ks.Version != version {
+		return nil, errors.Wrapf(ErrUnsupportedVersion, "%d", ks.Version)
+	}
+	return ks, nil
+}
+
+// Decrypt returns the secret key of the keystore. ErrInvalidPassword is
+// returned if the password does not match the checksum of the keystore.
+func (k *Keystore) Decrypt(password string) ([]byte, error) {
+	key, err := k.Crypto.deriveKey(normalizePassword(password))
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := hex.DecodeString(k.Crypto.Cipher.Message)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid cipher message")
+	}
+	if err = k.Crypto.verifyChecksum(key, message); err != nil {
+		return nil, err
+	}
+	return k.Crypto.decrypt(key, message)
+}
+
+// deriveKey derives the decryption key from the password.
+func (c *Crypto) deriveKey(password []byte) ([]byte, error) {
+	switch c.KDF.Function {
+	case "scrypt":
+		var params scryptParams
+		if err := json.Unmarshal(c.KDF.Params, &params); err != nil {
+			return nil, errors.Join(ErrInvalidParams, err)
+		}
+		salt, err := decodeSalt(params.Salt, params.DKLen)
+		if err != nil {
+			return nil, err
+		}
+		return scrypt.Key(
+			password, salt, params.N, params.R, params.P, params.DKLen,
+		)
+	case "pbkdf2":
+		var params pbkdf2Params
+		if err := json.Unmarshal(c.KDF.Params, &params); err != nil {
+			return nil, errors.Join(ErrInvalidParams, err)
+		}
+		if params.PRF != "hmac-sha256" {
+			return nil, errors.Wrapf(
+				ErrUnsupportedFunction, "prf %s", params.PRF,
+			)
+		}
+		if params.C < 1 {
+			return nil, errors.Wrapf(ErrInvalidParams, "c %d", params.C)
+		}
+		salt, err := decodeSalt(params.Salt, params.DKLen)
+		if err != nil {
+			return nil, err
+		}
+		return pbkdf2.Key(
+			password, salt, params.C, params.DKLen, sha256.New,
+		), nil
+	default:
+		return nil, errors.Wrapf(
+			ErrUnsupportedFunction, "kdf %s", c.KDF.Function,
+		)
+	}
+}
+
+// verifyChecksum checks the checksum of the keystore against the decryption
+// key and the cipher message.
+func (c *Crypto) verifyChecksum(key, message []byte) error {
+	if c.Checksum.Function != "sha256" {
+		return errors.Wrapf(
+			ErrUnsupportedFunction, "checksum %s", c.Checksum.Function,
+		)
+	}
+	checksum, err := hex.DecodeString(c.Checksum.Message)
+	if err != nil {
+		return errors.Wrap(err, "invalid checksum message")
+	}
+	sum := sha256.Sum256(slices.Concat(key[cipherKeyLen:minKeyLen], message))
+	if subtle.ConstantTimeCompare(sum[:], checksum) != 1 {
+		return ErrInvalidPassword
+	}
+	return nil
+}
+
+// decrypt decrypts the cipher message with the decryption key.
+func (c *Crypto) decrypt(key, message []byte) ([]byte, error) {
+	if c.Cipher.Function != "aes-128-ctr" {
+		return nil, errors.Wrapf(
+			ErrUnsupportedFunction, "cipher %s", c.Cipher.Function,
+		)
+	}
+	var params cipherParams
+	if err := json.Unmarshal(c.Cipher.Params, &params); err != nil {
+		return nil, errors.Join(ErrInvalidParams, err)
+	}
+	iv, err := hex.DecodeString(params.IV)
+	if err != nil || len(iv) != aes.BlockSize {
+		return nil, errors.Wrap(ErrInvalidParams, "iv")
+	}
+
+	block, err := aes.NewCipher(key[:cipherKeyLen])
+	if err != nil {
+		return nil, err
+	}
+	secret := make([]byte, len(message))
+	cipher.NewCTR(block, iv).XORKeyStream(secret, message)
+	return secret, nil
+}
+
+// decodeSalt checks the length of the derived key and decodes the salt of
+// a KDF.
+func decodeSalt(salt string, dkLen int) ([]byte, error) {
+	if dkLen < minKeyLen {
+		return nil, errors.Wrapf(ErrInvalidParams, "dklen %d", dkLen)
+	}
+	bz, err := hex.DecodeString(salt)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidParams, "salt")
+	}
+	return bz, nil
+}
+
+// normalizePassword applies the NFKD normalization of EIP-2335 to the
+// password and strips the control codes from it.
+func normalizePassword(password string) []byte {
+	return []byte(strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, norm.NFKD.String(password)))
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keystore_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer/keystore"
+	"github.com/stretchr/testify/require"
+)
+
+// The password and secret of the EIP-2335 test vectors in testdata.
+const (
+	testPassword = "𝔱𝔢𝔰𝔱𝔭𝔞𝔰𝔰𝔴𝔬𝔯𝔡🔑"
+	testSecret   = "000000000019d6689c085ae165831e934ff763ae46a2a6c1" +
+		"72b3f1b60a8ce26f"
+)
+
+func TestKeystore_Decrypt(t *testing.T) {
+	secret, err := hex.DecodeString(testSecret)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		file     string
+		tamper   bool
+		password string
+		wantErr  error
+	}{
+		{
+			name:     "Scrypt",
+			file:     "scrypt.json",
+			password: testPassword,
+		},
+		{
+			name:     "Pbkdf2",
+			file:     "pbkdf2.json",
+			password: testPassword,
+		},
+		{
+			name:     "ControlCodesStripped",
+			file:     "pbkdf2.json",
+			password: "\x7f" + testPassword + "\n",
+		},
+		{
+			name:     "WrongPassword",
+			file:     "pbkdf2.json",
+			password: "testpassword",
+			wantErr:  keystore.ErrInvalidPassword,
+		},
+		{
+			name:     "TamperedCipherMessage",
+			file:     "pbkdf2.json",
+			tamper:   true,
+			password: testPassword,
+			wantErr:  keystore.ErrInvalidPassword,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bz, err := os.ReadFile(filepath.Join("testdata", tt.file))
+			require.NoError(t, err)
+			if tt.tamper {
+				bz = bytes.Replace(bz, []byte(`"cee03fde`), []byte(`"cee03fdf`), 1)
+			}
+			ks, err := keystore.Parse(bz)
+			require.NoError(t, err)
+
+			got, err := ks.Decrypt(tt.password)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, secret, got)
+		})
+	}
+}
+
+func TestParse_UnsupportedVersion(t *testing.T) {
+	_, err := keystore.Parse([]byte(`{"version": 3}`))
+	require.ErrorIs(t, err, keystore.ErrUnsupportedVersion)
+}
+
+func TestReadPassword(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "password")
+	require.NoError(t, os.WriteFile(path, []byte(testPassword+"\n"), 0o600))
+
+	password, err := keystore.ReadPassword(path)
+	require.NoError(t, err)
+	require.Equal(t, testPassword, password)
+}
@@ -48,8 +48,8 @@ func NewLegacySigner(
 }
 
 // PublicKey returns the public key of the signer.
-func (b *LegacySigner) PublicKey() crypto.BLSPubkey {
-	return crypto.BLSPubkey(b.SecretKey.PublicKey().Marshal())
+func (b *LegacySigner) PublicKey() (crypto.BLSPubkey, error) {
+	return crypto.BLSPubkey(b.SecretKey.PublicKey().Marshal()), nil
 }
 
 // Sign generates a signature for a given message using the signer's secret key.
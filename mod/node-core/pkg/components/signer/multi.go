@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"os"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer/keystore"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
+
+// MultiSigner holds the keys of several validators run by the same node and
+// routes signing requests to the key of the requested validator. The first
+// key is the primary key, used by the methods of crypto.BLSSigner.
+type MultiSigner struct {
+	pubkeys []crypto.BLSPubkey
+	signers map[crypto.BLSPubkey]crypto.BLSSigner
+}
+
+// NewMultiSigner creates a MultiSigner from the signers of each key.
+func NewMultiSigner(signers ...crypto.BLSSigner) (*MultiSigner, error) {
+	if len(signers) == 0 {
+		return nil, ErrNoSigningKeys
+	}
+
+	m := &MultiSigner{
+		pubkeys: make([]crypto.BLSPubkey, 0, len(signers)),
+		signers: make(map[crypto.BLSPubkey]crypto.BLSSigner, len(signers)),
+	}
+	for _, s := range signers {
+		pubkey, err := s.PublicKey()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := m.signers[pubkey]; ok {
+			return nil, errors.Wrapf(ErrDuplicateSigningKey, "%x", pubkey)
+		}
+		m.pubkeys = append(m.pubkeys, pubkey)
+		m.signers[pubkey] = s
+	}
+	return m, nil
+}
+
+// NewMultiSignerFromConfig loads the keys of the configured keystores and
+// privval files.
+func NewMultiSignerFromConfig(cfg MultiConfig) (*MultiSigner, error) {
+	if len(cfg.PrivValidatorKeyFiles) != len(cfg.PrivValidatorStateFiles) {
+		return nil, ErrMismatchedPrivValidatorFiles
+	}
+
+	signers, err := loadKeystores(cfg.Keystores, cfg.PasswordFile)
+	if err != nil {
+		return nil, err
+	}
+	for i, keyFile := range cfg.PrivValidatorKeyFiles {
+		// The privval files are generated if missing, which must not happen
+		// for the key of an existing validator.
+		if _, err = os.Stat(keyFile); err != nil {
+			return nil, err
+		}
+		signers = append(signers, NewBLSSigner(
+			keyFile, cfg.PrivValidatorStateFiles[i],
+		))
+	}
+	return NewMultiSigner(signers...)
+}
+
+// loadKeystores creates a signer for each of the keystores, which share the
+// password of passwordFile.
+func loadKeystores(
+	paths []string, passwordFile string,
+) ([]crypto.BLSSigner, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	password, err := keystore.ReadPassword(passwordFile)
+	if err != nil {
+		return nil, err
+	}
+
+	signers := make([]crypto.BLSSigner, 0, len(paths))
+	for _, path := range paths {
+		var ks *keystore.Keystore
+		if ks, err = keystore.Load(path); err != nil {
+			return nil, errors.Wrapf(err, "keystore %s", path)
+		}
+		var s *LegacySigner
+		if s, err = SignerFromKeystore(ks, password); err != nil {
+			return nil, errors.Wrapf(err, "keystore %s", path)
+		}
+		signers = append(signers, s)
+	}
+	return signers, nil
+}
+
+// PublicKeys returns the public keys of the signer, the primary key first.
+func (m *MultiSigner) PublicKeys() []crypto.BLSPubkey {
+	pubkeys := make([]crypto.BLSPubkey, len(m.pubkeys))
+	copy(pubkeys, m.pubkeys)
+	return pubkeys
+}
+
+// SignFor signs msg with the key of the given public key.
+func (m *MultiSigner) SignFor(
+	pubkey crypto.BLSPubkey, msg []byte,
+) (crypto.BLSSignature, error) {
+	s, ok := m.signers[pubkey]
+	if !ok {
+		return crypto.BLSSignature{}, errors.Wrapf(
+			ErrUnknownSigningKey, "%x", pubkey,
+		)
+	}
+	return s.Sign(msg)
+}
+
+// ========================== Implements BLS Signer ==========================
+
+// PublicKey returns the primary public key of the signer.
+func (m *MultiSigner) PublicKey() (crypto.BLSPubkey, error) {
+	return m.pubkeys[0], nil
+}
+
+// Sign signs msg with the primary key of the signer.
+func (m *MultiSigner) Sign(msg []byte) (crypto.BLSSignature, error) {
+	return m.SignFor(m.pubkeys[0], msg)
+}
+
+// VerifySignature verifies a signature against a message and a public key.
+func (m *MultiSigner) VerifySignature(
+	pubkey crypto.BLSPubkey, msg []byte, signature crypto.BLSSignature,
+) error {
+	return m.signers[m.pubkeys[0]].VerifySignature(pubkey, msg, signature)
+}
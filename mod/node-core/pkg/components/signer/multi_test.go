@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// newKeySigner returns a signer of the given public key whose signatures
+// start with the first byte of the key.
+func newKeySigner(t *testing.T, pubkey crypto.BLSPubkey) *mocks.BLSSigner {
+	t.Helper()
+	m := mocks.NewBLSSigner(t)
+	m.EXPECT().PublicKey().Return(pubkey, nil)
+	m.EXPECT().Sign([]byte("msg")).
+		Return(crypto.BLSSignature{pubkey[0]}, nil).Maybe()
+	return m
+}
+
+func TestMultiSigner(t *testing.T) {
+	pubkeys := []crypto.BLSPubkey{{0x01}, {0x02}, {0x03}}
+	signers := make([]crypto.BLSSigner, len(pubkeys))
+	for i, pubkey := range pubkeys {
+		signers[i] = newKeySigner(t, pubkey)
+	}
+	m, err := signer.NewMultiSigner(signers...)
+	require.NoError(t, err)
+	require.Equal(t, pubkeys, m.PublicKeys())
+
+	for _, pubkey := range pubkeys {
+		var signature crypto.BLSSignature
+		signature, err = m.SignFor(pubkey, []byte("msg"))
+		require.NoError(t, err)
+		require.Equal(t, crypto.BLSSignature{pubkey[0]}, signature)
+	}
+
+	// The crypto.BLSSigner methods use the primary key.
+	primary, err := m.PublicKey()
+	require.NoError(t, err)
+	require.Equal(t, pubkeys[0], primary)
+	signature, err := m.Sign([]byte("msg"))
+	require.NoError(t, err)
+	require.Equal(t, crypto.BLSSignature{0x01}, signature)
+
+	_, err = m.SignFor(crypto.BLSPubkey{0x04}, []byte("msg"))
+	require.ErrorIs(t, err, signer.ErrUnknownSigningKey)
+}
+
+func TestNewMultiSigner_Errors(t *testing.T) {
+	_, err := signer.NewMultiSigner()
+	require.ErrorIs(t, err, signer.ErrNoSigningKeys)
+
+	_, err = signer.NewMultiSigner(
+		newKeySigner(t, crypto.BLSPubkey{0x01}),
+		newKeySigner(t, crypto.BLSPubkey{0x02}),
+		newKeySigner(t, crypto.BLSPubkey{0x01}),
+	)
+	require.ErrorIs(t, err, signer.ErrDuplicateSigningKey)
+
+	_, err = signer.NewMultiSignerFromConfig(signer.MultiConfig{
+		PrivValidatorKeyFiles: []string{"key.json"},
+	})
+	require.ErrorIs(t, err, signer.ErrMismatchedPrivValidatorFiles)
+}
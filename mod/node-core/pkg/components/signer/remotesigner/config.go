@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package remotesigner
+
+import "time"
+
+const (
+	// defaultEndpoint is the default URL of the Web3Signer.
+	defaultEndpoint = "http://localhost:9000"
+	// defaultTimeout is the default timeout of a request to the Web3Signer.
+	defaultTimeout = 5 * time.Second
+)
+
+// Config is the configuration of a Web3Signer remote signer.
+type Config struct {
+	// Endpoint is the base URL of the Web3Signer.
+	Endpoint string `mapstructure:"endpoint"`
+	// PublicKey is the hex encoded public key to sign with. It may be left
+	// empty if the Web3Signer holds a single key.
+	PublicKey string `mapstructure:"public-key"`
+	// Timeout is the timeout of a request to the Web3Signer.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// TLSCACertPath is the path to the CA certificate used to verify the
+	// Web3Signer. The system roots are used if it is empty.
+	TLSCACertPath string `mapstructure:"tls-ca-cert-path"`
+	// TLSCertPath is the path to the client certificate presented to the
+	// Web3Signer, for mutual TLS.
+	TLSCertPath string `mapstructure:"tls-cert-path"`
+	// TLSKeyPath is the path to the key of the client certificate.
+	TLSKeyPath string `mapstructure:"tls-key-path"`
+}
+
+// DefaultConfig returns the default configuration of a Web3Signer remote
+// signer.
+func DefaultConfig() Config {
+	return Config{
+		Endpoint: defaultEndpoint,
+		Timeout:  defaultTimeout,
+	}
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package remotesigner
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrUnknownPublicKey is returned when the Web3Signer does not hold the
+	// key to sign with.
+	ErrUnknownPublicKey = errors.New("public key unknown to web3signer")
+	// ErrNoPublicKey is returned when the Web3Signer holds no key.
+	ErrNoPublicKey = errors.New("web3signer holds no public key")
+	// ErrPublicKeyRequired is returned when the Web3Signer holds several
+	// keys and none was configured.
+	ErrPublicKeyRequired = errors.New(
+		"web3signer holds several keys, a public key must be configured",
+	)
+	// ErrRequestTimeout is returned when a request to the Web3Signer times
+	// out.
+	ErrRequestTimeout = errors.New("web3signer request timed out")
+	// ErrUnexpectedStatus is returned when the Web3Signer answers a request
+	// with an unexpected status code.
+	ErrUnexpectedStatus = errors.New("unexpected web3signer status")
+	// ErrInvalidSignature is returned when a signature is invalid.
+	ErrInvalidSignature = errors.New("invalid BLS signature")
+)
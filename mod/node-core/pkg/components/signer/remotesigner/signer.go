@@ -0,0 +1,247 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/hex"
+	"github.com/itsdevbear/comet-bls12-381/bls/blst"
+)
+
+const (
+	// upcheckPath is the path of the health check of the Web3Signer.
+	upcheckPath = "/upcheck"
+	// publicKeysPath is the path listing the keys held by the Web3Signer.
+	publicKeysPath = "/api/v1/eth2/publicKeys"
+	// signPath is the path signing requests are sent to, followed by the
+	// public key to sign with.
+	signPath = "/api/v1/eth2/sign/"
+)
+
+// Compile-time assertion of the signer interface.
+var _ crypto.BLSSigner = (*Signer)(nil)
+
+// Signer is a BLS signer that signs with a key held by a Web3Signer, over
+// its REST API.
+type Signer struct {
+	client   *http.Client
+	endpoint string
+	timeout  time.Duration
+	pubkey   crypto.BLSPubkey
+}
+
+// signRequest is the body of a signing request.
+type signRequest struct {
+	SigningRoot string `json:"signingRoot"`
+}
+
+// signResponse is the body of the answer to a signing request.
+type signResponse struct {
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// New creates a new Signer. It checks that the Web3Signer is up and selects
+// the key to sign with, so that a misconfigured signer is reported at
+// startup rather than when the node first needs a signature.
+func New(ctx context.Context, cfg Config) (*Signer, error) {
+	tlsConfig, err := newTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	s := &Signer{
+		client:   &http.Client{Transport: transport},
+		endpoint: strings.TrimSuffix(cfg.Endpoint, "/"),
+		timeout:  cfg.Timeout,
+	}
+	if err = s.Upcheck(ctx); err != nil {
+		return nil, errors.Wrap(err, "web3signer is not healthy")
+	}
+	if s.pubkey, err = s.selectPublicKey(ctx, cfg.PublicKey); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// PublicKey returns the public key of the signer.
+func (s *Signer) PublicKey() (crypto.BLSPubkey, error) {
+	return s.pubkey, nil
+}
+
+// Sign requests a signature of the given signing root from the Web3Signer.
+func (s *Signer) Sign(msg []byte) (crypto.BLSSignature, error) {
+	body, err := json.Marshal(signRequest{
+		SigningRoot: hex.FromBytes(msg).Unwrap(),
+	})
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+
+	var res signResponse
+	status, err := s.do(
+		context.Background(),
+		http.MethodPost,
+		signPath+s.pubkey.String(),
+		body,
+		&res,
+	)
+	switch {
+	case err != nil:
+		return crypto.BLSSignature{}, err
+	case status == http.StatusNotFound:
+		return crypto.BLSSignature{}, errors.Wrapf(
+			ErrUnknownPublicKey, "%s", s.pubkey,
+		)
+	case status != http.StatusOK:
+		return crypto.BLSSignature{}, errors.Wrapf(
+			ErrUnexpectedStatus, "sign: %d", status,
+		)
+	}
+	return res.Signature, nil
+}
+
+// VerifySignature verifies a signature against a message and a public key.
+func (s *Signer) VerifySignature(
+	pubKey crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error {
+	pk, err := blst.PublicKeyFromBytes(pubKey[:])
+	if err != nil {
+		return err
+	}
+
+	sig, err := blst.SignatureFromBytes(signature[:])
+	if err != nil {
+		return err
+	}
+
+	if !sig.Verify(pk, msg) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// Upcheck returns an error if the Web3Signer is not up.
+func (s *Signer) Upcheck(ctx context.Context) error {
+	status, err := s.do(ctx, http.MethodGet, upcheckPath, nil, nil)
+	if err != nil {
+		return err
+	} else if status != http.StatusOK {
+		return errors.Wrapf(ErrUnexpectedStatus, "upcheck: %d", status)
+	}
+	return nil
+}
+
+// selectPublicKey returns the configured public key if the Web3Signer holds
+// it, or the only key it holds if none is configured.
+func (s *Signer) selectPublicKey(
+	ctx context.Context, configured string,
+) (crypto.BLSPubkey, error) {
+	var pubkeys []crypto.BLSPubkey
+	status, err := s.do(ctx, http.MethodGet, publicKeysPath, nil, &pubkeys)
+	if err != nil {
+		return crypto.BLSPubkey{}, err
+	} else if status != http.StatusOK {
+		return crypto.BLSPubkey{}, errors.Wrapf(
+			ErrUnexpectedStatus, "public keys: %d", status,
+		)
+	}
+
+	if configured == "" {
+		switch len(pubkeys) {
+		case 0:
+			return crypto.BLSPubkey{}, ErrNoPublicKey
+		case 1:
+			return pubkeys[0], nil
+		default:
+			return crypto.BLSPubkey{}, ErrPublicKeyRequired
+		}
+	}
+
+	var pubkey crypto.BLSPubkey
+	if err = pubkey.UnmarshalText([]byte(configured)); err != nil {
+		return crypto.BLSPubkey{}, errors.Wrap(err, "invalid public key")
+	}
+	for _, held := range pubkeys {
+		if held == pubkey {
+			return pubkey, nil
+		}
+	}
+	return crypto.BLSPubkey{}, errors.Wrapf(
+		ErrUnknownPublicKey, "%s", pubkey,
+	)
+}
+
+// do sends a request to the Web3Signer and decodes the JSON answer into out
+// if it succeeded. It returns the status code of the answer.
+func (s *Signer) do(
+	ctx context.Context, method, path string, body []byte, out any,
+) (int, error) {
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, method, s.endpoint+path, bytes.NewReader(body),
+	)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := s.client.Do(req)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return 0, errors.Wrapf(ErrRequestTimeout, "%s %s", method, path)
+	} else if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK || out == nil {
+		// Drain the body so that the connection can be reused.
+		_, err = io.Copy(io.Discard, res.Body)
+		return res.StatusCode, err
+	}
+	if err = json.NewDecoder(res.Body).Decode(out); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return 0, errors.Wrapf(ErrRequestTimeout, "%s %s", method, path)
+		}
+		return 0, errors.Wrapf(err, "failed to decode %s answer", path)
+	}
+	return res.StatusCode, nil
+}
@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package remotesigner_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer/remotesigner"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeWeb3Signer is a Web3Signer that holds the given keys and answers every
+// signing request of a held key with signature.
+type fakeWeb3Signer struct {
+	mu        sync.Mutex
+	pubkeys   []crypto.BLSPubkey
+	signature crypto.BLSSignature
+	// delay is the time taken to answer a signing request.
+	delay time.Duration
+	// down makes the upcheck fail.
+	down bool
+	// roots records the signing roots of the signing requests.
+	roots []string
+}
+
+func (f *fakeWeb3Signer) start(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /upcheck", func(w http.ResponseWriter, _ *http.Request) {
+		if f.down {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("OK"))
+	})
+	mux.HandleFunc("GET /api/v1/eth2/publicKeys", func(
+		w http.ResponseWriter, _ *http.Request,
+	) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		require.NoError(t, json.NewEncoder(w).Encode(f.pubkeys))
+	})
+	mux.HandleFunc("POST /api/v1/eth2/sign/{pubkey}", func(
+		w http.ResponseWriter, r *http.Request,
+	) {
+		select {
+		case <-time.After(f.delay):
+		case <-r.Context().Done():
+			return
+		}
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		var pubkey crypto.BLSPubkey
+		if err := pubkey.UnmarshalText(
+			[]byte(r.PathValue("pubkey")),
+		); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if !slices.Contains(f.pubkeys, pubkey) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var req struct {
+			SigningRoot string `json:"signingRoot"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		f.roots = append(f.roots, req.SigningRoot)
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			"signature": f.signature,
+		}))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestSigner_New(t *testing.T) {
+	first := crypto.BLSPubkey{0x01}
+	second := crypto.BLSPubkey{0x02}
+	tests := []struct {
+		name       string
+		fake       *fakeWeb3Signer
+		configured string
+		expected   crypto.BLSPubkey
+		err        error
+	}{
+		{
+			name:       "ConfiguredKey",
+			fake:       &fakeWeb3Signer{pubkeys: []crypto.BLSPubkey{first, second}},
+			configured: second.String(),
+			expected:   second,
+		},
+		{
+			name:     "SingleKey",
+			fake:     &fakeWeb3Signer{pubkeys: []crypto.BLSPubkey{first}},
+			expected: first,
+		},
+		{
+			name:       "UnknownConfiguredKey",
+			fake:       &fakeWeb3Signer{pubkeys: []crypto.BLSPubkey{first}},
+			configured: second.String(),
+			err:        remotesigner.ErrUnknownPublicKey,
+		},
+		{
+			name: "SeveralKeys",
+			fake: &fakeWeb3Signer{pubkeys: []crypto.BLSPubkey{first, second}},
+			err:  remotesigner.ErrPublicKeyRequired,
+		},
+		{
+			name: "NoKey",
+			fake: &fakeWeb3Signer{},
+			err:  remotesigner.ErrNoPublicKey,
+		},
+		{
+			name: "Down",
+			fake: &fakeWeb3Signer{pubkeys: []crypto.BLSPubkey{first}, down: true},
+			err:  remotesigner.ErrUnexpectedStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := remotesigner.DefaultConfig()
+			cfg.Endpoint = tt.fake.start(t).URL
+			cfg.PublicKey = tt.configured
+
+			s, err := remotesigner.New(context.Background(), cfg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			pubkey, err := s.PublicKey()
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, pubkey)
+		})
+	}
+}
+
+func TestSigner_Sign(t *testing.T) {
+	pubkey := crypto.BLSPubkey{0x01}
+	signature := crypto.BLSSignature{0xaa, 0xbb}
+	root := []byte{0x12, 0x34}
+
+	t.Run("Success", func(t *testing.T) {
+		fake := &fakeWeb3Signer{
+			pubkeys:   []crypto.BLSPubkey{pubkey},
+			signature: signature,
+		}
+		cfg := remotesigner.DefaultConfig()
+		cfg.Endpoint = fake.start(t).URL
+		s, err := remotesigner.New(context.Background(), cfg)
+		require.NoError(t, err)
+
+		sig, err := s.Sign(root)
+		require.NoError(t, err)
+		require.Equal(t, signature, sig)
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+		require.Equal(t, []string{"0x1234"}, fake.roots)
+	})
+
+	t.Run("UnknownKey", func(t *testing.T) {
+		fake := &fakeWeb3Signer{pubkeys: []crypto.BLSPubkey{pubkey}}
+		cfg := remotesigner.DefaultConfig()
+		cfg.Endpoint = fake.start(t).URL
+		s, err := remotesigner.New(context.Background(), cfg)
+		require.NoError(t, err)
+
+		// The key is removed from the Web3Signer after startup.
+		fake.mu.Lock()
+		fake.pubkeys = nil
+		fake.mu.Unlock()
+		_, err = s.Sign(root)
+		require.ErrorIs(t, err, remotesigner.ErrUnknownPublicKey)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		fake := &fakeWeb3Signer{
+			pubkeys: []crypto.BLSPubkey{pubkey},
+			delay:   500 * time.Millisecond,
+		}
+		cfg := remotesigner.DefaultConfig()
+		cfg.Endpoint = fake.start(t).URL
+		cfg.Timeout = 50 * time.Millisecond
+		s, err := remotesigner.New(context.Background(), cfg)
+		require.NoError(t, err)
+
+		_, err = s.Sign(root)
+		require.ErrorIs(t, err, remotesigner.ErrRequestTimeout)
+	})
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
+
+// selfCheckProbe is the message signed by SelfCheck. It is not a valid
+// signing root of any beacon message.
+//
+//nolint:gochecknoglobals // constant.
+var selfCheckProbe = bytes.ToBytes32([]byte("beacon-kit signer self-check"))
+
+// SelfCheck signs a probe message with the signer and verifies the signature
+// against its public key, so that a broken key setup fails on startup rather
+// than on the first signature made for the chain.
+func SelfCheck(signer crypto.BLSSigner) error {
+	pubkey, err := signer.PublicKey()
+	if err != nil {
+		return errors.Wrap(err, "signer self-check: public key")
+	}
+	signature, err := signer.Sign(selfCheckProbe[:])
+	if err != nil {
+		return errors.Wrap(err, "signer self-check: sign")
+	}
+	if err = signer.VerifySignature(
+		pubkey, selfCheckProbe[:], signature,
+	); err != nil {
+		return errors.Wrap(err, "signer self-check: verify")
+	}
+	return nil
+}
@@ -41,12 +41,12 @@ func NewBLSSigner(keyFilePath string, stateFilePath string) *BLSSigner {
 // ========================== Implements BLS Signer ==========================
 
 // PublicKey returns the public key of the signer.
-func (f BLSSigner) PublicKey() crypto.BLSPubkey {
+func (f BLSSigner) PublicKey() (crypto.BLSPubkey, error) {
 	key, err := f.PrivValidator.GetPubKey()
 	if err != nil {
-		return crypto.BLSPubkey{}
+		return crypto.BLSPubkey{}, err
 	}
-	return crypto.BLSPubkey(key.Bytes())
+	return crypto.BLSPubkey(key.Bytes()), nil
 }
 
 // Sign generates a signature for a given message using the signer's secret key.
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var errPrivval = errors.New("privval unavailable")
+
+// failingPrivValidator is a privval whose key cannot be loaded.
+type failingPrivValidator struct {
+	types.PrivValidator
+}
+
+func (failingPrivValidator) GetPubKey() (cmtcrypto.PubKey, error) {
+	return nil, errPrivval
+}
+
+func (failingPrivValidator) SignBytes([]byte) ([]byte, error) {
+	return nil, errPrivval
+}
+
+func TestBLSSigner_PublicKeyError(t *testing.T) {
+	s := signer.BLSSigner{PrivValidator: failingPrivValidator{}}
+
+	_, err := s.PublicKey()
+	require.ErrorIs(t, err, errPrivval)
+	require.ErrorIs(t, signer.SelfCheck(s), errPrivval)
+}
+
+func TestSelfCheck(t *testing.T) {
+	errSign := errors.New("sign failed")
+	tests := []struct {
+		name    string
+		setup   func(*mocks.BLSSigner)
+		wantErr error
+	}{
+		{
+			name: "Healthy",
+			setup: func(m *mocks.BLSSigner) {
+				m.EXPECT().Sign(mock.Anything).
+					Return(crypto.BLSSignature{}, nil)
+				m.EXPECT().VerifySignature(
+					mock.Anything, mock.Anything, mock.Anything,
+				).Return(nil)
+			},
+		},
+		{
+			name: "SignFails",
+			setup: func(m *mocks.BLSSigner) {
+				m.EXPECT().Sign(mock.Anything).
+					Return(crypto.BLSSignature{}, errSign)
+			},
+			wantErr: errSign,
+		},
+		{
+			name: "MismatchedKey",
+			setup: func(m *mocks.BLSSigner) {
+				m.EXPECT().Sign(mock.Anything).
+					Return(crypto.BLSSignature{}, nil)
+				m.EXPECT().VerifySignature(
+					mock.Anything, mock.Anything, mock.Anything,
+				).Return(signer.ErrInvalidSignature)
+			},
+			wantErr: signer.ErrInvalidSignature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mocks.NewBLSSigner(t)
+			m.EXPECT().PublicKey().Return(crypto.BLSPubkey{0x01}, nil)
+			tt.setup(m)
+
+			err := signer.SelfCheck(m)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashing
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// InterchangeVersion is the version of the EIP-3076 interchange format.
+const InterchangeVersion = "5"
+
+// Interchange is the EIP-3076 slashing protection interchange format.
+type Interchange struct {
+	Metadata InterchangeMetadata `json:"metadata"`
+	Data     []InterchangeData   `json:"data"`
+}
+
+// InterchangeMetadata is the metadata of an interchange file.
+type InterchangeMetadata struct {
+	InterchangeFormatVersion string    `json:"interchange_format_version"`
+	GenesisValidatorsRoot    bytes.B32 `json:"genesis_validators_root"`
+}
+
+// InterchangeData is the signing history of a public key in an interchange
+// file.
+type InterchangeData struct {
+	Pubkey             crypto.BLSPubkey  `json:"pubkey"`
+	SignedBlocks       []SignedBlock     `json:"signed_blocks"`
+	SignedAttestations []json.RawMessage `json:"signed_attestations"`
+}
+
+// SignedBlock is a block signed for in an interchange file.
+type SignedBlock struct {
+	Slot        uint64     `json:"slot,string"`
+	SigningRoot *bytes.B32 `json:"signing_root,omitempty"`
+}
+
+// Export returns the signing history of the store in the EIP-3076
+// interchange format. The lowest and highest slots signed for are exported
+// for every public key.
+func (s *Store) Export(genesisValidatorsRoot bytes.B32) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	interchange := Interchange{
+		Metadata: InterchangeMetadata{
+			InterchangeFormatVersion: InterchangeVersion,
+			GenesisValidatorsRoot:    genesisValidatorsRoot,
+		},
+		Data: make([]InterchangeData, 0, len(s.records)),
+	}
+	for pubkey, record := range s.records {
+		data := InterchangeData{
+			Pubkey:             pubkey,
+			SignedAttestations: []json.RawMessage{},
+		}
+		if record.LowestSlot != record.HighestSlot {
+			data.SignedBlocks = append(data.SignedBlocks, SignedBlock{
+				Slot: record.LowestSlot.Unwrap(),
+			})
+		}
+		highest := SignedBlock{Slot: record.HighestSlot.Unwrap()}
+		if record.SigningRoot != (bytes.B32{}) {
+			highest.SigningRoot = &record.SigningRoot
+		}
+		data.SignedBlocks = append(data.SignedBlocks, highest)
+		interchange.Data = append(interchange.Data, data)
+	}
+	slices.SortFunc(interchange.Data, func(a, b InterchangeData) int {
+		return strings.Compare(a.Pubkey.String(), b.Pubkey.String())
+	})
+	return json.MarshalIndent(interchange, "", "  ")
+}
+
+// Import merges the signing history of an EIP-3076 interchange file into
+// the store. Only signed blocks are imported. The history of a public key
+// only ever grows, so importing cannot make signing for a slot possible
+// again.
+func (s *Store) Import(bz []byte, genesisValidatorsRoot bytes.B32) error {
+	var interchange Interchange
+	if err := json.Unmarshal(bz, &interchange); err != nil {
+		return errors.Wrap(err, "failed to decode interchange")
+	}
+	metadata := interchange.Metadata
+	if metadata.InterchangeFormatVersion != InterchangeVersion {
+		return errors.Wrapf(
+			ErrUnsupportedInterchange, "%s",
+			metadata.InterchangeFormatVersion,
+		)
+	}
+	if metadata.GenesisValidatorsRoot != genesisValidatorsRoot {
+		return errors.Wrapf(
+			ErrGenesisRootMismatch, "got %s, want %s",
+			metadata.GenesisValidatorsRoot, genesisValidatorsRoot,
+		)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	updates := make(map[crypto.BLSPubkey]Record)
+	for _, data := range interchange.Data {
+		for _, block := range data.SignedBlocks {
+			record, ok := updates[data.Pubkey]
+			if !ok {
+				record, ok = s.records[data.Pubkey]
+			}
+			updates[data.Pubkey] = mergeBlock(record, ok, block)
+		}
+	}
+	return s.update(updates)
+}
+
+// mergeBlock adds a signed block to the given record, which is empty if
+// exists is false.
+func mergeBlock(record Record, exists bool, block SignedBlock) Record {
+	slot := math.Slot(block.Slot)
+	if !exists {
+		record.LowestSlot = slot
+		record.HighestSlot = slot
+		record.SigningRoot = bytes.B32{}
+	}
+	record.LowestSlot = min(record.LowestSlot, slot)
+	switch {
+	case slot > record.HighestSlot:
+		record.HighestSlot = slot
+		record.SigningRoot = bytes.B32{}
+	case slot < record.HighestSlot:
+		return record
+	}
+	// A conflicting signing root for the highest slot leaves the root
+	// unknown, which refuses any signature for that slot.
+	if block.SigningRoot == nil ||
+		(record.SigningRoot != bytes.B32{} &&
+			record.SigningRoot != *block.SigningRoot) {
+		record.SigningRoot = bytes.B32{}
+		return record
+	}
+	record.SigningRoot = *block.SigningRoot
+	return record
+}
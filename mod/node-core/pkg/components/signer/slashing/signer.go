@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashing
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Signer is a crypto.BLSSigner that checks the signatures made for a slot
+// against the slashing protection store, whatever the signer it wraps.
+// Messages signed with Sign are not tied to a slot and are not checked.
+type Signer struct {
+	crypto.BLSSigner
+	pubkey crypto.BLSPubkey
+	store  *Store
+}
+
+// NewSigner wraps signer to check its signatures made for a slot against
+// store.
+func NewSigner(signer crypto.BLSSigner, store *Store) (*Signer, error) {
+	pubkey, err := signer.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	return &Signer{BLSSigner: signer, pubkey: pubkey, store: store}, nil
+}
+
+// SignForSlot signs the signing root of a message made for the block at the
+// given slot. Signing is refused if it could get the validator slashed, as
+// recorded by the store.
+func (s *Signer) SignForSlot(
+	slot math.Slot, signingRoot []byte,
+) (crypto.BLSSignature, error) {
+	if err := s.store.CheckAndRecord(
+		s.pubkey, slot, bytes.ToBytes32(signingRoot),
+	); err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	return s.BLSSigner.Sign(signingRoot)
+}
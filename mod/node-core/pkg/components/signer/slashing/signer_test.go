@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashing_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer/slashing"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigner_SignForSlot(t *testing.T) {
+	pubkey := crypto.BLSPubkey{0x01}
+	rootA, rootB := bytes.B32{0xaa}, bytes.B32{0xbb}
+	sig := crypto.BLSSignature{0x02}
+
+	// The wrapped signer is only asked for the signatures that are safe.
+	m := mocks.NewBLSSigner(t)
+	m.EXPECT().PublicKey().Return(pubkey, nil).Once()
+	m.EXPECT().Sign(rootA[:]).Return(sig, nil).Twice()
+	m.EXPECT().Sign(rootB[:]).Return(sig, nil).Twice()
+
+	store, path := openStore(t)
+	s, err := slashing.NewSigner(m, store)
+	require.NoError(t, err)
+
+	got, err := s.SignForSlot(10, rootA[:])
+	require.NoError(t, err)
+	require.Equal(t, sig, got)
+	_, err = s.SignForSlot(10, rootA[:])
+	require.NoError(t, err)
+	_, err = s.SignForSlot(10, rootB[:])
+	require.ErrorIs(t, err, slashing.ErrSlashableSlot)
+	_, err = s.SignForSlot(9, rootB[:])
+	require.ErrorIs(t, err, slashing.ErrSlashableSlot)
+	_, err = s.SignForSlot(11, rootB[:])
+	require.NoError(t, err)
+
+	// Messages not tied to a slot are signed as is.
+	_, err = s.Sign(rootB[:])
+	require.NoError(t, err)
+
+	record, ok := store.Record(pubkey)
+	require.True(t, ok)
+	require.Equal(t, slashing.Record{
+		LowestSlot:  10,
+		HighestSlot: 11,
+		SigningRoot: rootB,
+	}, record)
+	require.FileExists(t, path)
+}
@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashing
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Record is the signing history of a public key.
+type Record struct {
+	// LowestSlot is the lowest slot signed for.
+	LowestSlot math.Slot `json:"lowest_slot"`
+	// HighestSlot is the highest slot signed for.
+	HighestSlot math.Slot `json:"highest_slot"`
+	// SigningRoot is the signing root signed for at HighestSlot, it is zero
+	// if unknown.
+	SigningRoot bytes.B32 `json:"signing_root"`
+}
+
+// Store is a slashing protection database holding the range of slots signed
+// for by every public key. It is persisted to a single file, which is
+// replaced atomically on every update.
+type Store struct {
+	path string
+
+	mu      sync.Mutex
+	records map[crypto.BLSPubkey]Record
+}
+
+// Open opens the store persisted at the given path, which is created on the
+// first update if it does not exist.
+func Open(path string) (*Store, error) {
+	s := &Store{
+		path:    path,
+		records: make(map[crypto.BLSPubkey]Record),
+	}
+	bz, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(bz, &s.records); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode %s", path)
+	}
+	return s, nil
+}
+
+// Record returns the signing history of the given public key.
+func (s *Store) Record(pubkey crypto.BLSPubkey) (Record, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	record, ok := s.records[pubkey]
+	return record, ok
+}
+
+// CheckAndRecord records that the public key is about to sign the signing
+// root for the given slot. Signing for a slot at or below the highest slot
+// signed for is refused, unless it is the same signing root as the last one,
+// which is safe to sign again. A zero signing root is an unknown one. The
+// record is persisted before returning, so the signature must only be made if
+// no error is returned.
+func (s *Store) CheckAndRecord(
+	pubkey crypto.BLSPubkey, slot math.Slot, signingRoot bytes.B32,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.records[pubkey]
+	if ok {
+		switch {
+		case slot == record.HighestSlot &&
+			record.SigningRoot != bytes.B32{} &&
+			signingRoot == record.SigningRoot:
+			return nil
+		case slot <= record.HighestSlot:
+			return errors.Wrapf(
+				ErrSlashableSlot, "slot %d, last signed slot %d",
+				slot, record.HighestSlot,
+			)
+		}
+	} else {
+		record.LowestSlot = slot
+	}
+	record.HighestSlot = slot
+	record.SigningRoot = signingRoot
+
+	return s.update(map[crypto.BLSPubkey]Record{pubkey: record})
+}
+
+// update applies the given records and persists the store. The records are
+// left untouched if persisting fails. It must be called with mu held.
+func (s *Store) update(updates map[crypto.BLSPubkey]Record) error {
+	records := make(map[crypto.BLSPubkey]Record, len(s.records))
+	for pubkey, record := range s.records {
+		records[pubkey] = record
+	}
+	for pubkey, record := range updates {
+		records[pubkey] = record
+	}
+
+	bz, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	if err = writeFileAtomic(s.path, bz); err != nil {
+		return errors.Wrap(err, "failed to persist slashing protection")
+	}
+	s.records = records
+	return nil
+}
+
+// writeFileAtomic replaces the file at path with the given data, so that
+// either the old or the new content is found after a crash.
+func writeFileAtomic(path string, bz []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(bz); err != nil {
+		return errors.Join(err, tmp.Close())
+	}
+	if err = tmp.Sync(); err != nil {
+		return errors.Join(err, tmp.Close())
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return err
+	}
+
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	return errors.Join(d.Sync(), d.Close())
+}
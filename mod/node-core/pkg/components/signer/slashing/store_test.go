@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashing_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer/slashing"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func openStore(t *testing.T) (*slashing.Store, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "slashing_protection.json")
+	s, err := slashing.Open(path)
+	require.NoError(t, err)
+	return s, path
+}
+
+func TestStore_CheckAndRecord(t *testing.T) {
+	pubkey := crypto.BLSPubkey{0x01}
+	rootA, rootB := bytes.B32{0xaa}, bytes.B32{0xbb}
+
+	tests := []struct {
+		name    string
+		slot    math.Slot
+		root    bytes.B32
+		wantErr error
+	}{
+		{name: "FirstSignature", slot: 10, root: rootA},
+		{name: "SameSlotSameRoot", slot: 10, root: rootA},
+		{
+			name:    "SameSlotOtherRoot",
+			slot:    10,
+			root:    rootB,
+			wantErr: slashing.ErrSlashableSlot,
+		},
+		{
+			name:    "LowerSlot",
+			slot:    9,
+			root:    rootB,
+			wantErr: slashing.ErrSlashableSlot,
+		},
+		{name: "HigherSlot", slot: 11, root: rootB},
+		{
+			name:    "PreviousSlot",
+			slot:    10,
+			root:    rootA,
+			wantErr: slashing.ErrSlashableSlot,
+		},
+	}
+
+	s, path := openStore(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.CheckAndRecord(pubkey, tt.slot, tt.root)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// The history survives a restart.
+	reopened, err := slashing.Open(path)
+	require.NoError(t, err)
+	record, ok := reopened.Record(pubkey)
+	require.True(t, ok)
+	require.Equal(t, slashing.Record{
+		LowestSlot:  10,
+		HighestSlot: 11,
+		SigningRoot: rootB,
+	}, record)
+	require.ErrorIs(t,
+		reopened.CheckAndRecord(pubkey, 11, rootA),
+		slashing.ErrSlashableSlot,
+	)
+}
+
+func TestStore_InterchangeRoundTrip(t *testing.T) {
+	genesisRoot := bytes.B32{0x47}
+	first, second := crypto.BLSPubkey{0x01}, crypto.BLSPubkey{0x02}
+
+	src, _ := openStore(t)
+	require.NoError(t, src.CheckAndRecord(first, 3, bytes.B32{0x03}))
+	require.NoError(t, src.CheckAndRecord(first, 7, bytes.B32{0x07}))
+	require.NoError(t, src.CheckAndRecord(second, 5, bytes.B32{0x05}))
+
+	bz, err := src.Export(genesisRoot)
+	require.NoError(t, err)
+
+	dst, _ := openStore(t)
+	require.NoError(t, dst.CheckAndRecord(second, 9, bytes.B32{0x09}))
+	require.NoError(t, dst.Import(bz, genesisRoot))
+
+	for _, pubkey := range []crypto.BLSPubkey{first, second} {
+		want, _ := src.Record(pubkey)
+		got, ok := dst.Record(pubkey)
+		require.True(t, ok)
+		if pubkey == second {
+			// The local history of the destination is kept.
+			want = slashing.Record{
+				LowestSlot:  5,
+				HighestSlot: 9,
+				SigningRoot: bytes.B32{0x09},
+			}
+		}
+		require.Equal(t, want, got)
+	}
+	require.ErrorIs(t,
+		dst.CheckAndRecord(first, 7, bytes.B32{0x08}),
+		slashing.ErrSlashableSlot,
+	)
+	require.NoError(t, dst.CheckAndRecord(first, 7, bytes.B32{0x07}))
+
+	// Exporting again yields the same interchange for the imported key.
+	again, err := dst.Export(genesisRoot)
+	require.NoError(t, err)
+	require.Contains(t, string(again), first.String())
+}
+
+func TestStore_Import(t *testing.T) {
+	interchange, err := os.ReadFile(
+		filepath.Join("testdata", "minimal.json"),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		genesisRoot bytes.B32
+		wantErr     error
+	}{
+		{name: "Minimal", genesisRoot: bytes.B32{0x04}},
+		{
+			name:        "OtherChain",
+			genesisRoot: bytes.B32{0x05},
+			wantErr:     slashing.ErrGenesisRootMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := openStore(t)
+			err := s.Import(interchange, tt.genesisRoot)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			pubkey := crypto.BLSPubkey{0x01}
+			record, ok := s.Record(pubkey)
+			require.True(t, ok)
+			require.Equal(t, slashing.Record{
+				LowestSlot:  81951,
+				HighestSlot: 81952,
+			}, record)
+			require.ErrorIs(t,
+				s.CheckAndRecord(pubkey, 81952, bytes.B32{}),
+				slashing.ErrSlashableSlot,
+			)
+			require.NoError(t, s.CheckAndRecord(pubkey, 81953, bytes.B32{}))
+		})
+	}
+}
@@ -27,6 +27,8 @@ import (
 	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	execution "github.com/berachain/beacon-kit/mod/execution/pkg/engine"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
@@ -38,8 +40,11 @@ import (
 type StateProcessorInput struct {
 	depinject.In
 	ChainSpec       primitives.ChainSpec
+	Config          *config.Config
 	ExecutionEngine *execution.Engine[*types.ExecutionPayload]
 	Signer          crypto.BLSSigner
+	BatchVerifier   crypto.BatchVerifier
+	TelemetrySink   *metrics.TelemetrySink
 }
 
 // ProvideStateProcessor provides the state processor to the depinject
@@ -66,6 +71,7 @@ func ProvideStateProcessor(
 		*types.ExecutionPayloadHeader,
 		*types.Fork,
 		*types.ForkData,
+		*types.ProposerSlashing,
 		*types.Validator,
 		*engineprimitives.Withdrawal,
 		types.WithdrawalCredentials,
@@ -73,5 +79,8 @@ func ProvideStateProcessor(
 		in.ChainSpec,
 		in.ExecutionEngine,
 		in.Signer,
+		in.BatchVerifier,
+		in.TelemetrySink,
+		in.Config.Diagnostics.StateRootFields,
 	)
 }
@@ -0,0 +1,721 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingBackend is a metrics backend that records the number of times
+// each metric is reported.
+type recordingBackend struct {
+	mu        sync.Mutex
+	counters  map[string]int
+	durations map[string]int
+}
+
+func newRecordingBackend() *recordingBackend {
+	return &recordingBackend{
+		counters:  make(map[string]int),
+		durations: make(map[string]int),
+	}
+}
+
+func (b *recordingBackend) IncrementCounter(key string, _ ...string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.counters[key]++
+}
+
+func (b *recordingBackend) IncrementCounterBy(string, int64, ...string) {}
+
+func (b *recordingBackend) SetGauge(string, int64, ...string) {}
+
+func (b *recordingBackend) ObserveHistogram(string, float64, ...string) {}
+
+func (b *recordingBackend) MeasureSince(key string, _ time.Time, _ ...string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.durations[key]++
+}
+
+// recordingEngine is an execution engine that records the payloads it is
+// notified of.
+type recordingEngine struct {
+	mu       sync.Mutex
+	payloads int
+}
+
+func (e *recordingEngine) VerifyAndNotifyNewPayload(
+	context.Context,
+	*engineprimitives.NewPayloadRequest[
+		*types.ExecutionPayload, *engineprimitives.Withdrawal,
+	],
+) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.payloads++
+	return nil
+}
+
+func (e *recordingEngine) CheckForkReadiness(context.Context, uint32) error {
+	return nil
+}
+
+func (e *recordingEngine) GetPayload(
+	context.Context, *engineprimitives.GetPayloadRequest,
+) (engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload], error) {
+	return nil, errors.New("no payload built")
+}
+
+func (e *recordingEngine) NotifyForkchoiceUpdate(
+	context.Context, *engineprimitives.ForkchoiceUpdateRequest,
+) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
+	return nil, nil, nil
+}
+
+// newTestDeposit returns a deposit of the maximum effective balance of the
+// given index, signed by signer in the genesis domain.
+func newTestDeposit(
+	t *testing.T,
+	signer *signer.LegacySigner,
+	index uint64,
+) *types.Deposit {
+	t.Helper()
+	cs := spec.TestnetChainSpec()
+	credentials := types.NewCredentialsFromExecutionAddress(
+		common.ExecutionAddress{0x01},
+	)
+	msg, sig, err := types.CreateAndSignDepositMessage(
+		types.NewForkData(
+			version.FromUint32[common.Version](
+				cs.ActiveForkVersionForEpoch(0),
+			),
+			common.Root{},
+		),
+		cs.DomainTypeDeposit(),
+		signer,
+		credentials,
+		math.Gwei(cs.MaxEffectiveBalance()),
+	)
+	require.NoError(t, err)
+	return types.NewDeposit(msg.Pubkey, credentials, msg.Amount, sig, index)
+}
+
+// newTestInput returns the input of a state processor sending its metrics
+// to backend.
+func newTestInput(
+	signer *signer.LegacySigner,
+	backend metrics.Backend,
+) components.StateProcessorInput {
+	sink := metrics.NewTelemetrySinkWithBackend(backend)
+	return components.StateProcessorInput{
+		ChainSpec:     spec.TestnetChainSpec(),
+		Config:        config.DefaultConfig(),
+		Signer:        signer,
+		BatchVerifier: components.ProvideBatchVerifier(),
+		TelemetrySink: &sink,
+	}
+}
+
+// testChain is a beacon state initialized with a single genesis deposit.
+type testChain struct {
+	ctx sdk.Context
+	kv  *beacondb.KVStore[
+		*types.Fork,
+		*types.BeaconBlockHeader,
+		*types.ExecutionPayloadHeader,
+		*types.Eth1Data,
+		*types.Validator,
+	]
+	signer  *signer.LegacySigner
+	deposit *types.Deposit
+}
+
+func newTestChain(t *testing.T) *testChain {
+	t.Helper()
+	cs := spec.TestnetChainSpec()
+	key := storetypes.NewKVStoreKey("beacon")
+	c := &testChain{
+		ctx: testutil.DefaultContext(
+			key, storetypes.NewTransientStoreKey("transient"),
+		),
+		kv: beacondb.New[
+			*types.Fork,
+			*types.BeaconBlockHeader,
+			*types.ExecutionPayloadHeader,
+			*types.Eth1Data,
+			*types.Validator,
+		](
+			runtime.NewKVStoreService(key),
+			&encoding.SSZInterfaceCodec[*types.ExecutionPayloadHeader]{},
+		),
+	}
+
+	var err error
+	c.signer, err = signer.NewLegacySigner(signer.LegacyKey{31: 1})
+	require.NoError(t, err)
+	c.deposit = newTestDeposit(t, c.signer, 0)
+	_, err = components.ProvideStateProcessor(
+		newTestInput(c.signer, metrics.NoopBackend{}),
+	).InitializePreminedBeaconStateFromEth1(
+		c.stateAt(c.ctx),
+		[]*types.Deposit{c.deposit},
+		&types.ExecutionPayloadHeader{
+			InnerExecutionPayloadHeader: &types.ExecutionPayloadHeaderDeneb{
+				LogsBloom: make([]byte, 256),
+			},
+		},
+		version.FromUint32[primitives.Version](cs.ActiveForkVersionForEpoch(0)),
+	)
+	require.NoError(t, err)
+	return c
+}
+
+// stateAt returns the beacon state of the chain in ctx.
+func (c *testChain) stateAt(ctx sdk.Context) components.BeaconState {
+	return state.NewBeaconStateFromDB[components.BeaconState](
+		c.kv.WithContext(ctx), spec.TestnetChainSpec(),
+	)
+}
+
+// newBlock returns the block of slot 1 including the deposits. The balance
+// of the genesis validator above the maximum effective balance is withdrawn.
+func (c *testChain) newBlock(
+	t *testing.T,
+	deposits ...*types.Deposit,
+) *types.BeaconBlock {
+	t.Helper()
+	// The parent of the block is the genesis header, with the state root
+	// it is given when the slot is processed.
+	st := c.stateAt(c.ctx)
+	header, err := st.GetLatestBlockHeader()
+	require.NoError(t, err)
+	stateRoot, err := st.HashTreeRoot()
+	require.NoError(t, err)
+	header.SetStateRoot(stateRoot)
+	parentRoot, err := header.HashTreeRoot()
+	require.NoError(t, err)
+
+	return &types.BeaconBlock{
+		RawBeaconBlock: &types.BeaconBlockDeneb{
+			BeaconBlockHeaderBase: types.BeaconBlockHeaderBase{
+				Slot:            1,
+				ParentBlockRoot: parentRoot,
+			},
+			Body: &types.BeaconBlockBodyDeneb{
+				BeaconBlockBodyBase: types.BeaconBlockBodyBase{
+					Eth1Data: &types.Eth1Data{},
+					Deposits: deposits,
+				},
+				ExecutionPayload: &types.ExecutableDataDeneb{
+					LogsBloom: make([]byte, 256),
+					Withdrawals: []*engineprimitives.Withdrawal{{
+						Address: common.ExecutionAddress{0x01},
+						Amount: math.Gwei(
+							spec.TestnetChainSpec().MaxEffectiveBalance(),
+						),
+					}},
+				},
+			},
+		},
+	}
+}
+
+func TestStateProcessor_EpochUpdatesFromElectra(t *testing.T) {
+	c := newTestChain(t)
+	data := spec.BaseSpec()
+	data.ElectraForkEpoch = 1
+	cs := chain.NewChainSpec(data)
+	in := newTestInput(c.signer, metrics.NoopBackend{})
+	in.ChainSpec = cs
+	sp := components.ProvideStateProcessor(in)
+
+	// The balance of the genesis validator is gone, its effective balance
+	// only follows from the Electra fork on.
+	st := c.stateAt(c.ctx)
+	balance, err := st.GetBalance(0)
+	require.NoError(t, err)
+	require.NoError(t, st.DecreaseBalance(0, balance))
+	_, err = sp.ProcessSlots(st, math.Slot(cs.SlotsPerEpoch()))
+	require.NoError(t, err)
+	val, err := st.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(cs.MaxEffectiveBalance()), val.EffectiveBalance)
+
+	_, err = sp.ProcessSlots(st, math.Slot(2*cs.SlotsPerEpoch()))
+	require.NoError(t, err)
+	val, err = st.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.Zero(t, val.EffectiveBalance)
+}
+
+func TestStateProcessor_UnknownGenesisVersion(t *testing.T) {
+	c := newTestChain(t)
+	_, err := components.ProvideStateProcessor(
+		newTestInput(c.signer, metrics.NoopBackend{}),
+	).InitializePreminedBeaconStateFromEth1(
+		c.stateAt(c.ctx),
+		nil,
+		&types.ExecutionPayloadHeader{
+			InnerExecutionPayloadHeader: &types.ExecutionPayloadHeaderDeneb{},
+		},
+		primitives.Version{0x2a},
+	)
+	require.ErrorIs(t, err, core.ErrUnknownGenesisVersion)
+}
+
+func TestProvideStateProcessor_Metrics(t *testing.T) {
+	c := newTestChain(t)
+	backend := newRecordingBackend()
+	sp := components.ProvideStateProcessor(newTestInput(c.signer, backend))
+
+	// The block tops up the genesis validator.
+	blk := c.newBlock(t, c.deposit)
+
+	// The state root of the block is computed on a cached copy of the
+	// state.
+	cacheCtx, _ := c.ctx.CacheContext()
+	cached := c.stateAt(cacheCtx)
+	_, err := components.ProvideStateProcessor(
+		newTestInput(c.signer, metrics.NoopBackend{}),
+	).Transition(
+		&transition.Context{
+			Context:                 cacheCtx,
+			SkipPayloadVerification: true,
+			SkipValidateRandao:      true,
+			SkipValidateResult:      true,
+		}, cached, blk,
+	)
+	require.NoError(t, err)
+	stateRoot, err := cached.HashTreeRoot()
+	require.NoError(t, err)
+	blk.SetStateRoot(stateRoot)
+
+	_, err = sp.Transition(
+		&transition.Context{
+			Context:                 c.ctx,
+			SkipPayloadVerification: true,
+			SkipValidateRandao:      true,
+		}, c.stateAt(c.ctx), blk,
+	)
+	require.NoError(t, err)
+	for _, key := range []string{
+		"beacon_kit.state_processor.process_slots_duration",
+		"beacon_kit.state_processor.verify_signatures_duration",
+		"beacon_kit.state_processor.process_block_header_duration",
+		"beacon_kit.state_processor.process_execution_payload_duration",
+		"beacon_kit.state_processor.process_withdrawals_duration",
+		"beacon_kit.state_processor.process_randao_reveal_duration",
+		"beacon_kit.state_processor.process_operations_duration",
+		"beacon_kit.state_processor.state_root_duration",
+	} {
+		require.Equal(t, 1, backend.durations[key], key)
+	}
+	require.Equal(t, map[string]int{
+		"beacon_kit.state_processor.deposits_processed":    1,
+		"beacon_kit.state_processor.withdrawals_processed": 1,
+	}, backend.counters)
+}
+
+func TestProvideStateProcessor_VerifySignatures(t *testing.T) {
+	tests := []struct {
+		name string
+		skip bool
+		err  error
+	}{
+		{
+			name: "verified",
+			err:  core.ErrInvalidSignature,
+		},
+		{
+			name: "skipped",
+			skip: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestChain(t)
+			other, err := signer.NewLegacySigner(signer.LegacyKey{31: 2})
+			require.NoError(t, err)
+
+			// The deposit creating a validator is signed with the key of
+			// the genesis validator.
+			deposit := newTestDeposit(t, other, 1)
+			deposit.Signature = c.deposit.Signature
+
+			_, err = components.ProvideStateProcessor(
+				newTestInput(c.signer, metrics.NoopBackend{}),
+			).Transition(
+				&transition.Context{
+					Context:                   c.ctx,
+					SkipPayloadVerification:   true,
+					SkipValidateRandao:        true,
+					SkipValidateResult:        true,
+					SkipSignatureVerification: tt.skip,
+				}, c.stateAt(c.ctx), c.newBlock(t, c.deposit, deposit),
+			)
+			if tt.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.err)
+			require.ErrorContains(t, err, "deposit 1")
+		})
+	}
+}
+
+func TestProvideStateProcessor_StateRootFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		corrupt func(st components.BeaconState) error
+		field   string
+	}{
+		{
+			name: "eth1 deposit index",
+			corrupt: func(st components.BeaconState) error {
+				return st.SetEth1DepositIndex(2)
+			},
+			field: "eth1DepositIndex",
+		},
+		{
+			name: "randao mixes",
+			corrupt: func(st components.BeaconState) error {
+				return st.UpdateRandaoMixAtIndex(1, primitives.Bytes32{0x01})
+			},
+			field: "randaoMixes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestChain(t)
+			blk := c.newBlock(t)
+
+			// The reference is the state the state root of the block is
+			// computed on.
+			cacheCtx, _ := c.ctx.CacheContext()
+			reference := c.stateAt(cacheCtx)
+			_, err := components.ProvideStateProcessor(
+				newTestInput(c.signer, metrics.NoopBackend{}),
+			).Transition(
+				&transition.Context{
+					Context:                 cacheCtx,
+					SkipPayloadVerification: true,
+					SkipValidateRandao:      true,
+					SkipValidateResult:      true,
+				}, reference, blk,
+			)
+			require.NoError(t, err)
+			stateRoot, err := reference.HashTreeRoot()
+			require.NoError(t, err)
+			blk.SetStateRoot(stateRoot)
+			fields, err := reference.FieldRoots()
+			require.NoError(t, err)
+
+			// The state is corrupted once the slot of the block is
+			// processed, for the block to be a child of the genesis header.
+			in := newTestInput(c.signer, metrics.NoopBackend{})
+			in.Config.Diagnostics.StateRootFields = true
+			sp := components.ProvideStateProcessor(in)
+			st := c.stateAt(c.ctx)
+			_, err = sp.ProcessSlots(st, blk.GetSlot())
+			require.NoError(t, err)
+			require.NoError(t, tt.corrupt(st))
+			_, err = sp.Transition(
+				&transition.Context{
+					Context:                 c.ctx,
+					SkipPayloadVerification: true,
+					SkipValidateRandao:      true,
+				}, st, blk,
+			)
+			require.ErrorIs(t, err, core.ErrStateRootMismatch)
+			var report *core.StateRootMismatchError
+			require.True(t, errors.As(err, &report))
+			require.Equal(t, blk.GetStateRoot(), report.Block)
+			require.Len(t, report.Fields, len(fields))
+			require.Equal(t, []string{tt.field}, report.Diff(fields))
+			require.ErrorContains(t, err, tt.field+"=")
+		})
+	}
+}
+
+func TestProvideStateProcessor_StateRootFieldsDisabled(t *testing.T) {
+	c := newTestChain(t)
+	blk := c.newBlock(t)
+	blk.SetStateRoot(common.Root{0x01})
+
+	_, err := components.ProvideStateProcessor(
+		newTestInput(c.signer, metrics.NoopBackend{}),
+	).Transition(
+		&transition.Context{
+			Context:                 c.ctx,
+			SkipPayloadVerification: true,
+			SkipValidateRandao:      true,
+		}, c.stateAt(c.ctx), blk,
+	)
+	require.ErrorIs(t, err, core.ErrStateRootMismatch)
+	var report *core.StateRootMismatchError
+	require.False(t, errors.As(err, &report))
+}
+
+// newRecordingStateProcessor returns a state processor of input in sending
+// the payloads to engine.
+func newRecordingStateProcessor(
+	in components.StateProcessorInput,
+	engine *recordingEngine,
+) blockchain.StateProcessor[
+	*types.BeaconBlock,
+	components.BeaconState,
+	*datypes.BlobSidecars,
+	*transition.Context,
+	*types.Deposit,
+] {
+	return core.NewStateProcessor[
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		*types.BeaconBlockHeader,
+		components.BeaconState,
+		*datypes.BlobSidecars,
+		*transition.Context,
+		*types.Deposit,
+		*types.Eth1Data,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.ForkData,
+		*types.ProposerSlashing,
+		*types.Validator,
+		*engineprimitives.Withdrawal,
+		types.WithdrawalCredentials,
+	](
+		in.ChainSpec, engine, in.Signer, in.BatchVerifier, in.TelemetrySink,
+		false,
+	)
+}
+
+// newReplayBlock returns a block of a valid state root including a deposit
+// creating a validator, signed with the key of the genesis validator, which
+// is only noticed if signatures are verified.
+func (c *testChain) newReplayBlock(t *testing.T) *types.BeaconBlock {
+	t.Helper()
+	other, err := signer.NewLegacySigner(signer.LegacyKey{31: 2})
+	require.NoError(t, err)
+	deposit := newTestDeposit(t, other, 1)
+	deposit.Signature = c.deposit.Signature
+	blk := c.newBlock(t, deposit)
+
+	cacheCtx, _ := c.ctx.CacheContext()
+	cached := c.stateAt(cacheCtx)
+	_, err = components.ProvideStateProcessor(
+		newTestInput(c.signer, metrics.NoopBackend{}),
+	).Transition(
+		&transition.Context{
+			Context:                   cacheCtx,
+			SkipPayloadVerification:   true,
+			SkipValidateResult:        true,
+			SkipSignatureVerification: true,
+		}, cached, blk,
+	)
+	require.NoError(t, err)
+	stateRoot, err := cached.HashTreeRoot()
+	require.NoError(t, err)
+	blk.SetStateRoot(stateRoot)
+	return blk
+}
+
+func TestStateProcessor_Replay(t *testing.T) {
+	c := newTestChain(t)
+	blk := c.newReplayBlock(t)
+	engine := &recordingEngine{}
+	sp := newRecordingStateProcessor(
+		newTestInput(c.signer, metrics.NoopBackend{}), engine,
+	)
+
+	// The block is rejected if its signatures are verified.
+	verifiedCtx, _ := c.ctx.CacheContext()
+	verified := transition.NewReplayContext(verifiedCtx)
+	verified.SkipSignatureVerification = false
+	_, err := sp.Transition(verified, c.stateAt(verifiedCtx), blk)
+	require.ErrorIs(t, err, core.ErrInvalidSignature)
+
+	// The replay neither verifies the signatures nor sends the payload to
+	// the execution client, but still validates the state root.
+	_, err = sp.Transition(
+		transition.NewReplayContext(c.ctx), c.stateAt(c.ctx), blk,
+	)
+	require.NoError(t, err)
+	require.Zero(t, engine.payloads)
+}
+
+// chainBackend is the storage backend of the blockchain service over the
+// state of a test chain, of which all the blobs are available.
+type chainBackend struct {
+	c *testChain
+}
+
+func (b chainBackend) AvailabilityStore(context.Context) availableStore {
+	return availableStore{}
+}
+
+func (b chainBackend) StateFromContext(
+	ctx context.Context,
+) components.BeaconState {
+	return b.c.stateAt(sdk.UnwrapSDKContext(ctx))
+}
+
+func (b chainBackend) DepositStore(context.Context) noDeposits {
+	return noDeposits{}
+}
+
+// availableStore is an availability store of which all the blobs are
+// available.
+type availableStore struct{}
+
+func (availableStore) IsDataAvailable(
+	context.Context, math.Slot, *types.BeaconBlockBody,
+) bool {
+	return true
+}
+
+func (availableStore) Persist(math.Slot, *datypes.BlobSidecars) error {
+	return nil
+}
+
+// noDeposits is a deposit store without deposits.
+type noDeposits struct{}
+
+func (noDeposits) Prune(uint64, uint64) error { return nil }
+
+func (noDeposits) EnqueueDeposits([]*types.Deposit) error { return nil }
+
+// blobsProcessor is a blob processor accepting all the blobs.
+type blobsProcessor struct{}
+
+func (blobsProcessor) ProcessBlobs(
+	math.Slot, availableStore, *datypes.BlobSidecars,
+) error {
+	return nil
+}
+
+func (blobsProcessor) VerifyBlobs(math.Slot, *datypes.BlobSidecars) error {
+	return nil
+}
+
+// disabledBuilder is a local builder that is disabled.
+type disabledBuilder struct{}
+
+func (disabledBuilder) Enabled() bool { return false }
+
+func (disabledBuilder) RequestPayloadAsync(
+	context.Context, components.BeaconState, math.Slot, uint64,
+	primitives.Root, *engineprimitives.ForkchoiceStateV1,
+) (*engineprimitives.PayloadID, error) {
+	return nil, errors.New("builder disabled")
+}
+
+func (disabledBuilder) SendForceHeadFCU(
+	context.Context, *engineprimitives.ForkchoiceStateV1, math.Slot,
+) error {
+	return nil
+}
+
+// discardFeed is an event feed without subscribers.
+type discardFeed[EventT any] struct{}
+
+func (discardFeed[EventT]) Send(EventT) int { return 0 }
+
+// finalizedEvent is the event of a finalized block.
+type finalizedEvent = *events.BeaconBlockFinalizedEvent[*types.BeaconBlock]
+
+func TestChainService_ReplayBlockAndBlobs(t *testing.T) {
+	c := newTestChain(t)
+	blk := c.newReplayBlock(t)
+	in := newTestInput(c.signer, metrics.NoopBackend{})
+	engine := &recordingEngine{}
+	svc := blockchain.NewService[
+		availableStore,
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		components.BeaconState,
+		*datypes.BlobSidecars,
+		noDeposits,
+	](
+		chainBackend{c: c},
+		noop.NewLogger(),
+		in.ChainSpec,
+		engine,
+		disabledBuilder{},
+		blockchain.NewForkchoiceState(),
+		blobsProcessor{},
+		newRecordingStateProcessor(in, engine),
+		in.TelemetrySink,
+		discardFeed[*feed.Event[*types.BeaconBlock]]{},
+		discardFeed[*feed.Event[finalizedEvent]]{},
+		false,
+	)
+
+	// The block is rejected when it is processed during FinalizeBlock, its
+	// signatures being verified.
+	finalizeCtx, _ := c.ctx.CacheContext()
+	_, err := svc.ProcessBlockAndBlobs(
+		finalizeCtx, blk, &datypes.BlobSidecars{},
+	)
+	require.ErrorIs(t, err, core.ErrInvalidSignature)
+
+	// The replay neither verifies the signatures nor sends the payload to
+	// the execution client.
+	_, err = svc.ReplayBlockAndBlobs(c.ctx, blk, &datypes.BlobSidecars{})
+	require.NoError(t, err)
+	require.NoError(t, svc.Stop(c.ctx))
+	require.Zero(t, engine.payloads)
+}
@@ -27,11 +27,13 @@ import (
 	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/runtime"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // KVStore is a type alias for the beacon store with the generics defined using
@@ -41,6 +43,19 @@ type KVStore = beacondb.KVStore[
 	*types.Eth1Data, *types.Validator,
 ]
 
+// StateDump is a type alias for the beacon state dump with the generics
+// defined using primitives.
+type StateDump = beacondb.StateDump[
+	*types.Fork, *types.BeaconBlockHeader, *types.ExecutionPayloadHeader,
+	*types.Eth1Data, *types.Validator,
+]
+
+// QueryContextProvider provides contexts over historical versions of the
+// multistore.
+type QueryContextProvider interface {
+	CreateQueryContext(height int64, prove bool) (sdk.Context, error)
+}
+
 // Backend is a struct that holds the storage backend. It provides a simple
 // interface to access all types of storage required by the runtime.
 type Backend[
@@ -130,3 +145,21 @@ func (k Backend[
 ) DepositStoreT {
 	return k.ds
 }
+
+// ExportState returns the beacon state as it was at the given slot, read
+// from the version of the multistore committed at the height of that slot.
+func (k Backend[
+	AvailabilityStoreT, BeaconBlockT,
+	BeaconBlockBodyT, BeaconStateT, DepositStoreT,
+]) ExportState(
+	ctx context.Context,
+	qp QueryContextProvider,
+	slot math.Slot,
+) (*StateDump, error) {
+	//#nosec:G115 // slots never exceed the max height.
+	qctx, err := qp.CreateQueryContext(int64(slot.Unwrap()), false)
+	if err != nil {
+		return nil, err
+	}
+	return k.bs.Export(qctx.WithContext(ctx), slot)
+}
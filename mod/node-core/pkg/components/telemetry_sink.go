@@ -20,9 +20,44 @@
 
 package components
 
-import "github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
+)
 
-// ProvideTelemetrySink is a function that provides a TelemetrySink.
-func ProvideTelemetrySink() *metrics.TelemetrySink {
-	return &metrics.TelemetrySink{}
+// TelemetrySinkInput is the input for the dep inject framework.
+type TelemetrySinkInput struct {
+	depinject.In
+	Config *config.Config `optional:"true"`
+}
+
+// ProvideTelemetrySink is a function that provides a TelemetrySink backed by
+// the configured backend.
+func ProvideTelemetrySink(
+	in TelemetrySinkInput,
+) (*metrics.TelemetrySink, error) {
+	if in.Config == nil {
+		return &metrics.TelemetrySink{}, nil
+	}
+
+	var sink metrics.TelemetrySink
+	switch backend := in.Config.Telemetry.Backend; backend {
+	case "", metrics.BackendCosmos:
+		sink = metrics.NewTelemetrySink()
+	case metrics.BackendPrometheus:
+		sink = metrics.NewTelemetrySinkWithBackend(
+			metrics.NewPrometheusBackend(
+				in.Config.Telemetry.MaxSeriesPerMetric,
+			),
+		)
+	case metrics.BackendNoop:
+		sink = metrics.NewTelemetrySinkWithBackend(metrics.NoopBackend{})
+	default:
+		return nil, errors.Wrapf(
+			metrics.ErrUnsupportedBackend, "%s", backend,
+		)
+	}
+	return &sink, nil
 }
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dablob "github.com/berachain/beacon-kit/mod/da/pkg/blob"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/nodeapi"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/runtime"
+)
+
+// AvailabilityStore is the constraint on the availability store the runtime
+// can be built with.
+type AvailabilityStore[BeaconBlockBodyT any] interface {
+	runtime.AvailabilityStore[BeaconBlockBodyT, *datypes.BlobSidecars]
+	nodeapi.BlobStore
+}
+
+// BeaconBlock is the constraint on the beacon block the runtime can be built
+// with.
+type BeaconBlock[
+	BeaconBlockT any,
+	BeaconBlockBodyT BeaconBlockBody,
+] interface {
+	types.RawBeaconBlock[BeaconBlockBodyT]
+	validator.BeaconBlock[BeaconBlockT, BeaconBlockBodyT]
+	dablob.BeaconBlock[BeaconBlockBodyT]
+	// NewFromSSZ creates a new beacon block from the given SSZ bytes.
+	NewFromSSZ([]byte, uint32) (BeaconBlockT, error)
+	// Empty returns an empty beacon block of the given version.
+	Empty(uint32) BeaconBlockT
+}
+
+// BeaconBlockBody is the constraint on the beacon block body the runtime can
+// be built with.
+type BeaconBlockBody interface {
+	types.RawBeaconBlockBody
+	validator.BeaconBlockBody[
+		*types.Deposit, *types.Eth1Data, *types.ExecutionPayload,
+	]
+	dablob.BeaconBlockBody
+	deposit.BeaconBlockBody[*types.Deposit, *types.ExecutionPayload]
+}
@@ -21,41 +21,120 @@
 package config
 
 import (
+	"time"
+
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
 	"github.com/berachain/beacon-kit/mod/errors"
 	engineclient "github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/diagnostics"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/health"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/logging"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/nodeapi"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/flags"
 	viperlib "github.com/berachain/beacon-kit/mod/node-core/pkg/config/viper"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	depositstore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
+// defaultShutdownTimeout is the default time the services are given to stop
+// when the node shuts down.
+const defaultShutdownTimeout = 15 * time.Second
+
 // DefaultConfig returns the default configuration for a BeaconKit chain.
 func DefaultConfig() *Config {
 	return &Config{
-		Engine:         engineclient.DefaultConfig(),
-		KZG:            kzg.DefaultConfig(),
-		PayloadBuilder: builder.DefaultConfig(),
-		Validator:      validator.DefaultConfig(),
+		AvailabilityStore: dastore.DefaultConfig(),
+		Deposit:           deposit.DefaultConfig(),
+		DepositStore:      depositstore.DefaultConfig(),
+		Diagnostics:       diagnostics.DefaultConfig(),
+		Engine:            engineclient.DefaultConfig(),
+		EventBus:          feed.DefaultConfig(),
+		Health:            health.DefaultConfig(),
+		KZG:               kzg.DefaultConfig(),
+		Logging:           logging.DefaultConfig(),
+		NodeAPI:           nodeapi.DefaultConfig(),
+		PayloadBuilder:    builder.DefaultConfig(),
+		Pruning:           pruner.DefaultConfig(),
+		ShutdownTimeout:   defaultShutdownTimeout,
+		Signer:            signer.DefaultConfig(),
+		Telemetry:         metrics.DefaultConfig(),
+		Validator:         validator.DefaultConfig(),
 	}
 }
 
 // Config is the main configuration struct for the BeaconKit chain.
 type Config struct {
+	// AvailabilityStore is the configuration for the blob sidecar store.
+	AvailabilityStore dastore.Config `mapstructure:"availability-store"`
+	// Deposit is the configuration for the deposit service.
+	Deposit deposit.Config `mapstructure:"deposit"`
+	// DepositStore is the configuration for the deposit store.
+	DepositStore depositstore.Config `mapstructure:"deposit-store"`
+	// Diagnostics is the configuration for the diagnostics server.
+	Diagnostics diagnostics.Config `mapstructure:"diagnostics"`
 	// Engine is the configuration for the execution client.
 	Engine engineclient.Config `mapstructure:"engine"`
+	// EventBus is the configuration for the event bus.
+	EventBus feed.Config `mapstructure:"event-bus"`
+	// Health is the configuration for the health server.
+	Health health.Config `mapstructure:"health"`
 	// KZG is the configuration for the KZG blob verifier.
 	KZG kzg.Config `mapstructure:"kzg"`
+	// Logging is the configuration for the levels of the modules.
+	Logging logging.Config `mapstructure:"logging"`
+	// NodeAPI is the configuration for the beacon node API server.
+	NodeAPI nodeapi.Config `mapstructure:"node-api"`
 	// PayloadBuilder is the configuration for the local build payload timeout.
 	PayloadBuilder builder.Config `mapstructure:"payload-builder"`
+	// Pruning is the configuration for the pruners.
+	Pruning pruner.Config `mapstructure:"pruning"`
+	// ShutdownTimeout is the time the services are given to stop when the
+	// node shuts down.
+	ShutdownTimeout time.Duration `mapstructure:"shutdown-timeout"`
+	// Signer is the configuration for the BLS signer.
+	Signer signer.Config `mapstructure:"signer"`
+	// Telemetry is the configuration for the telemetry sink.
+	Telemetry metrics.Config `mapstructure:"telemetry"`
 	// Validator is the configuration for the validator client.
 	Validator validator.Config `mapstructure:"validator"`
 }
 
+// Validate returns all the problems of the configuration at once, keyed by
+// their path in the configuration file.
+func (c Config) Validate() error {
+	var errs errors.FieldErrors
+	errs.Merge(
+		"beacon-kit.availability-store", c.AvailabilityStore.Validate(),
+	)
+	errs.Merge("beacon-kit.engine", c.Engine.Validate())
+	errs.Merge("beacon-kit.kzg", c.KZG.Validate())
+	errs.Merge("beacon-kit.logging", c.Logging.Validate())
+	errs.Merge("beacon-kit.payload-builder", c.PayloadBuilder.Validate())
+	if c.ShutdownTimeout <= 0 {
+		errs.Add("beacon-kit.shutdown-timeout", errors.Newf(
+			"must be positive, got %s", c.ShutdownTimeout,
+		))
+	}
+	if c.Validator.EnableOptimisticPayloadBuilds && !c.PayloadBuilder.Enabled {
+		errs.Add(
+			"beacon-kit.validator.enable-optimistic-payload-builds",
+			errors.New("requires beacon-kit.payload-builder.enabled"),
+		)
+	}
+	return errs.Err()
+}
+
 // GetEngine returns the execution client configuration.
 func (c Config) GetEngine() engineclient.Config {
 	return c.Engine
@@ -121,6 +200,10 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		flags.RPCStartupCheckInterval,
 		defaultCfg.Engine.RPCStartupCheckInterval,
 		"rpc startup check interval")
+	startCmd.Flags().Duration(
+		flags.RPCStartupMaxWait,
+		defaultCfg.Engine.RPCStartupMaxWait,
+		"rpc startup max wait")
 	startCmd.Flags().Duration(flags.RPCJWTRefreshInterval,
 		defaultCfg.Engine.RPCJWTRefreshInterval,
 		"rpc jwt refresh interval")
@@ -135,6 +218,18 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 	startCmd.Flags().String(flags.KZGImplementation,
 		defaultCfg.KZG.Implementation,
 		"kzg implementation")
+	startCmd.Flags().Bool(flags.DepositEnabled,
+		defaultCfg.Deposit.Enabled,
+		"run the deposit service")
+	startCmd.Flags().Bool(flags.PruningEnabled,
+		defaultCfg.Pruning.Enabled,
+		"run the pruners of the deposit and availability stores")
+	startCmd.Flags().Bool(flags.PayloadBuilderEnabled,
+		defaultCfg.PayloadBuilder.Enabled,
+		"enable the local payload builder")
+	startCmd.Flags().String(flags.ChainSpec, "",
+		"chain spec of the network: a registered name, e.g. testnet or "+
+			"devnet, or file:<path> to load it from a file")
 }
 
 // AddToSFlag adds the terms of service flag to the given command.
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config_test
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	require.NoError(t, config.DefaultConfig().Validate())
+
+	// A zero fee recipient is only warned about.
+	cfg := config.DefaultConfig()
+	cfg.PayloadBuilder.SuggestedFeeRecipient = common.ZeroAddress
+	require.NoError(t, cfg.Validate())
+}
+
+func TestConfig_ValidateReportsAllErrors(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.PayloadBuilder.PayloadTimeout = 0
+	cfg.ShutdownTimeout = 0
+	cfg.KZG.Implementation = "ethereum/c-kzg-4845"
+	cfg.Logging.Format = "xml"
+	cfg.AvailabilityStore.SyncPolicy = "sometimes"
+	cfg.Logging.Modules = map[string]string{"deposit": "loud"}
+	dialURL, err := url.NewFromRaw("tcp://localhost:8551")
+	require.NoError(t, err)
+	cfg.Engine.RPCDialURL = dialURL
+
+	err = cfg.Validate()
+	var fieldErrs errors.FieldErrors
+	require.ErrorAs(t, err, &fieldErrs)
+	keys := make([]string, len(fieldErrs))
+	for i, fieldErr := range fieldErrs {
+		keys[i] = fieldErr.Key
+	}
+	require.ElementsMatch(t, []string{
+		"beacon-kit.availability-store.sync-policy",
+		"beacon-kit.engine.rpc-dial-url",
+		"beacon-kit.kzg.implementation",
+		"beacon-kit.logging.format",
+		"beacon-kit.logging.modules.deposit",
+		"beacon-kit.payload-builder.payload-timeout",
+		"beacon-kit.shutdown-timeout",
+	}, keys)
+	require.ErrorContains(t, err, `unsupported scheme "tcp"`)
+}
+
+func TestConfig_ValidateCrossField(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.PayloadBuilder.Enabled = false
+	cfg.Engine.RPCJWTRefreshInterval = time.Minute
+
+	err := cfg.Validate()
+	require.ErrorContains(t, err,
+		"beacon-kit.validator.enable-optimistic-payload-builds: "+
+			"requires beacon-kit.payload-builder.enabled",
+	)
+	require.ErrorContains(t, err,
+		"beacon-kit.engine.rpc-jwt-refresh-interval: must be below 1m0s",
+	)
+}
+
+// readTemplate renders the configuration template with cfg and reads it back.
+func readTemplate(t *testing.T, cfg *config.Config) *config.Config {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, template.Must(
+		template.New("config").Parse(config.Template),
+	).Execute(&buf, struct{ BeaconKit *config.Config }{cfg}))
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(&buf))
+	read, err := config.ReadConfigFromAppOpts(v)
+	require.NoError(t, err)
+	return read
+}
+
+func TestConfig_TemplateRoundTrip(t *testing.T) {
+	cfg := config.DefaultConfig()
+	read := readTemplate(t, cfg)
+	require.Equal(t, cfg.Telemetry, read.Telemetry)
+	require.Equal(t, cfg.AvailabilityStore, read.AvailabilityStore)
+
+	cfg.Telemetry.StateStoreOperations = false
+	cfg.AvailabilityStore.SyncPolicy = "per-write"
+	cfg.AvailabilityStore.SyncInterval = 5 * time.Second
+	read = readTemplate(t, cfg)
+	require.Equal(t, cfg.Telemetry, read.Telemetry)
+	require.Equal(t, cfg.AvailabilityStore, read.AvailabilityStore)
+}
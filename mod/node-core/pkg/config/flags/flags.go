@@ -24,6 +24,8 @@ const (
 	// Beacon Kit Root Flag.
 	beaconKitRoot      = "beacon-kit."
 	BeaconKitAcceptTos = beaconKitRoot + "accept-tos"
+	ChainSpec          = beaconKitRoot + "chain-spec"
+	DevMode            = beaconKitRoot + "dev-mode"
 
 	// Builder Config.
 	builderRoot              = beaconKitRoot + "builder."
@@ -32,12 +34,22 @@ const (
 	LocalBuilderEnabled      = builderRoot + "local-builder-enabled"
 	LocalBuildPayloadTimeout = builderRoot + "local-build-payload-timeout"
 
+	// Payload Builder Config.
+	PayloadBuilderEnabled = beaconKitRoot + "payload-builder.enabled"
+
+	// Deposit Config.
+	DepositEnabled = beaconKitRoot + "deposit.enabled"
+
+	// Pruning Config.
+	PruningEnabled = beaconKitRoot + "pruning.enabled"
+
 	// Engine Config.
 	engineRoot              = beaconKitRoot + "engine."
 	RPCDialURL              = engineRoot + "rpc-dial-url"
 	RPCRetries              = engineRoot + "rpc-retries"
 	RPCTimeout              = engineRoot + "rpc-timeout"
 	RPCStartupCheckInterval = engineRoot + "rpc-startup-check-interval"
+	RPCStartupMaxWait       = engineRoot + "rpc-startup-max-wait"
 	RPCHealthCheckInteval   = engineRoot + "rpc-health-check-interval"
 	RPCJWTRefreshInterval   = engineRoot + "rpc-jwt-refresh-interval"
 	JWTSecretPath           = engineRoot + "jwt-secret-path"
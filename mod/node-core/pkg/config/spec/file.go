@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spec
+
+import (
+	"reflect"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/viper"
+)
+
+// LoadChainSpecFile loads a chain spec from a TOML, YAML or JSON file, whose
+// keys are the mapstructure tags of chain.SpecData. The values missing from
+// the file, and the CometBFT consensus params, are those of BaseSpec. The
+// fork schedule of the chain spec must be valid.
+func LoadChainSpecFile(path string) (primitives.ChainSpec, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, errors.Wrapf(err, "failed to read chain spec %s", path)
+	}
+
+	data := BaseSpec()
+	cometValues := data.CometValues
+	if err := v.Unmarshal(
+		&data,
+		viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
+			mapstructure.TextUnmarshallerHookFunc(),
+			forkVersionHookFunc(),
+		)),
+		func(cfg *mapstructure.DecoderConfig) {
+			cfg.ErrorUnused = true
+		},
+	); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode chain spec %s", path)
+	}
+	data.CometValues = cometValues
+
+	chainSpec := chain.NewChainSpec(data)
+	if err := chainSpec.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid chain spec %s", path)
+	}
+	return chainSpec, nil
+}
+
+// forkVersionHookFunc decodes the fork versions given as strings, by the
+// name of their fork or their 4 bytes hex encoded. The fork versions are
+// the only uint32 values of a chain spec.
+func forkVersionHookFunc() mapstructure.DecodeHookFuncType {
+	return func(from, to reflect.Type, data any) (any, error) {
+		if from.Kind() != reflect.String || to.Kind() != reflect.Uint32 {
+			return data, nil
+		}
+		//nolint:errcheck // from is a string.
+		return version.Parse(data.(string))
+	}
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spec
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives"
+)
+
+const (
+	// Devnet is the name of the chain spec of the local devnet.
+	Devnet = "devnet"
+	// Testnet is the name of the chain spec of the public testnet.
+	Testnet = "testnet"
+
+	// fileScheme prefixes the path of a chain spec file.
+	fileScheme = "file:"
+)
+
+var (
+	// ErrUnknownChainSpec is returned when no chain spec is registered under
+	// a name.
+	ErrUnknownChainSpec = errors.New("unknown chain spec")
+	// ErrDuplicateChainSpec is returned when a chain spec is registered
+	// twice under the same name.
+	ErrDuplicateChainSpec = errors.New("chain spec already registered")
+)
+
+//nolint:gochecknoglobals // binaries register their networks at init.
+var registry = struct {
+	mu    sync.RWMutex
+	specs map[string]primitives.ChainSpec
+}{
+	specs: map[string]primitives.ChainSpec{
+		Devnet:  DevnetChainSpec(),
+		Testnet: TestnetChainSpec(),
+	},
+}
+
+// RegisterChainSpec registers spec under name, so that it can be selected
+// at runtime.
+func RegisterChainSpec(name string, spec primitives.ChainSpec) error {
+	if name == "" || strings.HasPrefix(name, fileScheme) {
+		return errors.Newf("invalid chain spec name %q", name)
+	}
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	if _, ok := registry.specs[name]; ok {
+		return errors.Wrapf(ErrDuplicateChainSpec, "%s", name)
+	}
+	registry.specs[name] = spec
+	return nil
+}
+
+// ChainSpecByName returns the chain spec registered under name.
+func ChainSpecByName(name string) (primitives.ChainSpec, error) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	spec, ok := registry.specs[name]
+	if !ok {
+		names := make([]string, 0, len(registry.specs))
+		for known := range registry.specs {
+			names = append(names, known)
+		}
+		slices.Sort(names)
+		return nil, errors.Wrapf(
+			ErrUnknownChainSpec, "%q, known: %s or %s<path>",
+			name, strings.Join(names, ", "), fileScheme,
+		)
+	}
+	return spec, nil
+}
+
+// Resolve returns the chain spec selected by selector: either the name of
+// a registered chain spec, or "file:" followed by the path of a chain spec
+// file. An empty selector selects fallback.
+func Resolve(
+	selector string, fallback primitives.ChainSpec,
+) (primitives.ChainSpec, error) {
+	switch {
+	case selector == "":
+		return fallback, nil
+	case strings.HasPrefix(selector, fileScheme):
+		return LoadChainSpecFile(strings.TrimPrefix(selector, fileScheme))
+	default:
+		return ChainSpecByName(selector)
+	}
+}
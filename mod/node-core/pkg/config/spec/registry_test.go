@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spec_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolve(t *testing.T) {
+	fallback := spec.TestnetChainSpec()
+
+	resolved, err := spec.Resolve("", fallback)
+	require.NoError(t, err)
+	require.Same(t, fallback, resolved)
+
+	resolved, err = spec.Resolve(spec.Devnet, fallback)
+	require.NoError(t, err)
+	require.Equal(t, uint64(80087), resolved.DepositEth1ChainID())
+
+	_, err = spec.Resolve("mainnet", fallback)
+	require.ErrorIs(t, err, spec.ErrUnknownChainSpec)
+	require.ErrorContains(t, err, "known: devnet, testnet or file:<path>")
+}
+
+func TestRegisterChainSpec(t *testing.T) {
+	custom := spec.DevnetChainSpec()
+	require.NoError(t, spec.RegisterChainSpec("registry-test", custom))
+	require.ErrorIs(t,
+		spec.RegisterChainSpec("registry-test", custom),
+		spec.ErrDuplicateChainSpec,
+	)
+	require.Error(t, spec.RegisterChainSpec("file:registry-test", custom))
+
+	resolved, err := spec.Resolve("registry-test", spec.TestnetChainSpec())
+	require.NoError(t, err)
+	require.Same(t, custom, resolved)
+}
+
+func TestLoadChainSpecFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "spec.toml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+deposit-eth1-chain-id = 1337
+domain-type-deposit = "0x03000001"
+deposit-contract-address = "0x00000000219ab540356cBB839Cbe05303d7705Fa"
+`), 0o600))
+
+	loaded, err := spec.Resolve("file:"+path, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1337), loaded.DepositEth1ChainID())
+	require.Equal(t,
+		common.DomainType{0x03, 0x00, 0x00, 0x01},
+		loaded.DomainTypeDeposit(),
+	)
+	require.Equal(t,
+		common.HexToAddress("0x00000000219ab540356cBB839Cbe05303d7705Fa"),
+		loaded.DepositContractAddress(),
+	)
+	// The values missing from the file are those of the base spec.
+	base := spec.BaseSpec()
+	require.Equal(t, base.SlotsPerEpoch, loaded.SlotsPerEpoch())
+
+	typo := filepath.Join(dir, "typo.toml")
+	require.NoError(t, os.WriteFile(
+		typo, []byte("slots-per-epok = 16\n"), 0o600,
+	))
+	_, err = spec.Resolve("file:"+typo, nil)
+	require.ErrorContains(t, err, "slots-per-epok")
+
+	_, err = spec.Resolve("file:"+filepath.Join(dir, "missing.toml"), nil)
+	require.Error(t, err)
+}
+
+func TestLoadChainSpecFile_ForkSchedule(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "spec.toml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+[[fork-schedule]]
+name = "deneb"
+version = "0x04000000"
+epoch = 0
+
+[[fork-schedule]]
+name = "electra"
+version = "electra"
+epoch = 10
+`), 0o600))
+
+	loaded, err := spec.Resolve("file:"+path, nil)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(10), loaded.ElectraForkEpoch())
+	require.Equal(t,
+		version.Deneb, loaded.ActiveForkVersionForSlot(math.Slot(10*32-1)),
+	)
+	require.Equal(t,
+		version.Electra, loaded.ActiveForkVersionForSlot(math.Slot(10*32)),
+	)
+
+	unordered := filepath.Join(dir, "unordered.toml")
+	require.NoError(t, os.WriteFile(unordered, []byte(`
+[[fork-schedule]]
+name = "electra"
+version = 5
+epoch = 0
+
+[[fork-schedule]]
+name = "deneb"
+version = 4
+epoch = 10
+`), 0o600))
+	_, err = spec.Resolve("file:"+unordered, nil)
+	require.ErrorIs(t, err, chain.ErrForkScheduleNotOrdered)
+
+	unknown := filepath.Join(dir, "unknown.toml")
+	require.NoError(t, os.WriteFile(unknown, []byte(`
+[[fork-schedule]]
+version = "fulu"
+epoch = 0
+`), 0o600))
+	_, err = spec.Resolve("file:"+unknown, nil)
+	require.ErrorContains(t, err, version.ErrUnknownVersion.Error())
+}
+
+func TestLoadChainSpecFile_ForkParams(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "spec.toml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+[[fork-schedule]]
+name = "deneb"
+version = "deneb"
+epoch = 0
+
+[[fork-schedule]]
+name = "electra"
+version = "electra"
+epoch = 10
+
+[fork-schedule.params]
+max-blobs-per-block = 9
+`), 0o600))
+
+	loaded, err := spec.Resolve("file:"+path, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), loaded.MaxBlobsPerBlock(version.Deneb))
+	require.Equal(t, uint64(9), loaded.MaxBlobsPerBlock(version.Electra))
+	require.Equal(t,
+		loaded.MaxWithdrawalsPerPayload(version.Deneb),
+		loaded.MaxWithdrawalsPerPayload(version.Electra),
+	)
+
+	lowered := filepath.Join(dir, "lowered.toml")
+	require.NoError(t, os.WriteFile(lowered, []byte(`
+[[fork-schedule]]
+name = "deneb"
+version = "deneb"
+epoch = 0
+
+[[fork-schedule]]
+name = "electra"
+version = "electra"
+epoch = 10
+
+[fork-schedule.params]
+max-blobs-per-block = 3
+`), 0o600))
+	_, err = spec.Resolve("file:"+lowered, nil)
+	require.ErrorIs(t, err, chain.ErrForkParamLowered)
+}
@@ -26,6 +26,10 @@ const Template = `
 ###                                BeaconKit                                ###
 ###############################################################################
 
+[beacon-kit]
+# Time the services are given to stop when the node shuts down.
+shutdown-timeout = "{{ .BeaconKit.ShutdownTimeout }}"
+
 [beacon-kit.engine]
 # HTTP url of the execution client JSON-RPC endpoint.
 rpc-dial-url = "{{ .BeaconKit.Engine.RPCDialURL }}"
@@ -39,12 +43,70 @@ rpc-timeout = "{{ .BeaconKit.Engine.RPCTimeout }}"
 # Interval for the startup check.
 rpc-startup-check-interval = "{{ .BeaconKit.Engine.RPCStartupCheckInterval }}"
 
+# Maximum time to wait for the execution client at startup, 0 waits
+# indefinitely.
+rpc-startup-max-wait = "{{ .BeaconKit.Engine.RPCStartupMaxWait }}"
+
 # Interval for the JWT refresh.
 rpc-jwt-refresh-interval = "{{ .BeaconKit.Engine.RPCJWTRefreshInterval }}"
 
 # Path to the execution client JWT-secret
 jwt-secret-path = "{{.BeaconKit.Engine.JWTSecretPath}}"
 
+# Number of recent payloads found VALID by the execution client which are
+# not sent to it again, 0 disables the cache.
+payload-cache-size = {{.BeaconKit.Engine.PayloadCacheSize}}
+
+# Number of slots the payloads found INVALID by the execution client are
+# remembered for, to fail their descendants without sending them to it, 0
+# disables it.
+invalid-payload-retention = {{.BeaconKit.Engine.InvalidPayloadRetention}}
+
+[beacon-kit.availability-store]
+# Backend blob sidecars are stored in.
+# Options are "filedb" or "pebble".
+storage-backend = "{{.BeaconKit.AvailabilityStore.StorageBackend}}"
+
+# Codec used to compress blob sidecars on disk, only used by filedb.
+# Options are "none", "snappy" or "zstd".
+compression = "{{.BeaconKit.AvailabilityStore.Compression}}"
+
+# When blob sidecars are synced to disk, only used by filedb.
+# Options are "per-batch", "per-write" or "never".
+sync-policy = "{{.BeaconKit.AvailabilityStore.SyncPolicy}}"
+
+# Minimum time between two syncs of the sidecars written outside of a batch
+# under the "per-batch" policy, 0 keeps the default of 1s. Writes are synced
+# by the next write once it has elapsed, there is no timer behind it.
+sync-interval = "{{.BeaconKit.AvailabilityStore.SyncInterval}}"
+
+# Number of epochs blob sidecars are kept for, 0 keeps them for the
+# minimum of the chain spec.
+blob-retention-epochs = {{.BeaconKit.AvailabilityStore.BlobRetentionEpochs}}
+
+# Allows blob-retention-epochs to be lower than the minimum of the chain
+# spec, the node may then fail to serve sidecars requested by its peers.
+unsafe-blob-retention = {{.BeaconKit.AvailabilityStore.UnsafeBlobRetention}}
+
+[beacon-kit.deposit]
+# Enabled runs the deposit service, which fetches deposits from the execution
+# layer. Nodes that do not propose blocks, such as RPC nodes, may disable it.
+enabled = {{ .BeaconKit.Deposit.Enabled }}
+
+[beacon-kit.deposit-store]
+# Number of deposits below the eth1 deposit index of the finalized state
+# that are kept when the deposit store is pruned.
+prune-safety-margin = {{.BeaconKit.DepositStore.PruneSafetyMargin}}
+
+[beacon-kit.pruning]
+# Enabled runs the pruners of the deposit and availability stores.
+enabled = {{ .BeaconKit.Pruning.Enabled }}
+
+[beacon-kit.event-bus]
+# Number of the latest events of each topic replayed to the services
+# subscribing late, such as the node API. No event is kept if 0.
+replay-size = {{.BeaconKit.EventBus.ReplaySize}}
+
 [beacon-kit.kzg]
 # Path to the trusted setup path.
 trusted-setup-path = "{{.BeaconKit.KZG.TrustedSetupPath}}"
@@ -53,6 +115,20 @@ trusted-setup-path = "{{.BeaconKit.KZG.TrustedSetupPath}}"
 # Options are "crate-crypto/go-kzg-4844" or "ethereum/c-kzg-4844".
 implementation = "{{.BeaconKit.KZG.Implementation}}"
 
+[beacon-kit.logging]
+# Format of the log lines of the node. Options are "console" for humans or
+# "json" for a JSON object per line, with the logged values as its fields.
+format = "{{.BeaconKit.Logging.Format}}"
+
+[beacon-kit.logging.modules]
+# Levels of the modules, by the name they log under with the "module" or
+# "service" key, e.g. "engine.client" = "warn" or "deposit" = "debug". Options
+# are "debug", "info", "warn" or "error". The lines below the log level of the
+# node are still discarded.
+{{- range $module, $level := .BeaconKit.Logging.Modules }}
+"{{ $module }}" = "{{ $level }}"
+{{- end }}
+
 [beacon-kit.payload-builder]
 # Enabled determines if the local payload builder is enabled.
 enabled = {{ .BeaconKit.PayloadBuilder.Enabled }}
@@ -66,6 +142,132 @@ suggested-fee-recipient = "{{.BeaconKit.PayloadBuilder.SuggestedFeeRecipient}}"
 # timeout_proposal in the CometBFT configuration.
 payload-timeout = "{{ .BeaconKit.PayloadBuilder.PayloadTimeout }}"
 
+[beacon-kit.signer]
+# Type of the BLS signer. Options are "local", which signs with the key of
+# the comet priv_validator_key.json, "web3signer", "keystore", which signs
+# with the key of an EIP-2335 keystore, or "multi", which holds the keys of
+# several validators.
+type = "{{.BeaconKit.Signer.Type}}"
+
+# Refuse to sign twice for a slot, whatever the type of the signer, as
+# recorded in data/slashing_protection.json.
+slashing-protection = {{.BeaconKit.Signer.SlashingProtection}}
+
+[beacon-kit.signer.web3signer]
+# Base URL of the Web3Signer.
+endpoint = "{{.BeaconKit.Signer.Web3Signer.Endpoint}}"
+
+# Hex encoded public key to sign with. May be left empty if the Web3Signer
+# holds a single key.
+public-key = "{{.BeaconKit.Signer.Web3Signer.PublicKey}}"
+
+# Timeout of a request to the Web3Signer.
+timeout = "{{.BeaconKit.Signer.Web3Signer.Timeout}}"
+
+# Path to the CA certificate used to verify the Web3Signer. The system roots
+# are used if empty.
+tls-ca-cert-path = "{{.BeaconKit.Signer.Web3Signer.TLSCACertPath}}"
+
+# Paths to the client certificate and key presented to the Web3Signer, for
+# mutual TLS.
+tls-cert-path = "{{.BeaconKit.Signer.Web3Signer.TLSCertPath}}"
+tls-key-path = "{{.BeaconKit.Signer.Web3Signer.TLSKeyPath}}"
+
+[beacon-kit.signer.keystore]
+# Path to the EIP-2335 keystore. The keystore imported with
+# "keys import-keystore" is used if empty.
+path = "{{.BeaconKit.Signer.Keystore.Path}}"
+
+# Path to the file holding the keystore password. The password is prompted
+# for on startup if empty.
+password-file = "{{.BeaconKit.Signer.Keystore.PasswordFile}}"
+
+[beacon-kit.signer.multi]
+# Paths to the EIP-2335 keystores of the validators.
+keystores = [{{range $i, $p := .BeaconKit.Signer.Multi.Keystores}}{{if $i}}, {{end}}"{{$p}}"{{end}}]
+
+# Path to the file holding the password shared by the keystores. The password
+# is prompted for on startup if empty.
+password-file = "{{.BeaconKit.Signer.Multi.PasswordFile}}"
+
+# Paths to the comet privval key and state files of the validators, in the
+# same order.
+priv-validator-key-files = [{{range $i, $p := .BeaconKit.Signer.Multi.PrivValidatorKeyFiles}}{{if $i}}, {{end}}"{{$p}}"{{end}}]
+priv-validator-state-files = [{{range $i, $p := .BeaconKit.Signer.Multi.PrivValidatorStateFiles}}{{if $i}}, {{end}}"{{$p}}"{{end}}]
+
+[beacon-kit.signer.audit]
+# Record every signing request to a log of chained JSON entries.
+enabled = {{.BeaconKit.Signer.Audit.Enabled}}
+
+# Path to the audit log. data/signing_audit.log is used if empty.
+path = "{{.BeaconKit.Signer.Audit.Path}}"
+
+# Size in megabytes above which the audit log is rotated, and number of
+# rotated logs kept.
+max-size-mb = {{.BeaconKit.Signer.Audit.MaxSizeMB}}
+max-backups = {{.BeaconKit.Signer.Audit.MaxBackups}}
+
+# Interval at which the audit log is synced to disk.
+flush-interval = "{{.BeaconKit.Signer.Audit.FlushInterval}}"
+
+[beacon-kit.diagnostics]
+# Serve the pprof profiles, the expvar variables and a runtime summary at
+# /debug/pprof/, /debug/vars and /debug/runtime.
+enabled = {{.BeaconKit.Diagnostics.Enabled}}
+
+# Address the diagnostics are served on. It should only be reachable from
+# the host.
+listen-address = "{{.BeaconKit.Diagnostics.ListenAddress}}"
+
+# Report the roots of the top-level fields of the state, such as the
+# validators, the balances or the latest execution payload header, when the
+# state root of a block does not match the computed one.
+state-root-fields = {{.BeaconKit.Diagnostics.StateRootFields}}
+
+[beacon-kit.health]
+# Serve the liveness of the node at /healthz and its readiness, with the
+# result of each check, at /readyz.
+enabled = {{.BeaconKit.Health.Enabled}}
+
+# Address /healthz and /readyz are served on. Kubernetes probes require it
+# to be reachable from the node the pod runs on, e.g. "0.0.0.0:8081".
+listen-address = "{{.BeaconKit.Health.ListenAddress}}"
+
+# Interval at which the checks are run to update their gauges.
+check-interval = "{{.BeaconKit.Health.CheckInterval}}"
+
+# Time after which a check that has not completed is reported as failed.
+check-timeout = "{{.BeaconKit.Health.CheckTimeout}}"
+
+[beacon-kit.node-api]
+# Serve the standard beacon node API, e.g. /eth/v1/beacon/genesis.
+enabled = {{.BeaconKit.NodeAPI.Enabled}}
+
+# Address the beacon node API is served on.
+listen-address = "{{.BeaconKit.NodeAPI.ListenAddress}}"
+
+# Address the admin routes of the node API, e.g. /admin/v1/prune/:pruner,
+# are served on, or "" to not serve them. The admin routes are not
+# authenticated: the address must only be reachable by the node operators.
+admin-listen-address = "{{.BeaconKit.NodeAPI.AdminListenAddress}}"
+
+[beacon-kit.telemetry]
+# Backend of the telemetry sink. Options are "cosmos", which reports metrics
+# through the cosmos telemetry, "prometheus", which serves them at
+# http://<listen-address>/metrics, or "noop".
+backend = "{{.BeaconKit.Telemetry.Backend}}"
+
+# Address the Prometheus metrics are served on.
+listen-address = "{{.BeaconKit.Telemetry.ListenAddress}}"
+
+# Number of label combinations of a Prometheus metric above which new
+# combinations are dropped.
+max-series-per-metric = {{.BeaconKit.Telemetry.MaxSeriesPerMetric}}
+
+# Reports the count and duration of the operations made on the beacon state
+# store, per collection and operation. Disable to avoid their overhead.
+state-store-operations = {{.BeaconKit.Telemetry.StateStoreOperations}}
+
 [beacon-kit.validator]
 # Graffiti string that will be included in the graffiti field of the beacon block.
 graffiti = "{{.BeaconKit.Validator.Graffiti}}"
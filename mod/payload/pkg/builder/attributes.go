@@ -22,12 +22,15 @@ package builder
 
 import (
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // getPayloadAttributes returns the payload attributes for the given state and
 // slot. The attribute is required to initiate a payload build process in the
-// context of an `engine_forkchoiceUpdated` call.
+// context of an `engine_forkchoiceUpdated` call. Its withdrawals are those
+// the state transition expects in the payload. A failure is returned along
+// with ErrPayloadAttributes and the error of its cause.
 func (pb *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 ]) getPayloadAttribute(
@@ -35,7 +38,7 @@ func (pb *PayloadBuilder[
 	slot math.Slot,
 	timestamp uint64,
 	prevHeadRoot [32]byte,
-) (engineprimitives.PayloadAttributer, error) {
+) (*engineprimitives.PayloadAttributes[*engineprimitives.Withdrawal], error) {
 	var (
 		prevRandao [32]byte
 	)
@@ -43,25 +46,28 @@ func (pb *PayloadBuilder[
 	// Get the expected withdrawals to include in this payload.
 	withdrawals, err := st.ExpectedWithdrawals()
 	if err != nil {
-		pb.logger.Error(
-			"Could not get expected withdrawals to get payload attribute",
-			"error",
-			err,
+		return nil, pb.payloadAttributeFailed(
+			slot, prevHeadRoot, ErrExpectedWithdrawals, err,
 		)
-		return nil, err
+	}
+
+	// If the slots are of a fixed duration, the payload must be of the
+	// timestamp of its slot rather than the one requested.
+	if slotTimestamp, ok := pb.chainSpec.SlotTimestamp(slot); ok {
+		timestamp = slotTimestamp
 	}
 
 	epoch := pb.chainSpec.SlotToEpoch(slot)
 
 	// Get the previous randao mix.
-	prevRandao, err = st.GetRandaoMixAtIndex(
-		uint64(epoch) % pb.chainSpec.EpochsPerHistoricalVector(),
-	)
+	prevRandao, err = st.GetRandaoMixAtEpoch(epoch)
 	if err != nil {
-		return nil, err
+		return nil, pb.payloadAttributeFailed(
+			slot, prevHeadRoot, ErrRandaoMix, err,
+		)
 	}
 
-	return engineprimitives.NewPayloadAttributes(
+	attrs, err := engineprimitives.NewPayloadAttributes(
 		pb.chainSpec.ActiveForkVersionForEpoch(epoch),
 		timestamp,
 		prevRandao,
@@ -69,4 +75,35 @@ func (pb *PayloadBuilder[
 		withdrawals,
 		prevHeadRoot,
 	)
+	if err != nil {
+		return nil, pb.payloadAttributeFailed(
+			slot, prevHeadRoot, ErrInvalidPayloadAttributes, err,
+		)
+	}
+	return attrs, nil
+}
+
+// payloadAttributeFailed logs and counts the failure of the payload
+// attributes for slot on top of prevHeadRoot, of cause, and returns err
+// along with ErrPayloadAttributes and cause.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+]) payloadAttributeFailed(
+	slot math.Slot,
+	prevHeadRoot [32]byte,
+	cause error,
+	err error,
+) error {
+	pb.logger.Error(
+		"failed to get payload attributes",
+		"for_slot", slot,
+		"parent_block_root", prevHeadRoot,
+		"cause", cause,
+		"error", err,
+	)
+	pb.metrics.markPayloadAttributeFailed(cause)
+	return errors.Wrapf(
+		errors.Join(ErrPayloadAttributes, cause, err),
+		"slot %d, parent block root %x", slot, prevHeadRoot,
+	)
 }
@@ -26,6 +26,8 @@ import (
 	"github.com/berachain/beacon-kit/mod/payload/pkg/cache"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -39,6 +41,8 @@ type PayloadBuilder[
 		GetBlockHash() common.ExecutionHash
 		GetFeeRecipient() common.ExecutionAddress
 		GetParentHash() common.ExecutionHash
+		GetTimestamp() math.U64
+		GetWithdrawals() []*engineprimitves.Withdrawal
 	},
 	ExecutionPayloadHeaderT interface {
 		GetBlockHash() common.ExecutionHash
@@ -50,7 +54,7 @@ type PayloadBuilder[
 	// chainSpec holds the chain specifications for the PayloadBuilder.
 	chainSpec primitives.ChainSpec
 	// logger is used for logging within the PayloadBuilder.
-	logger log.Logger[any]
+	logger *log.ThrottledLogger[any]
 	// ee is the execution engine.
 	ee ExecutionEngine[ExecutionPayloadT]
 	// pc is the payload ID cache, it is used to store
@@ -59,6 +63,14 @@ type PayloadBuilder[
 	pc *cache.PayloadIDCache[
 		engineprimitves.PayloadID, [32]byte, math.Slot,
 	]
+	// withdrawals holds the withdrawals the payloads being built were
+	// requested with, to verify those of the payloads retrieved.
+	withdrawals *requestedWithdrawals
+	// payloadFeed is the event feed for the payloads retrieved from the
+	// execution client.
+	payloadFeed EventFeed[*feed.Event[*events.PayloadBuiltEvent]]
+	// metrics is the metrics of the payload builder.
+	metrics *payloadBuilderMetrics
 }
 
 // NewService creates a new service.
@@ -70,6 +82,8 @@ func New[
 		GetBlockHash() common.ExecutionHash
 		GetParentHash() common.ExecutionHash
 		GetFeeRecipient() common.ExecutionAddress
+		GetTimestamp() math.U64
+		GetWithdrawals() []*engineprimitves.Withdrawal
 	},
 	ExecutionPayloadHeaderT interface {
 		GetBlockHash() common.ExecutionHash
@@ -83,17 +97,22 @@ func New[
 	pc *cache.PayloadIDCache[
 		engineprimitves.PayloadID, [32]byte, math.Slot,
 	],
+	payloadFeed EventFeed[*feed.Event[*events.PayloadBuiltEvent]],
+	telemetrySink TelemetrySink,
 ) *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 ] {
 	return &PayloadBuilder[
 		BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	]{
-		cfg:       cfg,
-		chainSpec: chainSpec,
-		logger:    logger,
-		ee:        ee,
-		pc:        pc,
+		cfg:         cfg,
+		chainSpec:   chainSpec,
+		logger:      log.NewThrottledLogger(logger),
+		ee:          ee,
+		pc:          pc,
+		withdrawals: newRequestedWithdrawals(),
+		payloadFeed: payloadFeed,
+		metrics:     newPayloadBuilderMetrics(telemetrySink),
 	}
 }
 
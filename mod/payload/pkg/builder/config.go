@@ -23,6 +23,7 @@ package builder
 import (
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 )
 
@@ -56,3 +57,20 @@ func DefaultConfig() Config {
 		PayloadTimeout:        defaultPayloadTimeout,
 	}
 }
+
+// Validate returns the problems of the configuration. The timeout is only
+// used, and hence only checked, if the local builder is enabled. A zero fee
+// recipient is allowed, the engine client warns about it when payloads are
+// requested.
+func (c Config) Validate() error {
+	var errs errors.FieldErrors
+	if !c.Enabled {
+		return nil
+	}
+	if c.PayloadTimeout <= 0 {
+		errs.Add("payload-timeout", errors.Newf(
+			"must be positive, got %s", c.PayloadTimeout,
+		))
+	}
+	return errs.Err()
+}
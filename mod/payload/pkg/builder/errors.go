@@ -47,6 +47,36 @@ var (
 	// received.
 	ErrNilPayloadEnvelope = errors.New("received nil payload envelope")
 
+	// ErrPayloadAttributes is returned when the payload attributes of a
+	// payload to build could not be assembled, the execution client is not
+	// asked to build it.
+	ErrPayloadAttributes = errors.New("failed to get payload attributes")
+
+	// ErrExpectedWithdrawals is returned along with ErrPayloadAttributes when
+	// the withdrawals expected in the payload could not be computed.
+	ErrExpectedWithdrawals = errors.New(
+		"failed to compute expected withdrawals",
+	)
+
+	// ErrRandaoMix is returned along with ErrPayloadAttributes when the
+	// randao mix of the epoch of the payload could not be read.
+	ErrRandaoMix = errors.New("failed to get randao mix")
+
+	// ErrInvalidPayloadAttributes is returned along with ErrPayloadAttributes
+	// when the payload attributes assembled are invalid, e.g. of a zero
+	// timestamp.
+	ErrInvalidPayloadAttributes = errors.New("invalid payload attributes")
+
+	// ErrForkchoiceUpdate is returned when the forkchoice update requesting
+	// a payload build failed.
+	ErrForkchoiceUpdate = errors.New("forkchoice update failed")
+
+	// ErrWithdrawalsMismatch is returned when the withdrawals of a payload
+	// built by the execution client are not those it was requested with.
+	ErrWithdrawalsMismatch = errors.New(
+		"payload withdrawals do not match the requested ones",
+	)
+
 	// ErrNilPayload is returned when a nil payload envelope is
 	// received.
 	ErrNilPayload = errors.New("received nil payload envelope")
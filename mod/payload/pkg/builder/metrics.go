@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+// payloadBuilderMetrics is a struct that contains metrics for the payload
+// builder.
+type payloadBuilderMetrics struct {
+	// sink is the sink for the metrics.
+	sink TelemetrySink
+}
+
+// newPayloadBuilderMetrics creates a new payloadBuilderMetrics.
+func newPayloadBuilderMetrics(sink TelemetrySink) *payloadBuilderMetrics {
+	return &payloadBuilderMetrics{sink: sink}
+}
+
+// setPayloadIDCacheSize sets the number of payloads being built, as cached
+// by their slot and parent block root.
+func (m *payloadBuilderMetrics) setPayloadIDCacheSize(size int) {
+	m.sink.SetGauge(
+		"beacon_kit.payload_builder.payload_id_cache_size", int64(size),
+	)
+}
+
+// markPayloadAttributeFailed increments the counter of the payload
+// attributes which could not be assembled, labelled with the cause of the
+// failure.
+func (m *payloadBuilderMetrics) markPayloadAttributeFailed(cause error) {
+	var label string
+	switch {
+	case errors.Is(cause, ErrExpectedWithdrawals):
+		label = "expected_withdrawals"
+	case errors.Is(cause, ErrRandaoMix):
+		label = "randao_mix"
+	case errors.Is(cause, ErrInvalidPayloadAttributes):
+		label = "invalid_attributes"
+	default:
+		label = "unknown"
+	}
+	m.sink.IncrementCounter(
+		"beacon_kit.payload_builder.payload_attribute_failed", "cause", label,
+	)
+}
+
+// markForkchoiceUpdateFailed increments the counter of the forkchoice
+// updates requesting a payload build which failed.
+func (m *payloadBuilderMetrics) markForkchoiceUpdateFailed() {
+	m.sink.IncrementCounter(
+		"beacon_kit.payload_builder.forkchoice_update_failed",
+	)
+}
@@ -25,12 +25,19 @@ import (
 	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
+// feeRecipientWarningInterval is the minimum interval between two warnings
+// about mismatched fee recipients, which would otherwise be logged at every
+// block.
+const feeRecipientWarningInterval = 5 * time.Minute
+
 // RequestPayload builds a payload for the given slot and
 // returns the payload ID.
 func (pb *PayloadBuilder[
@@ -41,8 +48,7 @@ func (pb *PayloadBuilder[
 	slot math.Slot,
 	timestamp uint64,
 	parentBlockRoot primitives.Root,
-	headEth1BlockHash common.ExecutionHash,
-	finalEth1BlockHash common.ExecutionHash,
+	fcs *engineprimitives.ForkchoiceStateV1,
 ) (*engineprimitives.PayloadID, error) {
 	if !pb.Enabled() {
 		return nil, ErrPayloadBuilderDisabled
@@ -59,27 +65,36 @@ func (pb *PayloadBuilder[
 		return &payloadID, nil
 	}
 
-	// Assemble the payload attributes.
+	// Assemble the payload attributes. The execution client is not asked
+	// to build a payload without them.
 	attrs, err := pb.getPayloadAttribute(st, slot, timestamp, parentBlockRoot)
 	if err != nil {
-		return nil, errors.Newf("%w error when getting payload attributes", err)
+		return nil, err
 	}
 
 	// Submit the forkchoice update to the execution client.
 	var payloadID *engineprimitives.PayloadID
 	payloadID, _, err = pb.ee.NotifyForkchoiceUpdate(
 		ctx, &engineprimitives.ForkchoiceUpdateRequest{
-			State: &engineprimitives.ForkchoiceStateV1{
-				HeadBlockHash:      headEth1BlockHash,
-				SafeBlockHash:      finalEth1BlockHash,
-				FinalizedBlockHash: finalEth1BlockHash,
-			},
+			State:             fcs,
 			PayloadAttributes: attrs,
 			ForkVersion:       pb.chainSpec.ActiveForkVersionForSlot(slot),
 		},
 	)
 	if err != nil {
-		return nil, err
+		pb.metrics.markForkchoiceUpdateFailed()
+		// A payload is only built on the latest payload of the state, an
+		// INVALID one is reported with the latest valid hash for the head
+		// to be moved back to it.
+		if latestValidHash, ok := engineerrors.LatestValidHash(err); ok {
+			pb.logger.Warn(
+				"not building on an INVALID head",
+				"head_eth1_hash", fcs.HeadBlockHash,
+				"latest_valid_hash", latestValidHash,
+				"for_slot", slot,
+			)
+		}
+		return nil, errors.Join(ErrForkchoiceUpdate, err)
 	}
 
 	// Only add to cache if we received back a payload ID.
@@ -88,7 +103,7 @@ func (pb *PayloadBuilder[
 			"bob the builder; can we forkchoice update it?;"+
 				" bob the builder; yes we can 🚧",
 			"head_eth1_hash",
-			headEth1BlockHash,
+			fcs.HeadBlockHash,
 			"for_slot",
 			slot,
 			"parent_block_root",
@@ -97,6 +112,8 @@ func (pb *PayloadBuilder[
 			payloadID,
 		)
 		pb.pc.Set(slot, parentBlockRoot, *payloadID)
+		pb.withdrawals.set(slot, *payloadID, attrs.Withdrawals)
+		pb.metrics.setPayloadIDCacheSize(pb.pc.Len())
 	}
 
 	return payloadID, nil
@@ -112,8 +129,7 @@ func (pb *PayloadBuilder[
 	slot math.Slot,
 	timestamp uint64,
 	parentBlockRoot primitives.Root,
-	parentEth1Hash common.ExecutionHash,
-	finalBlockHash common.ExecutionHash,
+	fcs *engineprimitives.ForkchoiceStateV1,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
 	if !pb.Enabled() {
 		return nil, ErrPayloadBuilderDisabled
@@ -127,8 +143,7 @@ func (pb *PayloadBuilder[
 		slot,
 		timestamp,
 		parentBlockRoot,
-		parentEth1Hash,
-		finalBlockHash,
+		fcs,
 	)
 	if err != nil {
 		return nil, err
@@ -151,13 +166,25 @@ func (pb *PayloadBuilder[
 	}
 
 	// Get the payload from the execution client.
-	return pb.ee.GetPayload(
+	envelope, err := pb.ee.GetPayload(
 		ctx,
 		&engineprimitives.GetPayloadRequest{
 			PayloadID:   *payloadID,
 			ForkVersion: pb.chainSpec.ActiveForkVersionForSlot(slot),
 		},
 	)
+	if err != nil {
+		return nil, err
+	} else if envelope == nil {
+		return nil, ErrNilPayloadEnvelope
+	}
+	if err = pb.verifyPayloadWithdrawals(
+		*payloadID, envelope.GetExecutionPayload(),
+	); err != nil {
+		return nil, err
+	}
+	pb.sendPayloadBuilt(ctx, slot, *payloadID, envelope)
+	return envelope, nil
 }
 
 // RetrieveOrBuildPayload attempts to pull a previously built payload
@@ -216,21 +243,83 @@ func (pb *PayloadBuilder[
 
 	pb.logger.Info("payload retrieved from local builder 🏗️ ", args...)
 
+	if !payload.IsNil() {
+		if err = engineprimitives.VerifyPayloadTimestamp(
+			pb.chainSpec, slot, uint64(payload.GetTimestamp()),
+		); err != nil {
+			return nil, err
+		}
+	}
+	if err = pb.verifyPayloadWithdrawals(payloadID, payload); err != nil {
+		return nil, err
+	}
+
 	// If the payload was built by a different builder, something is
 	// wrong the EL<>CL setup.
 	if payload.GetFeeRecipient() != pb.cfg.SuggestedFeeRecipient {
-		pb.logger.Warn(
+		pb.logger.WarnThrottled(
+			"fee-recipient-mismatch",
+			feeRecipientWarningInterval,
 			"payload fee recipient does not match suggested fee recipient - "+
 				"please check both your CL and EL configuration",
 			"payload_fee_recipient", payload.GetFeeRecipient(),
 			"suggested_fee_recipient", pb.cfg.SuggestedFeeRecipient,
 		)
 	}
+	pb.sendPayloadBuilt(ctx, slot, payloadID, envelope)
 	return envelope, err
 }
 
-// RequestPayload builds a payload for the given slot and
-// returns the payload ID.
+// verifyPayloadWithdrawals returns an error if the withdrawals of payload,
+// built under payloadID, are not those it was requested with. The payloads
+// not requested by the builder, or empty, are not verified.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+]) verifyPayloadWithdrawals(
+	payloadID engineprimitives.PayloadID,
+	payload ExecutionPayloadT,
+) error {
+	requested, ok := pb.withdrawals.get(payloadID)
+	if !ok || payload.IsNil() {
+		return nil
+	}
+	if err := verifyWithdrawals(requested, payload.GetWithdrawals()); err != nil {
+		pb.logger.Error(
+			"execution client built a payload with unexpected withdrawals",
+			"payload_id", payloadID, "error", err,
+		)
+		return err
+	}
+	return nil
+}
+
+// sendPayloadBuilt publishes the PayloadBuilt event of the payload of
+// envelope, built for slot under payloadID. Nothing is published for an
+// empty payload.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+]) sendPayloadBuilt(
+	ctx context.Context,
+	slot math.Slot,
+	payloadID engineprimitives.PayloadID,
+	envelope engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+) {
+	payload := envelope.GetExecutionPayload()
+	if payload.IsNil() {
+		return
+	}
+	pb.payloadFeed.Send(feed.NewEvent(
+		ctx, events.PayloadBuilt, &events.PayloadBuiltEvent{
+			Slot:      slot,
+			PayloadID: payloadID,
+			BlockHash: payload.GetBlockHash(),
+			Value:     envelope.GetValue(),
+		},
+	))
+}
+
+// SendForceHeadFCU sends the forkchoice state fcs to the execution client,
+// without payload attributes, to force its head at startup.
 //
 // TODO: This should be moved onto a "sync service"
 // of some kind.
@@ -238,30 +327,21 @@ func (pb *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 ]) SendForceHeadFCU(
 	ctx context.Context,
-	st BeaconStateT,
+	fcs *engineprimitives.ForkchoiceStateV1,
 	slot math.Slot,
 ) error {
-	lph, err := st.GetLatestExecutionPayloadHeader()
-	if err != nil {
-		return err
-	}
-
 	pb.logger.Info(
 		"sending startup forkchoice update to execution client 🚀 ",
-		"head_eth1_hash", lph.GetBlockHash(),
-		"safe_eth1_hash", lph.GetParentHash(),
-		"finalized_eth1_hash", lph.GetParentHash(),
+		"head_eth1_hash", fcs.HeadBlockHash,
+		"safe_eth1_hash", fcs.SafeBlockHash,
+		"finalized_eth1_hash", fcs.FinalizedBlockHash,
 		"for_slot", slot,
 	)
 
 	// Submit the forkchoice update to the execution client.
-	_, _, err = pb.ee.NotifyForkchoiceUpdate(
+	_, _, err := pb.ee.NotifyForkchoiceUpdate(
 		ctx, &engineprimitives.ForkchoiceUpdateRequest{
-			State: &engineprimitives.ForkchoiceStateV1{
-				HeadBlockHash:      lph.GetBlockHash(),
-				SafeBlockHash:      lph.GetParentHash(),
-				FinalizedBlockHash: lph.GetParentHash(),
-			},
+			State:             fcs,
 			PayloadAttributes: nil,
 			ForkVersion:       pb.chainSpec.ActiveForkVersionForSlot(slot),
 		},
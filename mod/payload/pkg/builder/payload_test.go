@@ -0,0 +1,483 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/cache"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	metricstesting "github.com/berachain/beacon-kit/mod/primitives/pkg/metrics/testing"
+	"github.com/stretchr/testify/require"
+)
+
+type testPayload struct {
+	blockHash   common.ExecutionHash
+	withdrawals []*engineprimitives.Withdrawal
+}
+
+func (p *testPayload) IsNil() bool { return p == nil }
+
+func (*testPayload) Empty(uint32) *testPayload { return new(testPayload) }
+
+func (p *testPayload) GetBlockHash() common.ExecutionHash {
+	return p.blockHash
+}
+
+func (*testPayload) GetFeeRecipient() common.ExecutionAddress {
+	return common.ExecutionAddress{}
+}
+
+func (*testPayload) GetParentHash() common.ExecutionHash {
+	return common.ExecutionHash{}
+}
+
+func (*testPayload) GetTimestamp() math.U64 { return 0 }
+
+func (p *testPayload) GetWithdrawals() []*engineprimitives.Withdrawal {
+	return p.withdrawals
+}
+
+type testHeader struct{}
+
+func (testHeader) GetBlockHash() common.ExecutionHash {
+	return common.ExecutionHash{}
+}
+
+func (testHeader) GetParentHash() common.ExecutionHash {
+	return common.ExecutionHash{}
+}
+
+type testEnvelope struct {
+	payload *testPayload
+	value   math.Wei
+}
+
+func (e testEnvelope) GetExecutionPayload() *testPayload { return e.payload }
+
+func (e testEnvelope) GetValue() math.Wei { return e.value }
+
+func (testEnvelope) GetBlobsBundle() engineprimitives.BlobsBundle {
+	return nil
+}
+
+func (testEnvelope) ShouldOverrideBuilder() bool { return false }
+
+// testState is a state expecting its withdrawals in the next payload, or
+// failing with its errors.
+type testState struct {
+	withdrawals    []*engineprimitives.Withdrawal
+	withdrawalsErr error
+	randaoMix      *primitives.Bytes32
+	randaoMixErr   error
+}
+
+func (s testState) GetRandaoMixAtEpoch(
+	math.Epoch,
+) (primitives.Bytes32, error) {
+	if s.randaoMix != nil {
+		return *s.randaoMix, s.randaoMixErr
+	}
+	return primitives.Bytes32{0x01}, s.randaoMixErr
+}
+
+func (s testState) ExpectedWithdrawals() (
+	[]*engineprimitives.Withdrawal, error,
+) {
+	if s.withdrawalsErr != nil {
+		return nil, s.withdrawalsErr
+	}
+	return append([]*engineprimitives.Withdrawal{}, s.withdrawals...), nil
+}
+
+func (testState) GetLatestExecutionPayloadHeader() (testHeader, error) {
+	return testHeader{}, nil
+}
+
+func (testState) ValidatorIndexByPubkey(
+	crypto.BLSPubkey,
+) (math.ValidatorIndex, error) {
+	return 0, nil
+}
+
+func (testState) GetBlockRootAtIndex(uint64) (primitives.Root, error) {
+	return primitives.Root{}, nil
+}
+
+// testEngine returns its envelope for every payload, and its payload ID and
+// error for every forkchoice update.
+type testEngine struct {
+	envelope  testEnvelope
+	payloadID *engineprimitives.PayloadID
+	err       error
+}
+
+func (e testEngine) GetPayload(
+	context.Context, *engineprimitives.GetPayloadRequest,
+) (engineprimitives.BuiltExecutionPayloadEnv[*testPayload], error) {
+	return e.envelope, nil
+}
+
+func (e testEngine) NotifyForkchoiceUpdate(
+	context.Context, *engineprimitives.ForkchoiceUpdateRequest,
+) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
+	return e.payloadID, nil, e.err
+}
+
+func newTestBuilder(
+	t *testing.T,
+	engine builder.ExecutionEngine[*testPayload],
+	pc *cache.PayloadIDCache[engineprimitives.PayloadID, [32]byte, math.Slot],
+	payloadFeed builder.EventFeed[*feed.Event[*events.PayloadBuiltEvent]],
+	sink builder.TelemetrySink,
+) *builder.PayloadBuilder[
+	builder.BeaconState[testHeader], *testPayload, testHeader,
+] {
+	return builder.New[
+		builder.BeaconState[testHeader], *testPayload, testHeader,
+	](
+		&builder.Config{Enabled: true},
+		chain.NewChainSpec(chain.SpecData[
+			common.DomainType, math.Epoch, common.ExecutionAddress,
+			math.Slot, any,
+		]{SlotsPerEpoch: 32}),
+		log.NewTestLogger(t),
+		engine,
+		pc,
+		payloadFeed,
+		sink,
+	)
+}
+
+func TestRequestPayloadAsync_PayloadIDCacheSize(t *testing.T) {
+	pc := cache.NewPayloadIDCache[
+		engineprimitives.PayloadID, [32]byte, math.Slot,
+	]()
+	var payloadFeed feed.Topic[*feed.Event[*events.PayloadBuiltEvent]]
+	sink := metricstesting.NewRecordingSink()
+	pb := newTestBuilder(
+		t,
+		testEngine{payloadID: &engineprimitives.PayloadID{0x01}},
+		pc, &payloadFeed, sink,
+	)
+
+	for _, root := range []common.Root{{0x01}, {0x02}} {
+		_, err := pb.RequestPayloadAsync(
+			context.Background(), testState{}, 1, 1, root,
+			&engineprimitives.ForkchoiceStateV1{},
+		)
+		require.NoError(t, err)
+	}
+	size, ok := sink.LastGauge(
+		"beacon_kit.payload_builder.payload_id_cache_size",
+	)
+	require.True(t, ok)
+	require.Equal(t, int64(2), size)
+}
+
+func TestRetrievePayload_PayloadBuiltEvent(t *testing.T) {
+	const slot = math.Slot(12)
+	var (
+		parentBlockRoot = common.Root{0x01}
+		payloadID       = engineprimitives.PayloadID{0x02}
+		blockHash       = common.ExecutionHash{0x03}
+		value           = math.Wei{0x04}
+	)
+	tests := []struct {
+		name     string
+		cached   bool
+		envelope testEnvelope
+		event    *events.PayloadBuiltEvent
+	}{
+		{
+			name:   "Built",
+			cached: true,
+			envelope: testEnvelope{
+				payload: &testPayload{blockHash: blockHash},
+				value:   value,
+			},
+			event: &events.PayloadBuiltEvent{
+				Slot:      slot,
+				PayloadID: payloadID,
+				BlockHash: blockHash,
+				Value:     value,
+			},
+		},
+		{
+			name:     "EmptyPayload",
+			cached:   true,
+			envelope: testEnvelope{value: value},
+		},
+		{
+			name: "NotRequested",
+			envelope: testEnvelope{
+				payload: &testPayload{blockHash: blockHash},
+				value:   value,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pc := cache.NewPayloadIDCache[
+				engineprimitives.PayloadID, [32]byte, math.Slot,
+			]()
+			if tt.cached {
+				pc.Set(slot, parentBlockRoot, payloadID)
+			}
+			var payloadFeed feed.Topic[*feed.Event[*events.PayloadBuiltEvent]]
+			ch := make(chan *feed.Event[*events.PayloadBuiltEvent], 1)
+			sub := payloadFeed.Subscribe(ch)
+			defer sub.Unsubscribe()
+
+			pb := newTestBuilder(
+				t, testEngine{envelope: tt.envelope}, pc, &payloadFeed,
+				metricstesting.NoopSink{},
+			)
+			//nolint:errcheck // the event is what is checked.
+			pb.RetrievePayload(context.Background(), slot, parentBlockRoot)
+
+			select {
+			case ev := <-ch:
+				require.NotNil(t, tt.event, "unexpected event")
+				require.True(t, ev.Is(events.PayloadBuilt))
+				require.Equal(t, tt.event, ev.Data())
+			default:
+				require.Nil(t, tt.event, "event not published")
+			}
+		})
+	}
+}
+
+// echoEngine is an execution client building its payloads with the
+// withdrawals of the payload attributes it was sent, as decoded from their
+// engine API JSON. It drops the last withdrawal if drop is set.
+type echoEngine struct {
+	drop              bool
+	forkchoiceUpdates int
+	attributes        json.RawMessage
+	withdrawals       []*engineprimitives.Withdrawal
+}
+
+func (e *echoEngine) GetPayload(
+	context.Context, *engineprimitives.GetPayloadRequest,
+) (engineprimitives.BuiltExecutionPayloadEnv[*testPayload], error) {
+	withdrawals := e.withdrawals
+	if e.drop {
+		withdrawals = withdrawals[:len(withdrawals)-1]
+	}
+	return testEnvelope{
+		payload: &testPayload{
+			blockHash:   common.ExecutionHash{0x01},
+			withdrawals: withdrawals,
+		},
+	}, nil
+}
+
+func (e *echoEngine) NotifyForkchoiceUpdate(
+	_ context.Context, req *engineprimitives.ForkchoiceUpdateRequest,
+) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
+	e.forkchoiceUpdates++
+	var err error
+	if e.attributes, err = json.Marshal(req.PayloadAttributes); err != nil {
+		return nil, nil, err
+	}
+	var attributes struct {
+		Withdrawals []*engineprimitives.Withdrawal `json:"withdrawals"`
+	}
+	if err = json.Unmarshal(e.attributes, &attributes); err != nil {
+		return nil, nil, err
+	}
+	e.withdrawals = attributes.Withdrawals
+	return &engineprimitives.PayloadID{0x01}, nil, nil
+}
+
+func TestRetrievePayload_Withdrawals(t *testing.T) {
+	const slot = math.Slot(1)
+	parentBlockRoot := common.Root{0x01}
+	st := testState{withdrawals: []*engineprimitives.Withdrawal{
+		{Index: 10, Validator: 3, Address: common.ExecutionAddress{0x0a}},
+		{Index: 11, Validator: 5, Amount: 1000},
+	}}
+
+	for _, tt := range []struct {
+		name string
+		drop bool
+		err  error
+	}{
+		{name: "Echoed"},
+		{name: "Dropped", drop: true, err: builder.ErrWithdrawalsMismatch},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			engine := &echoEngine{drop: tt.drop}
+			var payloadFeed feed.Topic[*feed.Event[*events.PayloadBuiltEvent]]
+			pb := newTestBuilder(
+				t, engine,
+				cache.NewPayloadIDCache[
+					engineprimitives.PayloadID, [32]byte, math.Slot,
+				](),
+				&payloadFeed, metricstesting.NoopSink{},
+			)
+
+			_, err := pb.RequestPayloadAsync(
+				ctx, st, slot, 1, parentBlockRoot,
+				&engineprimitives.ForkchoiceStateV1{},
+			)
+			require.NoError(t, err)
+
+			// The withdrawals are sent as engine API quantities.
+			var attributes struct {
+				Withdrawals []map[string]string `json:"withdrawals"`
+			}
+			require.NoError(t, json.Unmarshal(engine.attributes, &attributes))
+			require.Equal(t, []map[string]string{
+				{
+					"index":          "0xa",
+					"validatorIndex": "0x3",
+					"address":        "0x0a00000000000000000000000000000000000000",
+					"amount":         "0x0",
+				},
+				{
+					"index":          "0xb",
+					"validatorIndex": "0x5",
+					"address":        "0x0000000000000000000000000000000000000000",
+					"amount":         "0x3e8",
+				},
+			}, attributes.Withdrawals)
+
+			envelope, err := pb.RetrievePayload(ctx, slot, parentBlockRoot)
+			require.ErrorIs(t, err, tt.err)
+			if tt.err == nil {
+				require.Equal(t,
+					st.withdrawals,
+					envelope.GetExecutionPayload().GetWithdrawals(),
+				)
+			}
+		})
+	}
+}
+
+func TestRequestPayloadAsync_PayloadAttributeFailed(t *testing.T) {
+	errState := errors.New("state error")
+	tests := []struct {
+		name      string
+		st        testState
+		timestamp uint64
+		cause     error
+		label     string
+	}{
+		{
+			name:      "ExpectedWithdrawals",
+			st:        testState{withdrawalsErr: errState},
+			timestamp: 1,
+			cause:     builder.ErrExpectedWithdrawals,
+			label:     "expected_withdrawals",
+		},
+		{
+			name:      "RandaoMix",
+			st:        testState{randaoMixErr: errState},
+			timestamp: 1,
+			cause:     builder.ErrRandaoMix,
+			label:     "randao_mix",
+		},
+		{
+			name:      "EmptyRandaoMix",
+			st:        testState{randaoMix: &primitives.Bytes32{}},
+			timestamp: 1,
+			cause:     engineprimitives.ErrEmptyPrevRandao,
+			label:     "invalid_attributes",
+		},
+		{
+			name:  "ZeroTimestamp",
+			st:    testState{},
+			cause: engineprimitives.ErrInvalidTimestamp,
+			label: "invalid_attributes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pc := cache.NewPayloadIDCache[
+				engineprimitives.PayloadID, [32]byte, math.Slot,
+			]()
+			engine := &echoEngine{}
+			var payloadFeed feed.Topic[*feed.Event[*events.PayloadBuiltEvent]]
+			sink := metricstesting.NewRecordingSink()
+			pb := newTestBuilder(t, engine, pc, &payloadFeed, sink)
+
+			_, err := pb.RequestPayloadAsync(
+				context.Background(), tt.st, 1, tt.timestamp, common.Root{},
+				&engineprimitives.ForkchoiceStateV1{},
+			)
+			require.ErrorIs(t, err, builder.ErrPayloadAttributes)
+			require.ErrorIs(t, err, tt.cause)
+			require.NotErrorIs(t, err, builder.ErrForkchoiceUpdate)
+			require.Equal(t, 1, sink.CountFor(
+				"beacon_kit.payload_builder.payload_attribute_failed",
+				"cause", tt.label,
+			))
+
+			// The execution client is not asked to build the payload.
+			require.Zero(t, engine.forkchoiceUpdates)
+			require.Zero(t, pc.Len())
+		})
+	}
+}
+
+func TestRequestPayloadAsync_ForkchoiceUpdateFailed(t *testing.T) {
+	errEngine := errors.New("engine error")
+	pc := cache.NewPayloadIDCache[
+		engineprimitives.PayloadID, [32]byte, math.Slot,
+	]()
+	var payloadFeed feed.Topic[*feed.Event[*events.PayloadBuiltEvent]]
+	sink := metricstesting.NewRecordingSink()
+	pb := newTestBuilder(
+		t,
+		testEngine{
+			payloadID: &engineprimitives.PayloadID{0x01},
+			err:       errEngine,
+		},
+		pc, &payloadFeed, sink,
+	)
+
+	_, err := pb.RequestPayloadAsync(
+		context.Background(), testState{}, 1, 1, common.Root{},
+		&engineprimitives.ForkchoiceStateV1{},
+	)
+	require.ErrorIs(t, err, builder.ErrForkchoiceUpdate)
+	require.ErrorIs(t, err, errEngine)
+	require.NotErrorIs(t, err, builder.ErrPayloadAttributes)
+	require.Equal(t, 1, sink.CountFor(
+		"beacon_kit.payload_builder.forkchoice_update_failed",
+	))
+	require.Zero(t, pc.Len())
+}
@@ -36,9 +36,10 @@ type BeaconState[ExecutionPayloadHeaderT interface {
 	GetBlockHash() common.ExecutionHash
 	GetParentHash() common.ExecutionHash
 }] interface {
-	// GetRandaoMixAtIndex retrieves the RANDAO mix at a specified index.
-	GetRandaoMixAtIndex(uint64) (primitives.Bytes32, error)
-	// ExpectedWithdrawals lists the expected withdrawals in the current state.
+	// GetRandaoMixAtEpoch retrieves the RANDAO mix of an epoch.
+	GetRandaoMixAtEpoch(math.Epoch) (primitives.Bytes32, error)
+	// ExpectedWithdrawals lists the expected withdrawals in the current
+	// state, as swept by core.ExpectedWithdrawals.
 	ExpectedWithdrawals() ([]*engineprimitives.Withdrawal, error)
 	// GetLatestExecutionPayloadHeader fetches the most recent execution payload
 	// header.
@@ -66,3 +67,20 @@ type ExecutionEngine[ExecutionPayloadT any] interface {
 		req *engineprimitives.ForkchoiceUpdateRequest,
 	) (*engineprimitives.PayloadID, *common.ExecutionHash, error)
 }
+
+// EventFeed is an interface for sending events.
+type EventFeed[EventT any] interface {
+	// Send sends an event and returns the number of subscribers that
+	// received it.
+	Send(event EventT) int
+}
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
+}
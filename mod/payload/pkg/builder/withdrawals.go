@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"sync"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// requestedWithdrawalsSlots is the number of slots the withdrawals requested
+// for a payload are kept for, as its payload ID in the payload ID cache.
+const requestedWithdrawalsSlots = 2
+
+// requestedWithdrawals holds the withdrawals the payloads being built on the
+// execution client were requested with, by payload ID.
+type requestedWithdrawals struct {
+	// mu protects byPayloadID.
+	mu          sync.Mutex
+	byPayloadID map[engineprimitives.PayloadID]withdrawalsRequest
+}
+
+// withdrawalsRequest is the withdrawals requested for a payload of slot.
+type withdrawalsRequest struct {
+	slot        math.Slot
+	withdrawals []*engineprimitives.Withdrawal
+}
+
+// newRequestedWithdrawals returns an empty requestedWithdrawals.
+func newRequestedWithdrawals() *requestedWithdrawals {
+	return &requestedWithdrawals{
+		byPayloadID: make(map[engineprimitives.PayloadID]withdrawalsRequest),
+	}
+}
+
+// set records the withdrawals the payload of payloadID, for slot, was
+// requested with, pruning those of the slots too old to be retrieved.
+func (r *requestedWithdrawals) set(
+	slot math.Slot,
+	payloadID engineprimitives.PayloadID,
+	withdrawals []*engineprimitives.Withdrawal,
+) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, req := range r.byPayloadID {
+		if req.slot+requestedWithdrawalsSlots < slot {
+			delete(r.byPayloadID, id)
+		}
+	}
+	r.byPayloadID[payloadID] = withdrawalsRequest{
+		slot:        slot,
+		withdrawals: withdrawals,
+	}
+}
+
+// get returns the withdrawals the payload of payloadID was requested with,
+// and whether they are known.
+func (r *requestedWithdrawals) get(
+	payloadID engineprimitives.PayloadID,
+) ([]*engineprimitives.Withdrawal, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	req, ok := r.byPayloadID[payloadID]
+	return req.withdrawals, ok
+}
+
+// verifyWithdrawals returns an error if the withdrawals of a payload are
+// not those it was requested with: the state transition expects the
+// requested ones, it would reject the payload.
+func verifyWithdrawals(
+	requested, built []*engineprimitives.Withdrawal,
+) error {
+	if len(built) != len(requested) {
+		return errors.Wrapf(
+			ErrWithdrawalsMismatch, "requested %d withdrawals, got %d",
+			len(requested), len(built),
+		)
+	}
+	for i, withdrawal := range built {
+		if withdrawal == nil || !withdrawal.Equals(requested[i]) {
+			return errors.Wrapf(
+				ErrWithdrawalsMismatch, "withdrawal %d differs from the "+
+					"requested one with index %d", i, requested[i].GetIndex(),
+			)
+		}
+	}
+	return nil
+}
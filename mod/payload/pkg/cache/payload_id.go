@@ -21,7 +21,7 @@
 package cache
 
 import (
-	"sync"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/cache"
 )
 
 // historicalPayloadIDCacheSize defines the maximum number of slots to retain
@@ -29,16 +29,24 @@ import (
 // memory usage.
 const historicalPayloadIDCacheSize = 2
 
+// payloadIDCacheSize is the maximum number of payload IDs in the cache,
+// across the slots retained. The least recently used one is evicted beyond.
+const payloadIDCacheSize = 64
+
+// payloadIDKey is the key of a payload ID in the cache.
+type payloadIDKey[RootT ~[32]byte, SlotT ~uint64] struct {
+	slot      SlotT
+	stateRoot RootT
+}
+
 // PayloadIDCache provides a mechanism to store and retrieve payload IDs based
 // on slot and parent block hash. It is designed to improve the efficiency of
 // payload ID retrieval by caching recent entries.
 type PayloadIDCache[
 	PayloadIDT ~[8]byte, RootT ~[32]byte, SlotT ~uint64,
 ] struct {
-	// mu protects access to the slotToStateRootToPayloadID map.
-	mu sync.RWMutex
-	// slotToStateRootToPayloadID is used for storing payload ID mappings
-	slotToStateRootToPayloadID map[SlotT]map[RootT]PayloadIDT
+	// payloadIDs is used for storing payload ID mappings.
+	payloadIDs *cache.LRU[payloadIDKey[RootT, SlotT], PayloadIDT]
 }
 
 // NewPayloadIDCache initializes and returns a new instance of PayloadIDCache.
@@ -47,41 +55,27 @@ func NewPayloadIDCache[
 	PayloadIDT ~[8]byte, RootT ~[32]byte, SlotT ~uint64,
 ]() *PayloadIDCache[PayloadIDT, RootT, SlotT] {
 	return &PayloadIDCache[PayloadIDT, RootT, SlotT]{
-		mu: sync.RWMutex{},
-		slotToStateRootToPayloadID: make(
-			map[SlotT]map[RootT]PayloadIDT,
+		payloadIDs: cache.NewLRU[payloadIDKey[RootT, SlotT], PayloadIDT](
+			payloadIDCacheSize,
 		),
 	}
 }
 
-// Get retrieves the payload ID associated with a given slot and eth1 hash.
 // Has checks if a payload ID exists for a given slot and eth1 hash.
 func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) Has(
 	slot SlotT,
 	stateRoot RootT,
 ) bool {
-	p.mu.RLock()
-	defer p.mu.RUnlock()
-	_, ok := p.slotToStateRootToPayloadID[slot][stateRoot]
-	return ok
+	return p.payloadIDs.Contains(payloadIDKey[RootT, SlotT]{slot, stateRoot})
 }
 
-// was successful.
+// Get retrieves the payload ID associated with a given slot and eth1 hash,
+// and whether it was found.
 func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) Get(
 	slot SlotT,
 	stateRoot RootT,
 ) (PayloadIDT, bool) {
-	p.mu.RLock()
-	defer p.mu.RUnlock()
-	innerMap, ok := p.slotToStateRootToPayloadID[slot]
-	if !ok {
-		return PayloadIDT{}, false
-	}
-	pid, ok := innerMap[stateRoot]
-	if !ok {
-		return PayloadIDT{}, false
-	}
-	return pid, true
+	return p.payloadIDs.Get(payloadIDKey[RootT, SlotT]{slot, stateRoot})
 }
 
 // Set updates or inserts a payload ID for a given slot and eth1 hash.
@@ -90,21 +84,18 @@ func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) Get(
 func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) Set(
 	slot SlotT, stateRoot RootT, pid PayloadIDT,
 ) {
-	p.mu.Lock()
-	defer p.mu.Unlock()
-
 	// Prune older slots to maintain the cache size limit.
 	if slot >= historicalPayloadIDCacheSize {
 		p.prunePrior(slot - historicalPayloadIDCacheSize)
 	}
 
 	// Update the cache with the new payload ID.
-	innerMap, exists := p.slotToStateRootToPayloadID[slot]
-	if !exists {
-		innerMap = make(map[RootT]PayloadIDT)
-		p.slotToStateRootToPayloadID[slot] = innerMap
-	}
-	innerMap[stateRoot] = pid
+	p.payloadIDs.Set(payloadIDKey[RootT, SlotT]{slot, stateRoot}, pid)
+}
+
+// Len returns the number of payload IDs in the cache.
+func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) Len() int {
+	return p.payloadIDs.Len()
 }
 
 // UnsafePrunePrior removes payload IDs from the cache for slots less than
@@ -112,8 +103,6 @@ func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) Set(
 func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) UnsafePrunePrior(
 	slot SlotT,
 ) {
-	p.mu.Lock()
-	defer p.mu.Unlock()
 	p.prunePrior(slot)
 }
 
@@ -121,9 +110,9 @@ func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) UnsafePrunePrior(
 // slot. This method helps in managing the memory usage of the cache by
 // discarding outdated entries.
 func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) prunePrior(slot SlotT) {
-	for s := range p.slotToStateRootToPayloadID {
-		if s < slot {
-			delete(p.slotToStateRootToPayloadID, s)
-		}
-	}
+	p.payloadIDs.RemoveFunc(
+		func(key payloadIDKey[RootT, SlotT], _ PayloadIDT) bool {
+			return key.slot < slot
+		},
+	)
 }
@@ -46,6 +46,7 @@ func TestPayloadIDCache(t *testing.T) {
 		p, ok := cacheUnderTest.Get(slot, r)
 		require.True(t, ok)
 		require.Equal(t, pid, p)
+		require.Equal(t, 1, cacheUnderTest.Len())
 	})
 
 	t.Run("Overwrite existing", func(t *testing.T) {
@@ -99,4 +100,15 @@ func TestPayloadIDCache(t *testing.T) {
 			require.True(t, ok, "Expected entry to exist for slot", slot)
 		}
 	})
+	t.Run("Bounded number of entries", func(t *testing.T) {
+		bounded := cache.NewPayloadIDCache[[8]byte, [32]byte, uint64]()
+		for i := range uint8(65) {
+			bounded.Set(1, [32]byte{i}, [8]byte{i})
+		}
+
+		// The least recently used entry is evicted.
+		require.Equal(t, 64, bounded.Len())
+		require.False(t, bounded.Has(1, [32]byte{0}))
+		require.True(t, bounded.Has(1, [32]byte{64}))
+	})
 }
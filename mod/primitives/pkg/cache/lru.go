@@ -0,0 +1,331 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// EvictionReason is the reason an entry left the cache.
+type EvictionReason uint8
+
+const (
+	// EvictedCapacity is the reason of the least recently used entry
+	// leaving a full cache for a new one.
+	EvictedCapacity EvictionReason = iota
+	// EvictedExpired is the reason of an entry leaving the cache once its
+	// time to live elapsed.
+	EvictedExpired
+	// EvictedRemoved is the reason of an entry removed from the cache.
+	EvictedRemoved
+)
+
+// String returns the name of the reason.
+func (r EvictionReason) String() string {
+	switch r {
+	case EvictedCapacity:
+		return "capacity"
+	case EvictedExpired:
+		return "expired"
+	case EvictedRemoved:
+		return "removed"
+	default:
+		return "unknown"
+	}
+}
+
+// entry is an entry of an LRU.
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+	// expiresAt is the time the entry expires at, zero if it does not.
+	expiresAt time.Time
+}
+
+// eviction is an entry which left the cache, for the eviction callback.
+type eviction[K comparable, V any] struct {
+	entry  *entry[K, V]
+	reason EvictionReason
+}
+
+// LRU is a cache of a bounded number of entries, evicting the least recently
+// used one when full. Its entries may expire after a time to live. It is
+// safe for concurrent use.
+type LRU[K comparable, V any] struct {
+	cfg  config[K, V]
+	size int
+
+	// mu protects entries and order, a lookup changes the order.
+	mu sync.Mutex
+	// entries maps the keys to their element in order.
+	entries map[K]*list.Element
+	// order lists the entries from the most to the least recently used.
+	order *list.List
+}
+
+// NewLRU returns an empty LRU of at most size entries, unbounded if size is
+// not positive.
+func NewLRU[K comparable, V any](size int, opts ...Option[K, V]) *LRU[K, V] {
+	cfg := config[K, V]{now: time.Now}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &LRU[K, V]{
+		cfg:     cfg,
+		size:    size,
+		entries: make(map[K]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// Get returns the value of key and true, or false if the cache has no
+// unexpired entry for it. The entry becomes the most recently used.
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	var evicted []eviction[K, V]
+	defer func() { c.evicted(evicted) }()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.lookup(key, &evicted)
+	if !ok {
+		c.cfg.metrics.markMiss()
+		var zero V
+		return zero, false
+	}
+	c.cfg.metrics.markHit()
+	return e.value, true
+}
+
+// Contains returns whether the cache has an unexpired entry for key,
+// without it becoming the most recently used.
+func (c *LRU[K, V]) Contains(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	return ok && !c.expired(elem.Value.(*entry[K, V]))
+}
+
+// Set sets the value of key, to expire after the time to live of the cache.
+// The entry becomes the most recently used.
+func (c *LRU[K, V]) Set(key K, value V) {
+	c.SetWithTTL(key, value, c.cfg.ttl)
+}
+
+// SetWithTTL sets the value of key, to expire after ttl, or never if ttl is
+// not positive. The entry becomes the most recently used.
+func (c *LRU[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	var evicted []eviction[K, V]
+	defer func() { c.evicted(evicted) }()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.set(key, value, ttl, &evicted)
+}
+
+// GetOrSet returns the value of key and true if the cache has an unexpired
+// entry for it. Otherwise it sets the value returned by create, called with
+// the cache locked, and returns it and false. The entry becomes the most
+// recently used.
+func (c *LRU[K, V]) GetOrSet(key K, create func() V) (V, bool) {
+	var evicted []eviction[K, V]
+	defer func() { c.evicted(evicted) }()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.lookup(key, &evicted); ok {
+		c.cfg.metrics.markHit()
+		return e.value, true
+	}
+	c.cfg.metrics.markMiss()
+	value := create()
+	c.set(key, value, c.cfg.ttl, &evicted)
+	return value, false
+}
+
+// Remove removes the entry of key, and returns whether the cache had one.
+func (c *LRU[K, V]) Remove(key K) bool {
+	var evicted []eviction[K, V]
+	defer func() { c.evicted(evicted) }()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if ok {
+		evicted = append(evicted, c.remove(elem, EvictedRemoved))
+		c.cfg.metrics.setSize(c.order.Len())
+	}
+	return ok
+}
+
+// RemoveFunc removes the entries for which remove returns true, and returns
+// their number. remove is called with the cache locked.
+func (c *LRU[K, V]) RemoveFunc(remove func(K, V) bool) int {
+	var evicted []eviction[K, V]
+	defer func() { c.evicted(evicted) }()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for elem := c.order.Front(); elem != nil; {
+		next := elem.Next()
+		if e := elem.Value.(*entry[K, V]); remove(e.key, e.value) {
+			evicted = append(evicted, c.remove(elem, EvictedRemoved))
+		}
+		elem = next
+	}
+	if len(evicted) > 0 {
+		c.cfg.metrics.setSize(c.order.Len())
+	}
+	return len(evicted)
+}
+
+// PurgeExpired removes the expired entries, and returns their number.
+func (c *LRU[K, V]) PurgeExpired() int {
+	var evicted []eviction[K, V]
+	defer func() { c.evicted(evicted) }()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.purgeExpired(&evicted)
+	return len(evicted)
+}
+
+// Len returns the number of entries in the cache, including the expired
+// ones not removed yet.
+func (c *LRU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// Keys returns the keys of the unexpired entries, from the least to the
+// most recently used.
+func (c *LRU[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	keys := make([]K, 0, c.order.Len())
+	for elem := c.order.Back(); elem != nil; elem = elem.Prev() {
+		if e := elem.Value.(*entry[K, V]); !c.expired(e) {
+			keys = append(keys, e.key)
+		}
+	}
+	return keys
+}
+
+// lookup returns the unexpired entry of key, as the most recently used.
+// An expired entry is removed, and added to evicted.
+func (c *LRU[K, V]) lookup(
+	key K,
+	evicted *[]eviction[K, V],
+) (*entry[K, V], bool) {
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	e := elem.Value.(*entry[K, V])
+	if c.expired(e) {
+		*evicted = append(*evicted, c.remove(elem, EvictedExpired))
+		c.cfg.metrics.setSize(c.order.Len())
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return e, true
+}
+
+// set sets the value of key, to expire after ttl, as the most recently
+// used. The entries evicted to make room for it are added to evicted.
+func (c *LRU[K, V]) set(
+	key K,
+	value V,
+	ttl time.Duration,
+	evicted *[]eviction[K, V],
+) {
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = c.cfg.now().Add(ttl)
+	}
+
+	if elem, ok := c.entries[key]; ok {
+		e := elem.Value.(*entry[K, V])
+		e.value, e.expiresAt = value, expiresAt
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	// The expired entries make room first, then the least recently used.
+	if c.size > 0 && c.order.Len() >= c.size {
+		c.purgeExpired(evicted)
+	}
+	for c.size > 0 && c.order.Len() >= c.size {
+		*evicted = append(
+			*evicted, c.remove(c.order.Back(), EvictedCapacity),
+		)
+	}
+	c.entries[key] = c.order.PushFront(&entry[K, V]{
+		key:       key,
+		value:     value,
+		expiresAt: expiresAt,
+	})
+	c.cfg.metrics.setSize(c.order.Len())
+}
+
+// purgeExpired removes the expired entries, and adds them to evicted.
+func (c *LRU[K, V]) purgeExpired(evicted *[]eviction[K, V]) {
+	n := len(*evicted)
+	for elem := c.order.Front(); elem != nil; {
+		next := elem.Next()
+		if c.expired(elem.Value.(*entry[K, V])) {
+			*evicted = append(*evicted, c.remove(elem, EvictedExpired))
+		}
+		elem = next
+	}
+	if len(*evicted) > n {
+		c.cfg.metrics.setSize(c.order.Len())
+	}
+}
+
+// expired returns whether e expired.
+func (c *LRU[K, V]) expired(e *entry[K, V]) bool {
+	return !e.expiresAt.IsZero() && !c.cfg.now().Before(e.expiresAt)
+}
+
+// remove removes the entry of elem for reason, and returns its eviction.
+func (c *LRU[K, V]) remove(
+	elem *list.Element,
+	reason EvictionReason,
+) eviction[K, V] {
+	e := c.order.Remove(elem).(*entry[K, V])
+	delete(c.entries, e.key)
+	c.cfg.metrics.markEvicted(reason)
+	return eviction[K, V]{entry: e, reason: reason}
+}
+
+// evicted calls the eviction callback with the entries which left the
+// cache, once it is unlocked.
+func (c *LRU[K, V]) evicted(evicted []eviction[K, V]) {
+	if c.cfg.onEvict == nil {
+		return
+	}
+	for _, ev := range evicted {
+		c.cfg.onEvict(ev.entry.key, ev.entry.value, ev.reason)
+	}
+}
@@ -0,0 +1,254 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cache_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/cache"
+	metricstesting "github.com/berachain/beacon-kit/mod/primitives/pkg/metrics/testing"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a clock advanced by hand.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// evictions records the entries evicted from a cache.
+type evictions struct {
+	mu      sync.Mutex
+	keys    []string
+	reasons []cache.EvictionReason
+}
+
+func (e *evictions) onEvict(key string, _ int, reason cache.EvictionReason) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.keys = append(e.keys, key)
+	e.reasons = append(e.reasons, reason)
+}
+
+func TestLRU_EvictionOrder(t *testing.T) {
+	var evicted evictions
+	c := cache.NewLRU(
+		3, cache.WithEvictCallback[string, int](evicted.onEvict),
+	)
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Set("c", 3)
+	require.Equal(t, []string{"a", "b", "c"}, c.Keys())
+
+	// A lookup makes its entry the most recently used, a check does not.
+	_, ok := c.Get("a")
+	require.True(t, ok)
+	require.True(t, c.Contains("b"))
+	require.Equal(t, []string{"b", "c", "a"}, c.Keys())
+
+	// Setting an entry again updates it in place.
+	c.Set("c", 30)
+	require.Equal(t, []string{"b", "a", "c"}, c.Keys())
+	require.Empty(t, evicted.keys)
+
+	// The least recently used entries make room for the new ones.
+	c.Set("d", 4)
+	c.Set("e", 5)
+	require.Equal(t, []string{"c", "d", "e"}, c.Keys())
+	require.Equal(t, []string{"b", "a"}, evicted.keys)
+	require.Equal(t, []cache.EvictionReason{
+		cache.EvictedCapacity, cache.EvictedCapacity,
+	}, evicted.reasons)
+
+	value, ok := c.Get("c")
+	require.True(t, ok)
+	require.Equal(t, 30, value)
+	_, ok = c.Get("a")
+	require.False(t, ok)
+	require.Equal(t, 3, c.Len())
+}
+
+func TestLRU_Remove(t *testing.T) {
+	var evicted evictions
+	c := cache.NewLRU(
+		0, cache.WithEvictCallback[string, int](evicted.onEvict),
+	)
+	for i, key := range []string{"a", "b", "c", "d"} {
+		c.Set(key, i)
+	}
+
+	require.True(t, c.Remove("a"))
+	require.False(t, c.Remove("a"))
+	require.Equal(t, 2, c.RemoveFunc(func(_ string, v int) bool {
+		return v%2 == 1
+	}))
+	require.Equal(t, []string{"c"}, c.Keys())
+	require.ElementsMatch(t, []string{"a", "b", "d"}, evicted.keys)
+	for _, reason := range evicted.reasons {
+		require.Equal(t, cache.EvictedRemoved, reason)
+	}
+}
+
+func TestLRU_TTL(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1_700_000_000, 0)}
+	var evicted evictions
+	c := cache.NewLRU(
+		2,
+		cache.WithTTL[string, int](time.Minute),
+		cache.WithClock[string, int](clock.Now),
+		cache.WithEvictCallback[string, int](evicted.onEvict),
+	)
+	c.Set("a", 1)
+	c.SetWithTTL("b", 2, 2*time.Minute)
+	c.SetWithTTL("forever", 3, 0)
+	require.Equal(t, []string{"b", "forever"}, c.Keys())
+
+	// The entries expire once their time to live elapsed.
+	c.Remove("forever")
+	c.Set("a", 1)
+	clock.Advance(time.Minute - time.Nanosecond)
+	require.True(t, c.Contains("a"))
+	clock.Advance(time.Nanosecond)
+	require.False(t, c.Contains("a"))
+	require.True(t, c.Contains("b"))
+	require.Equal(t, []string{"b"}, c.Keys())
+
+	// An expired entry is removed once looked up.
+	_, ok := c.Get("a")
+	require.False(t, ok)
+	require.Equal(t, 1, c.Len())
+
+	// Setting an entry renews its time to live.
+	c.Set("a", 10)
+	clock.Advance(time.Minute / 2)
+	c.Set("a", 11)
+	clock.Advance(3 * time.Minute / 4)
+	require.Equal(t, 1, c.PurgeExpired())
+	value, ok := c.Get("a")
+	require.True(t, ok)
+	require.Equal(t, 11, value)
+	clock.Advance(time.Minute / 4)
+	_, ok = c.Get("a")
+	require.False(t, ok)
+
+	// The expired entries make room before the least recently used one.
+	c.SetWithTTL("b", 2, time.Second)
+	c.SetWithTTL("c", 3, time.Hour)
+	clock.Advance(time.Second)
+	c.Set("d", 4)
+	require.Equal(t, []string{"c", "d"}, c.Keys())
+
+	require.Equal(t,
+		[]string{"a", "forever", "a", "b", "a", "b"}, evicted.keys,
+	)
+	require.Equal(t, []cache.EvictionReason{
+		cache.EvictedCapacity, cache.EvictedRemoved, cache.EvictedExpired,
+		cache.EvictedExpired, cache.EvictedExpired, cache.EvictedExpired,
+	}, evicted.reasons)
+}
+
+func TestLRU_GetOrSet(t *testing.T) {
+	c := cache.NewLRU[string, int](2)
+	calls := 0
+	create := func() int {
+		calls++
+		return calls
+	}
+
+	value, loaded := c.GetOrSet("a", create)
+	require.False(t, loaded)
+	require.Equal(t, 1, value)
+	value, loaded = c.GetOrSet("a", create)
+	require.True(t, loaded)
+	require.Equal(t, 1, value)
+	require.Equal(t, 1, calls)
+}
+
+func TestLRU_Telemetry(t *testing.T) {
+	sink := metricstesting.NewRecordingSink()
+	c := cache.NewLRU(1, cache.WithTelemetry[string, int](sink, "test"))
+	c.Set("a", 1)
+	c.Get("a")
+	c.Get("b")
+	c.Set("b", 2)
+
+	require.Equal(t, 1, sink.CountFor("beacon_kit.cache.hits", "cache", "test"))
+	require.Equal(t,
+		1, sink.CountFor("beacon_kit.cache.misses", "cache", "test"),
+	)
+	require.Equal(t, 1, sink.CountFor(
+		"beacon_kit.cache.evictions", "cache", "test", "reason", "capacity",
+	))
+	size, ok := sink.LastGauge("beacon_kit.cache.size", "cache", "test")
+	require.True(t, ok)
+	require.Equal(t, int64(1), size)
+}
+
+func TestLRU_Concurrency(t *testing.T) {
+	const workers, keys = 8, 64
+	clock := &fakeClock{now: time.Unix(1_700_000_000, 0)}
+	c := cache.NewLRU(
+		keys/2,
+		cache.WithTTL[int, int](time.Second),
+		cache.WithClock[int, int](clock.Now),
+	)
+
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 1000 {
+				key := (w*i + i) % keys
+				switch i % 5 {
+				case 0:
+					c.Set(key, i)
+				case 1:
+					c.Get(key)
+				case 2:
+					c.GetOrSet(key, func() int { return i })
+				case 3:
+					c.Remove(key)
+				default:
+					clock.Advance(time.Millisecond)
+					c.PurgeExpired()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	require.LessOrEqual(t, c.Len(), keys/2)
+	require.LessOrEqual(t, len(c.Keys()), c.Len())
+}
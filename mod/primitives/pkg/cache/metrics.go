@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cache
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
+}
+
+// lruMetrics records the metrics of a cache, labeled with its name. No
+// metric is recorded without a sink.
+type lruMetrics struct {
+	sink  TelemetrySink
+	cache string
+}
+
+// markHit counts a lookup finding its entry.
+func (m lruMetrics) markHit() {
+	if m.sink != nil {
+		m.sink.IncrementCounter("beacon_kit.cache.hits", "cache", m.cache)
+	}
+}
+
+// markMiss counts a lookup not finding its entry, or finding it expired.
+func (m lruMetrics) markMiss() {
+	if m.sink != nil {
+		m.sink.IncrementCounter("beacon_kit.cache.misses", "cache", m.cache)
+	}
+}
+
+// markEvicted counts an entry leaving the cache for reason.
+func (m lruMetrics) markEvicted(reason EvictionReason) {
+	if m.sink != nil {
+		m.sink.IncrementCounter(
+			"beacon_kit.cache.evictions",
+			"cache", m.cache, "reason", reason.String(),
+		)
+	}
+}
+
+// setSize sets the number of entries in the cache.
+func (m lruMetrics) setSize(size int) {
+	if m.sink != nil {
+		m.sink.SetGauge("beacon_kit.cache.size", int64(size), "cache", m.cache)
+	}
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cache
+
+import "time"
+
+// Option configures an LRU.
+type Option[K comparable, V any] func(*config[K, V])
+
+// config is the configuration of an LRU.
+type config[K comparable, V any] struct {
+	ttl     time.Duration
+	now     func() time.Time
+	onEvict func(K, V, EvictionReason)
+	metrics lruMetrics
+}
+
+// WithTTL sets the time the entries are kept for, unless set with their
+// own. The entries do not expire by default.
+func WithTTL[K comparable, V any](ttl time.Duration) Option[K, V] {
+	return func(c *config[K, V]) {
+		c.ttl = ttl
+	}
+}
+
+// WithClock sets the clock the entries expire by, time.Now by default.
+func WithClock[K comparable, V any](now func() time.Time) Option[K, V] {
+	return func(c *config[K, V]) {
+		c.now = now
+	}
+}
+
+// WithEvictCallback sets the function called with the entries leaving the
+// cache, and the reason they left it. It is called once the cache is
+// unlocked, it may use the cache.
+func WithEvictCallback[K comparable, V any](
+	onEvict func(K, V, EvictionReason),
+) Option[K, V] {
+	return func(c *config[K, V]) {
+		c.onEvict = onEvict
+	}
+}
+
+// WithTelemetry records the metrics of the cache to sink, labeled with its
+// name. No metric is recorded by default.
+func WithTelemetry[K comparable, V any](
+	sink TelemetrySink,
+	name string,
+) Option[K, V] {
+	return func(c *config[K, V]) {
+		c.metrics = lruMetrics{sink: sink, cache: name}
+	}
+}
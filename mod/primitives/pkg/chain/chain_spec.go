@@ -20,6 +20,8 @@
 
 package chain
 
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+
 // Spec defines an interface for accessing chain-specific parameters.
 type Spec[
 	DomainTypeT ~[4]byte,
@@ -41,6 +43,15 @@ type Spec[
 	// EffectiveBalanceIncrement returns the increment of balance used in reward
 	// calculations.
 	EffectiveBalanceIncrement() uint64
+	// HysteresisQuotient returns the quotient of the effective balance
+	// increment giving the unit of the hysteresis thresholds.
+	HysteresisQuotient() uint64
+	// HysteresisDownwardMultiplier returns the number of hysteresis units a
+	// balance must fall below the effective balance for it to be updated.
+	HysteresisDownwardMultiplier() uint64
+	// HysteresisUpwardMultiplier returns the number of hysteresis units a
+	// balance must rise above the effective balance for it to be updated.
+	HysteresisUpwardMultiplier() uint64
 
 	// Time parameters constants.
 	//
@@ -51,6 +62,29 @@ type Spec[
 	// MinEpochsToInactivityPenalty returns the minimum number of epochs before
 	// an inactivity penalty is applied.
 	MinEpochsToInactivityPenalty() uint64
+	// MaxSeedLookahead returns the number of epochs after the current one at
+	// which activations and exits take effect.
+	MaxSeedLookahead() uint64
+	// MinValidatorWithdrawabilityDelay returns the number of epochs after its
+	// exit before a validator can withdraw.
+	MinValidatorWithdrawabilityDelay() uint64
+	// GenesisTime returns the unix time of the genesis of the chain.
+	GenesisTime() uint64
+	// SecondsPerSlot returns the duration of a slot, zero if the slots are
+	// not of a fixed duration.
+	SecondsPerSlot() uint64
+
+	// Validator cycle
+	//
+	// MinPerEpochChurnLimit returns the minimum number of validators that may
+	// be activated or exited in an epoch.
+	MinPerEpochChurnLimit() uint64
+	// ChurnLimitQuotient returns the quotient of the number of active
+	// validators giving the churn limit of an epoch.
+	ChurnLimitQuotient() uint64
+	// MaxPerEpochActivationChurnLimit returns the maximum number of
+	// validators that may be activated in an epoch.
+	MaxPerEpochActivationChurnLimit() uint64
 
 	// Signature Domains
 	//
@@ -89,8 +123,16 @@ type Spec[
 	// Fork-related values.
 	//
 	// ElectraForkEpoch returns the epoch at which the Electra fork takes
-	// effect.
+	// effect, the maximum epoch if it is not scheduled.
 	ElectraForkEpoch() EpochT
+	// ForkSchedule returns the forks of the chain with their activation
+	// epochs.
+	ForkSchedule() ForkSchedule[EpochT]
+	// Validate returns an error if the hysteresis, churn limit or slashing
+	// quotients, or the length of the slashings vector, are zero, if the
+	// fork schedule is invalid, or if a fork lowers a per-fork parameter or
+	// sets it above its limit.
+	Validate() error
 
 	// State list lengths
 	//
@@ -112,12 +154,18 @@ type Spec[
 	// ProportionalSlashingMultiplier returns the multiplier for calculating
 	// slashing penalties.
 	ProportionalSlashingMultiplier() uint64
+	// MinSlashingPenaltyQuotient returns the quotient of the effective balance
+	// a slashed validator is penalized by.
+	MinSlashingPenaltyQuotient() uint64
+	// WhistleblowerRewardQuotient returns the quotient of the effective balance
+	// of a slashed validator the whistleblower is rewarded with.
+	WhistleblowerRewardQuotient() uint64
 
 	// Capella Values
 	//
 	// MaxWithdrawalsPerPayload returns the maximum number of withdrawals per
-	// payload.
-	MaxWithdrawalsPerPayload() uint64
+	// payload at the fork of forkVersion.
+	MaxWithdrawalsPerPayload(forkVersion uint32) uint64
 	// MaxValidatorsPerWithdrawalsSweep returns the maximum number of validators
 	// per withdrawal sweep.
 	MaxValidatorsPerWithdrawalsSweep() uint64
@@ -130,8 +178,9 @@ type Spec[
 	// MaxBlobCommitmentsPerBlock returns the maximum number of blob commitments
 	// per block.
 	MaxBlobCommitmentsPerBlock() uint64
-	// MaxBlobsPerBlock returns the maximum number of blobs per block.
-	MaxBlobsPerBlock() uint64
+	// MaxBlobsPerBlock returns the maximum number of blobs per block at the
+	// fork of forkVersion.
+	MaxBlobsPerBlock(forkVersion uint32) uint64
 	// FieldElementsPerBlob returns the number of field elements per blob.
 	FieldElementsPerBlob() uint64
 	// BytesPerBlob returns the number of bytes per blob.
@@ -150,9 +199,15 @@ type Spec[
 	// WithinDAPeriod checks if a given block slot is within the data
 	// availability period relative to the current slot.
 	WithinDAPeriod(block, current SlotT) bool
+	// SlotTimestamp returns the timestamp of the payload of a slot, and
+	// whether the slots are of a fixed duration for it to be defined.
+	SlotTimestamp(slot SlotT) (uint64, bool)
 
 	// CometBFT Consensus
 	GetCometBFTConfigForSlot(slot SlotT) CometBFTConfigT
+	// ConsensusParams returns the consensus parameters of CometBFT
+	// overridden at the fork of forkVersion.
+	ConsensusParams(forkVersion uint32) ConsensusParams
 }
 
 // chainSpec is a concrete implementation of the ChainSpec interface, holding
@@ -215,6 +270,27 @@ func (c chainSpec[
 	return c.Data.EffectiveBalanceIncrement
 }
 
+// HysteresisQuotient returns the hysteresis quotient.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) HysteresisQuotient() uint64 {
+	return c.Data.HysteresisQuotient
+}
+
+// HysteresisDownwardMultiplier returns the hysteresis downward multiplier.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) HysteresisDownwardMultiplier() uint64 {
+	return c.Data.HysteresisDownwardMultiplier
+}
+
+// HysteresisUpwardMultiplier returns the hysteresis upward multiplier.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) HysteresisUpwardMultiplier() uint64 {
+	return c.Data.HysteresisUpwardMultiplier
+}
+
 // SlotsPerEpoch returns the number of slots per epoch.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -237,6 +313,61 @@ func (c chainSpec[
 	return c.Data.MinEpochsToInactivityPenalty
 }
 
+// MaxSeedLookahead returns the number of epochs after the current one at which
+// activations and exits take effect.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxSeedLookahead() uint64 {
+	return c.Data.MaxSeedLookahead
+}
+
+// MinValidatorWithdrawabilityDelay returns the number of epochs after its exit
+// before a validator can withdraw.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinValidatorWithdrawabilityDelay() uint64 {
+	return c.Data.MinValidatorWithdrawabilityDelay
+}
+
+// GenesisTime returns the unix time of the genesis of the chain.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) GenesisTime() uint64 {
+	return c.Data.GenesisTime
+}
+
+// SecondsPerSlot returns the duration of a slot, zero if the slots are not of
+// a fixed duration.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) SecondsPerSlot() uint64 {
+	return c.Data.SecondsPerSlot
+}
+
+// MinPerEpochChurnLimit returns the minimum number of validators that may be
+// activated or exited in an epoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinPerEpochChurnLimit() uint64 {
+	return c.Data.MinPerEpochChurnLimit
+}
+
+// ChurnLimitQuotient returns the quotient of the number of active validators
+// giving the churn limit of an epoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ChurnLimitQuotient() uint64 {
+	return c.Data.ChurnLimitQuotient
+}
+
+// MaxPerEpochActivationChurnLimit returns the maximum number of validators that
+// may be activated in an epoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxPerEpochActivationChurnLimit() uint64 {
+	return c.Data.MaxPerEpochActivationChurnLimit
+}
+
 // DomainProposer returns the domain for beacon proposer signatures.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -330,11 +461,34 @@ func (c chainSpec[
 	return c.Data.TargetSecondsPerEth1Block
 }
 
-// ElectraForkEpoch returns the epoch of the Electra fork.
+// ElectraForkEpoch returns the epoch of the Electra fork, the maximum epoch
+// if it is not scheduled.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) ElectraForkEpoch() EpochT {
-	return c.Data.ElectraForkEpoch
+	if epoch, ok := c.ForkSchedule().EpochOf(version.Electra); ok {
+		return epoch
+	}
+	return ^EpochT(0)
+}
+
+// ForkSchedule returns the fork schedule of the chain. It is the one set in
+// the data, or else Deneb from genesis followed by Electra from
+// ElectraForkEpoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ForkSchedule() ForkSchedule[EpochT] {
+	if len(c.Data.ForkSchedule) > 0 {
+		return c.Data.ForkSchedule
+	}
+	return ForkSchedule[EpochT]{
+		{Name: "deneb", Version: version.Deneb, Epoch: 0},
+		{
+			Name:    "electra",
+			Version: version.Electra,
+			Epoch:   c.Data.ElectraForkEpoch,
+		},
+	}
 }
 
 // EpochsPerHistoricalVector returns the number of epochs per historical vector.
@@ -379,12 +533,18 @@ func (c chainSpec[
 	return c.Data.ProportionalSlashingMultiplier
 }
 
-// MaxWithdrawalsPerPayload returns the maximum number of withdrawals per
-// payload.
+// MinSlashingPenaltyQuotient returns the minimum slashing penalty quotient.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinSlashingPenaltyQuotient() uint64 {
+	return c.Data.MinSlashingPenaltyQuotient
+}
+
+// WhistleblowerRewardQuotient returns the whistleblower reward quotient.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
-]) MaxWithdrawalsPerPayload() uint64 {
-	return c.Data.MaxWithdrawalsPerPayload
+]) WhistleblowerRewardQuotient() uint64 {
+	return c.Data.WhistleblowerRewardQuotient
 }
 
 // MaxValidatorsPerWithdrawalsSweep returns the maximum number of validators per
@@ -411,13 +571,6 @@ func (c chainSpec[
 	return c.Data.MaxBlobCommitmentsPerBlock
 }
 
-// MaxBlobsPerBlock returns the maximum number of blobs per block.
-func (c chainSpec[
-	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
-]) MaxBlobsPerBlock() uint64 {
-	return c.Data.MaxBlobsPerBlock
-}
-
 // FieldElementsPerBlob returns the number of field elements per blob.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -42,6 +42,16 @@ type SpecData[
 	EjectionBalance uint64 `mapstructure:"ejection-balance"`
 	// EffectiveBalanceIncrement is the effective balance increment.
 	EffectiveBalanceIncrement uint64 `mapstructure:"effective-balance-increment"`
+	// HysteresisQuotient is the quotient of the effective balance increment
+	// giving the unit of the hysteresis thresholds of effective balance
+	// updates.
+	HysteresisQuotient uint64 `mapstructure:"hysteresis-quotient"`
+	// HysteresisDownwardMultiplier is the number of hysteresis units a balance
+	// must fall below the effective balance for it to be updated.
+	HysteresisDownwardMultiplier uint64 `mapstructure:"hysteresis-downward-multiplier"`
+	// HysteresisUpwardMultiplier is the number of hysteresis units a balance
+	// must rise above the effective balance for it to be updated.
+	HysteresisUpwardMultiplier uint64 `mapstructure:"hysteresis-upward-multiplier"`
 
 	// Time parameters constants.
 	//
@@ -52,6 +62,30 @@ type SpecData[
 	// MinEpochsToInactivityPenalty is the minimum number of epochs before a
 	// validator is penalized for inactivity.
 	MinEpochsToInactivityPenalty uint64 `mapstructure:"min-epochs-to-inactivity-penalty"`
+	// MaxSeedLookahead is the number of epochs after the current one at
+	// which the validators that are activated or exited take effect.
+	MaxSeedLookahead uint64 `mapstructure:"max-seed-lookahead"`
+	// MinValidatorWithdrawabilityDelay is the number of epochs after its
+	// exit before a validator can withdraw.
+	MinValidatorWithdrawabilityDelay uint64 `mapstructure:"min-validator-withdrawability-delay"`
+	// GenesisTime is the unix time of the genesis of the chain.
+	GenesisTime uint64 `mapstructure:"genesis-time"`
+	// SecondsPerSlot is the duration of a slot. Zero if the slots are not of
+	// a fixed duration, the payload timestamps then not being bound to them.
+	SecondsPerSlot uint64 `mapstructure:"seconds-per-slot"`
+
+	// Validator cycle constants.
+	//
+	// MinPerEpochChurnLimit is the minimum number of validators that may be
+	// activated or exited in an epoch.
+	MinPerEpochChurnLimit uint64 `mapstructure:"min-per-epoch-churn-limit"`
+	// ChurnLimitQuotient is the quotient of the number of active validators
+	// giving the number of validators that may be activated or exited in an
+	// epoch.
+	ChurnLimitQuotient uint64 `mapstructure:"churn-limit-quotient"`
+	// MaxPerEpochActivationChurnLimit is the maximum number of validators
+	// that may be activated in an epoch.
+	MaxPerEpochActivationChurnLimit uint64 `mapstructure:"max-per-epoch-activation-churn-limit"`
 
 	// Signature domains.
 	//
@@ -92,7 +126,12 @@ type SpecData[
 	// Fork-related values.
 	//
 	// ElectraForkEpoch is the epoch at which the Electra fork is activated.
+	// It is ignored if ForkSchedule is set.
 	ElectraForkEpoch EpochT `mapstructure:"electra-fork-epoch"`
+	// ForkSchedule is the list of the forks of the chain with their
+	// activation epochs. If empty, Deneb is active from genesis and Electra
+	// from ElectraForkEpoch.
+	ForkSchedule ForkSchedule[EpochT] `mapstructure:"fork-schedule"`
 
 	// State list lengths
 	//
@@ -114,11 +153,18 @@ type SpecData[
 	// ProportionalSlashingMultiplier is the slashing multiplier relative to the
 	// base penalty.
 	ProportionalSlashingMultiplier uint64 `mapstructure:"proportional-slashing-multiplier"`
+	// MinSlashingPenaltyQuotient is the quotient of the effective balance a
+	// slashed validator is penalized by.
+	MinSlashingPenaltyQuotient uint64 `mapstructure:"min-slashing-penalty-quotient"`
+	// WhistleblowerRewardQuotient is the quotient of the effective balance of a
+	// slashed validator the whistleblower is rewarded with.
+	WhistleblowerRewardQuotient uint64 `mapstructure:"whistleblower-reward-quotient"`
 
 	// Capella Values
 	//
 	// MaxWithdrawalsPerPayload indicates the maximum number of withdrawal
-	// operations allowed in a single payload.
+	// operations allowed in a single payload. It may be overridden from a
+	// fork on by the fork schedule.
 	MaxWithdrawalsPerPayload uint64 `mapstructure:"max-withdrawals-per-payload"`
 	// MaxValidatorsPerWithdrawalsSweep specifies the maximum number of
 	// validator
@@ -134,6 +180,7 @@ type SpecData[
 	// commitments allowed per block.
 	MaxBlobCommitmentsPerBlock uint64 `mapstructure:"max-blob-commitments-per-block"`
 	// MaxBlobsPerBlock specifies the maximum number of blobs allowed per block.
+	// It may be overridden from a fork on by the fork schedule.
 	MaxBlobsPerBlock uint64 `mapstructure:"max-blobs-per-block"`
 	// FieldElementsPerBlob specifies the number of field elements per blob.
 	FieldElementsPerBlob uint64 `mapstructure:"field-elements-per-blob"`
@@ -0,0 +1,223 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+var (
+	// ErrForkParamLowered is returned when a fork sets a parameter below its
+	// value at the previous fork.
+	ErrForkParamLowered = errors.New("fork lowers a parameter")
+	// ErrForkParamAboveLimit is returned when a parameter is set above the
+	// limit of the data it bounds.
+	ErrForkParamAboveLimit = errors.New("fork parameter above its limit")
+	// ErrZeroQuotient is returned when a quotient of the chain spec, or the
+	// length of the slashings vector, is zero.
+	ErrZeroQuotient = errors.New("chain spec quotient is zero")
+)
+
+// ForkParams are the parameters of the chain spec overridden from a fork
+// on. A parameter left nil keeps its value at the previous fork, or its
+// base value in the chain spec.
+//
+//nolint:lll // struct tags.
+type ForkParams struct {
+	// MaxWithdrawalsPerPayload overrides the maximum number of withdrawals
+	// per payload.
+	MaxWithdrawalsPerPayload *uint64 `mapstructure:"max-withdrawals-per-payload"`
+	// MaxBlobsPerBlock overrides the maximum number of blobs per block.
+	MaxBlobsPerBlock *uint64 `mapstructure:"max-blobs-per-block"`
+	// Consensus overrides the consensus parameters of CometBFT.
+	Consensus ConsensusParams `mapstructure:"consensus"`
+}
+
+// ConsensusParams are the consensus parameters of CometBFT overridden from
+// a fork on, over those of the chain spec. A parameter left nil keeps its
+// value at the previous fork.
+//
+//nolint:lll // struct tags.
+type ConsensusParams struct {
+	// MaxBlockBytes overrides the maximum size of a block, in bytes.
+	MaxBlockBytes *int64 `mapstructure:"max-block-bytes"`
+	// MaxBlockGas overrides the maximum gas of a block, -1 is unlimited.
+	MaxBlockGas *int64 `mapstructure:"max-block-gas"`
+	// EvidenceMaxAgeNumBlocks overrides the number of blocks evidence is
+	// valid for.
+	EvidenceMaxAgeNumBlocks *int64 `mapstructure:"evidence-max-age-num-blocks"`
+	// EvidenceMaxBytes overrides the maximum size of the evidence of a
+	// block, in bytes.
+	EvidenceMaxBytes *int64 `mapstructure:"evidence-max-bytes"`
+}
+
+// paramAt returns the value of a parameter at the fork of version v: the
+// last override of the forks of the schedule up to v, or base if none of
+// them overrides it.
+func (s ForkSchedule[EpochT]) paramAt(
+	v uint32,
+	base uint64,
+	param func(ForkParams) *uint64,
+) uint64 {
+	value := base
+	for _, fork := range s {
+		if !version.IsAtLeast(v, fork.Version) {
+			break
+		}
+		if override := param(fork.Params); override != nil {
+			value = *override
+		}
+	}
+	return value
+}
+
+// MaxWithdrawalsPerPayload returns the maximum number of withdrawals per
+// payload at the fork of forkVersion.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxWithdrawalsPerPayload(forkVersion uint32) uint64 {
+	return c.ForkSchedule().paramAt(
+		forkVersion, c.Data.MaxWithdrawalsPerPayload,
+		func(p ForkParams) *uint64 { return p.MaxWithdrawalsPerPayload },
+	)
+}
+
+// MaxBlobsPerBlock returns the maximum number of blobs per block at the fork
+// of forkVersion.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxBlobsPerBlock(forkVersion uint32) uint64 {
+	return c.ForkSchedule().paramAt(
+		forkVersion, c.Data.MaxBlobsPerBlock,
+		func(p ForkParams) *uint64 { return p.MaxBlobsPerBlock },
+	)
+}
+
+// ConsensusParams returns the consensus parameters of CometBFT overridden
+// at the fork of forkVersion: for each parameter, the last override of the
+// forks of the schedule up to it, nil if none of them overrides it.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ConsensusParams(forkVersion uint32) ConsensusParams {
+	var params ConsensusParams
+	for _, fork := range c.ForkSchedule() {
+		if !version.IsAtLeast(forkVersion, fork.Version) {
+			break
+		}
+		for _, param := range []struct {
+			value    **int64
+			override *int64
+		}{
+			{&params.MaxBlockBytes, fork.Params.Consensus.MaxBlockBytes},
+			{&params.MaxBlockGas, fork.Params.Consensus.MaxBlockGas},
+			{
+				&params.EvidenceMaxAgeNumBlocks,
+				fork.Params.Consensus.EvidenceMaxAgeNumBlocks,
+			},
+			{&params.EvidenceMaxBytes, fork.Params.Consensus.EvidenceMaxBytes},
+		} {
+			if param.override != nil {
+				*param.value = param.override
+			}
+		}
+	}
+	return params
+}
+
+// Validate returns an error if the hysteresis, churn limit or slashing
+// quotients, or the length of the slashings vector, are zero, as the state
+// transition divides by them. It also returns an error if the fork schedule
+// is invalid, or if a fork lowers a per-fork parameter or sets it above the
+// limit of the data it bounds: the withdrawals of a payload and the blob
+// commitments of a block stored under the previous forks must remain valid.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) Validate() error {
+	for _, quotient := range []struct {
+		name  string
+		value uint64
+	}{
+		{name: "hysteresis-quotient", value: c.Data.HysteresisQuotient},
+		{name: "churn-limit-quotient", value: c.Data.ChurnLimitQuotient},
+		{
+			name:  "epochs-per-slashings-vector",
+			value: c.Data.EpochsPerSlashingsVector,
+		},
+		{
+			name:  "min-slashing-penalty-quotient",
+			value: c.Data.MinSlashingPenaltyQuotient,
+		},
+		{
+			name:  "whistleblower-reward-quotient",
+			value: c.Data.WhistleblowerRewardQuotient,
+		},
+	} {
+		if quotient.value == 0 {
+			return errors.Wrapf(ErrZeroQuotient, "%s", quotient.name)
+		}
+	}
+
+	schedule := c.ForkSchedule()
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+
+	for _, param := range []struct {
+		name  string
+		limit uint64
+		value func(forkVersion uint32) uint64
+	}{
+		{
+			name:  "max-withdrawals-per-payload",
+			limit: constants.MaxWithdrawalsPerPayload,
+			value: c.MaxWithdrawalsPerPayload,
+		},
+		{
+			name:  "max-blobs-per-block",
+			limit: c.Data.MaxBlobCommitmentsPerBlock,
+			value: c.MaxBlobsPerBlock,
+		},
+	} {
+		for i, fork := range schedule {
+			value := param.value(fork.Version)
+			if value > param.limit {
+				return errors.Wrapf(
+					ErrForkParamAboveLimit, "%s at %s: %d above %d",
+					param.name, version.Name(fork.Version), value, param.limit,
+				)
+			}
+			if i == 0 {
+				continue
+			}
+			prev := schedule[i-1].Version
+			if prevValue := param.value(prev); value < prevValue {
+				return errors.Wrapf(
+					ErrForkParamLowered, "%s lowered from %d at %s to %d at %s",
+					param.name, prevValue, version.Name(prev),
+					value, version.Name(fork.Version),
+				)
+			}
+		}
+	}
+	return nil
+}
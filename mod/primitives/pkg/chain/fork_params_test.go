@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+func ptr(v uint64) *uint64 {
+	return &v
+}
+
+func TestChainSpec_ForkParams(t *testing.T) {
+	cs := chain.NewChainSpec(testSpecData{
+		SlotsPerEpoch:               32,
+		HysteresisQuotient:          4,
+		ChurnLimitQuotient:          1 << 16,
+		EpochsPerSlashingsVector:    8,
+		MinSlashingPenaltyQuotient:  32,
+		WhistleblowerRewardQuotient: 512,
+		MaxWithdrawalsPerPayload:    16,
+		MaxBlobCommitmentsPerBlock:  16,
+		MaxBlobsPerBlock:            6,
+		ForkSchedule: testSchedule{
+			{Name: "deneb", Version: version.Deneb, Epoch: 0},
+			{
+				Name:    "electra",
+				Version: version.Electra,
+				Epoch:   10,
+				Params:  chain.ForkParams{MaxBlobsPerBlock: ptr(9)},
+			},
+		},
+	})
+	require.NoError(t, cs.Validate())
+
+	tests := []struct {
+		name           string
+		slot           uint64
+		maxBlobs       uint64
+		maxWithdrawals uint64
+	}{
+		{"before the boundary", 10*32 - 1, 6, 16},
+		{"at the boundary", 10 * 32, 9, 16},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			forkVersion := cs.ActiveForkVersionForSlot(tt.slot)
+			require.Equal(t, tt.maxBlobs, cs.MaxBlobsPerBlock(forkVersion))
+			require.Equal(
+				t, tt.maxWithdrawals, cs.MaxWithdrawalsPerPayload(forkVersion),
+			)
+		})
+	}
+}
+
+func TestChainSpec_ValidateForkParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		params chain.ForkParams
+		err    error
+	}{
+		{
+			name:   "raised",
+			params: chain.ForkParams{MaxWithdrawalsPerPayload: ptr(16)},
+		},
+		{
+			name:   "lowered",
+			params: chain.ForkParams{MaxBlobsPerBlock: ptr(3)},
+			err:    chain.ErrForkParamLowered,
+		},
+		{
+			name:   "above the commitments limit",
+			params: chain.ForkParams{MaxBlobsPerBlock: ptr(17)},
+			err:    chain.ErrForkParamAboveLimit,
+		},
+		{
+			name:   "above the withdrawals limit",
+			params: chain.ForkParams{MaxWithdrawalsPerPayload: ptr(17)},
+			err:    chain.ErrForkParamAboveLimit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs := chain.NewChainSpec(testSpecData{
+				HysteresisQuotient:          4,
+				ChurnLimitQuotient:          1 << 16,
+				EpochsPerSlashingsVector:    8,
+				MinSlashingPenaltyQuotient:  32,
+				WhistleblowerRewardQuotient: 512,
+				MaxWithdrawalsPerPayload:    8,
+				MaxBlobCommitmentsPerBlock:  16,
+				MaxBlobsPerBlock:            6,
+				ForkSchedule: testSchedule{
+					{Name: "deneb", Version: version.Deneb, Epoch: 0},
+					{
+						Name:    "electra",
+						Version: version.Electra,
+						Epoch:   10,
+						Params:  tt.params,
+					},
+				},
+			})
+			require.ErrorIs(t, cs.Validate(), tt.err)
+		})
+	}
+}
+
+func TestChainSpec_ValidateZeroQuotient(t *testing.T) {
+	for _, zero := range []func(*testSpecData){
+		func(d *testSpecData) { d.HysteresisQuotient = 0 },
+		func(d *testSpecData) { d.ChurnLimitQuotient = 0 },
+		func(d *testSpecData) { d.EpochsPerSlashingsVector = 0 },
+		func(d *testSpecData) { d.MinSlashingPenaltyQuotient = 0 },
+		func(d *testSpecData) { d.WhistleblowerRewardQuotient = 0 },
+	} {
+		data := testSpecData{
+			HysteresisQuotient:          4,
+			ChurnLimitQuotient:          1 << 16,
+			EpochsPerSlashingsVector:    8,
+			MinSlashingPenaltyQuotient:  32,
+			WhistleblowerRewardQuotient: 512,
+			ForkSchedule: testSchedule{
+				{Name: "deneb", Version: version.Deneb, Epoch: 0},
+			},
+		}
+		require.NoError(t, chain.NewChainSpec(data).Validate())
+		zero(&data)
+		require.ErrorIs(t,
+			chain.NewChainSpec(data).Validate(), chain.ErrZeroQuotient,
+		)
+	}
+}
+
+func TestChainSpec_ConsensusParams(t *testing.T) {
+	maxBlockBytes, evidenceMaxAge := int64(1<<21), int64(200_000)
+	cs := chain.NewChainSpec(testSpecData{
+		SlotsPerEpoch: 32,
+		ForkSchedule: testSchedule{
+			{
+				Name:    "deneb",
+				Version: version.Deneb,
+				Epoch:   0,
+				Params: chain.ForkParams{
+					Consensus: chain.ConsensusParams{
+						EvidenceMaxAgeNumBlocks: &evidenceMaxAge,
+					},
+				},
+			},
+			{
+				Name:    "electra",
+				Version: version.Electra,
+				Epoch:   10,
+				Params: chain.ForkParams{
+					Consensus: chain.ConsensusParams{
+						MaxBlockBytes: &maxBlockBytes,
+					},
+				},
+			},
+		},
+	})
+
+	// The overrides of the previous forks are kept.
+	require.Equal(t, chain.ConsensusParams{
+		EvidenceMaxAgeNumBlocks: &evidenceMaxAge,
+	}, cs.ConsensusParams(version.Deneb))
+	require.Equal(t, chain.ConsensusParams{
+		MaxBlockBytes:           &maxBlockBytes,
+		EvidenceMaxAgeNumBlocks: &evidenceMaxAge,
+	}, cs.ConsensusParams(version.Electra))
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+var (
+	// ErrUnknownForkVersion is returned when a fork of the schedule has a
+	// version that is not known.
+	ErrUnknownForkVersion = errors.New("unknown fork version")
+	// ErrForkScheduleNotOrdered is returned when the forks of the schedule
+	// are not ordered by version and epoch.
+	ErrForkScheduleNotOrdered = errors.New("fork schedule not ordered")
+	// ErrForkNameMismatch is returned when a fork of the schedule is named
+	// after another fork than that of its version.
+	ErrForkNameMismatch = errors.New("fork name does not match its version")
+	// ErrEmptyForkSchedule is returned when the schedule has no fork.
+	ErrEmptyForkSchedule = errors.New("empty fork schedule")
+)
+
+// Fork is a fork of the schedule, with the epoch at which it is activated.
+type Fork[EpochT ~uint64] struct {
+	// Name is the name of the fork, e.g. "deneb". If set, it must be that
+	// of its version.
+	Name string `mapstructure:"name"`
+	// Version is the version of the fork. In a chain spec file, it may be
+	// given by the name of its fork or its 4 bytes hex encoded.
+	Version uint32 `mapstructure:"version"`
+	// Epoch is the epoch at which the fork is activated.
+	Epoch EpochT `mapstructure:"epoch"`
+	// Params are the parameters of the chain spec overridden from the fork
+	// on.
+	Params ForkParams `mapstructure:"params"`
+}
+
+// ForkSchedule is the list of the forks of the chain, ordered by version
+// and activation epoch. The first fork is active from genesis.
+type ForkSchedule[EpochT ~uint64] []Fork[EpochT]
+
+// Validate returns an error if the schedule is empty, has a fork of an
+// unknown version or named after another fork, or is not ordered by
+// strictly increasing versions and non-decreasing epochs. Forks may share
+// an epoch, the last of them is then active from it.
+func (s ForkSchedule[EpochT]) Validate() error {
+	if len(s) == 0 {
+		return ErrEmptyForkSchedule
+	}
+	for i, fork := range s {
+		if !version.IsKnown(fork.Version) {
+			return errors.Wrapf(
+				ErrUnknownForkVersion, "fork %q: %d", fork.Name, fork.Version,
+			)
+		}
+		if fork.Name != "" && fork.Name != version.Name(fork.Version) {
+			return errors.Wrapf(
+				ErrForkNameMismatch, "fork %q has the version of %s",
+				fork.Name, version.Name(fork.Version),
+			)
+		}
+		if i == 0 {
+			continue
+		}
+		if prev := s[i-1]; version.IsAtLeast(prev.Version, fork.Version) ||
+			fork.Epoch < prev.Epoch {
+			return errors.Wrapf(
+				ErrForkScheduleNotOrdered,
+				"%s at epoch %d after %s at epoch %d",
+				version.Name(fork.Version), fork.Epoch,
+				version.Name(prev.Version), prev.Epoch,
+			)
+		}
+	}
+	return nil
+}
+
+// ForkAtEpoch returns the fork active at epoch: the last fork of the
+// schedule activated at or before it. The first fork is returned for the
+// epochs before it.
+func (s ForkSchedule[EpochT]) ForkAtEpoch(epoch EpochT) Fork[EpochT] {
+	if len(s) == 0 {
+		return Fork[EpochT]{}
+	}
+	active := s[0]
+	for _, fork := range s[1:] {
+		if fork.Epoch > epoch {
+			break
+		}
+		active = fork
+	}
+	return active
+}
+
+// NextFork returns the first fork of the schedule activated after epoch,
+// and false if there is none.
+func (s ForkSchedule[EpochT]) NextFork(epoch EpochT) (Fork[EpochT], bool) {
+	for _, fork := range s {
+		if fork.Epoch > epoch {
+			return fork, true
+		}
+	}
+	return Fork[EpochT]{}, false
+}
+
+// EpochOf returns the epoch at which the fork of version v is activated,
+// and false if it is not scheduled.
+func (s ForkSchedule[EpochT]) EpochOf(v uint32) (EpochT, bool) {
+	for _, fork := range s {
+		if fork.Version == v {
+			return fork.Epoch, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	testSpecData = chain.SpecData[[4]byte, uint64, [20]byte, uint64, any]
+	testSchedule = chain.ForkSchedule[uint64]
+)
+
+func TestForkSchedule_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule testSchedule
+		err      error
+	}{
+		{
+			name: "valid",
+			schedule: testSchedule{
+				{Name: "deneb", Version: version.Deneb, Epoch: 0},
+				{Name: "electra", Version: version.Electra, Epoch: 10},
+			},
+		},
+		{
+			name: "same epoch",
+			schedule: testSchedule{
+				{Name: "capella", Version: version.Capella, Epoch: 0},
+				{Name: "deneb", Version: version.Deneb, Epoch: 0},
+			},
+		},
+		{
+			name:     "empty",
+			schedule: testSchedule{},
+			err:      chain.ErrEmptyForkSchedule,
+		},
+		{
+			name: "unknown version",
+			schedule: testSchedule{
+				{Name: "deneb", Version: version.Deneb, Epoch: 0},
+				{Version: version.Electra + 1, Epoch: 10},
+			},
+			err: chain.ErrUnknownForkVersion,
+		},
+		{
+			name: "name mismatch",
+			schedule: testSchedule{
+				{Name: "electra", Version: version.Deneb, Epoch: 0},
+			},
+			err: chain.ErrForkNameMismatch,
+		},
+		{
+			name: "decreasing epochs",
+			schedule: testSchedule{
+				{Name: "deneb", Version: version.Deneb, Epoch: 10},
+				{Name: "electra", Version: version.Electra, Epoch: 5},
+			},
+			err: chain.ErrForkScheduleNotOrdered,
+		},
+		{
+			name: "decreasing versions",
+			schedule: testSchedule{
+				{Name: "electra", Version: version.Electra, Epoch: 0},
+				{Name: "deneb", Version: version.Deneb, Epoch: 10},
+			},
+			err: chain.ErrForkScheduleNotOrdered,
+		},
+		{
+			name: "duplicate version",
+			schedule: testSchedule{
+				{Name: "deneb", Version: version.Deneb, Epoch: 0},
+				{Name: "deneb", Version: version.Deneb, Epoch: 10},
+			},
+			err: chain.ErrForkScheduleNotOrdered,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schedule.Validate()
+			if tt.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestActiveForkVersionForSlot(t *testing.T) {
+	const slotsPerEpoch = 32
+	spec := chain.NewChainSpec(testSpecData{
+		SlotsPerEpoch: slotsPerEpoch,
+		ForkSchedule: testSchedule{
+			{Name: "capella", Version: version.Capella, Epoch: 0},
+			{Name: "deneb", Version: version.Deneb, Epoch: 5},
+			{Name: "electra", Version: version.Electra, Epoch: 10},
+		},
+	})
+
+	tests := []struct {
+		name string
+		slot uint64
+		want uint32
+	}{
+		{name: "genesis", slot: 0, want: version.Capella},
+		{name: "before deneb", slot: 5*slotsPerEpoch - 1, want: version.Capella},
+		{name: "deneb boundary", slot: 5 * slotsPerEpoch, want: version.Deneb},
+		{name: "before electra", slot: 10*slotsPerEpoch - 1, want: version.Deneb},
+		{
+			name: "electra boundary",
+			slot: 10 * slotsPerEpoch,
+			want: version.Electra,
+		},
+		{
+			name: "after electra",
+			slot: 10*slotsPerEpoch + 1,
+			want: version.Electra,
+		},
+		{name: "far future", slot: 1 << 40, want: version.Electra},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, spec.ActiveForkVersionForSlot(tt.slot))
+			require.Equal(t,
+				tt.want,
+				spec.ActiveForkVersionForEpoch(spec.SlotToEpoch(tt.slot)),
+			)
+		})
+	}
+	require.Equal(t, uint64(10), spec.ElectraForkEpoch())
+}
+
+func TestForkSchedule_ElectraForkEpoch(t *testing.T) {
+	// Without a schedule, Electra is activated at ElectraForkEpoch.
+	spec := chain.NewChainSpec(testSpecData{
+		SlotsPerEpoch:    32,
+		ElectraForkEpoch: 3,
+	})
+	require.Equal(t, testSchedule{
+		{Name: "deneb", Version: version.Deneb, Epoch: 0},
+		{Name: "electra", Version: version.Electra, Epoch: 3},
+	}, spec.ForkSchedule())
+	require.NoError(t, spec.ForkSchedule().Validate())
+	require.Equal(t, version.Deneb, spec.ActiveForkVersionForSlot(3*32-1))
+	require.Equal(t, version.Electra, spec.ActiveForkVersionForSlot(3*32))
+
+	// A schedule without Electra never activates it.
+	spec = chain.NewChainSpec(testSpecData{
+		SlotsPerEpoch:    32,
+		ElectraForkEpoch: 3,
+		ForkSchedule: testSchedule{
+			{Name: "deneb", Version: version.Deneb, Epoch: 0},
+		},
+	})
+	require.Equal(t, ^uint64(0), spec.ElectraForkEpoch())
+	require.Equal(t, version.Deneb, spec.ActiveForkVersionForSlot(1<<40))
+}
+
+func TestForkSchedule_NextFork(t *testing.T) {
+	schedule := testSchedule{
+		{Name: "deneb", Version: version.Deneb, Epoch: 0},
+		{Name: "electra", Version: version.Electra, Epoch: 10},
+	}
+	next, ok := schedule.NextFork(0)
+	require.True(t, ok)
+	require.Equal(t, version.Electra, next.Version)
+	next, ok = schedule.NextFork(9)
+	require.True(t, ok)
+	require.Equal(t, uint64(10), next.Epoch)
+	_, ok = schedule.NextFork(10)
+	require.False(t, ok)
+}
@@ -20,11 +20,7 @@
 
 package chain
 
-import (
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
-)
-
-// ActiveForkVersion returns the active fork version for a given slot.
+// ActiveForkVersionForSlot returns the active fork version for a given slot.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) ActiveForkVersionForSlot(
@@ -33,17 +29,14 @@ func (c chainSpec[
 	return c.ActiveForkVersionForEpoch(c.SlotToEpoch(slot))
 }
 
-// ActiveForkVersionBySlot returns the active fork version for a given epoch.
+// ActiveForkVersionForEpoch returns the active fork version for a given
+// epoch, that of the last fork of the schedule activated at or before it.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 ]) ActiveForkVersionForEpoch(
 	epoch EpochT,
 ) uint32 {
-	if epoch >= c.Data.ElectraForkEpoch {
-		return version.Electra
-	}
-
-	return version.Deneb
+	return c.ForkSchedule().ForkAtEpoch(epoch).Version
 }
 
 // SlotToEpoch converts a slot to an epoch.
@@ -70,3 +63,16 @@ func (c chainSpec[
 		current,
 	)
 }
+
+// SlotTimestamp returns the timestamp of the payload of a slot, the genesis
+// time followed by the duration of the slots before it. The slots are of a
+// fixed duration only if SecondsPerSlot is set, as otherwise they follow the
+// blocks of CometBFT and the timestamp of a slot is not defined.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) SlotTimestamp(slot SlotT) (uint64, bool) {
+	if c.SecondsPerSlot() == 0 {
+		return 0, false
+	}
+	return c.GenesisTime() + uint64(slot)*c.SecondsPerSlot(), true
+}
@@ -0,0 +1,228 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package crypto
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"math/big"
+	"runtime"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"golang.org/x/sync/errgroup"
+)
+
+// BLSSignatureDST is the domain separation tag of the proof of possession
+// BLS signature scheme used by Ethereum.
+const BLSSignatureDST = "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"
+
+// BatchVerifier verifies many BLS signatures at once.
+type BatchVerifier interface {
+	// VerifySignatureBatch verifies that sigs[i] is a signature of msgs[i]
+	// by pubkeys[i] for every i.
+	VerifySignatureBatch(
+		pubkeys [][48]byte, msgs [][]byte, sigs [][96]byte,
+	) error
+	// AggregateVerify verifies that sig is the aggregate of the signatures
+	// of msgs[i] by pubkeys[i].
+	AggregateVerify(pubkeys [][48]byte, msgs [][]byte, sig [96]byte) error
+}
+
+// GoBatchVerifier is a BatchVerifier verifying with the pure Go
+// VerifySignatureBatch and AggregateVerify of this package.
+type GoBatchVerifier struct{}
+
+// VerifySignatureBatch implements BatchVerifier.
+func (GoBatchVerifier) VerifySignatureBatch(
+	pubkeys [][48]byte, msgs [][]byte, sigs [][96]byte,
+) error {
+	return VerifySignatureBatch(pubkeys, msgs, sigs)
+}
+
+// AggregateVerify implements BatchVerifier.
+func (GoBatchVerifier) AggregateVerify(
+	pubkeys [][48]byte, msgs [][]byte, sig [96]byte,
+) error {
+	return AggregateVerify(pubkeys, msgs, sig)
+}
+
+// VerifySignatureBatch verifies that sigs[i] is a signature of msgs[i] by
+// pubkeys[i] for every i, with a single pairing check. Each triple is weighted
+// by a random scalar, so that invalid signatures cannot cancel each other
+// out. If the batch does not verify, the index of the first invalid
+// signature is reported.
+func VerifySignatureBatch(
+	pubkeys [][48]byte, msgs [][]byte, sigs [][96]byte,
+) error {
+	n := len(pubkeys)
+	if n != len(msgs) || n != len(sigs) {
+		return ErrBatchLengthMismatch
+	}
+	if n == 0 {
+		return nil
+	}
+
+	// The triples are decoded, hashed and weighted in parallel, since hashing
+	// to G2 dominates the cost of the verification.
+	g1s := make([]bls12381.G1Affine, n+1)
+	g2s := make([]bls12381.G2Affine, n+1)
+	weighted := make([]bls12381.G2Affine, n)
+	eg := new(errgroup.Group)
+	eg.SetLimit(runtime.GOMAXPROCS(0))
+	for i := range n {
+		eg.Go(func() error {
+			pubkey, err := decodePubkey(pubkeys[i])
+			if err != nil {
+				return errors.Wrapf(err, "index %d", i)
+			}
+			sig, err := decodeSignature(sigs[i])
+			if err != nil {
+				return errors.Wrapf(err, "index %d", i)
+			}
+			if g2s[i], err = hashToG2(msgs[i]); err != nil {
+				return err
+			}
+			scalar, err := randomScalar()
+			if err != nil {
+				return err
+			}
+			g1s[i].ScalarMultiplication(&pubkey, scalar)
+			weighted[i].ScalarMultiplication(&sig, scalar)
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	var aggregate bls12381.G2Jac
+	for i := range weighted {
+		aggregate.AddMixed(&weighted[i])
+	}
+	g1s[n] = negG1Generator()
+	g2s[n].FromJacobian(&aggregate)
+
+	ok, err := bls12381.PairingCheck(g1s, g2s)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return nil
+	}
+	for i := range n {
+		if err = VerifySignature(pubkeys[i], msgs[i], sigs[i]); err != nil {
+			return errors.Wrapf(err, "index %d", i)
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// AggregateVerify verifies that sig is the aggregate of the signatures of
+// msgs[i] by pubkeys[i].
+func AggregateVerify(pubkeys [][48]byte, msgs [][]byte, sig [96]byte) error {
+	n := len(pubkeys)
+	if n != len(msgs) {
+		return ErrBatchLengthMismatch
+	}
+	if n == 0 {
+		return ErrEmptyBatch
+	}
+
+	g1s := make([]bls12381.G1Affine, n+1)
+	g2s := make([]bls12381.G2Affine, n+1)
+	var err error
+	for i := range n {
+		if g1s[i], err = decodePubkey(pubkeys[i]); err != nil {
+			return errors.Wrapf(err, "index %d", i)
+		}
+		if g2s[i], err = hashToG2(msgs[i]); err != nil {
+			return err
+		}
+	}
+	g1s[n] = negG1Generator()
+	if g2s[n], err = decodeSignature(sig); err != nil {
+		return err
+	}
+
+	ok, err := bls12381.PairingCheck(g1s, g2s)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// VerifySignature verifies that sig is a signature of msg by pubkey.
+func VerifySignature(pubkey [48]byte, msg []byte, sig [96]byte) error {
+	return AggregateVerify([][48]byte{pubkey}, [][]byte{msg}, sig)
+}
+
+// decodePubkey decodes a compressed public key, which must be in the G1
+// subgroup and not the point at infinity.
+func decodePubkey(pubkey [48]byte) (bls12381.G1Affine, error) {
+	var p bls12381.G1Affine
+	if _, err := p.SetBytes(pubkey[:]); err != nil {
+		return p, errors.Join(ErrInvalidPubkey, err)
+	}
+	if p.IsInfinity() {
+		return p, ErrInvalidPubkey
+	}
+	return p, nil
+}
+
+// decodeSignature decodes a compressed signature, which must be in the G2
+// subgroup.
+func decodeSignature(sig [96]byte) (bls12381.G2Affine, error) {
+	var p bls12381.G2Affine
+	if _, err := p.SetBytes(sig[:]); err != nil {
+		return p, errors.Join(ErrInvalidSignature, err)
+	}
+	return p, nil
+}
+
+// hashToG2 hashes a message to G2 with the signature DST.
+func hashToG2(msg []byte) (bls12381.G2Affine, error) {
+	return bls12381.HashToG2(msg, []byte(BLSSignatureDST))
+}
+
+// negG1Generator returns the negation of the generator of G1.
+func negG1Generator() bls12381.G1Affine {
+	_, _, g1, _ := bls12381.Generators()
+	var neg bls12381.G1Affine
+	neg.Neg(&g1)
+	return neg
+}
+
+// randomScalar returns a random non-zero 64-bit scalar.
+func randomScalar() (*big.Int, error) {
+	var bz [8]byte
+	for {
+		if _, err := rand.Read(bz[:]); err != nil {
+			return nil, err
+		}
+		if r := binary.BigEndian.Uint64(bz[:]); r != 0 {
+			return new(big.Int).SetUint64(r), nil
+		}
+	}
+}
@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package crypto_test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/stretchr/testify/require"
+)
+
+// triples returns n valid (pubkey, message, signature) triples.
+func triples(tb testing.TB, n int) ([][48]byte, [][]byte, [][96]byte) {
+	tb.Helper()
+	_, _, g1, _ := bls12381.Generators()
+	pubkeys := make([][48]byte, n)
+	msgs := make([][]byte, n)
+	sigs := make([][96]byte, n)
+	for i := range n {
+		sk := big.NewInt(int64(i + 1))
+		var pubkey bls12381.G1Affine
+		pubkey.ScalarMultiplication(&g1, sk)
+		pubkeys[i] = pubkey.Bytes()
+
+		msgs[i] = []byte(fmt.Sprintf("message %d", i))
+		h, err := bls12381.HashToG2(msgs[i], []byte(crypto.BLSSignatureDST))
+		require.NoError(tb, err)
+		var sig bls12381.G2Affine
+		sig.ScalarMultiplication(&h, sk)
+		sigs[i] = sig.Bytes()
+	}
+	return pubkeys, msgs, sigs
+}
+
+func mustDecode(t *testing.T, s string) []byte {
+	t.Helper()
+	bz, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return bz
+}
+
+// TestVerifySignature checks the verifier against a test vector of the
+// consensus spec.
+func TestVerifySignature(t *testing.T) {
+	pubkey := [48]byte(mustDecode(t,
+		"a491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20f"+
+			"d6e10c1b77654d067c0618f6e5a7f79a",
+	))
+	msg := mustDecode(t,
+		"5656565656565656565656565656565656565656565656565656565656565656",
+	)
+	sig := [96]byte(mustDecode(t,
+		"882730e5d03f6b42c3abc26d3372625034e1d871b65a8a6b900a56dae22da98a"+
+			"bbe1b68f85e49fe7652a55ec3d0591c20767677e33e5cbb1207315c41a9ac03b"+
+			"e39c2e7668edc043d6cb1d9fd93033caa8a1c5b0e84bedaeb6c64972503a43eb",
+	))
+
+	require.NoError(t, crypto.VerifySignature(pubkey, msg, sig))
+	msg[0] ^= 1
+	require.ErrorIs(t,
+		crypto.VerifySignature(pubkey, msg, sig), crypto.ErrInvalidSignature,
+	)
+}
+
+// mutation alters a batch of triples before it is verified.
+type mutation func(
+	[][48]byte, [][]byte, [][96]byte,
+) ([][48]byte, [][]byte, [][96]byte)
+
+func TestVerifySignatureBatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		n       int
+		mutate  mutation
+		wantErr error
+		wantMsg string
+	}{
+		{name: "Valid", n: 16},
+		{name: "Empty", n: 0},
+		{
+			name: "OneInvalidSignature",
+			n:    16,
+			mutate: func(
+				p [][48]byte, m [][]byte, s [][96]byte,
+			) ([][48]byte, [][]byte, [][96]byte) {
+				s[11] = s[3]
+				return p, m, s
+			},
+			wantErr: crypto.ErrInvalidSignature,
+			wantMsg: "index 11",
+		},
+		{
+			name: "InfinityPubkey",
+			n:    4,
+			mutate: func(
+				p [][48]byte, m [][]byte, s [][96]byte,
+			) ([][48]byte, [][]byte, [][96]byte) {
+				var infinity bls12381.G1Affine
+				p[2] = infinity.Bytes()
+				return p, m, s
+			},
+			wantErr: crypto.ErrInvalidPubkey,
+			wantMsg: "index 2",
+		},
+		{
+			name: "LengthMismatch",
+			n:    4,
+			mutate: func(
+				p [][48]byte, m [][]byte, s [][96]byte,
+			) ([][48]byte, [][]byte, [][96]byte) {
+				return p, m[1:], s
+			},
+			wantErr: crypto.ErrBatchLengthMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pubkeys, msgs, sigs := triples(t, tt.n)
+			if tt.mutate != nil {
+				pubkeys, msgs, sigs = tt.mutate(pubkeys, msgs, sigs)
+			}
+
+			err := crypto.VerifySignatureBatch(pubkeys, msgs, sigs)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.wantErr)
+			require.ErrorContains(t, err, tt.wantMsg)
+		})
+	}
+}
+
+func TestAggregateVerify(t *testing.T) {
+	pubkeys, msgs, sigs := triples(t, 8)
+	var aggregate bls12381.G2Jac
+	for _, sig := range sigs {
+		var p bls12381.G2Affine
+		_, err := p.SetBytes(sig[:])
+		require.NoError(t, err)
+		aggregate.AddMixed(&p)
+	}
+	var affine bls12381.G2Affine
+	sig := affine.FromJacobian(&aggregate).Bytes()
+
+	require.NoError(t, crypto.AggregateVerify(pubkeys, msgs, sig))
+
+	msgs[5] = []byte("tampered")
+	require.ErrorIs(t,
+		crypto.AggregateVerify(pubkeys, msgs, sig),
+		crypto.ErrInvalidSignature,
+	)
+	require.ErrorIs(t,
+		crypto.AggregateVerify(nil, nil, sig), crypto.ErrEmptyBatch,
+	)
+}
+
+func BenchmarkVerifySignatureBatch(b *testing.B) {
+	pubkeys, msgs, sigs := triples(b, 1000)
+	b.ResetTimer()
+	for range b.N {
+		if err := crypto.VerifySignatureBatch(pubkeys, msgs, sigs); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkVerifySignatureSequential(b *testing.B) {
+	pubkeys, msgs, sigs := triples(b, 1000)
+	b.ResetTimer()
+	for range b.N {
+		for i := range pubkeys {
+			err := crypto.VerifySignature(pubkeys[i], msgs[i], sigs[i])
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
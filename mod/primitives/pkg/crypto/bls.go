@@ -49,8 +49,9 @@ type (
 // It uses generic type parameters Signature and Pubkey, both of which are
 // slices of bytes.
 type BLSSigner interface {
-	// PublicKey returns the public key of the signer.
-	PublicKey() BLSPubkey
+	// PublicKey returns the public key of the signer, or an error if the
+	// key cannot be retrieved.
+	PublicKey() (BLSPubkey, error)
 
 	// Sign takes a message as a slice of bytes and returns a signature as a
 	// slice of bytes and an error.
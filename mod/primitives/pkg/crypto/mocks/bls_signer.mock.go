@@ -22,7 +22,7 @@ func (_m *BLSSigner) EXPECT() *BLSSigner_Expecter {
 }
 
 // PublicKey provides a mock function with given fields:
-func (_m *BLSSigner) PublicKey() bytes.B48 {
+func (_m *BLSSigner) PublicKey() (bytes.B48, error) {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
@@ -30,6 +30,10 @@ func (_m *BLSSigner) PublicKey() bytes.B48 {
 	}
 
 	var r0 bytes.B48
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (bytes.B48, error)); ok {
+		return rf()
+	}
 	if rf, ok := ret.Get(0).(func() bytes.B48); ok {
 		r0 = rf()
 	} else {
@@ -38,7 +42,13 @@ func (_m *BLSSigner) PublicKey() bytes.B48 {
 		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // BLSSigner_PublicKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublicKey'
@@ -58,12 +68,12 @@ func (_c *BLSSigner_PublicKey_Call) Run(run func()) *BLSSigner_PublicKey_Call {
 	return _c
 }
 
-func (_c *BLSSigner_PublicKey_Call) Return(_a0 bytes.B48) *BLSSigner_PublicKey_Call {
-	_c.Call.Return(_a0)
+func (_c *BLSSigner_PublicKey_Call) Return(_a0 bytes.B48, _a1 error) *BLSSigner_PublicKey_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *BLSSigner_PublicKey_Call) RunAndReturn(run func() bytes.B48) *BLSSigner_PublicKey_Call {
+func (_c *BLSSigner_PublicKey_Call) RunAndReturn(run func() (bytes.B48, error)) *BLSSigner_PublicKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
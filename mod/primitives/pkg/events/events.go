@@ -20,9 +20,74 @@
 
 package events
 
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
 const (
 	MissedSlot           = "MissedSlot"
 	BeaconBlockAccepted  = "BeaconBlockAccepted"
 	BeaconBlockRejected  = "BeaconBlockRejected"
 	BeaconBlockFinalized = "BeaconBlockFinalized"
+	PayloadBuilt         = "PayloadBuilt"
+	DepositProcessed     = "DepositProcessed"
+)
+
+// Topics of the event bus.
+const (
+	// BlockTopic is the topic of the events of the beacon blocks.
+	BlockTopic = "block"
+	// FinalizedBlockTopic is the topic of the BeaconBlockFinalized events.
+	FinalizedBlockTopic = "finalized_block"
+	// PayloadTopic is the topic of the PayloadBuilt events.
+	PayloadTopic = "payload"
+	// DepositTopic is the topic of the DepositProcessed events.
+	DepositTopic = "deposit"
 )
+
+// BeaconBlockFinalizedEvent is the data of the BeaconBlockFinalized events,
+// published once a block has been finalized.
+type BeaconBlockFinalizedEvent[BeaconBlockT any] struct {
+	// Root is the root of the finalized block.
+	Root common.Root
+	// Slot is the slot of the finalized block.
+	Slot math.Slot
+	// Block is the finalized block, for the subscribers that need its
+	// contents.
+	Block BeaconBlockT
+	// Eth1DepositIndex is the eth1 deposit index of the state after the
+	// finalized block, one past the index of the last deposit processed.
+	Eth1DepositIndex uint64
+}
+
+// GetSlot returns the slot of the finalized block.
+func (e *BeaconBlockFinalizedEvent[BeaconBlockT]) GetSlot() math.Slot {
+	return e.Slot
+}
+
+// PayloadBuiltEvent is the data of the PayloadBuilt events, published once a
+// payload has been retrieved from the local builder.
+type PayloadBuiltEvent struct {
+	// Slot is the slot the payload was built for.
+	Slot math.Slot
+	// PayloadID is the ID the execution client built the payload under.
+	PayloadID bytes.B8
+	// BlockHash is the hash of the execution block of the payload.
+	BlockHash common.ExecutionHash
+	// Value is the value of the payload to its fee recipient.
+	Value math.Wei
+}
+
+// DepositProcessedEvent is the data of the DepositProcessed events,
+// published once a deposit read from the deposit contract has been stored.
+type DepositProcessedEvent struct {
+	// Index is the index of the deposit.
+	Index uint64
+	// Pubkey is the public key of the validator of the deposit.
+	Pubkey crypto.BLSPubkey
+	// Amount is the amount of the deposit.
+	Amount math.Gwei
+}
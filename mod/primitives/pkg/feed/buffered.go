@@ -0,0 +1,280 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feed
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// defaultBufferSize is the number of events buffered for a buffered
+// subscription without a size set.
+const defaultBufferSize = 16
+
+// OverflowPolicy is the event dropped when an event is published to a
+// buffered subscription whose buffer is full.
+type OverflowPolicy int
+
+const (
+	// DropOldest drops the oldest event of the buffer for the new one.
+	DropOldest OverflowPolicy = iota
+	// DropNewest drops the new event.
+	DropNewest
+)
+
+// BufferOption is a functional option for a buffered subscription.
+type BufferOption func(*bufferOptions)
+
+// bufferOptions holds the configuration of a buffered subscription.
+type bufferOptions struct {
+	// size is the number of events buffered.
+	size int
+	// policy is the event dropped when the buffer is full.
+	policy OverflowPolicy
+	// telemetrySink is the sink the metrics of the subscriber are recorded
+	// in.
+	telemetrySink TelemetrySink
+	// name is the name of the subscriber, used as a label of the metrics.
+	name string
+}
+
+// WithBufferSize sets the number of events buffered for the subscriber.
+func WithBufferSize(size int) BufferOption {
+	return func(o *bufferOptions) {
+		o.size = size
+	}
+}
+
+// WithOverflowPolicy sets the event dropped when an event is published while
+// the buffer is full. The oldest event is dropped by default.
+func WithOverflowPolicy(policy OverflowPolicy) BufferOption {
+	return func(o *bufferOptions) {
+		o.policy = policy
+	}
+}
+
+// WithTelemetrySink sets the sink the dropped events are counted in and the
+// delivery latency is measured in, labeled with the topic and the name of
+// the subscriber. The name is a fixed name of a service, for the number of
+// labels to be bounded.
+func WithTelemetrySink(sink TelemetrySink, name string) BufferOption {
+	return func(o *bufferOptions) {
+		o.telemetrySink = sink
+		o.name = name
+	}
+}
+
+// BufferedSubscription is a subscription whose events are buffered and
+// delivered by its own goroutine, for publishing to never wait for the
+// subscriber. Events published while the buffer is full are dropped, as set
+// by the overflow policy, and counted.
+type BufferedSubscription[T any] struct {
+	*subscription[T]
+}
+
+// Dropped returns the number of events dropped for the subscriber.
+func (s *BufferedSubscription[T]) Dropped() uint64 {
+	return s.buffer.dropped.Load()
+}
+
+// SubscribeBuffered subscribes ch to the events of the topic through a
+// buffer, until the subscription is unsubscribed. The channel is never
+// closed.
+func (t *Topic[T]) SubscribeBuffered(
+	ch chan<- T,
+	opts ...BufferOption,
+) *BufferedSubscription[T] {
+	sub := t.newBufferedSubscription(ch, 0, opts)
+	t.add(sub)
+	go sub.forward(nil)
+	return &BufferedSubscription[T]{subscription: sub}
+}
+
+// Buffered returns a feed of the topic whose subscriptions are buffered
+// with opts, for it to be subscribed to by the services taking a feed.
+func (t *Topic[T]) Buffered(opts ...BufferOption) *BufferedFeed[T] {
+	return &BufferedFeed[T]{topic: t, opts: opts}
+}
+
+// Replayed returns a feed of the topic whose subscriptions are buffered
+// with opts and start with the history of the topic.
+func (t *Topic[T]) Replayed(opts ...BufferOption) *BufferedFeed[T] {
+	return &BufferedFeed[T]{topic: t, opts: opts, replay: true}
+}
+
+// BufferedFeed is a feed of a topic whose subscriptions are buffered.
+type BufferedFeed[T any] struct {
+	topic *Topic[T]
+	opts  []BufferOption
+	// replay is whether the subscriptions start with the history of the
+	// topic.
+	replay bool
+}
+
+// Subscribe subscribes ch to the events of the topic through a buffer. See
+// Topic.SubscribeBuffered and Topic.SubscribeWithReplay.
+func (f *BufferedFeed[T]) Subscribe(ch chan<- T) event.Subscription {
+	if f.replay {
+		return f.topic.SubscribeWithReplay(ch, f.opts...)
+	}
+	return f.topic.SubscribeBuffered(ch, f.opts...)
+}
+
+// newBufferedSubscription returns a buffered subscription of ch to the
+// topic, not yet added to it, whose buffer holds at least minSize events.
+func (t *Topic[T]) newBufferedSubscription(
+	ch chan<- T,
+	minSize int,
+	opts []BufferOption,
+) *subscription[T] {
+	o := bufferOptions{size: defaultBufferSize}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	sub := t.subscribe(ch)
+	sub.metrics = subscriberMetrics{
+		sink:       o.telemetrySink,
+		topic:      t.name,
+		subscriber: o.name,
+	}
+	sub.buffer = newRingBuffer[envelope[T]](
+		max(o.size, minSize, 1), o.policy,
+	)
+	sub.buffer.markDropped = func() {
+		t.metrics.markDropped()
+		sub.metrics.markDropped()
+	}
+	return sub
+}
+
+// forward delivers the buffered events to the channel of the subscription,
+// until it is unsubscribed or done is closed.
+func (s *subscription[T]) forward(done <-chan struct{}) {
+	for {
+		select {
+		case <-s.buffer.ready:
+		case <-s.quit:
+			return
+		case <-done:
+			return
+		}
+		for {
+			env, ok := s.buffer.pop()
+			if !ok {
+				break
+			}
+			select {
+			case s.ch <- env.data:
+				s.topic.metrics.markDelivered(env.publishedAt)
+				s.metrics.markDelivered(env.publishedAt)
+			case <-s.quit:
+				return
+			case <-done:
+				return
+			}
+		}
+	}
+}
+
+// ringBuffer is the bounded buffer of the events of a buffered
+// subscription.
+type ringBuffer[T any] struct {
+	mu     sync.Mutex
+	events []T
+	// head is the index of the oldest event, and count the number of
+	// events buffered.
+	head, count int
+	policy      OverflowPolicy
+	// ready is signaled once events are pushed.
+	ready   chan struct{}
+	dropped atomic.Uint64
+	// markDropped, if set, is called for each event dropped.
+	markDropped func()
+}
+
+// newRingBuffer returns an empty buffer of size events.
+func newRingBuffer[T any](size int, policy OverflowPolicy) *ringBuffer[T] {
+	return &ringBuffer[T]{
+		events: make([]T, size),
+		policy: policy,
+		ready:  make(chan struct{}, 1),
+	}
+}
+
+// push buffers data, dropping an event if the buffer is full. It never
+// blocks beyond the lock of the buffer.
+func (b *ringBuffer[T]) push(data T) {
+	b.mu.Lock()
+	switch {
+	case b.count < len(b.events):
+		b.events[(b.head+b.count)%len(b.events)] = data
+		b.count++
+	case b.policy == DropNewest:
+		b.drop()
+	default:
+		b.events[b.head] = data
+		b.head = (b.head + 1) % len(b.events)
+		b.drop()
+	}
+	b.mu.Unlock()
+
+	select {
+	case b.ready <- struct{}{}:
+	default:
+	}
+}
+
+// pop returns the oldest event of the buffer, if any.
+func (b *ringBuffer[T]) pop() (T, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var data, zero T
+	if b.count == 0 {
+		return data, false
+	}
+	data = b.events[b.head]
+	// The event is not retained once delivered.
+	b.events[b.head] = zero
+	b.head = (b.head + 1) % len(b.events)
+	b.count--
+	return data, true
+}
+
+// snapshot returns the events of the buffer, from the oldest.
+func (b *ringBuffer[T]) snapshot() []T {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	events := make([]T, b.count)
+	for i := range events {
+		events[i] = b.events[(b.head+i)%len(b.events)]
+	}
+	return events
+}
+
+// drop counts an event dropped.
+func (b *ringBuffer[T]) drop() {
+	b.dropped.Add(1)
+	if b.markDropped != nil {
+		b.markDropped()
+	}
+}
@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feed_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// publishAll publishes the events from 0 to n on topic, failing if one of
+// the publications takes more than maxLatency.
+func publishAll(
+	t *testing.T,
+	topic *feed.Topic[int],
+	n int,
+	maxLatency time.Duration,
+) {
+	t.Helper()
+	for i := range n {
+		start := time.Now()
+		sent, err := topic.Publish(context.Background(), i)
+		require.NoError(t, err)
+		require.Equal(t, 1, sent)
+		require.Less(t, time.Since(start), maxLatency, "event %d", i)
+	}
+}
+
+// receiveAll returns the events received on ch until none is received for
+// a while.
+func receiveAll(ch <-chan int) []int {
+	var received []int
+	for {
+		select {
+		case data := <-ch:
+			received = append(received, data)
+		case <-time.After(100 * time.Millisecond):
+			return received
+		}
+	}
+}
+
+func TestBufferedSubscription_SlowConsumer(t *testing.T) {
+	const (
+		events = 1000
+		size   = 8
+	)
+	tests := []struct {
+		name   string
+		policy feed.OverflowPolicy
+	}{
+		{name: "DropOldest", policy: feed.DropOldest},
+		{name: "DropNewest", policy: feed.DropNewest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bus := feed.NewBus()
+			topic, err := feed.Register[int](bus, "numbers")
+			require.NoError(t, err)
+			sink := newRecordingSink()
+			ch := make(chan int)
+			sub := topic.SubscribeBuffered(
+				ch,
+				feed.WithBufferSize(size),
+				feed.WithOverflowPolicy(tt.policy),
+				feed.WithTelemetrySink(sink, "slow"),
+			)
+			defer sub.Unsubscribe()
+
+			// The subscriber does not receive while the events are
+			// published, which does not wait for it.
+			publishAll(t, topic, events, 50*time.Millisecond)
+			received := receiveAll(ch)
+
+			// The events received are in order, the buffered ones and the
+			// one that was being delivered.
+			require.NotEmpty(t, received)
+			require.LessOrEqual(t, len(received), size+1)
+			require.IsIncreasing(t, received)
+			require.Equal(t, uint64(events-len(received)), sub.Dropped())
+			require.Equal(t, map[string]int{
+				"beacon_kit.feed.dropped_events numbers slow": int(sub.Dropped()),
+			}, sink.counters())
+			if tt.policy == feed.DropOldest {
+				// The newest events are kept.
+				require.Equal(t, events-1, received[len(received)-1])
+			} else {
+				// The oldest events are kept, the first ones filling the
+				// buffer.
+				require.Equal(
+					t, []int{0, 1, 2, 3, 4, 5, 6, 7}, received[:size],
+				)
+			}
+		})
+	}
+}
+
+func TestBufferedSubscription_Stalled(t *testing.T) {
+	bus := feed.NewBus()
+	topic, err := feed.Register[int](bus, "numbers")
+	require.NoError(t, err)
+
+	// A stalled subscriber never receives, the others still receive every
+	// event.
+	stalled := topic.SubscribeBuffered(make(chan int), feed.WithBufferSize(1))
+	defer stalled.Unsubscribe()
+	ch := make(chan int, 100)
+	sub := topic.SubscribeBuffered(ch, feed.WithBufferSize(100))
+	defer sub.Unsubscribe()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := range 100 {
+			sent, _ := topic.Publish(context.Background(), i)
+			assert.Equal(t, 2, sent)
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("publication blocked by a stalled subscriber")
+	}
+
+	received := receiveAll(ch)
+	require.Len(t, received, 100)
+	require.Zero(t, sub.Dropped())
+	// The stalled subscriber buffers an event, and holds another if its
+	// goroutine took one before the others were published.
+	require.Contains(t, []uint64{98, 99}, stalled.Dropped())
+}
+
+func TestBufferedFeed(t *testing.T) {
+	var topic feed.Topic[int]
+	ch := make(chan int)
+	sub := topic.Buffered(feed.WithBufferSize(4)).Subscribe(ch)
+	require.Equal(t, 1, topic.Subscribers())
+
+	sent, err := topic.Publish(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, sent)
+	require.Equal(t, 1, <-ch)
+
+	sub.Unsubscribe()
+	require.Zero(t, topic.Subscribers())
+	_, ok := <-sub.Err()
+	require.False(t, ok)
+}
@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feed
+
+import (
+	"context"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+var (
+	// ErrTopicNotFound is returned when a topic is not registered on the bus.
+	ErrTopicNotFound = errors.New("topic not found")
+
+	// ErrTopicTypeMismatch is returned when a topic is accessed with a type
+	// other than the one of its events.
+	ErrTopicTypeMismatch = errors.New("topic type mismatch")
+
+	// ErrBusClosed is returned when a closed bus is published or registered
+	// to.
+	ErrBusClosed = errors.New("bus closed")
+)
+
+// registeredTopic is a topic of any type of events.
+type registeredTopic interface {
+	Subscribers() int
+	close()
+}
+
+// Bus is a bus of events published on topics registered by name, each topic
+// carrying events of a single type.
+type Bus struct {
+	mu     sync.RWMutex
+	topics map[string]registeredTopic
+	closed bool
+	// telemetrySink is the sink the metrics of the topics are recorded in,
+	// nil if they are not recorded.
+	telemetrySink TelemetrySink
+}
+
+// BusOption is a functional option for a bus.
+type BusOption func(*Bus)
+
+// WithMetrics records the metrics of the topics of the bus in sink: the
+// events published, delivered and dropped, the subscribers, and the latency
+// from the publication of an event to its reception by a subscriber.
+func WithMetrics(sink TelemetrySink) BusOption {
+	return func(b *Bus) {
+		b.telemetrySink = sink
+	}
+}
+
+// NewBus returns a bus without topics.
+func NewBus(opts ...BusOption) *Bus {
+	b := &Bus{topics: make(map[string]registeredTopic)}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+// Register registers the topic of name for events of type T. Registering a
+// topic again with the same type returns the registered topic, with its
+// options.
+func Register[T any](
+	bus *Bus,
+	name string,
+	opts ...TopicOption,
+) (*Topic[T], error) {
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+	if bus.closed {
+		return nil, ErrBusClosed
+	}
+	if registered, ok := bus.topics[name]; ok {
+		return topicAs[T](name, registered)
+	}
+	topic := newTopic[T](name, opts...)
+	topic.metrics = topicMetrics{sink: bus.telemetrySink, topic: name}
+	bus.topics[name] = topic
+	return topic, nil
+}
+
+// TopicOf returns the topic of name, of events of type T.
+func TopicOf[T any](bus *Bus, name string) (*Topic[T], error) {
+	bus.mu.RLock()
+	defer bus.mu.RUnlock()
+	registered, ok := bus.topics[name]
+	if !ok {
+		return nil, errors.Wrap(ErrTopicNotFound, name)
+	}
+	return topicAs[T](name, registered)
+}
+
+// Subscribe subscribes to the topic of name, returning the channel of its
+// events and the function unsubscribing from it. The channel is never
+// closed.
+func Subscribe[T any](bus *Bus, name string) (<-chan T, func(), error) {
+	topic, err := TopicOf[T](bus, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	ch := make(chan T)
+	sub := topic.Subscribe(ch)
+	return ch, sub.Unsubscribe, nil
+}
+
+// Publish publishes data on the topic of name, returning the number of
+// subscribers that received it. See Topic.Publish.
+func Publish[T any](
+	ctx context.Context,
+	bus *Bus,
+	name string,
+	data T,
+) (int, error) {
+	topic, err := TopicOf[T](bus, name)
+	if err != nil {
+		return 0, err
+	}
+	return topic.Publish(ctx, data)
+}
+
+// Subscribers returns the number of subscribers of each topic of the bus,
+// by name.
+func (b *Bus) Subscribers() map[string]int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	counts := make(map[string]int, len(b.topics))
+	for name, topic := range b.topics {
+		counts[name] = topic.Subscribers()
+	}
+	return counts
+}
+
+// Close ends the subscriptions of the topics of the bus, and the
+// subscriptions added after it. The channels of the subscriptions with a
+// context are closed, and publishing returns ErrBusClosed. It is called once
+// the services using the bus are stopped, on shutdown.
+func (b *Bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for _, topic := range b.topics {
+		topic.close()
+	}
+}
+
+// topicAs returns the registered topic of name as a topic of events of type
+// T.
+func topicAs[T any](
+	name string,
+	registered registeredTopic,
+) (*Topic[T], error) {
+	topic, ok := registered.(*Topic[T])
+	if !ok {
+		return nil, errors.Wrapf(
+			ErrTopicTypeMismatch, "%s is of type %T", name, registered,
+		)
+	}
+	return topic, nil
+}
@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feed_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBus_Register(t *testing.T) {
+	bus := feed.NewBus()
+	topic, err := feed.Register[int](bus, "numbers")
+	require.NoError(t, err)
+	require.Equal(t, "numbers", topic.Name())
+
+	// Registering the topic again returns it.
+	again, err := feed.Register[int](bus, "numbers")
+	require.NoError(t, err)
+	require.Same(t, topic, again)
+
+	_, err = feed.Register[string](bus, "numbers")
+	require.ErrorIs(t, err, feed.ErrTopicTypeMismatch)
+	_, _, err = feed.Subscribe[string](bus, "numbers")
+	require.ErrorIs(t, err, feed.ErrTopicTypeMismatch)
+	_, _, err = feed.Subscribe[int](bus, "letters")
+	require.ErrorIs(t, err, feed.ErrTopicNotFound)
+	_, err = feed.Publish(context.Background(), bus, "letters", 1)
+	require.ErrorIs(t, err, feed.ErrTopicNotFound)
+}
+
+func TestBus_Subscribers(t *testing.T) {
+	bus := feed.NewBus()
+	_, err := feed.Register[int](bus, "numbers")
+	require.NoError(t, err)
+	_, err = feed.Register[string](bus, "letters")
+	require.NoError(t, err)
+
+	_, unsubscribe1, err := feed.Subscribe[int](bus, "numbers")
+	require.NoError(t, err)
+	_, unsubscribe2, err := feed.Subscribe[int](bus, "numbers")
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"numbers": 2, "letters": 0}, bus.Subscribers())
+
+	unsubscribe1()
+	// Unsubscribing twice has no effect.
+	unsubscribe1()
+	require.Equal(t, map[string]int{"numbers": 1, "letters": 0}, bus.Subscribers())
+	unsubscribe2()
+	require.Equal(t, map[string]int{"numbers": 0, "letters": 0}, bus.Subscribers())
+}
+
+func TestBus_ConcurrentPublishSubscribe(t *testing.T) {
+	const (
+		publishers  = 4
+		events      = 100
+		subscribers = 8
+	)
+	bus := feed.NewBus()
+	_, err := feed.Register[int](bus, "numbers")
+	require.NoError(t, err)
+
+	var (
+		subscribed sync.WaitGroup
+		received   = make([][]int, subscribers)
+		receivers  sync.WaitGroup
+	)
+	subscribed.Add(subscribers)
+	receivers.Add(subscribers)
+	for i := range subscribers {
+		go func() {
+			defer receivers.Done()
+			ch, unsubscribe, err := feed.Subscribe[int](bus, "numbers")
+			subscribed.Done()
+			if err != nil {
+				return
+			}
+			defer unsubscribe()
+			for range publishers * events {
+				received[i] = append(received[i], <-ch)
+			}
+		}()
+	}
+	subscribed.Wait()
+
+	var published sync.WaitGroup
+	published.Add(publishers)
+	for p := range publishers {
+		go func() {
+			defer published.Done()
+			for e := range events {
+				sent, err := feed.Publish(
+					context.Background(), bus, "numbers", p*events+e,
+				)
+				assert.NoError(t, err)
+				assert.Equal(t, subscribers, sent)
+			}
+		}()
+	}
+	published.Wait()
+	receivers.Wait()
+
+	// The publications are serialized, so every subscriber received every
+	// event in the same order.
+	require.Len(t, received[0], publishers*events)
+	for i := 1; i < subscribers; i++ {
+		require.Equal(t, received[0], received[i])
+	}
+}
+
+func TestTopic_UnsubscribeDuringPublish(t *testing.T) {
+	bus := feed.NewBus()
+	topic, err := feed.Register[int](bus, "numbers")
+	require.NoError(t, err)
+
+	// The first subscriber never receives, blocking the publication until
+	// it is unsubscribed.
+	stalled := make(chan int)
+	sub := topic.Subscribe(stalled)
+	received := make(chan int, 1)
+	topic.Subscribe(received)
+
+	done := make(chan int)
+	go func() {
+		sent, _ := topic.Publish(context.Background(), 1)
+		done <- sent
+	}()
+	select {
+	case <-done:
+		t.Fatal("publication not blocked by the stalled subscriber")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	sub.Unsubscribe()
+	select {
+	case sent := <-done:
+		require.Equal(t, 1, sent)
+	case <-time.After(time.Second):
+		t.Fatal("publication blocked by an unsubscribed subscriber")
+	}
+	require.Equal(t, 1, <-received)
+	require.Equal(t, 1, topic.Subscribers())
+
+	// The subscription is closed once unsubscribed.
+	_, ok := <-sub.Err()
+	require.False(t, ok)
+}
+
+func TestTopic_PublishContext(t *testing.T) {
+	var topic feed.Topic[int]
+	topic.Subscribe(make(chan int))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	sent, err := topic.Publish(ctx, 1)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Zero(t, sent)
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feed
+
+import "context"
+
+// SubscribeWithContext subscribes to the events of the topic through a
+// buffer, as SubscribeBuffered, until ctx is done or the bus of the topic is
+// closed. The returned channel is closed once the subscription has ended,
+// for a subscriber ranging over it to exit.
+func (t *Topic[T]) SubscribeWithContext(
+	ctx context.Context,
+	opts ...BufferOption,
+) <-chan T {
+	ch := make(chan T)
+	sub := t.newBufferedSubscription(ch, 0, opts)
+	t.add(sub)
+	go func() {
+		// The goroutine forwarding the events is the only one sending on
+		// the channel, so it is safe to close once it has returned.
+		defer close(ch)
+		defer sub.Unsubscribe()
+		sub.forward(ctx.Done())
+	}()
+	return ch
+}
+
+// SubscribeWithContext subscribes to the topic of name until ctx is done or
+// the bus is closed, returning the channel of its events. See
+// Topic.SubscribeWithContext.
+func SubscribeWithContext[T any](
+	ctx context.Context,
+	bus *Bus,
+	name string,
+	opts ...BufferOption,
+) (<-chan T, error) {
+	topic, err := TopicOf[T](bus, name)
+	if err != nil {
+		return nil, err
+	}
+	return topic.SubscribeWithContext(ctx, opts...), nil
+}
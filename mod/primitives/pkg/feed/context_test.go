@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feed_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+// requireClosed requires ch to be closed within a second, once the events
+// still buffered are drained.
+func requireClosed[T any](t *testing.T, ch <-chan T) {
+	t.Helper()
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case _, ok := <-ch:
+			if !ok {
+				return
+			}
+		case <-timeout:
+			t.Fatal("channel not closed")
+		}
+	}
+}
+
+func TestTopic_SubscribeWithContext(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+	bus := feed.NewBus()
+	topic, err := feed.Register[int](bus, "numbers")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch, err := feed.SubscribeWithContext[int](ctx, bus, "numbers")
+	require.NoError(t, err)
+	require.Equal(t, 1, topic.Subscribers())
+
+	for i := range 3 {
+		_, err = topic.Publish(context.Background(), i)
+		require.NoError(t, err)
+	}
+	for i := range 3 {
+		require.Equal(t, i, <-ch)
+	}
+
+	// The subscription ends with its context.
+	cancel()
+	requireClosed(t, ch)
+	require.Zero(t, topic.Subscribers())
+
+	// A subscription whose context is already done ends right away.
+	requireClosed(t, topic.SubscribeWithContext(ctx))
+	require.Zero(t, topic.Subscribers())
+}
+
+func TestTopic_SubscribeWithContext_StalledSubscriber(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+	var topic feed.Topic[int]
+	ctx, cancel := context.WithCancel(context.Background())
+	// The subscriber never reads, its buffer fills without stalling the
+	// publications.
+	ch := topic.SubscribeWithContext(ctx, feed.WithBufferSize(2))
+	for i := range 10 {
+		sent, err := topic.Publish(context.Background(), i)
+		require.NoError(t, err)
+		require.Equal(t, 1, sent)
+	}
+
+	cancel()
+	requireClosed(t, ch)
+	require.Zero(t, topic.Subscribers())
+}
+
+func TestBus_Close(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+	bus := feed.NewBus()
+	topic, err := feed.Register[int](bus, "numbers", feed.WithReplay(4))
+	require.NoError(t, err)
+	_, err = feed.Register[string](bus, "letters")
+	require.NoError(t, err)
+
+	plain := topic.Subscribe(make(chan int))
+	buffered := topic.SubscribeBuffered(make(chan int))
+	replayed := topic.SubscribeWithReplay(make(chan int))
+	withContext, err := feed.SubscribeWithContext[string](
+		context.Background(), bus, "letters",
+	)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"numbers": 3, "letters": 1}, bus.Subscribers())
+
+	bus.Close()
+	require.Equal(t, map[string]int{"numbers": 0, "letters": 0}, bus.Subscribers())
+	for _, sub := range []interface{ Err() <-chan error }{
+		plain, buffered, replayed,
+	} {
+		_, ok := <-sub.Err()
+		require.False(t, ok)
+	}
+	requireClosed(t, withContext)
+
+	// Nothing is published nor subscribed once the bus is closed.
+	_, err = feed.Publish(context.Background(), bus, "numbers", 1)
+	require.ErrorIs(t, err, feed.ErrBusClosed)
+	_, err = feed.Register[int](bus, "others")
+	require.ErrorIs(t, err, feed.ErrBusClosed)
+	late, err := feed.SubscribeWithContext[int](
+		context.Background(), bus, "numbers",
+	)
+	require.NoError(t, err)
+	requireClosed(t, late)
+	sub := topic.SubscribeBuffered(make(chan int))
+	_, ok := <-sub.Err()
+	require.False(t, ok)
+	require.Zero(t, topic.Subscribers())
+
+	// Closing again has no effect.
+	bus.Close()
+}
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feed
+
+import "time"
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
+	// MeasureSince measures the time since the provided start time,
+	// identified by the provided keys.
+	MeasureSince(key string, start time.Time, args ...string)
+}
+
+// envelope is an event buffered for a subscriber, with the time it was
+// published at to measure its delivery latency.
+type envelope[T any] struct {
+	data        T
+	publishedAt time.Time
+}
+
+// topicMetrics records the metrics of a topic, labeled with its name only
+// for their cardinality to be bounded by the number of topics. No metric is
+// recorded without a sink.
+type topicMetrics struct {
+	sink  TelemetrySink
+	topic string
+}
+
+// markPublished counts an event published on the topic.
+func (m topicMetrics) markPublished() {
+	if m.sink != nil {
+		m.sink.IncrementCounter(
+			"beacon_kit.event_bus.published_events", "topic", m.topic,
+		)
+	}
+}
+
+// markDelivered counts an event received by a subscriber of the topic, and
+// measures the time since it was published.
+func (m topicMetrics) markDelivered(publishedAt time.Time) {
+	if m.sink != nil {
+		m.sink.IncrementCounter(
+			"beacon_kit.event_bus.delivered_events", "topic", m.topic,
+		)
+		m.sink.MeasureSince(
+			"beacon_kit.event_bus.delivery_latency",
+			publishedAt,
+			"topic", m.topic,
+		)
+	}
+}
+
+// markDropped counts an event dropped for a subscriber of the topic.
+func (m topicMetrics) markDropped() {
+	if m.sink != nil {
+		m.sink.IncrementCounter(
+			"beacon_kit.event_bus.dropped_events", "topic", m.topic,
+		)
+	}
+}
+
+// setSubscribers sets the number of subscribers of the topic.
+func (m topicMetrics) setSubscribers(count int) {
+	if m.sink != nil {
+		m.sink.SetGauge(
+			"beacon_kit.event_bus.subscribers", int64(count), "topic", m.topic,
+		)
+	}
+}
+
+// subscriberMetrics records the metrics of a buffered subscriber set with
+// WithTelemetrySink, labeled with the topic and the name of the subscriber.
+// No metric is recorded without a sink.
+type subscriberMetrics struct {
+	sink       TelemetrySink
+	topic      string
+	subscriber string
+}
+
+// markDelivered measures the time since an event received by the
+// subscriber was published.
+func (m subscriberMetrics) markDelivered(publishedAt time.Time) {
+	if m.sink != nil {
+		m.sink.MeasureSince(
+			"beacon_kit.feed.delivery_latency",
+			publishedAt,
+			"topic", m.topic, "subscriber", m.subscriber,
+		)
+	}
+}
+
+// markDropped counts an event dropped for the subscriber.
+func (m subscriberMetrics) markDropped() {
+	if m.sink != nil {
+		m.sink.IncrementCounter(
+			"beacon_kit.feed.dropped_events",
+			"topic", m.topic, "subscriber", m.subscriber,
+		)
+	}
+}
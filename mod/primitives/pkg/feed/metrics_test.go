@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feed_test
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingSink records the metrics by key, followed by the values of their
+// labels.
+type recordingSink struct {
+	mu        sync.Mutex
+	counts    map[string]int
+	gauges    map[string]int64
+	durations map[string][]time.Duration
+}
+
+func newRecordingSink() *recordingSink {
+	return &recordingSink{
+		counts:    make(map[string]int),
+		gauges:    make(map[string]int64),
+		durations: make(map[string][]time.Duration),
+	}
+}
+
+// metricName returns the key followed by the values of the labels in args.
+func metricName(key string, args []string) string {
+	name := []string{key}
+	for i := 1; i < len(args); i += 2 {
+		name = append(name, args[i])
+	}
+	return strings.Join(name, " ")
+}
+
+func (s *recordingSink) IncrementCounter(key string, args ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counts[metricName(key, args)]++
+}
+
+func (s *recordingSink) SetGauge(key string, value int64, args ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gauges[metricName(key, args)] = value
+}
+
+func (s *recordingSink) MeasureSince(
+	key string,
+	start time.Time,
+	args ...string,
+) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name := metricName(key, args)
+	s.durations[name] = append(s.durations[name], time.Since(start))
+}
+
+func (s *recordingSink) counters() map[string]int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return maps.Clone(s.counts)
+}
+
+func (s *recordingSink) gauge(name string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.gauges[name]
+}
+
+func (s *recordingSink) measured(name string) []time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.durations[name])
+}
+
+func TestBus_Metrics(t *testing.T) {
+	const (
+		events      = 20
+		subscribers = "beacon_kit.event_bus.subscribers numbers"
+	)
+	sink := newRecordingSink()
+	bus := feed.NewBus(feed.WithMetrics(sink))
+	topic, err := feed.Register[int](bus, "numbers")
+	require.NoError(t, err)
+
+	fastCh := make(chan int)
+	fast := topic.SubscribeBuffered(
+		fastCh,
+		feed.WithBufferSize(events),
+		feed.WithTelemetrySink(sink, "fast"),
+	)
+	defer fast.Unsubscribe()
+	slowCh := make(chan int)
+	slow := topic.SubscribeBuffered(
+		slowCh,
+		feed.WithBufferSize(2),
+		feed.WithTelemetrySink(sink, "slow"),
+	)
+	require.Equal(t, int64(2), sink.gauge(subscribers))
+
+	// The fast subscriber receives the events as they are published, the
+	// slow one takes a while for each of them.
+	var (
+		wg           sync.WaitGroup
+		slowReceived int
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for range events {
+			<-fastCh
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-slowCh:
+				slowReceived++
+				time.Sleep(20 * time.Millisecond)
+			case <-time.After(200 * time.Millisecond):
+				return
+			}
+		}
+	}()
+	for i := range events {
+		sent, err := topic.Publish(context.Background(), i)
+		require.NoError(t, err)
+		require.Equal(t, 2, sent)
+	}
+	wg.Wait()
+
+	require.Positive(t, slow.Dropped())
+	require.Zero(t, fast.Dropped())
+	require.Equal(t, events, slowReceived+int(slow.Dropped()))
+	require.Equal(t, map[string]int{
+		"beacon_kit.event_bus.published_events numbers": events,
+		"beacon_kit.event_bus.delivered_events numbers": events + slowReceived,
+		"beacon_kit.event_bus.dropped_events numbers":   int(slow.Dropped()),
+		"beacon_kit.feed.dropped_events numbers slow":   int(slow.Dropped()),
+	}, sink.counters())
+
+	// The latency of the slow subscriber is measured apart, and grows as it
+	// falls behind.
+	fastLatency := sink.measured("beacon_kit.feed.delivery_latency numbers fast")
+	slowLatency := sink.measured("beacon_kit.feed.delivery_latency numbers slow")
+	require.Len(t, fastLatency, events)
+	require.Len(t, slowLatency, slowReceived)
+	require.Greater(t, slices.Max(slowLatency), slices.Max(fastLatency))
+	require.GreaterOrEqual(t, slices.Max(slowLatency), 20*time.Millisecond)
+	require.Len(
+		t,
+		sink.measured("beacon_kit.event_bus.delivery_latency numbers"),
+		events+slowReceived,
+	)
+
+	slow.Unsubscribe()
+	require.Equal(t, int64(1), sink.gauge(subscribers))
+	bus.Close()
+	require.Zero(t, sink.gauge(subscribers))
+}
+
+func TestBus_MetricsUnbuffered(t *testing.T) {
+	sink := newRecordingSink()
+	bus := feed.NewBus(feed.WithMetrics(sink))
+	ch, unsubscribe, err := feed.Subscribe[int](bus, "numbers")
+	require.ErrorIs(t, err, feed.ErrTopicNotFound)
+	require.Nil(t, ch)
+	require.Nil(t, unsubscribe)
+
+	_, err = feed.Register[int](bus, "numbers")
+	require.NoError(t, err)
+	ch, unsubscribe, err = feed.Subscribe[int](bus, "numbers")
+	require.NoError(t, err)
+	defer unsubscribe()
+	go func() { <-ch }()
+
+	sent, err := feed.Publish(context.Background(), bus, "numbers", 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, sent)
+	require.Equal(t, map[string]int{
+		"beacon_kit.event_bus.published_events numbers": 1,
+		"beacon_kit.event_bus.delivered_events numbers": 1,
+	}, sink.counters())
+	require.Len(
+		t, sink.measured("beacon_kit.event_bus.delivery_latency numbers"), 1,
+	)
+}
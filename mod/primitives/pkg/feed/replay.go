@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feed
+
+import "time"
+
+// TopicOption is a functional option for a topic.
+type TopicOption func(*topicOptions)
+
+// topicOptions holds the configuration of a topic.
+type topicOptions struct {
+	// replaySize is the number of the latest events of the topic kept for
+	// the subscriptions with replay.
+	replaySize int
+}
+
+// WithReplay keeps the latest size events of the topic, for them to be
+// replayed to the subscriptions with replay. The events are not kept if size
+// is zero.
+func WithReplay(size int) TopicOption {
+	return func(o *topicOptions) {
+		o.replaySize = size
+	}
+}
+
+// SubscribeWithReplay subscribes ch to the events of the topic through a
+// buffer, as SubscribeBuffered, delivering the history of the topic before
+// its live events. The history is the latest events published, up to the
+// replay size of the topic, and it is followed by the events published after
+// it without gap nor duplicate. The buffer holds at least the history, whose
+// delivery latency is measured from the subscription.
+func (t *Topic[T]) SubscribeWithReplay(
+	ch chan<- T,
+	opts ...BufferOption,
+) *BufferedSubscription[T] {
+	// No event is published while the subscription is added, for the live
+	// events to follow the history.
+	t.sendMu.Lock()
+	defer t.sendMu.Unlock()
+
+	var history []T
+	if t.history != nil {
+		history = t.history.snapshot()
+	}
+	sub := t.newBufferedSubscription(ch, len(history), opts)
+	now := time.Now()
+	for _, data := range history {
+		sub.buffer.push(envelope[T]{data: data, publishedAt: now})
+	}
+	t.add(sub)
+	go sub.forward(nil)
+	return &BufferedSubscription[T]{subscription: sub}
+}
+
+// SubscribeWithReplay subscribes to the topic of name with replay,
+// returning the channel of its events and the function unsubscribing from
+// it. See Topic.SubscribeWithReplay.
+func SubscribeWithReplay[T any](
+	bus *Bus,
+	name string,
+	opts ...BufferOption,
+) (<-chan T, func(), error) {
+	topic, err := TopicOf[T](bus, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	ch := make(chan T)
+	sub := topic.SubscribeWithReplay(ch, opts...)
+	return ch, sub.Unsubscribe, nil
+}
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feed_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blockEvent is an event of the block of a slot.
+type blockEvent = *feed.Event[uint64]
+
+// publishBlocks publishes the events of the blocks of the slots from start
+// to end on topic.
+func publishBlocks(
+	t *testing.T,
+	topic *feed.Topic[blockEvent],
+	start, end uint64,
+) {
+	t.Helper()
+	for slot := start; slot < end; slot++ {
+		_, err := topic.Publish(
+			context.Background(),
+			feed.NewEvent(context.Background(), "block", slot),
+		)
+		require.NoError(t, err)
+	}
+}
+
+// receiveSlots returns the slots of the n next events received on ch.
+func receiveSlots(ch <-chan blockEvent, n int) []uint64 {
+	slots := make([]uint64, n)
+	for i := range slots {
+		ev := <-ch
+		slots[i] = ev.Data()
+	}
+	return slots
+}
+
+func TestSubscribeWithReplay(t *testing.T) {
+	tests := []struct {
+		name       string
+		replaySize int
+		replayed   []uint64
+	}{
+		{
+			name:       "AllEvents",
+			replaySize: 10,
+			replayed:   []uint64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		},
+		{
+			// Only the latest events are kept.
+			name:       "LatestEvents",
+			replaySize: 4,
+			replayed:   []uint64{6, 7, 8, 9},
+		},
+		{
+			name:       "NoReplay",
+			replaySize: 0,
+			replayed:   []uint64{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bus := feed.NewBus()
+			topic, err := feed.Register[blockEvent](
+				bus, "blocks", feed.WithReplay(tt.replaySize),
+			)
+			require.NoError(t, err)
+			publishBlocks(t, topic, 0, 10)
+
+			// The history is replayed in order, with a buffer smaller than
+			// it.
+			ch, unsubscribe, err := feed.SubscribeWithReplay[blockEvent](
+				bus, "blocks", feed.WithBufferSize(2),
+			)
+			require.NoError(t, err)
+			defer unsubscribe()
+			require.Equal(t, tt.replayed, receiveSlots(ch, len(tt.replayed)))
+
+			// The live events follow.
+			publishBlocks(t, topic, 10, 12)
+			require.Equal(t, []uint64{10, 11}, receiveSlots(ch, 2))
+		})
+	}
+}
+
+func TestSubscribeWithReplay_Concurrent(t *testing.T) {
+	bus := feed.NewBus()
+	topic, err := feed.Register[blockEvent](bus, "blocks", feed.WithReplay(8))
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for slot := range uint64(200) {
+			_, err := topic.Publish(
+				context.Background(),
+				feed.NewEvent(context.Background(), "block", slot),
+			)
+			assert.NoError(t, err)
+		}
+	}()
+
+	// A subscriber joining while blocks are published receives consecutive
+	// slots, the history being followed by the live events without gap nor
+	// duplicate.
+	ch := make(chan blockEvent)
+	sub := topic.SubscribeWithReplay(ch, feed.WithBufferSize(256))
+	defer sub.Unsubscribe()
+	<-done
+
+	first := <-ch
+	slots := receiveSlots(ch, int(199-first.Data()))
+	for i, slot := range slots {
+		require.Equal(t, first.Data()+uint64(i)+1, slot)
+	}
+	require.Zero(t, sub.Dropped())
+}
+
+func TestReplayedFeed(t *testing.T) {
+	bus := feed.NewBus()
+	replayed, err := feed.Register[int](bus, "numbers", feed.WithReplay(2))
+	require.NoError(t, err)
+	for i := range 3 {
+		_, err = replayed.Publish(context.Background(), i)
+		require.NoError(t, err)
+	}
+
+	ch := make(chan int)
+	sub := replayed.Replayed().Subscribe(ch)
+	defer sub.Unsubscribe()
+	require.Equal(t, 1, <-ch)
+	require.Equal(t, 2, <-ch)
+}
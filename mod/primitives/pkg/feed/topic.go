@@ -0,0 +1,221 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package feed
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Topic is a topic of a bus, carrying events of type T to its subscribers.
+// It has the methods of an event.FeedOf, for it to replace one, and as it
+// its zero value is ready to use.
+type Topic[T any] struct {
+	// name is the name of the topic.
+	name string
+	// sendMu serializes the publications, for the subscribers to receive
+	// the events in the same order.
+	sendMu sync.Mutex
+	// mu protects subs.
+	mu sync.RWMutex
+	// subs are the subscriptions to the topic.
+	subs map[*subscription[T]]struct{}
+	// history holds the latest events published, nil if they are not kept.
+	history *ringBuffer[T]
+	// closed is whether the bus of the topic is closed, it is guarded by mu.
+	closed bool
+	// metrics records the metrics of the topic, set by the bus.
+	metrics topicMetrics
+}
+
+// newTopic returns a topic of name without subscribers.
+func newTopic[T any](name string, opts ...TopicOption) *Topic[T] {
+	var o topicOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	t := &Topic[T]{name: name}
+	if o.replaySize > 0 {
+		t.history = newRingBuffer[T](o.replaySize, DropOldest)
+	}
+	return t
+}
+
+// Name returns the name of the topic.
+func (t *Topic[T]) Name() string {
+	return t.name
+}
+
+// Subscribe subscribes ch to the events of the topic, until the
+// subscription is unsubscribed. The channel is never closed.
+func (t *Topic[T]) Subscribe(ch chan<- T) event.Subscription {
+	sub := t.subscribe(ch)
+	t.add(sub)
+	return sub
+}
+
+// Publish delivers data to the subscribers of the topic, returning the
+// number of subscribers that received it. It returns early with the error
+// of ctx if it is done before data is delivered to all of them. The
+// subscribers unsubscribed during the publication are skipped, and the
+// buffered ones receive data once it is in their buffer. ErrBusClosed is
+// returned once the bus of the topic is closed.
+func (t *Topic[T]) Publish(ctx context.Context, data T) (int, error) {
+	t.sendMu.Lock()
+	defer t.sendMu.Unlock()
+
+	t.mu.RLock()
+	if t.closed {
+		t.mu.RUnlock()
+		return 0, ErrBusClosed
+	}
+	publishedAt := time.Now()
+	t.metrics.markPublished()
+	if t.history != nil {
+		t.history.push(data)
+	}
+	subs := make([]*subscription[T], 0, len(t.subs))
+	for sub := range t.subs {
+		subs = append(subs, sub)
+	}
+	t.mu.RUnlock()
+
+	var sent int
+	for _, sub := range subs {
+		// The buffered subscriptions never block the publication.
+		if sub.buffer != nil {
+			sub.buffer.push(envelope[T]{data: data, publishedAt: publishedAt})
+			sent++
+			continue
+		}
+		select {
+		case sub.ch <- data:
+			t.metrics.markDelivered(publishedAt)
+			sent++
+		case <-sub.quit:
+		case <-ctx.Done():
+			return sent, ctx.Err()
+		}
+	}
+	return sent, nil
+}
+
+// Send delivers data to the subscribers of the topic, returning the number
+// of subscribers that received it. It blocks until all of them received it,
+// as event.FeedOf.Send.
+func (t *Topic[T]) Send(data T) int {
+	sent, _ := t.Publish(context.Background(), data)
+	return sent
+}
+
+// Subscribers returns the number of subscribers of the topic.
+func (t *Topic[T]) Subscribers() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return len(t.subs)
+}
+
+// subscribe returns a subscription of ch to the topic, not yet added to it.
+func (t *Topic[T]) subscribe(ch chan<- T) *subscription[T] {
+	return &subscription[T]{
+		topic: t,
+		ch:    ch,
+		quit:  make(chan struct{}),
+		err:   make(chan error),
+	}
+}
+
+// add adds sub to the subscriptions of the topic. The subscription is
+// ended right away if the bus of the topic is closed.
+func (t *Topic[T]) add(sub *subscription[T]) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		sub.end()
+		return
+	}
+	if t.subs == nil {
+		t.subs = make(map[*subscription[T]]struct{})
+	}
+	t.subs[sub] = struct{}{}
+	t.metrics.setSubscribers(len(t.subs))
+}
+
+// remove removes sub from the subscriptions of the topic.
+func (t *Topic[T]) remove(sub *subscription[T]) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.subs, sub)
+	t.metrics.setSubscribers(len(t.subs))
+}
+
+// close ends the subscriptions of the topic, and the subscriptions added
+// after it.
+func (t *Topic[T]) close() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.closed = true
+	for sub := range t.subs {
+		sub.end()
+	}
+	t.subs = nil
+	t.metrics.setSubscribers(0)
+}
+
+// subscription is the subscription of a channel to a topic.
+type subscription[T any] struct {
+	topic *Topic[T]
+	ch    chan<- T
+	// quit is closed once the subscription is unsubscribed, for a
+	// publication in progress to skip it.
+	quit chan struct{}
+	err  chan error
+	once sync.Once
+	// buffer is the buffer of the events of a buffered subscription, nil
+	// if the subscription is not buffered.
+	buffer *ringBuffer[envelope[T]]
+	// metrics records the metrics of a buffered subscription.
+	metrics subscriberMetrics
+}
+
+// Unsubscribe removes the subscription from its topic. It can be called
+// more than once, and while an event is published.
+func (s *subscription[T]) Unsubscribe() {
+	s.topic.remove(s)
+	s.end()
+}
+
+// end ends the subscription, once it is removed from its topic.
+func (s *subscription[T]) end() {
+	s.once.Do(func() {
+		close(s.quit)
+		close(s.err)
+	})
+}
+
+// Err returns a channel closed once the subscription is unsubscribed, as
+// the subscription of an event.FeedOf.
+func (s *subscription[T]) Err() <-chan error {
+	return s.err
+}
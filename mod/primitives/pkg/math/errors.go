@@ -37,6 +37,9 @@ var (
 	// ErrNegativeBigInt is returned when a negative big.Int is provided to a
 	// function that requires a positive big.Int.
 	ErrNegativeBigIntBase = errors.New("big.Int is negative")
+
+	// ErrOverflow is returned when an arithmetic operation overflows.
+	ErrOverflow = errors.New("arithmetic overflow")
 )
 
 // ErrUnexpectedInputLength returns an error indicating that the input length.
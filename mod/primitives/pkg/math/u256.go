@@ -21,6 +21,7 @@
 package math
 
 import (
+	"log/slog"
 	"math/big"
 
 	byteslib "github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
@@ -176,3 +177,9 @@ func (s U256L) SizeSSZ() int {
 func (s *U256L) String() string {
 	return s.UnwrapU256().String()
 }
+
+// LogValue implements slog.LogValuer, for U256L to be logged in its
+// big-endian hex encoding as it is marshaled.
+func (s U256L) LogValue() slog.Value {
+	return slog.StringValue(hex.FromBigInt(s.UnwrapBig()).Unwrap())
+}
@@ -22,6 +22,8 @@ package math_test
 
 import (
 	"bytes"
+	"encoding/json"
+	"log/slog"
 	"math/big"
 	"testing"
 
@@ -85,6 +87,17 @@ func TestLittleEndian_MarshalJSON(t *testing.T) {
 	}
 }
 
+func TestLittleEndian_LogValue(t *testing.T) {
+	le, err := math.NewU256L([]byte{1, 2, 3, 4, 5})
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	slog.New(slog.NewJSONHandler(&buf, nil)).Info("msg", "base_fee", le)
+
+	var fields map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &fields))
+	require.Equal(t, "0x504030201", fields["base_fee"])
+}
+
 func TestLittleEndian_UnmarshalJSON(t *testing.T) {
 	testCases := []struct {
 		json     string
@@ -22,10 +22,12 @@ package math
 
 import (
 	"encoding/binary"
+	"log/slog"
 	"math/big"
 	"math/bits"
 	"reflect"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/hex"
 )
@@ -141,6 +143,12 @@ func (u U64) String() hex.String {
 	return hex.FromUint64(u.Unwrap())
 }
 
+// LogValue implements slog.LogValuer, for U64 to be logged in its hex
+// encoding as it is marshaled, its String not being a fmt.Stringer.
+func (u U64) LogValue() slog.Value {
+	return slog.StringValue(u.String().Unwrap())
+}
+
 // ----------------------- U64 Mathematical Methods -----------------------
 
 // Unwrap returns a copy of the underlying uint64 value of U64.
@@ -221,6 +229,26 @@ func (u U64) ILog2Floor() uint8 {
 	return uint8(bits.Len64(uint64(u))) - 1
 }
 
+// CheckedAdd returns the sum of the U64s, or ErrOverflow if it does not fit
+// in a U64.
+func (u U64) CheckedAdd(v U64) (U64, error) {
+	sum, carry := bits.Add64(uint64(u), uint64(v), 0)
+	if carry != 0 {
+		return 0, errors.Wrapf(ErrOverflow, "%d + %d", uint64(u), uint64(v))
+	}
+	return U64(sum), nil
+}
+
+// CheckedMul returns the product of the U64s, or ErrOverflow if it does not
+// fit in a U64.
+func (u U64) CheckedMul(v U64) (U64, error) {
+	hi, lo := bits.Mul64(uint64(u), uint64(v))
+	if hi != 0 {
+		return 0, errors.Wrapf(ErrOverflow, "%d * %d", uint64(u), uint64(v))
+	}
+	return U64(lo), nil
+}
+
 // ---------------------------- Gwei Methods ----------------------------
 
 // GweiToWei returns the value of Wei in Gwei.
@@ -21,6 +21,10 @@
 package math_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	stdmath "math"
 	"reflect"
 	"testing"
 
@@ -174,6 +178,17 @@ func TestU64_MarshalText(t *testing.T) {
 	}
 }
 
+func TestU64_LogValue(t *testing.T) {
+	var buf bytes.Buffer
+	slog.New(slog.NewJSONHandler(&buf, nil)).Info(
+		"msg", "slot", math.Slot(123),
+	)
+
+	var fields map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &fields))
+	require.Equal(t, "0x7b", fields["slot"])
+}
+
 func TestU64_UnmarshalJSON(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -381,6 +396,56 @@ func TestU64_ILog2Floor(t *testing.T) {
 	}
 }
 
+func TestU64_CheckedAdd(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     math.U64
+		expected math.U64
+		err      error
+	}{
+		{name: "zero", a: 0, b: 0, expected: 0},
+		{name: "sum", a: 32e9, b: 1e9, expected: 33e9},
+		{name: "max", a: stdmath.MaxUint64 - 1, b: 1, expected: stdmath.MaxUint64},
+		{name: "overflow", a: stdmath.MaxUint64, b: 1, err: math.ErrOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sum, err := tt.a.CheckedAdd(tt.b)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, sum)
+		})
+	}
+}
+
+func TestU64_CheckedMul(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     math.U64
+		expected math.U64
+		err      error
+	}{
+		{name: "zero", a: 0, b: stdmath.MaxUint64, expected: 0},
+		{name: "product", a: 25e7, b: 5, expected: 125e7},
+		{name: "max", a: stdmath.MaxUint64, b: 1, expected: stdmath.MaxUint64},
+		{name: "overflow", a: 1 << 32, b: 1 << 32, err: math.ErrOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product, err := tt.a.CheckedMul(tt.b)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, product)
+		})
+	}
+}
+
 func TestU64_PrevPowerOfTwo(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package testing provides telemetry sinks for the tests of the packages
+// recording metrics.
+package testing
+
+import "time"
+
+// NoopSink is a telemetry sink discarding the metrics.
+type NoopSink struct{}
+
+// IncrementCounter does nothing.
+func (NoopSink) IncrementCounter(string, ...string) {}
+
+// IncrementCounterBy does nothing.
+func (NoopSink) IncrementCounterBy(string, int64, ...string) {}
+
+// SetGauge does nothing.
+func (NoopSink) SetGauge(string, int64, ...string) {}
+
+// MeasureSince does nothing.
+func (NoopSink) MeasureSince(string, time.Time, ...string) {}
+
+// ObserveHistogram does nothing.
+func (NoopSink) ObserveHistogram(string, float64, ...string) {}
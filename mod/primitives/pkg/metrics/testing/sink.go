@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package testing
+
+import (
+	"math"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Kind is the kind of a recorded metric.
+type Kind int
+
+const (
+	// KindCounter is a counter incremented with IncrementCounter or
+	// IncrementCounterBy.
+	KindCounter Kind = iota
+	// KindGauge is a gauge set with SetGauge.
+	KindGauge
+	// KindDuration is a duration measured with MeasureSince.
+	KindDuration
+	// KindHistogram is a value observed with ObserveHistogram.
+	KindHistogram
+)
+
+// Call is a call received by a RecordingSink.
+type Call struct {
+	// Kind is the kind of the metric.
+	Kind Kind
+	// Key is the key of the metric.
+	Key string
+	// Labels are the label names and values of the call, in pairs.
+	Labels []string
+	// Value is the increment of a counter, the value of a gauge or a
+	// histogram, or the duration of a measure in seconds.
+	Value float64
+}
+
+// matches returns true if the call is of the given kind and key, and has the
+// given labels, in pairs, among its labels.
+func (c Call) matches(kind Kind, key string, labels []string) bool {
+	if c.Kind != kind || c.Key != key {
+		return false
+	}
+	//nolint:mnd // label pairs.
+	for i := 0; i+1 < len(labels); i += 2 {
+		if !hasLabel(c.Labels, labels[i], labels[i+1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasLabel returns true if labels has the label name with the value.
+//
+//nolint:mnd // label pairs.
+func hasLabel(labels []string, name, value string) bool {
+	for i := 0; i+1 < len(labels); i += 2 {
+		if labels[i] == name && labels[i+1] == value {
+			return true
+		}
+	}
+	return false
+}
+
+// RecordingSink is a telemetry sink recording the calls it receives, for the
+// tests to query them. It is safe for concurrent use.
+type RecordingSink struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// NewRecordingSink creates a RecordingSink without calls.
+func NewRecordingSink() *RecordingSink {
+	return &RecordingSink{}
+}
+
+// IncrementCounter records the increment of a counter.
+func (s *RecordingSink) IncrementCounter(key string, args ...string) {
+	s.record(Call{Kind: KindCounter, Key: key, Labels: args, Value: 1})
+}
+
+// IncrementCounterBy records the increment of a counter by value.
+func (s *RecordingSink) IncrementCounterBy(
+	key string, value int64, args ...string,
+) {
+	s.record(Call{
+		Kind: KindCounter, Key: key, Labels: args, Value: float64(value),
+	})
+}
+
+// SetGauge records the value of a gauge.
+func (s *RecordingSink) SetGauge(key string, value int64, args ...string) {
+	s.record(Call{
+		Kind: KindGauge, Key: key, Labels: args, Value: float64(value),
+	})
+}
+
+// MeasureSince records the time since start.
+func (s *RecordingSink) MeasureSince(
+	key string, start time.Time, args ...string,
+) {
+	s.record(Call{
+		Kind:   KindDuration,
+		Key:    key,
+		Labels: args,
+		Value:  time.Since(start).Seconds(),
+	})
+}
+
+// ObserveHistogram records the value observed by a histogram.
+func (s *RecordingSink) ObserveHistogram(
+	key string, value float64, args ...string,
+) {
+	s.record(Call{Kind: KindHistogram, Key: key, Labels: args, Value: value})
+}
+
+// Calls returns the calls recorded so far.
+func (s *RecordingSink) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.calls)
+}
+
+// CountFor returns the number of increments of the counter of the given key
+// having the given labels, in pairs, among its labels.
+func (s *RecordingSink) CountFor(key string, labels ...string) int {
+	return len(s.values(KindCounter, key, labels))
+}
+
+// CounterTotal returns the sum of the increments of the counter of the given
+// key having the given labels, in pairs, among its labels.
+func (s *RecordingSink) CounterTotal(key string, labels ...string) int64 {
+	var total float64
+	for _, value := range s.values(KindCounter, key, labels) {
+		total += value
+	}
+	return int64(total)
+}
+
+// LastGauge returns the last value of the gauge of the given key having the
+// given labels, and false if it has not been set.
+func (s *RecordingSink) LastGauge(
+	key string, labels ...string,
+) (int64, bool) {
+	values := s.values(KindGauge, key, labels)
+	if len(values) == 0 {
+		return 0, false
+	}
+	return int64(values[len(values)-1]), true
+}
+
+// Durations returns the durations measured for the given key and labels.
+func (s *RecordingSink) Durations(
+	key string, labels ...string,
+) []time.Duration {
+	values := s.values(KindDuration, key, labels)
+	durations := make([]time.Duration, len(values))
+	for i, value := range values {
+		durations[i] = time.Duration(math.Round(value * float64(time.Second)))
+	}
+	return durations
+}
+
+// Observations returns the values observed by the histogram of the given key
+// and labels.
+func (s *RecordingSink) Observations(key string, labels ...string) []float64 {
+	return s.values(KindHistogram, key, labels)
+}
+
+// record records the call.
+func (s *RecordingSink) record(call Call) {
+	call.Labels = slices.Clone(call.Labels)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, call)
+}
+
+// values returns the values of the calls of the given kind, key and labels.
+func (s *RecordingSink) values(
+	kind Kind, key string, labels []string,
+) []float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var values []float64
+	for _, call := range s.calls {
+		if call.matches(kind, key, labels) {
+			values = append(values, call.Value)
+		}
+	}
+	return values
+}
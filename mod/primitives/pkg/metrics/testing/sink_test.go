@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package testing_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	metricstesting "github.com/berachain/beacon-kit/mod/primitives/pkg/metrics/testing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordingSink(t *testing.T) {
+	sink := metricstesting.NewRecordingSink()
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			route := "a"
+			if i%2 == 1 {
+				route = "b"
+			}
+			sink.IncrementCounter("requests", "route", route, "code", "200")
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, 10, sink.CountFor("requests"))
+	require.Equal(t, 5, sink.CountFor("requests", "route", "a"))
+	require.Equal(t, 5, sink.CountFor("requests", "code", "200", "route", "b"))
+	require.Zero(t, sink.CountFor("requests", "route", "c"))
+	require.Zero(t, sink.CountFor("responses"))
+
+	_, ok := sink.LastGauge("size")
+	require.False(t, ok)
+	sink.SetGauge("size", 3)
+	sink.SetGauge("size", 7)
+	size, ok := sink.LastGauge("size")
+	require.True(t, ok)
+	require.Equal(t, int64(7), size)
+
+	sink.MeasureSince("duration", time.Now().Add(-time.Second))
+	durations := sink.Durations("duration")
+	require.Len(t, durations, 1)
+	require.GreaterOrEqual(t, durations[0], time.Second)
+
+	sink.ObserveHistogram("batch", 2.5, "kind", "blobs")
+	require.Equal(t, []float64{2.5}, sink.Observations("batch", "kind", "blobs"))
+	require.Empty(t, sink.Observations("batch", "kind", "deposits"))
+	require.Len(t, sink.Calls(), 14)
+}
+
+func TestNoopSink(_ *testing.T) {
+	var sink metricstesting.NoopSink
+	sink.IncrementCounter("requests")
+	sink.SetGauge("size", 1)
+	sink.MeasureSince("duration", time.Now())
+	sink.ObserveHistogram("batch", 1)
+}
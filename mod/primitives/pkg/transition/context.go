@@ -38,6 +38,26 @@ type Context struct {
 	// SkipValidateResult indicates whether to validate the result of
 	// the state transition.
 	SkipValidateResult bool
+	// SkipSignatureVerification indicates whether to skip verifying the
+	// signatures of the block, which can be done when replaying trusted
+	// blocks.
+	SkipSignatureVerification bool
+}
+
+// NewReplayContext returns the context of the replay of a block that is
+// already finalized, when catching up or rebuilding the state from stored
+// blocks. Its signatures are not verified and its payload is not sent to the
+// execution client, though the state root is still validated for the
+// replayed state to be checked against the chain.
+func NewReplayContext(ctx context.Context) *Context {
+	return &Context{
+		Context:                   ctx,
+		OptimisticEngine:          true,
+		SkipPayloadVerification:   true,
+		SkipValidateRandao:        true,
+		SkipValidateResult:        false,
+		SkipSignatureVerification: true,
+	}
 }
 
 // GetOptimisticEngine returns whether to optimistically assume the execution
@@ -65,6 +85,12 @@ func (c *Context) GetSkipValidateResult() bool {
 	return c.SkipValidateResult
 }
 
+// GetSkipSignatureVerification returns whether to skip verifying the
+// signatures of the block.
+func (c *Context) GetSkipSignatureVerification() bool {
+	return c.SkipSignatureVerification
+}
+
 // Unwrap returns the underlying standard context.
 func (c *Context) Unwrap() context.Context {
 	return c.Context
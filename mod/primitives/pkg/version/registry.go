@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package version
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// ErrForkAlreadyRegistered is returned when the handlers of a subsystem are
+// registered twice for a fork version.
+var ErrForkAlreadyRegistered = errors.New("fork already registered")
+
+// ErrForkNotSupported is returned when a subsystem has no handlers
+// registered for a fork version. Any ErrForkNotSupported matches it with
+// errors.Is, whatever its version and subsystem.
+type ErrForkNotSupported struct {
+	// Version is the fork version that is not supported.
+	Version uint32
+	// Subsystem is the name of the subsystem not supporting it.
+	Subsystem string
+}
+
+// Error implements the error interface.
+func (e ErrForkNotSupported) Error() string {
+	return fmt.Sprintf(
+		"fork %s not supported by %s", Name(e.Version), e.Subsystem,
+	)
+}
+
+// Is returns whether target is an ErrForkNotSupported.
+func (ErrForkNotSupported) Is(target error) bool {
+	_, ok := target.(ErrForkNotSupported)
+	return ok
+}
+
+// Registry holds the handlers of a subsystem for each fork version it
+// supports, for the subsystem to dispatch on the version without a switch.
+// Supporting a new fork is then registering its handlers.
+type Registry[T any] struct {
+	// subsystem is the name of the subsystem, reported in the errors.
+	subsystem string
+	mu        sync.RWMutex
+	handlers  map[uint32]T
+}
+
+// NewRegistry returns an empty registry of the handlers of subsystem.
+func NewRegistry[T any](subsystem string) *Registry[T] {
+	return &Registry[T]{
+		subsystem: subsystem,
+		handlers:  make(map[uint32]T),
+	}
+}
+
+// Register registers the handlers of the fork of version.
+func (r *Registry[T]) Register(version uint32, handlers T) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.handlers[version]; ok {
+		return errors.Wrapf(
+			ErrForkAlreadyRegistered, "%s for %s", Name(version), r.subsystem,
+		)
+	}
+	r.handlers[version] = handlers
+	return nil
+}
+
+// MustRegister registers the handlers of the fork of version, and panics
+// if the fork is already registered.
+func (r *Registry[T]) MustRegister(version uint32, handlers T) {
+	if err := r.Register(version, handlers); err != nil {
+		panic(err)
+	}
+}
+
+// Get returns the handlers of the fork of version, or ErrForkNotSupported
+// if none are registered.
+func (r *Registry[T]) Get(version uint32) (T, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	handlers, ok := r.handlers[version]
+	if !ok {
+		return handlers, ErrForkNotSupported{
+			Version:   version,
+			Subsystem: r.subsystem,
+		}
+	}
+	return handlers, nil
+}
+
+// Versions returns the fork versions registered, in increasing order.
+func (r *Registry[T]) Versions() []uint32 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	versions := make([]uint32, 0, len(r.handlers))
+	for version := range r.handlers {
+		versions = append(versions, version)
+	}
+	slices.Sort(versions)
+	return versions
+}
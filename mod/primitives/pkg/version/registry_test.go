@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package version_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	r := version.NewRegistry[string]("test subsystem")
+	require.NoError(t, r.Register(version.Deneb, "deneb handlers"))
+	require.ErrorIs(t,
+		r.Register(version.Deneb, "other handlers"),
+		version.ErrForkAlreadyRegistered,
+	)
+	require.Panics(t, func() { r.MustRegister(version.Deneb, "other") })
+	r.MustRegister(version.Capella, "capella handlers")
+
+	handlers, err := r.Get(version.Deneb)
+	require.NoError(t, err)
+	require.Equal(t, "deneb handlers", handlers)
+	require.Equal(t,
+		[]uint32{version.Capella, version.Deneb}, r.Versions(),
+	)
+
+	_, err = r.Get(version.Electra)
+	require.ErrorIs(t, err, version.ErrForkNotSupported{})
+	var notSupported version.ErrForkNotSupported
+	require.True(t, errors.As(err, &notSupported))
+	require.Equal(t, version.ErrForkNotSupported{
+		Version:   version.Electra,
+		Subsystem: "test subsystem",
+	}, notSupported)
+	require.EqualError(t, err, "fork electra not supported by test subsystem")
+}
@@ -22,6 +22,20 @@ package version
 
 import (
 	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+var (
+	// ErrUnknownVersion is returned when a version is not one of the known
+	// fork versions.
+	ErrUnknownVersion = errors.New("unknown fork version")
+	// ErrMalformedVersion is returned when a version is neither the name of
+	// a fork nor 4 hex encoded bytes.
+	ErrMalformedVersion = errors.New("malformed fork version")
 )
 
 const (
@@ -44,3 +58,69 @@ func FromUint32[VersionT ~[4]byte](version uint32) VersionT {
 func ToUint32[VersionT ~[4]byte](version VersionT) uint32 {
 	return binary.LittleEndian.Uint32(version[:])
 }
+
+//nolint:gochecknoglobals // the names of the known fork versions.
+var names = [...]string{
+	Phase0:    "phase0",
+	Altair:    "altair",
+	Bellatrix: "bellatrix",
+	Capella:   "capella",
+	Deneb:     "deneb",
+	Electra:   "electra",
+}
+
+// IsKnown returns whether version is one of the known fork versions.
+func IsKnown(version uint32) bool {
+	return version < uint32(len(names))
+}
+
+// Name returns the name of the fork of version, e.g. "deneb", or
+// "unknown(N)" if the version is not known.
+func Name(version uint32) string {
+	if !IsKnown(version) {
+		return "unknown(" + strconv.FormatUint(uint64(version), 10) + ")"
+	}
+	return names[version]
+}
+
+// Parse returns the known fork version given either by the name of its
+// fork, e.g. "deneb", or by its 4 bytes hex encoded, e.g. "0x04000000".
+func Parse(s string) (uint32, error) {
+	if hexStr, ok := strings.CutPrefix(s, "0x"); ok {
+		var bz [4]byte
+		if len(hexStr) != 2*len(bz) {
+			return 0, errors.Wrapf(ErrMalformedVersion, "%q", s)
+		}
+		if _, err := hex.Decode(bz[:], []byte(hexStr)); err != nil {
+			return 0, errors.Wrapf(ErrMalformedVersion, "%q: %v", s, err)
+		}
+		version := FromBytes4(bz)
+		if !IsKnown(version) {
+			return 0, errors.Wrapf(ErrUnknownVersion, "%q", s)
+		}
+		return version, nil
+	}
+	for version, name := range names {
+		if strings.EqualFold(s, name) {
+			//#nosec:G115 // bounded by the number of known versions.
+			return uint32(version), nil
+		}
+	}
+	return 0, errors.Wrapf(ErrUnknownVersion, "%q", s)
+}
+
+// ToBytes4 returns the 4 bytes of version, as used in the fork data.
+func ToBytes4(version uint32) [4]byte {
+	return FromUint32[[4]byte](version)
+}
+
+// FromBytes4 returns the version of its 4 bytes, as used in the fork data.
+func FromBytes4(bz [4]byte) uint32 {
+	return ToUint32(bz)
+}
+
+// IsAtLeast returns whether the fork of version a is that of b or a later
+// one.
+func IsAtLeast(a, b uint32) bool {
+	return a >= b
+}
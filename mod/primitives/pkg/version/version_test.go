@@ -22,9 +22,11 @@ package version_test
 
 import (
 	"encoding/binary"
+	"encoding/hex"
 	"testing"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
 )
 
 func TestFromUint32(t *testing.T) {
@@ -92,3 +94,60 @@ func TestFromUint32_CustomType(t *testing.T) {
 		t.Errorf("FromUint32(%d) = %v, expected %v", input, result, expected)
 	}
 }
+
+func TestName(t *testing.T) {
+	require.Equal(t, "phase0", version.Name(version.Phase0))
+	require.Equal(t, "deneb", version.Name(version.Deneb))
+	require.Equal(t, "electra", version.Name(version.Electra))
+	require.Equal(t, "unknown(42)", version.Name(42))
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  uint32
+		err   error
+	}{
+		{input: "deneb", want: version.Deneb},
+		{input: "Electra", want: version.Electra},
+		{input: "0x04000000", want: version.Deneb},
+		{input: "0x05000000", want: version.Electra},
+		{input: "fulu", err: version.ErrUnknownVersion},
+		{input: "", err: version.ErrUnknownVersion},
+		{input: "0x2a000000", err: version.ErrUnknownVersion},
+		{input: "0x0400", err: version.ErrMalformedVersion},
+		{input: "0x04000000ff", err: version.ErrMalformedVersion},
+		{input: "0x0400000g", err: version.ErrMalformedVersion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := version.Parse(tt.input)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for v := version.Phase0; v <= version.Electra; v++ {
+		parsed, err := version.Parse(version.Name(v))
+		require.NoError(t, err)
+		require.Equal(t, v, parsed)
+
+		bz := version.ToBytes4(v)
+		require.Equal(t, v, version.FromBytes4(bz))
+		parsed, err = version.Parse("0x" + hex.EncodeToString(bz[:]))
+		require.NoError(t, err)
+		require.Equal(t, v, parsed)
+	}
+}
+
+func TestIsAtLeast(t *testing.T) {
+	require.True(t, version.IsAtLeast(version.Electra, version.Deneb))
+	require.True(t, version.IsAtLeast(version.Deneb, version.Deneb))
+	require.False(t, version.IsAtLeast(version.Deneb, version.Electra))
+}
@@ -22,38 +22,103 @@ package comet
 
 import (
 	"context"
+	"reflect"
+	"sync"
 
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
 	math "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type ChainSpec interface {
 	// GetCometBFTConfigForSlot returns the CometBFT configuration for the given
 	// slot.
 	GetCometBFTConfigForSlot(math.Slot) any
+	// ActiveForkVersionForSlot returns the active fork version for a given
+	// slot.
+	ActiveForkVersionForSlot(math.Slot) uint32
+	// ConsensusParams returns the consensus parameters of CometBFT
+	// overridden at the fork of forkVersion.
+	ConsensusParams(forkVersion uint32) chain.ConsensusParams
 }
 
-// ConsensusParamsStore is a store for consensus parameters.
+// ConsensusParamsStore is a store for consensus parameters. The parameters
+// are those of the chain spec, with the overrides of the fork active at the
+// height of the block being processed.
 type ConsensusParamsStore struct {
-	cs ChainSpec
+	cs     ChainSpec
+	logger log.Logger[any]
+
+	// mu protects last.
+	mu sync.Mutex
+	// last is the set of parameters last returned, the changes are logged
+	// against it.
+	last *cmttypes.ConsensusParams
 }
 
 // NewConsensusParamsStore creates a new ConsensusParamsStore.
-func NewConsensusParamsStore(cs ChainSpec) *ConsensusParamsStore {
+func NewConsensusParamsStore(
+	cs ChainSpec,
+	logger log.Logger[any],
+) *ConsensusParamsStore {
 	return &ConsensusParamsStore{
-		cs: cs,
+		cs:     cs,
+		logger: logger,
 	}
 }
 
 // Get retrieves the consensus parameters from the store.
 // It returns the consensus parameters and an error, if any.
 func (s *ConsensusParamsStore) Get(
-	context.Context,
+	ctx context.Context,
 ) (cmtproto.ConsensusParams, error) {
-	return s.cs.
-		GetCometBFTConfigForSlot(0).(*cmttypes.ConsensusParams).
-		ToProto(), nil
+	height := blockHeight(ctx)
+	slot := math.Slot(height)
+	forkVersion := s.cs.ActiveForkVersionForSlot(slot)
+
+	// The overrides are applied on a copy, the configuration of the chain
+	// spec is shared.
+	params := *s.cs.GetCometBFTConfigForSlot(slot).(*cmttypes.ConsensusParams)
+	overrides := s.cs.ConsensusParams(forkVersion)
+	for _, param := range []struct {
+		value    *int64
+		override *int64
+	}{
+		{&params.Block.MaxBytes, overrides.MaxBlockBytes},
+		{&params.Block.MaxGas, overrides.MaxBlockGas},
+		{&params.Evidence.MaxAgeNumBlocks, overrides.EvidenceMaxAgeNumBlocks},
+		{&params.Evidence.MaxBytes, overrides.EvidenceMaxBytes},
+	} {
+		if param.override != nil {
+			*param.value = *param.override
+		}
+	}
+	if err := params.ValidateBasic(); err != nil {
+		return cmtproto.ConsensusParams{}, errors.Wrapf(
+			err, "consensus params of fork %s", version.Name(forkVersion),
+		)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.last != nil && !reflect.DeepEqual(*s.last, params) {
+		s.logger.Info(
+			"consensus params changed 🔀",
+			"height", height,
+			"fork", version.Name(forkVersion),
+			"max_block_bytes", params.Block.MaxBytes,
+			"max_block_gas", params.Block.MaxGas,
+			"evidence_max_age_num_blocks", params.Evidence.MaxAgeNumBlocks,
+			"evidence_max_bytes", params.Evidence.MaxBytes,
+		)
+	}
+	s.last = &params
+	return params.ToProto(), nil
 }
 
 // Has checks if the consensus parameters exist in the store.
@@ -71,3 +136,13 @@ func (s *ConsensusParamsStore) Set(
 ) error {
 	return nil
 }
+
+// blockHeight returns the height of the block being processed by the
+// context, 0 outside of a block.
+func blockHeight(ctx context.Context) int64 {
+	sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context)
+	if !ok {
+		return 0
+	}
+	return sdkCtx.BlockHeight()
+}
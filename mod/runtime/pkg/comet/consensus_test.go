@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package comet_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/comet"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// logLines records the lines of a log.TestLogger.
+type logLines []string
+
+func (*logLines) Helper() {}
+
+func (l *logLines) Logf(format string, args ...any) {
+	*l = append(*l, fmt.Sprintf(format, args...))
+}
+
+func TestConsensusParamsStore_Get(t *testing.T) {
+	const electraEpoch, slotsPerEpoch = 10, 4
+	maxBlockBytes := int64(1 << 21)
+	base := cmttypes.DefaultConsensusParams()
+	cs := chain.NewChainSpec(chain.SpecData[
+		[4]byte, math.Epoch, [20]byte, math.Slot, any,
+	]{
+		SlotsPerEpoch: slotsPerEpoch,
+		ForkSchedule: chain.ForkSchedule[math.Epoch]{
+			{Name: "deneb", Version: version.Deneb, Epoch: 0},
+			{
+				Name:    "electra",
+				Version: version.Electra,
+				Epoch:   electraEpoch,
+				Params: chain.ForkParams{
+					Consensus: chain.ConsensusParams{
+						MaxBlockBytes: &maxBlockBytes,
+					},
+				},
+			},
+		},
+		CometValues: base,
+	})
+	var lines logLines
+	store := comet.NewConsensusParamsStore(cs, log.NewTestLogger(&lines))
+
+	forkHeight := int64(electraEpoch * slotsPerEpoch)
+	for _, tt := range []struct {
+		height        int64
+		maxBlockBytes int64
+		changed       bool
+	}{
+		{forkHeight - 2, base.Block.MaxBytes, false},
+		{forkHeight - 1, base.Block.MaxBytes, false},
+		{forkHeight, maxBlockBytes, true},
+		{forkHeight + 1, maxBlockBytes, false},
+	} {
+		lines = nil
+		sdkCtx := sdk.Context{}.WithBlockHeight(tt.height)
+		params, err := store.Get(
+			context.WithValue(context.Background(), sdk.SdkContextKey, sdkCtx),
+		)
+		require.NoError(t, err)
+		require.Equal(t, tt.maxBlockBytes, params.GetBlock().GetMaxBytes())
+		require.Equal(t, base.Block.MaxGas, params.GetBlock().GetMaxGas())
+
+		// The change is logged at the boundary only.
+		if tt.changed {
+			require.Len(t, lines, 1)
+			require.Contains(t, lines[0], "consensus params changed")
+			require.Contains(t, lines[0], "fork=electra")
+		} else {
+			require.Empty(t, lines)
+		}
+	}
+
+	// The configuration of the chain spec is left untouched.
+	require.Equal(t, cmttypes.DefaultConsensusParams(), base)
+}
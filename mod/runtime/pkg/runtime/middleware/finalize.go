@@ -22,7 +22,6 @@ package middleware
 
 import (
 	"context"
-	"encoding/json"
 	"sort"
 	"time"
 
@@ -94,7 +93,7 @@ func (h *FinalizeBlockMiddleware[
 	data := new(
 		genesis.Genesis[*types.Deposit, *types.ExecutionPayloadHeaderDeneb],
 	)
-	if err := json.Unmarshal(bz, data); err != nil {
+	if err := data.UnmarshalJSONStrict(bz); err != nil {
 		return nil, err
 	}
 	updates, err := h.chainService.ProcessGenesisData(
@@ -134,13 +133,13 @@ func (h *FinalizeBlockMiddleware[
 	}
 
 	// Process the state transition and produce the required delta from
-	// the sync committee.
-	h.valUpdates, err = h.chainService.ProcessBlockAndBlobs(
-		ctx, blk, blobs,
-		// TODO: Speak with @melekes about this, doesn't seem to
-		// work reliably.
-		/*req.SyncingToHeight == req.Height*/
-	)
+	// the sync committee. While CometBFT catches up with a later height,
+	// the block is already finalized and is replayed.
+	process := h.chainService.ProcessBlockAndBlobs
+	if req.SyncingToHeight > req.Height {
+		process = h.chainService.ReplayBlockAndBlobs
+	}
+	h.valUpdates, err = process(ctx, blk, blobs)
 	return err
 }
 
@@ -53,6 +53,14 @@ type BlockchainService[
 		BeaconBlockT,
 		BlobSidecarsT,
 	) ([]*transition.ValidatorUpdate, error)
+	// ReplayBlockAndBlobs processes the given beacon block, already
+	// finalized, and associated blobs sidecars without verifying its
+	// signatures nor sending its payload to the execution client.
+	ReplayBlockAndBlobs(
+		context.Context,
+		BeaconBlockT,
+		BlobSidecarsT,
+	) ([]*transition.ValidatorUpdate, error)
 
 	// ReceiveBlockAndBlobs receives a beacon block and
 	// associated blobs sidecars for processing.
@@ -203,6 +203,17 @@ func (r *BeaconKitRuntime[
 	return r.services.StartAll(ctx)
 }
 
+// StopServices stops the services, waiting for them to exit until ctx is
+// done.
+func (r *BeaconKitRuntime[
+	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
+	BlobSidecarsT, DepositStoreT, StorageBackendT,
+]) StopServices(
+	ctx context.Context,
+) error {
+	return r.services.StopAll(ctx)
+}
+
 // ABCIHandler returns the ABCI handler.
 func (r *BeaconKitRuntime[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
@@ -24,6 +24,10 @@ package service
 import "github.com/berachain/beacon-kit/mod/errors"
 
 var (
+	// ErrStopTimeout is returned when a service has not stopped before the
+	// stop timeout of the registry elapsed.
+	ErrStopTimeout = errors.New("service did not stop in time")
+
 	// errServiceAlreadyExists defines an error for when a service already
 	// exists.
 	errServiceAlreadyExists = func(serviceName string) error {
@@ -20,7 +20,11 @@
 
 package service
 
-import "github.com/berachain/beacon-kit/mod/log"
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log"
+)
 
 // RegistryOption is a functional option for the Registry.
 type RegistryOption func(*Registry) error
@@ -33,6 +37,24 @@ func WithLogger(logger log.Logger[any]) RegistryOption {
 	}
 }
 
+// WithStopTimeout is an option to set the time StopAll waits for the
+// services to stop.
+func WithStopTimeout(timeout time.Duration) RegistryOption {
+	return func(r *Registry) error {
+		r.stopTimeout = timeout
+		return nil
+	}
+}
+
+// WithStopHook is an option to add a hook StopAll runs once the services
+// are stopped, to release what they shared.
+func WithStopHook(hook func()) RegistryOption {
+	return func(r *Registry) error {
+		r.stopHooks = append(r.stopHooks, hook)
+		return nil
+	}
+}
+
 // WithService is an Option that registers a service with the Registry.
 func WithService(svc Basic) RegistryOption {
 	return func(r *Registry) error {
@@ -23,7 +23,9 @@ package service
 import (
 	"context"
 	"reflect"
+	"time"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/sourcegraph/conc"
 )
@@ -40,6 +42,13 @@ type Basic interface {
 	WaitForHealthy(ctx context.Context)
 }
 
+// Stoppable is a service that must be stopped before the node exits.
+type Stoppable interface {
+	// Stop stops the service, waiting for its goroutines to exit until ctx
+	// is done.
+	Stop(ctx context.Context) error
+}
+
 // Registry provides a useful pattern for managing services.
 // It allows for ease of dependency management and ensures services
 // dependent on others use the same references in memory.
@@ -50,6 +59,11 @@ type Registry struct {
 	services map[string]Basic
 	// serviceTypes is an ordered slice of registered service types.
 	serviceTypes []string
+	// stopTimeout is the time StopAll waits for the services to stop, no
+	// timeout is applied if it is zero.
+	stopTimeout time.Duration
+	// stopHooks are run by StopAll once the services are stopped.
+	stopHooks []func()
 }
 
 // NewRegistry starts a registry instance for convenience.
@@ -84,6 +98,66 @@ func (s *Registry) StartAll(ctx context.Context) error {
 	return nil
 }
 
+// StopAll stops each Stoppable service in the reverse order of registration,
+// so that services are stopped before the services they depend on. Every
+// service is stopped even if stopping a previous one failed, and the errors
+// are returned joined. If a stop timeout is set, StopAll stops waiting for
+// the services once it elapses, and the remaining services are asked to stop
+// without being waited for. The stop hooks are run once every service was
+// asked to stop.
+func (s *Registry) StopAll(ctx context.Context) error {
+	if s.stopTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.stopTimeout)
+		defer cancel()
+	}
+
+	s.logger.Info("stopping services", "num", len(s.serviceTypes))
+	var errs []error
+	for i := len(s.serviceTypes) - 1; i >= 0; i-- {
+		typeName := s.serviceTypes[i]
+		svc, ok := s.services[typeName].(Stoppable)
+		if !ok {
+			continue
+		}
+
+		s.logger.Info("stopping service", "type", typeName)
+		if err := s.stopService(ctx, typeName, svc); err != nil {
+			s.logger.Error(
+				"failed to stop service", "type", typeName, "error", err,
+			)
+			errs = append(errs, errors.Wrapf(err, "stop %s", typeName))
+		}
+	}
+	for _, hook := range s.stopHooks {
+		hook()
+	}
+	return errors.Join(errs...)
+}
+
+// stopService stops a service, returning ErrStopTimeout if it has not
+// stopped when ctx is done.
+func (s *Registry) stopService(
+	ctx context.Context, typeName string, svc Stoppable,
+) error {
+	start := time.Now()
+	stopped := make(chan error, 1)
+	go func() { stopped <- svc.Stop(ctx) }()
+
+	var err error
+	select {
+	case err = <-stopped:
+	case <-ctx.Done():
+		return ErrStopTimeout
+	}
+	if err == nil {
+		s.logger.Info(
+			"stopped service", "type", typeName, "duration", time.Since(start),
+		)
+	}
+	return err
+}
+
 // Statuses returns a map of Service type -> error. The map will be populated
 // with the results of each service.Status() method call.
 func (s *Registry) Statuses(services ...string) map[string]error {
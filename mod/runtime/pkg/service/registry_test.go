@@ -22,6 +22,7 @@ package service_test
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -128,3 +129,112 @@ func TestRegistry_FetchService(t *testing.T) {
 		t.Errorf("Fetched service type mismatch")
 	}
 }
+
+// stoppableService is a service that records the order in which it is
+// stopped.
+type stoppableService struct {
+	*mocks.Basic
+	stopped *[]string
+	err     error
+}
+
+func (s stoppableService) Stop(context.Context) error {
+	*s.stopped = append(*s.stopped, s.Name())
+	return s.err
+}
+
+func TestRegistry_StopAll(t *testing.T) {
+	registry := service.NewRegistry(service.WithLogger(noop.NewLogger()))
+
+	var (
+		stopped []string
+		errStop = errors.New("stop failed")
+	)
+	for _, svc := range []service.Basic{
+		stoppableService{Basic: newNamedService("Service1"), stopped: &stopped},
+		newNamedService("Service2"),
+		stoppableService{
+			Basic:   newNamedService("Service3"),
+			stopped: &stopped,
+			err:     errStop,
+		},
+	} {
+		require.NoError(t, registry.RegisterService(svc))
+	}
+
+	err := registry.StopAll(context.Background())
+	require.ErrorIs(t, err, errStop)
+	require.ErrorContains(t, err, "Service3")
+	require.Equal(t, []string{"Service3", "Service1"}, stopped)
+}
+
+func TestRegistry_StopAllHooks(t *testing.T) {
+	var stopped []string
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger()),
+		service.WithStopHook(func() { stopped = append(stopped, "hook") }),
+		service.WithService(stoppableService{
+			Basic:   newNamedService("Service1"),
+			stopped: &stopped,
+		}),
+	)
+
+	require.NoError(t, registry.StopAll(context.Background()))
+	require.Equal(t, []string{"Service1", "hook"}, stopped)
+}
+
+// blockingService is a service whose Stop blocks until its context is
+// done.
+type blockingService struct {
+	*mocks.Basic
+}
+
+func (blockingService) Stop(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// signalingService is a service that closes its channel when stopped.
+type signalingService struct {
+	*mocks.Basic
+	stopped chan struct{}
+}
+
+func (s signalingService) Stop(context.Context) error {
+	close(s.stopped)
+	return nil
+}
+
+func TestRegistry_StopAllTimeout(t *testing.T) {
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger()),
+		service.WithStopTimeout(50*time.Millisecond),
+	)
+
+	stopped := make(chan struct{})
+	for _, svc := range []service.Basic{
+		signalingService{Basic: newNamedService("Service1"), stopped: stopped},
+		blockingService{Basic: newNamedService("Service2")},
+	} {
+		require.NoError(t, registry.RegisterService(svc))
+	}
+
+	start := time.Now()
+	err := registry.StopAll(context.Background())
+	require.ErrorIs(t, err, service.ErrStopTimeout)
+	require.ErrorContains(t, err, "Service2")
+	require.Less(t, time.Since(start), time.Second)
+
+	// The services after the one that timed out are still asked to stop.
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("Service1 was not stopped")
+	}
+}
+
+func newNamedService(name string) *mocks.Basic {
+	svc := &mocks.Basic{}
+	svc.On("Name").Return(name)
+	return svc
+}
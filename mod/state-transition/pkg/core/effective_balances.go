@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// EffectiveBalanceValidator is a validator whose effective balance follows
+// its balance.
+type EffectiveBalanceValidator interface {
+	GetEffectiveBalance() math.Gwei
+	SetEffectiveBalance(balance math.Gwei)
+}
+
+// EffectiveBalanceState is the part of the beacon state the effective
+// balances are updated in.
+type EffectiveBalanceState[ValidatorT EffectiveBalanceValidator] interface {
+	IterateValidators(
+		fn func(index math.ValidatorIndex, val ValidatorT) (bool, error),
+	) error
+	GetBalance(index math.ValidatorIndex) (math.Gwei, error)
+	UpdateValidatorAtIndex(index math.ValidatorIndex, val ValidatorT) error
+}
+
+// ProcessEffectiveBalanceUpdates as defined in the Ethereum 2.0
+// specification. The effective balance of a validator is only updated once
+// its balance falls below it by the downward threshold, or rises above it by
+// the upward threshold, so that it does not change every epoch with the
+// rewards of the validator. It is then set to the balance rounded down to the
+// effective balance increment, up to the maximum effective balance.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#effective-balances-updates
+//
+//nolint:lll
+func ProcessEffectiveBalanceUpdates[ValidatorT EffectiveBalanceValidator](
+	st EffectiveBalanceState[ValidatorT],
+	cs primitives.ChainSpec,
+) error {
+	var (
+		increment           = math.Gwei(cs.EffectiveBalanceIncrement())
+		maxEffectiveBalance = math.Gwei(cs.MaxEffectiveBalance())
+		hysteresisIncrement = increment / math.Gwei(cs.HysteresisQuotient())
+	)
+	downwardThreshold, err := hysteresisIncrement.CheckedMul(
+		math.Gwei(cs.HysteresisDownwardMultiplier()),
+	)
+	if err != nil {
+		return err
+	}
+	upwardThreshold, err := hysteresisIncrement.CheckedMul(
+		math.Gwei(cs.HysteresisUpwardMultiplier()),
+	)
+	if err != nil {
+		return err
+	}
+
+	// The validators are updated in order of index once iterated over, as
+	// the store is not written to while it is iterated.
+	var (
+		indices []math.ValidatorIndex
+		updated []ValidatorT
+	)
+	if err = st.IterateValidators(func(
+		index math.ValidatorIndex, val ValidatorT,
+	) (bool, error) {
+		balance, bErr := st.GetBalance(index)
+		if bErr != nil {
+			return true, bErr
+		}
+		effectiveBalance := val.GetEffectiveBalance()
+
+		lower, bErr := balance.CheckedAdd(downwardThreshold)
+		if bErr != nil {
+			return true, bErr
+		}
+		upper, bErr := effectiveBalance.CheckedAdd(upwardThreshold)
+		if bErr != nil {
+			return true, bErr
+		}
+		if lower >= effectiveBalance && upper >= balance {
+			return false, nil
+		}
+
+		// The validators whose effective balance is unchanged, such as those
+		// capped at the maximum, are not written.
+		newEffectiveBalance := min(
+			balance-balance%increment, maxEffectiveBalance,
+		)
+		if newEffectiveBalance != effectiveBalance {
+			val.SetEffectiveBalance(newEffectiveBalance)
+			indices = append(indices, index)
+			updated = append(updated, val)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for i, index := range indices {
+		if err = st.UpdateValidatorAtIndex(index, updated[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
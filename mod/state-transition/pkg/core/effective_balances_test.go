@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/stretchr/testify/require"
+)
+
+// effectiveBalanceState is the state the effective balances are updated in.
+type effectiveBalanceState struct {
+	registryState
+	balances []math.Gwei
+}
+
+func (s *effectiveBalanceState) GetBalance(
+	index math.ValidatorIndex,
+) (math.Gwei, error) {
+	return s.balances[index], nil
+}
+
+// effectiveBalanceSpec has the hysteresis parameters of the mainnet, the
+// downward and upward thresholds being 0.25 and 1.25 ETH.
+func effectiveBalanceSpec() chain.Spec[
+	common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+] {
+	return chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+	]{
+		MaxEffectiveBalance:          uint64(maxEffectiveBalance),
+		EffectiveBalanceIncrement:    1e9,
+		HysteresisQuotient:           4,
+		HysteresisDownwardMultiplier: 1,
+		HysteresisUpwardMultiplier:   5,
+	})
+}
+
+func TestProcessEffectiveBalanceUpdates(t *testing.T) {
+	tests := []struct {
+		name             string
+		effectiveBalance math.Gwei
+		balance          math.Gwei
+		expected         math.Gwei
+	}{
+		{
+			name:             "unchanged",
+			effectiveBalance: 31e9,
+			balance:          31e9,
+			expected:         31e9,
+		},
+		{
+			name:             "at downward threshold",
+			effectiveBalance: 32e9,
+			balance:          31.75e9,
+			expected:         32e9,
+		},
+		{
+			name:             "below downward threshold",
+			effectiveBalance: 32e9,
+			balance:          31.75e9 - 1,
+			expected:         31e9,
+		},
+		{
+			name:             "at upward threshold",
+			effectiveBalance: 30e9,
+			balance:          31.25e9,
+			expected:         30e9,
+		},
+		{
+			name:             "above upward threshold",
+			effectiveBalance: 30e9,
+			balance:          31.25e9 + 1,
+			expected:         31e9,
+		},
+		{
+			name:             "rounded down to increment",
+			effectiveBalance: 20e9,
+			balance:          25.9e9,
+			expected:         25e9,
+		},
+		{
+			name:             "capped at max effective balance",
+			effectiveBalance: 30e9,
+			balance:          40e9,
+			expected:         maxEffectiveBalance,
+		},
+		{
+			name:             "at max effective balance",
+			effectiveBalance: maxEffectiveBalance,
+			balance:          40e9,
+			expected:         maxEffectiveBalance,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := &effectiveBalanceState{
+				registryState: registryState{validators: []*types.Validator{
+					activeValidator(tt.effectiveBalance),
+				}},
+				balances: []math.Gwei{tt.balance},
+			}
+			err := core.ProcessEffectiveBalanceUpdates[*types.Validator](
+				st, effectiveBalanceSpec(),
+			)
+			require.NoError(t, err)
+			require.Equal(
+				t, tt.expected, st.validators[0].GetEffectiveBalance(),
+			)
+
+			// Only the validators whose effective balance changed are
+			// written.
+			if tt.expected == tt.effectiveBalance {
+				require.Empty(t, st.writes)
+			} else {
+				require.Equal(t, []math.ValidatorIndex{0}, st.writes)
+			}
+		})
+	}
+}
@@ -51,6 +51,30 @@ var (
 	// deposit limit.
 	ErrExceedsBlockDepositLimit = errors.New("block exceeds deposit limit")
 
+	// ErrExceedsBlockProposerSlashingLimit is returned when the block exceeds
+	// the proposer slashing limit.
+	ErrExceedsBlockProposerSlashingLimit = errors.New(
+		"block exceeds proposer slashing limit")
+
+	// ErrProposerSlashingSlotMismatch is returned when the headers of a
+	// proposer slashing are of different slots.
+	ErrProposerSlashingSlotMismatch = errors.New(
+		"proposer slashing headers slot mismatch")
+
+	// ErrProposerSlashingProposerMismatch is returned when the headers of a
+	// proposer slashing are of different proposers.
+	ErrProposerSlashingProposerMismatch = errors.New(
+		"proposer slashing headers proposer mismatch")
+
+	// ErrProposerSlashingSameHeaders is returned when the headers of a
+	// proposer slashing are the same.
+	ErrProposerSlashingSameHeaders = errors.New(
+		"proposer slashing headers are the same")
+
+	// ErrValidatorNotSlashable is returned when a validator that is not
+	// slashable is slashed.
+	ErrValidatorNotSlashable = errors.New("validator is not slashable")
+
 	// ErrRewardsLengthMismatch is returned when the length of the rewards
 	// in a block does not match the expected value.
 	ErrRewardsLengthMismatch = errors.New("rewards length mismatch")
@@ -77,4 +101,16 @@ var (
 
 	// ErrXorInvalid is returned when the XOR operation is invalid.
 	ErrXorInvalid = errors.New("xor invalid")
+
+	// ErrNoActiveValidators is returned when a proposer is computed for an
+	// epoch without active validators.
+	ErrNoActiveValidators = errors.New("no active validators")
+
+	// ErrShuffleIndexOutOfRange is returned when an index is shuffled in a
+	// list it is not part of.
+	ErrShuffleIndexOutOfRange = errors.New("shuffle index out of range")
+
+	// ErrUnknownGenesisVersion is returned when the genesis is not of a
+	// known fork version.
+	ErrUnknownGenesisVersion = errors.New("unknown genesis fork version")
 )
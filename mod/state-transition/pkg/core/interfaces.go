@@ -43,6 +43,8 @@ type BeaconState[
 	Save()
 	Context() context.Context
 	HashTreeRoot() ([32]byte, error)
+	FieldRoots() ([]StateFieldRoot, error)
+	GetFork() (ForkT, error)
 	ReadOnlyBeaconState[
 		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
 		ValidatorT, WithdrawalT,
@@ -68,9 +70,15 @@ type ReadOnlyBeaconState[
 	GetSlot() (math.Slot, error)
 	GetGenesisValidatorsRoot() (primitives.Root, error)
 	GetBlockRootAtIndex(uint64) (primitives.Root, error)
+	GetBlockRootAtSlot(slot, currentSlot math.Slot) (primitives.Root, error)
 	GetLatestBlockHeader() (BeaconBlockHeaderT, error)
 	GetTotalActiveBalances(uint64) (math.Gwei, error)
 	GetValidators() ([]ValidatorT, error)
+	IterateValidators(
+		fn func(index math.ValidatorIndex, val ValidatorT) (bool, error),
+	) error
+	GetActiveValidatorCount(epoch math.Epoch) (uint64, error)
+	GetSlashingAtIndex(uint64) (math.Gwei, error)
 	GetTotalSlashing() (math.Gwei, error)
 	GetNextWithdrawalIndex() (uint64, error)
 	GetNextWithdrawalValidatorIndex() (math.ValidatorIndex, error)
@@ -143,6 +151,7 @@ type WriteOnlyRandaoMixes interface {
 // mixes methods.
 type ReadOnlyRandaoMixes interface {
 	GetRandaoMixAtIndex(uint64) (primitives.Bytes32, error)
+	GetRandaoMixAtEpoch(math.Epoch) (primitives.Bytes32, error)
 }
 
 // WriteOnlyValidators has write access to validator methods.
@@ -0,0 +1,242 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// shuffleRoundCount is the number of rounds of the swap-or-not shuffle.
+	shuffleRoundCount = 90
+	// minSeedLookahead is the number of epochs a seed is known ahead of the
+	// epoch it is used in.
+	minSeedLookahead = 1
+	// maxRandomByte is the largest value of a random byte.
+	maxRandomByte = 1<<8 - 1
+)
+
+// ProposerValidator is a validator proposers are sampled from.
+type ProposerValidator interface {
+	IsActive(epoch math.Epoch) bool
+	GetEffectiveBalance() math.Gwei
+}
+
+// ProposerState is the part of the beacon state proposers are computed
+// from.
+type ProposerState[ValidatorT ProposerValidator] interface {
+	GetRandaoMixAtIndex(index uint64) (primitives.Bytes32, error)
+	IterateValidators(
+		fn func(index math.ValidatorIndex, val ValidatorT) (bool, error),
+	) error
+}
+
+// GetSeed as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_seed
+//
+//nolint:lll
+func GetSeed[ValidatorT ProposerValidator](
+	st ProposerState[ValidatorT],
+	cs primitives.ChainSpec,
+	epoch math.Epoch,
+	domainType common.DomainType,
+) (primitives.Bytes32, error) {
+	epochs := cs.EpochsPerHistoricalVector()
+	mix, err := st.GetRandaoMixAtIndex(
+		(uint64(epoch) + epochs - minSeedLookahead - 1) % epochs,
+	)
+	if err != nil {
+		return primitives.Bytes32{}, err
+	}
+	buf := make([]byte, 0, len(domainType)+8+len(mix))
+	buf = append(buf, domainType[:]...)
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(epoch))
+	buf = append(buf, mix[:]...)
+	return sha256.Sum256(buf), nil
+}
+
+// ComputeShuffledIndex as defined in the Ethereum 2.0 specification, the
+// position of index in a list of indexCount elements shuffled with seed.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_shuffled_index
+//
+//nolint:lll
+func ComputeShuffledIndex(
+	index, indexCount uint64,
+	seed primitives.Bytes32,
+) (uint64, error) {
+	if index >= indexCount {
+		return 0, errors.Wrapf(
+			ErrShuffleIndexOutOfRange, "index %d, count %d", index, indexCount,
+		)
+	}
+	// The seed is followed by the round, and the position for the source.
+	buf := make([]byte, len(seed)+1+4)
+	copy(buf, seed[:])
+	for round := range shuffleRoundCount {
+		buf[len(seed)] = byte(round)
+		pivotHash := sha256.Sum256(buf[:len(seed)+1])
+		pivot := binary.LittleEndian.Uint64(pivotHash[:8]) % indexCount
+		flip := (pivot + indexCount - index) % indexCount
+		position := max(index, flip)
+		//#nosec:G115 // the position is below indexCount.
+		binary.LittleEndian.PutUint32(buf[len(seed)+1:], uint32(position/256))
+		source := sha256.Sum256(buf)
+		if (source[(position%256)/8]>>(position%8))&1 == 1 {
+			index = flip
+		}
+	}
+	return index, nil
+}
+
+// ComputeProposerIndex as defined in the Ethereum 2.0 specification, the
+// validator of indices selected with seed, sampled by effective balance.
+// The effective balances are those of the validators of indices.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_proposer_index
+//
+//nolint:lll
+func ComputeProposerIndex(
+	cs primitives.ChainSpec,
+	indices []math.ValidatorIndex,
+	effectiveBalances []math.Gwei,
+	seed primitives.Bytes32,
+) (math.ValidatorIndex, error) {
+	total := uint64(len(indices))
+	// A validator without balance is never selected.
+	if !slices.ContainsFunc(effectiveBalances, func(b math.Gwei) bool {
+		return b > 0
+	}) {
+		return 0, ErrNoActiveValidators
+	}
+	buf := make([]byte, len(seed)+8)
+	copy(buf, seed[:])
+	for i := uint64(0); ; i++ {
+		shuffled, err := ComputeShuffledIndex(i%total, total, seed)
+		if err != nil {
+			return 0, err
+		}
+		binary.LittleEndian.PutUint64(buf[len(seed):], i/32)
+		randomByte := sha256.Sum256(buf)[i%32]
+		if uint64(effectiveBalances[shuffled])*maxRandomByte >=
+			cs.MaxEffectiveBalance()*uint64(randomByte) {
+			return indices[shuffled], nil
+		}
+	}
+}
+
+// GetBeaconProposerIndex as defined in the Ethereum 2.0 specification, the
+// proposer of slot, sampled by effective balance from the validators active
+// at its epoch with a seed of the randao mix and the slot. The state must be
+// at the epoch of slot, for the effective balances and the randao mixes to be
+// those of the epoch.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_beacon_proposer_index
+//
+//nolint:lll
+func GetBeaconProposerIndex[ValidatorT ProposerValidator](
+	st ProposerState[ValidatorT],
+	cs primitives.ChainSpec,
+	slot math.Slot,
+) (math.ValidatorIndex, error) {
+	epoch := cs.SlotToEpoch(slot)
+	indices, effectiveBalances, err := activeValidators(st, epoch)
+	if err != nil {
+		return 0, err
+	}
+	epochSeed, err := GetSeed(st, cs, epoch, cs.DomainTypeProposer())
+	if err != nil {
+		return 0, err
+	}
+	return ComputeProposerIndex(
+		cs, indices, effectiveBalances, proposerSeed(epochSeed, slot),
+	)
+}
+
+// GetBeaconProposerIndices returns the proposers of the slots of epoch, as
+// GetBeaconProposerIndex at each slot, with the active validators and the
+// seed of the epoch computed once. The state must be at epoch.
+func GetBeaconProposerIndices[ValidatorT ProposerValidator](
+	st ProposerState[ValidatorT],
+	cs primitives.ChainSpec,
+	epoch math.Epoch,
+) ([]math.ValidatorIndex, error) {
+	indices, effectiveBalances, err := activeValidators(st, epoch)
+	if err != nil {
+		return nil, err
+	}
+	epochSeed, err := GetSeed(st, cs, epoch, cs.DomainTypeProposer())
+	if err != nil {
+		return nil, err
+	}
+	proposers := make([]math.ValidatorIndex, cs.SlotsPerEpoch())
+	for i := range proposers {
+		slot := math.Slot(uint64(epoch)*cs.SlotsPerEpoch() + uint64(i))
+		if proposers[i], err = ComputeProposerIndex(
+			cs, indices, effectiveBalances, proposerSeed(epochSeed, slot),
+		); err != nil {
+			return nil, err
+		}
+	}
+	return proposers, nil
+}
+
+// activeValidators returns the indices and the effective balances of the
+// validators active at epoch.
+func activeValidators[ValidatorT ProposerValidator](
+	st ProposerState[ValidatorT],
+	epoch math.Epoch,
+) ([]math.ValidatorIndex, []math.Gwei, error) {
+	var (
+		indices           []math.ValidatorIndex
+		effectiveBalances []math.Gwei
+	)
+	if err := st.IterateValidators(
+		func(index math.ValidatorIndex, val ValidatorT) (bool, error) {
+			if val.IsActive(epoch) {
+				indices = append(indices, index)
+				effectiveBalances = append(
+					effectiveBalances, val.GetEffectiveBalance(),
+				)
+			}
+			return false, nil
+		},
+	); err != nil {
+		return nil, nil, err
+	}
+	return indices, effectiveBalances, nil
+}
+
+// proposerSeed returns the seed the proposer of slot is sampled with, the
+// hash of the seed of its epoch and the slot.
+func proposerSeed(
+	epochSeed primitives.Bytes32,
+	slot math.Slot,
+) primitives.Bytes32 {
+	buf := make([]byte, 0, len(epochSeed)+8)
+	buf = append(buf, epochSeed[:]...)
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(slot))
+	return sha256.Sum256(buf)
+}
@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/stretchr/testify/require"
+)
+
+// proposerState is the state the proposers are sampled from.
+type proposerState struct {
+	registryState
+	mixes [randaoEpochs]primitives.Bytes32
+}
+
+func (s *proposerState) GetRandaoMixAtIndex(
+	index uint64,
+) (primitives.Bytes32, error) {
+	return s.mixes[index], nil
+}
+
+func proposerSpec() chain.Spec[
+	common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+] {
+	return chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+	]{
+		MaxEffectiveBalance:       uint64(maxEffectiveBalance),
+		SlotsPerEpoch:             32,
+		EpochsPerHistoricalVector: randaoEpochs,
+		DomainTypeProposer:        common.DomainType{0x00},
+	})
+}
+
+// newProposerState returns a state of validators of different effective
+// balances and statuses, with the randao mixes derived from seed.
+func newProposerState(seed uint64) *proposerState {
+	st := &proposerState{registryState: registryState{
+		validators: []*types.Validator{
+			activeValidator(maxEffectiveBalance),
+			pendingValidator(registryEpoch - 1),
+			activeValidator(maxEffectiveBalance / 2),
+			activeValidator(0),
+			{
+				EffectiveBalance:  maxEffectiveBalance,
+				ExitEpoch:         registryEpoch,
+				WithdrawableEpoch: farFutureEpoch,
+			},
+			activeValidator(maxEffectiveBalance),
+			activeValidator(1e9),
+		},
+	}}
+	for i := range st.mixes {
+		st.mixes[i] = sha256.Sum256(
+			binary.LittleEndian.AppendUint64([]byte{byte(i)}, seed),
+		)
+	}
+	return st
+}
+
+func TestComputeShuffledIndex(t *testing.T) {
+	seed := primitives.Bytes32{0x01}
+	shuffled := make([]uint64, 10)
+	for i := range shuffled {
+		var err error
+		shuffled[i], err = core.ComputeShuffledIndex(uint64(i), 10, seed)
+		require.NoError(t, err)
+	}
+	require.Equal(t, []uint64{0, 3, 6, 9, 1, 5, 4, 7, 8, 2}, shuffled)
+
+	_, err := core.ComputeShuffledIndex(10, 10, seed)
+	require.ErrorIs(t, err, core.ErrShuffleIndexOutOfRange)
+}
+
+func TestGetBeaconProposerIndex(t *testing.T) {
+	st := newProposerState(0)
+	startSlot := math.Slot(uint64(registryEpoch) * 32)
+	proposers := make([]math.ValidatorIndex, 8)
+	for i := range proposers {
+		var err error
+		proposers[i], err = core.GetBeaconProposerIndex(
+			st, proposerSpec(), startSlot+math.Slot(i),
+		)
+		require.NoError(t, err)
+	}
+	// The validators of the maximum effective balance are the most likely
+	// proposers.
+	require.Equal(t, []math.ValidatorIndex{5, 0, 0, 5, 0, 0, 0, 5}, proposers)
+}
+
+func TestGetBeaconProposerIndex_Active(t *testing.T) {
+	cs := proposerSpec()
+	for seed := range uint64(64) {
+		st := newProposerState(seed)
+		epoch := registryEpoch + math.Epoch(seed%4)
+		proposers, err := core.GetBeaconProposerIndices(st, cs, epoch)
+		require.NoError(t, err)
+		for i, proposer := range proposers {
+			slot := math.Slot(uint64(epoch)*32 + uint64(i))
+			index, err := core.GetBeaconProposerIndex(st, cs, slot)
+			require.NoError(t, err)
+			require.Equal(t, proposer, index)
+
+			require.True(
+				t, st.validators[proposer].IsActive(epoch),
+				"seed %d slot %d", seed, slot,
+			)
+		}
+	}
+}
+
+func TestGetBeaconProposerIndex_NoActiveValidators(t *testing.T) {
+	st := newProposerState(0)
+	st.validators = []*types.Validator{
+		pendingValidator(registryEpoch), activeValidator(0),
+	}
+	_, err := core.GetBeaconProposerIndex(
+		st, proposerSpec(), math.Slot(uint64(registryEpoch)*32),
+	)
+	require.ErrorIs(t, err, core.ErrNoActiveValidators)
+}
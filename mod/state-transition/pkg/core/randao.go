@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"crypto/sha256"
+
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/go-faster/xor"
+)
+
+// RandaoValidator is the proposer of a block, revealing its randao.
+type RandaoValidator interface {
+	GetPubkey() crypto.BLSPubkey
+}
+
+// RandaoState is the part of the beacon state the randao reveals of the
+// blocks are mixed in.
+type RandaoState[ValidatorT RandaoValidator] interface {
+	GetSlot() (math.Slot, error)
+	GetGenesisValidatorsRoot() (primitives.Root, error)
+	ValidatorByIndex(index math.ValidatorIndex) (ValidatorT, error)
+	GetRandaoMixAtIndex(index uint64) (primitives.Bytes32, error)
+	UpdateRandaoMixAtIndex(index uint64, mix primitives.Bytes32) error
+}
+
+// ProcessRandao as defined in the Ethereum 2.0 specification. The randao
+// reveal of a block, the signature of the epoch by its proposer, is checked
+// with signatureVerificationFn and its hash mixed into the randao mix of the
+// epoch.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#randao
+//
+//nolint:lll
+func ProcessRandao[
+	ForkDataT ForkData[ForkDataT],
+	ValidatorT RandaoValidator,
+](
+	st RandaoState[ValidatorT],
+	cs primitives.ChainSpec,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+	proposerIndex math.ValidatorIndex,
+	reveal crypto.BLSSignature,
+) error {
+	if err := VerifyRandaoReveal[ForkDataT](
+		st, cs, signatureVerificationFn, proposerIndex, reveal,
+	); err != nil {
+		return err
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	index := uint64(cs.SlotToEpoch(slot)) % cs.EpochsPerHistoricalVector()
+	mix, err := st.GetRandaoMixAtIndex(index)
+	if err != nil {
+		return err
+	}
+	if mix, err = MixRandaoReveal(mix, reveal); err != nil {
+		return err
+	}
+	return st.UpdateRandaoMixAtIndex(index, mix)
+}
+
+// VerifyRandaoReveal checks the randao reveal of a block with
+// signatureVerificationFn. It is the signature of the epoch of the state by
+// the proposer of the block, in the randao domain of the fork of the epoch.
+func VerifyRandaoReveal[
+	ForkDataT ForkData[ForkDataT],
+	ValidatorT RandaoValidator,
+](
+	st RandaoState[ValidatorT],
+	cs primitives.ChainSpec,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+	proposerIndex math.ValidatorIndex,
+	reveal crypto.BLSSignature,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	proposer, err := st.ValidatorByIndex(proposerIndex)
+	if err != nil {
+		return err
+	}
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+
+	epoch := cs.SlotToEpoch(slot)
+	var fd ForkDataT
+	signingRoot, err := fd.New(
+		version.FromUint32[primitives.Version](
+			cs.ActiveForkVersionForEpoch(epoch),
+		), genesisValidatorsRoot,
+	).ComputeRandaoSigningRoot(cs.DomainTypeRandao(), epoch)
+	if err != nil {
+		return err
+	}
+	return signatureVerificationFn(
+		proposer.GetPubkey(), signingRoot[:], reveal,
+	)
+}
+
+// MixRandaoReveal returns the randao mix with the hash of the reveal mixed
+// in.
+func MixRandaoReveal(
+	mix primitives.Bytes32,
+	reveal crypto.BLSSignature,
+) (primitives.Bytes32, error) {
+	newMix := make([]byte, constants.RootLength)
+	revealHash := sha256.Sum256(reveal[:])
+	if numXor := xor.Bytes(
+		newMix, mix[:], revealHash[:],
+	); numXor != constants.RootLength {
+		return primitives.Bytes32{}, ErrXorInvalid
+	}
+	return primitives.Bytes32(newMix), nil
+}
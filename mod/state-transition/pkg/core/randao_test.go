@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/stretchr/testify/require"
+)
+
+// randaoEpochs is the length of the randao mixes vector.
+const randaoEpochs = 4
+
+// randaoState is the state the randao reveals are mixed in.
+type randaoState struct {
+	*slashingState
+	mixes [randaoEpochs]primitives.Bytes32
+}
+
+func (s *randaoState) GetRandaoMixAtIndex(
+	index uint64,
+) (primitives.Bytes32, error) {
+	return s.mixes[index], nil
+}
+
+func (s *randaoState) UpdateRandaoMixAtIndex(
+	index uint64,
+	mix primitives.Bytes32,
+) error {
+	s.mixes[index] = mix
+	return nil
+}
+
+func randaoSpec() chain.Spec[
+	common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+] {
+	return chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+	]{
+		SlotsPerEpoch:             32,
+		EpochsPerHistoricalVector: randaoEpochs,
+		DomainTypeRandao:          common.DomainType{0x02},
+		ElectraForkEpoch:          math.Epoch(^uint64(0)),
+	})
+}
+
+// signRandao returns the randao reveal of the epoch signed by signer.
+func signRandao(
+	t *testing.T,
+	signer testSigner,
+	epoch math.Epoch,
+) crypto.BLSSignature {
+	t.Helper()
+	cs := randaoSpec()
+	signingRoot, err := types.NewForkData(
+		version.FromUint32[common.Version](
+			cs.ActiveForkVersionForEpoch(epoch),
+		),
+		genesisValidatorsRoot,
+	).ComputeRandaoSigningRoot(cs.DomainTypeRandao(), epoch)
+	require.NoError(t, err)
+	reveal, err := signer.Sign(signingRoot[:])
+	require.NoError(t, err)
+	return reveal
+}
+
+func TestProcessRandao(t *testing.T) {
+	signer := testSigner{sk: big.NewInt(42)}
+	st := &randaoState{slashingState: newSlashingState(t, signer)}
+	initial := primitives.Bytes32{0x01}
+	st.mixes[registryEpoch%randaoEpochs] = initial
+
+	// The reveal of the proposer, the validator of index 1, is mixed into
+	// the mix of the epoch of the state, at index 10 % 4.
+	reveal := signRandao(t, signer, registryEpoch)
+	require.NoError(t, core.ProcessRandao[*types.ForkData](
+		st, randaoSpec(), signer.VerifySignature, 1, reveal,
+	))
+	require.Equal(
+		t,
+		"54d3c8a99f1286f59e2683ee535d2adc158a5e9d94b771b05facc2cd66571156",
+		hex.EncodeToString(st.mixes[2][:]),
+	)
+	for _, index := range []int{0, 1, 3} {
+		require.Equal(t, primitives.Bytes32{}, st.mixes[index])
+	}
+
+	// The hash of the reveal is XORed into the mix, so mixing it again
+	// restores the initial mix.
+	require.NoError(t, core.ProcessRandao[*types.ForkData](
+		st, randaoSpec(), signer.VerifySignature, 1, reveal,
+	))
+	require.Equal(t, initial, st.mixes[2])
+}
+
+func TestProcessRandao_Invalid(t *testing.T) {
+	signer := testSigner{sk: big.NewInt(42)}
+	other := testSigner{sk: big.NewInt(43)}
+	otherPubkey, err := other.PublicKey()
+	require.NoError(t, err)
+	tests := []struct {
+		name     string
+		proposer math.ValidatorIndex
+		reveal   crypto.BLSSignature
+	}{
+		{
+			name:     "other proposer",
+			proposer: 0,
+			reveal:   signRandao(t, signer, registryEpoch),
+		},
+		{
+			name:     "other epoch",
+			proposer: 1,
+			reveal:   signRandao(t, signer, registryEpoch+1),
+		},
+		{
+			name:     "other signer",
+			proposer: 1,
+			reveal:   signRandao(t, other, registryEpoch),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := &randaoState{slashingState: newSlashingState(t, signer)}
+			st.validators[0].Pubkey = otherPubkey
+			require.ErrorIs(t, core.ProcessRandao[*types.ForkData](
+				st, randaoSpec(), signer.VerifySignature,
+				tt.proposer, tt.reveal,
+			), crypto.ErrInvalidSignature)
+			require.Equal(t, [randaoEpochs]primitives.Bytes32{}, st.mixes)
+		})
+	}
+}
@@ -0,0 +1,267 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// RegistryValidator is a validator of the registry that is activated and
+// exited.
+type RegistryValidator interface {
+	IsActive(epoch math.Epoch) bool
+	IsEligibleForActivationQueue(maxEffectiveBalance math.Gwei) bool
+	IsEligibleForActivation(finalizedEpoch math.Epoch) bool
+	GetEffectiveBalance() math.Gwei
+	GetActivationEligibilityEpoch() math.Epoch
+	SetActivationEligibilityEpoch(epoch math.Epoch)
+	SetActivationEpoch(epoch math.Epoch)
+	GetExitEpoch() math.Epoch
+	SetExitEpoch(epoch math.Epoch)
+	SetWithdrawableEpoch(epoch math.Epoch)
+}
+
+// RegistryState is the part of the beacon state holding the registry.
+type RegistryState[ValidatorT RegistryValidator] interface {
+	GetSlot() (math.Slot, error)
+	IterateValidators(
+		fn func(index math.ValidatorIndex, val ValidatorT) (bool, error),
+	) error
+	UpdateValidatorAtIndex(index math.ValidatorIndex, val ValidatorT) error
+}
+
+// ComputeActivationExitEpoch as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_activation_exit_epoch
+//
+//nolint:lll
+func ComputeActivationExitEpoch(
+	cs primitives.ChainSpec,
+	epoch math.Epoch,
+) math.Epoch {
+	return epoch + 1 + math.Epoch(cs.MaxSeedLookahead())
+}
+
+// GetValidatorChurnLimit as defined in the Ethereum 2.0 specification, for
+// the given number of active validators.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_validator_churn_limit
+//
+//nolint:lll
+func GetValidatorChurnLimit(
+	cs primitives.ChainSpec,
+	activeValidators uint64,
+) uint64 {
+	return max(
+		cs.MinPerEpochChurnLimit(),
+		activeValidators/cs.ChurnLimitQuotient(),
+	)
+}
+
+// GetValidatorActivationChurnLimit as defined in the Ethereum 2.0
+// specification, for the given number of active validators.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/deneb/beacon-chain.md#new-get_validator_activation_churn_limit
+//
+//nolint:lll
+func GetValidatorActivationChurnLimit(
+	cs primitives.ChainSpec,
+	activeValidators uint64,
+) uint64 {
+	return min(
+		cs.MaxPerEpochActivationChurnLimit(),
+		GetValidatorChurnLimit(cs, activeValidators),
+	)
+}
+
+// ProcessRegistryUpdates as defined in the Ethereum 2.0 specification. The
+// validators with the maximum effective balance join the activation queue,
+// the active validators at or below the ejection balance are exited and the
+// queue is activated up to the activation churn limit, in order of
+// eligibility epoch and then index. As blocks are final as soon as they are
+// committed, the finalized epoch is the current epoch.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/deneb/beacon-chain.md#modified-process_registry_updates
+//
+//nolint:lll
+func ProcessRegistryUpdates[ValidatorT RegistryValidator](
+	st RegistryState[ValidatorT],
+	cs primitives.ChainSpec,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := cs.SlotToEpoch(slot)
+
+	reg, err := newRegistry(st, cs, epoch)
+	if err != nil {
+		return err
+	}
+
+	var (
+		maxEffectiveBalance = math.Gwei(cs.MaxEffectiveBalance())
+		ejectionBalance     = math.Gwei(cs.EjectionBalance())
+		queue               []math.ValidatorIndex
+	)
+	for i, val := range reg.validators {
+		index := math.ValidatorIndex(i)
+		if val.IsEligibleForActivationQueue(maxEffectiveBalance) {
+			val.SetActivationEligibilityEpoch(epoch + 1)
+			reg.updated[index] = struct{}{}
+		}
+		if val.IsActive(epoch) &&
+			val.GetEffectiveBalance() <= ejectionBalance {
+			reg.initiateExit(index)
+		}
+		if val.IsEligibleForActivation(epoch) {
+			queue = append(queue, index)
+		}
+	}
+
+	// The queue is built in order of index, a stable sort keeps it for the
+	// validators eligible at the same epoch.
+	slices.SortStableFunc(queue, func(a, b math.ValidatorIndex) int {
+		return cmp.Compare(
+			reg.validators[a].GetActivationEligibilityEpoch(),
+			reg.validators[b].GetActivationEligibilityEpoch(),
+		)
+	})
+	limit := GetValidatorActivationChurnLimit(cs, reg.activeValidators)
+	for _, index := range queue[:min(uint64(len(queue)), limit)] {
+		reg.validators[index].SetActivationEpoch(
+			ComputeActivationExitEpoch(cs, epoch),
+		)
+		reg.updated[index] = struct{}{}
+	}
+	return reg.save(st)
+}
+
+// InitiateValidatorExit as defined in the Ethereum 2.0 specification, the
+// validator is exited at the first epoch that has not reached the churn
+// limit.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#initiate_validator_exit
+//
+//nolint:lll
+func InitiateValidatorExit[ValidatorT RegistryValidator](
+	st RegistryState[ValidatorT],
+	cs primitives.ChainSpec,
+	index math.ValidatorIndex,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	reg, err := newRegistry(st, cs, cs.SlotToEpoch(slot))
+	if err != nil {
+		return err
+	}
+	reg.initiateExit(index)
+	return reg.save(st)
+}
+
+// registry holds the validators while they are activated and exited, along
+// with the exit queue.
+type registry[ValidatorT RegistryValidator] struct {
+	cs         primitives.ChainSpec
+	validators []ValidatorT
+	// updated are the indices of the validators to write back.
+	updated map[math.ValidatorIndex]struct{}
+	// activeValidators is the number of validators active at the epoch.
+	activeValidators uint64
+	// exitQueueEpoch is the latest exit epoch, and exitQueueChurn the
+	// number of validators exiting at it.
+	exitQueueEpoch math.Epoch
+	exitQueueChurn uint64
+}
+
+// newRegistry loads the validators of st at epoch.
+func newRegistry[ValidatorT RegistryValidator](
+	st RegistryState[ValidatorT],
+	cs primitives.ChainSpec,
+	epoch math.Epoch,
+) (*registry[ValidatorT], error) {
+	reg := &registry[ValidatorT]{
+		cs:             cs,
+		updated:        make(map[math.ValidatorIndex]struct{}),
+		exitQueueEpoch: ComputeActivationExitEpoch(cs, epoch),
+	}
+	farFutureEpoch := math.Epoch(constants.FarFutureEpoch)
+	if err := st.IterateValidators(
+		func(_ math.ValidatorIndex, val ValidatorT) (bool, error) {
+			reg.validators = append(reg.validators, val)
+			if val.IsActive(epoch) {
+				reg.activeValidators++
+			}
+			switch exitEpoch := val.GetExitEpoch(); {
+			case exitEpoch == farFutureEpoch:
+			case exitEpoch > reg.exitQueueEpoch:
+				reg.exitQueueEpoch, reg.exitQueueChurn = exitEpoch, 1
+			case exitEpoch == reg.exitQueueEpoch:
+				reg.exitQueueChurn++
+			}
+			return false, nil
+		},
+	); err != nil {
+		return nil, err
+	}
+	return reg, nil
+}
+
+// initiateExit exits the validator of index at the exit queue epoch, or at
+// the next one if it has reached the churn limit. The validator is left as
+// is if it is already exiting.
+func (r *registry[ValidatorT]) initiateExit(index math.ValidatorIndex) {
+	val := r.validators[index]
+	if val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) {
+		return
+	}
+
+	if r.exitQueueChurn >= GetValidatorChurnLimit(r.cs, r.activeValidators) {
+		r.exitQueueEpoch, r.exitQueueChurn = r.exitQueueEpoch+1, 0
+	}
+	r.exitQueueChurn++
+
+	val.SetExitEpoch(r.exitQueueEpoch)
+	val.SetWithdrawableEpoch(
+		r.exitQueueEpoch + math.Epoch(r.cs.MinValidatorWithdrawabilityDelay()),
+	)
+	r.updated[index] = struct{}{}
+}
+
+// save writes the updated validators to st, in order of index.
+func (r *registry[ValidatorT]) save(st RegistryState[ValidatorT]) error {
+	indices := make([]math.ValidatorIndex, 0, len(r.updated))
+	for index := range r.updated {
+		indices = append(indices, index)
+	}
+	slices.Sort(indices)
+	for _, index := range indices {
+		if err := st.UpdateValidatorAtIndex(
+			index, r.validators[index],
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
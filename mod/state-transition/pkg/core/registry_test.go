@@ -0,0 +1,234 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// registryEpoch is the epoch the registry is updated at.
+	registryEpoch = math.Epoch(10)
+	// activationExitEpoch is the epoch validators activated or exited at
+	// registryEpoch take effect.
+	activationExitEpoch = registryEpoch + 1 + 4
+	ejectionBalance     = math.Gwei(16e9)
+)
+
+// registryState is the state the registry is updated in.
+type registryState struct {
+	validators []*types.Validator
+	// writes are the indices of the validators written, in order.
+	writes []math.ValidatorIndex
+}
+
+func (s *registryState) GetSlot() (math.Slot, error) {
+	return math.Slot(uint64(registryEpoch)*32 + 31), nil
+}
+
+func (s *registryState) IterateValidators(
+	fn func(index math.ValidatorIndex, val *types.Validator) (bool, error),
+) error {
+	for i, val := range s.validators {
+		// The validators are copied as they would be decoded from the
+		// store.
+		val := *val
+		if stop, err := fn(math.ValidatorIndex(i), &val); err != nil || stop {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *registryState) UpdateValidatorAtIndex(
+	index math.ValidatorIndex,
+	val *types.Validator,
+) error {
+	s.validators[index] = val
+	s.writes = append(s.writes, index)
+	return nil
+}
+
+func registrySpec() chain.Spec[
+	common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+] {
+	return chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+	]{
+		MaxEffectiveBalance:              uint64(maxEffectiveBalance),
+		EjectionBalance:                  uint64(ejectionBalance),
+		SlotsPerEpoch:                    32,
+		MaxSeedLookahead:                 4,
+		MinValidatorWithdrawabilityDelay: 256,
+		MinPerEpochChurnLimit:            2,
+		ChurnLimitQuotient:               1 << 16,
+		MaxPerEpochActivationChurnLimit:  8,
+	})
+}
+
+// activeValidator returns a validator active since genesis.
+func activeValidator(effectiveBalance math.Gwei) *types.Validator {
+	return &types.Validator{
+		EffectiveBalance:           effectiveBalance,
+		ActivationEligibilityEpoch: 0,
+		ActivationEpoch:            0,
+		ExitEpoch:                  farFutureEpoch,
+		WithdrawableEpoch:          farFutureEpoch,
+	}
+}
+
+// pendingValidator returns a validator waiting for its activation since
+// eligibilityEpoch.
+func pendingValidator(eligibilityEpoch math.Epoch) *types.Validator {
+	return &types.Validator{
+		EffectiveBalance:           maxEffectiveBalance,
+		ActivationEligibilityEpoch: eligibilityEpoch,
+		ActivationEpoch:            farFutureEpoch,
+		ExitEpoch:                  farFutureEpoch,
+		WithdrawableEpoch:          farFutureEpoch,
+	}
+}
+
+func TestProcessRegistryUpdates_ActivationChurn(t *testing.T) {
+	st := &registryState{validators: []*types.Validator{
+		activeValidator(maxEffectiveBalance),
+		pendingValidator(10),
+		pendingValidator(9),
+		pendingValidator(9),
+		pendingValidator(8),
+		// Deposited the maximum effective balance.
+		pendingValidator(farFutureEpoch),
+		// Deposited less than the maximum effective balance.
+		{
+			EffectiveBalance:           maxEffectiveBalance - 1e9,
+			ActivationEligibilityEpoch: farFutureEpoch,
+			ActivationEpoch:            farFutureEpoch,
+			ExitEpoch:                  farFutureEpoch,
+			WithdrawableEpoch:          farFutureEpoch,
+		},
+		// Eligible after the current epoch.
+		pendingValidator(registryEpoch + 1),
+	}}
+	require.NoError(t, core.ProcessRegistryUpdates(st, registrySpec()))
+
+	// The churn limit of 2 activates the earliest eligible validators, in
+	// order of index for the same eligibility epoch.
+	activationEpochs := make([]math.Epoch, 0, len(st.validators))
+	for _, val := range st.validators {
+		activationEpochs = append(activationEpochs, val.ActivationEpoch)
+	}
+	require.Equal(t, []math.Epoch{
+		0,
+		farFutureEpoch,
+		activationExitEpoch,
+		farFutureEpoch,
+		activationExitEpoch,
+		farFutureEpoch,
+		farFutureEpoch,
+		farFutureEpoch,
+	}, activationEpochs)
+	require.Equal(t, registryEpoch+1, st.validators[5].ActivationEligibilityEpoch)
+	require.Equal(t, farFutureEpoch, st.validators[6].ActivationEligibilityEpoch)
+	require.Equal(t, []math.ValidatorIndex{2, 4, 5}, st.writes)
+}
+
+func TestProcessRegistryUpdates_Exits(t *testing.T) {
+	exiting := activeValidator(maxEffectiveBalance)
+	exiting.ExitEpoch = activationExitEpoch
+	exiting.WithdrawableEpoch = activationExitEpoch + 256
+
+	st := &registryState{validators: []*types.Validator{
+		activeValidator(maxEffectiveBalance),
+		exiting,
+		activeValidator(ejectionBalance),
+		activeValidator(ejectionBalance - 1e9),
+		activeValidator(ejectionBalance + 1e9),
+		activeValidator(0),
+	}}
+	require.NoError(t, core.ProcessRegistryUpdates(st, registrySpec()))
+
+	// The churn limit of 2 is reached at the exit queue epoch by the
+	// validator already exiting and the first one ejected.
+	exitEpochs := make([]math.Epoch, 0, len(st.validators))
+	for _, val := range st.validators {
+		exitEpochs = append(exitEpochs, val.ExitEpoch)
+		if val.ExitEpoch != farFutureEpoch {
+			require.Equal(t, val.ExitEpoch+256, val.WithdrawableEpoch)
+		}
+	}
+	require.Equal(t, []math.Epoch{
+		farFutureEpoch,
+		activationExitEpoch,
+		activationExitEpoch,
+		activationExitEpoch + 1,
+		farFutureEpoch,
+		activationExitEpoch + 1,
+	}, exitEpochs)
+	require.Equal(t, []math.ValidatorIndex{2, 3, 5}, st.writes)
+}
+
+func TestProcessRegistryUpdates_NoOp(t *testing.T) {
+	exited := activeValidator(0)
+	exited.ExitEpoch = registryEpoch - 1
+	exited.WithdrawableEpoch = registryEpoch + 255
+
+	st := &registryState{validators: []*types.Validator{
+		activeValidator(maxEffectiveBalance),
+		activeValidator(ejectionBalance + 1e9),
+		exited,
+		// Eligible after the current epoch.
+		pendingValidator(registryEpoch + 1),
+	}}
+	validators := make([]types.Validator, 0, len(st.validators))
+	for _, val := range st.validators {
+		validators = append(validators, *val)
+	}
+	require.NoError(t, core.ProcessRegistryUpdates(st, registrySpec()))
+
+	require.Empty(t, st.writes)
+	for i, val := range st.validators {
+		require.Equal(t, validators[i], *val)
+	}
+}
+
+func TestInitiateValidatorExit(t *testing.T) {
+	st := &registryState{validators: []*types.Validator{
+		activeValidator(maxEffectiveBalance),
+		activeValidator(maxEffectiveBalance),
+	}}
+	cs := registrySpec()
+	require.NoError(t, core.InitiateValidatorExit(st, cs, 1))
+	require.Equal(t, activationExitEpoch, st.validators[1].ExitEpoch)
+	require.Equal(
+		t, activationExitEpoch+256, st.validators[1].WithdrawableEpoch,
+	)
+
+	// A validator already exiting is left as is.
+	require.NoError(t, core.InitiateValidatorExit(st, cs, 1))
+	require.Equal(t, []math.ValidatorIndex{1}, st.writes)
+}
@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"golang.org/x/sync/errgroup"
+)
+
+// signatureBatchSize is the number of signatures verified at once by a
+// worker of a SignatureBatch.
+const signatureBatchSize = 32
+
+// SignatureBatch collects the signatures of the operations of a block, so
+// that they are verified at once before the state is mutated.
+type SignatureBatch struct {
+	pubkeys [][48]byte
+	msgs    [][]byte
+	sigs    [][96]byte
+	// operations describe the operation of each signature, to identify the
+	// failing one when the batch is rejected.
+	operations []string
+}
+
+// NewSignatureBatch returns an empty SignatureBatch.
+func NewSignatureBatch() *SignatureBatch {
+	return &SignatureBatch{}
+}
+
+// Add returns a signature verification function adding the signatures it is
+// given to the batch, as those of the described operation.
+func (b *SignatureBatch) Add(operation string) func(
+	pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+) error {
+	return func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error {
+		b.pubkeys = append(b.pubkeys, pubkey)
+		b.msgs = append(b.msgs, message)
+		b.sigs = append(b.sigs, signature)
+		b.operations = append(b.operations, operation)
+		return nil
+	}
+}
+
+// Len returns the number of signatures in the batch.
+func (b *SignatureBatch) Len() int {
+	return len(b.sigs)
+}
+
+// Verify verifies the signatures of the batch with the batch verifier, split
+// between a bounded number of workers. The signatures of a rejected split are
+// then verified one by one with signatureVerificationFn, and the first
+// invalid one is reported with its operation.
+func (b *SignatureBatch) Verify(
+	verifier crypto.BatchVerifier,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	// The error of each split is kept, so that the first invalid signature
+	// is reported regardless of the order the workers complete in.
+	errs := make([]error, (b.Len()+signatureBatchSize-1)/signatureBatchSize)
+	eg := new(errgroup.Group)
+	eg.SetLimit(runtime.GOMAXPROCS(0))
+	for i := range errs {
+		start := i * signatureBatchSize
+		end := min(start+signatureBatchSize, b.Len())
+		eg.Go(func() error {
+			errs[i] = b.verifyRange(
+				verifier, signatureVerificationFn, start, end,
+			)
+			return nil
+		})
+	}
+	//nolint:errcheck // the workers do not return errors.
+	eg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyRange verifies the signatures of the batch from start to end,
+// falling back to verifying them one by one if they are rejected.
+func (b *SignatureBatch) verifyRange(
+	verifier crypto.BatchVerifier,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+	start, end int,
+) error {
+	err := verifier.VerifySignatureBatch(
+		b.pubkeys[start:end], b.msgs[start:end], b.sigs[start:end],
+	)
+	if err == nil {
+		return nil
+	}
+	for i := start; i < end; i++ {
+		if vErr := signatureVerificationFn(
+			b.pubkeys[i], b.msgs[i], b.sigs[i],
+		); vErr != nil {
+			return errors.Wrapf(
+				ErrInvalidSignature, "%s: %v", b.operations[i], vErr,
+			)
+		}
+	}
+	// None of the signatures is invalid on its own, so the batch itself
+	// could not be verified.
+	return err
+}
+
+// DepositSignatureState is the part of the beacon state the signatures of
+// deposits are verified against.
+type DepositSignatureState interface {
+	GetSlot() (math.Slot, error)
+	GetGenesisValidatorsRoot() (primitives.Root, error)
+	ValidatorIndexByPubkey(pubkey crypto.BLSPubkey) (math.ValidatorIndex, error)
+}
+
+// CollectDepositSignatures adds the signatures of the deposits creating a
+// validator to the batch. The deposits topping up a validator, including one
+// created by an earlier deposit of the list, are not signature checked.
+func CollectDepositSignatures[
+	DepositT Deposit[ForkDataT, WithdrawalCredentialsT],
+	ForkDataT ForkData[ForkDataT],
+	WithdrawalCredentialsT ~[32]byte,
+](
+	st DepositSignatureState,
+	cs primitives.ChainSpec,
+	batch *SignatureBatch,
+	deposits []DepositT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+
+	var fd ForkDataT
+	fd = fd.New(
+		version.FromUint32[primitives.Version](
+			cs.ActiveForkVersionForEpoch(cs.SlotToEpoch(slot)),
+		), genesisValidatorsRoot,
+	)
+	created := make(map[crypto.BLSPubkey]struct{})
+	for _, dep := range deposits {
+		pubkey := dep.GetPubkey()
+		if _, err = st.ValidatorIndexByPubkey(pubkey); err == nil {
+			continue
+		}
+		if _, ok := created[pubkey]; ok {
+			continue
+		}
+		created[pubkey] = struct{}{}
+
+		if err = dep.VerifySignature(
+			fd, cs.DomainTypeDeposit(),
+			batch.Add(fmt.Sprintf("deposit %d", dep.GetIndex())),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
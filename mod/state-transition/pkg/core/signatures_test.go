@@ -0,0 +1,242 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/stretchr/testify/require"
+)
+
+// errValidatorNotFound is returned by the deposit state for unknown keys.
+var errValidatorNotFound = errors.New("validator not found")
+
+// depositState is the state the signatures of deposits are verified against.
+type depositState struct {
+	pubkeys map[crypto.BLSPubkey]math.ValidatorIndex
+}
+
+func (s *depositState) GetSlot() (math.Slot, error) {
+	return 0, nil
+}
+
+func (s *depositState) GetGenesisValidatorsRoot() (primitives.Root, error) {
+	return genesisValidatorsRoot, nil
+}
+
+func (s *depositState) ValidatorIndexByPubkey(
+	pubkey crypto.BLSPubkey,
+) (math.ValidatorIndex, error) {
+	index, ok := s.pubkeys[pubkey]
+	if !ok {
+		return 0, errValidatorNotFound
+	}
+	return index, nil
+}
+
+// rejectingVerifier is a batch verifier rejecting every batch.
+type rejectingVerifier struct {
+	crypto.GoBatchVerifier
+}
+
+// errBatchRejected is returned by the rejecting verifier.
+var errBatchRejected = errors.New("batch rejected")
+
+func (rejectingVerifier) VerifySignatureBatch(
+	[][48]byte, [][]byte, [][96]byte,
+) error {
+	return errBatchRejected
+}
+
+// newDeposits returns n deposits of index i signed by the i+1 secret key.
+func newDeposits(tb testing.TB, n int) []*types.Deposit {
+	tb.Helper()
+	cs := slashingSpec()
+	deposits := make([]*types.Deposit, n)
+	for i := range deposits {
+		credentials := types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{byte(i)},
+		)
+		msg, sig, err := types.CreateAndSignDepositMessage(
+			types.NewForkData(
+				version.FromUint32[common.Version](
+					cs.ActiveForkVersionForEpoch(0),
+				),
+				genesisValidatorsRoot,
+			),
+			cs.DomainTypeDeposit(),
+			testSigner{sk: big.NewInt(int64(i + 1))},
+			credentials,
+			maxEffectiveBalance,
+		)
+		require.NoError(tb, err)
+		deposits[i] = types.NewDeposit(
+			msg.Pubkey, credentials, msg.Amount, sig, uint64(i),
+		)
+	}
+	return deposits
+}
+
+// newDepositBlock returns a block including the deposits.
+func newDepositBlock(deposits []*types.Deposit) *types.BeaconBlock {
+	return &types.BeaconBlock{
+		RawBeaconBlock: &types.BeaconBlockDeneb{
+			Body: &types.BeaconBlockBodyDeneb{
+				BeaconBlockBodyBase: types.BeaconBlockBodyBase{
+					Deposits: deposits,
+				},
+			},
+		},
+	}
+}
+
+// verifyDepositSignatures verifies the signatures of the deposits of blk as
+// the state processor does.
+func verifyDepositSignatures(
+	st *depositState,
+	verifier crypto.BatchVerifier,
+	blk *types.BeaconBlock,
+) error {
+	batch := core.NewSignatureBatch()
+	if err := core.CollectDepositSignatures[
+		*types.Deposit, *types.ForkData, types.WithdrawalCredentials,
+	](st, slashingSpec(), batch, blk.GetBody().GetDeposits()); err != nil {
+		return err
+	}
+	return batch.Verify(verifier, testSigner{}.VerifySignature)
+}
+
+func TestSignatureBatch_Verify(t *testing.T) {
+	tests := []struct {
+		name     string
+		verifier crypto.BatchVerifier
+		// invalid are the indexes of the deposits signed with the key of
+		// another one.
+		invalid []int
+		err     error
+		culprit string
+	}{
+		{
+			name:     "valid",
+			verifier: crypto.GoBatchVerifier{},
+		},
+		{
+			name:     "invalid",
+			verifier: crypto.GoBatchVerifier{},
+			invalid:  []int{35},
+			err:      core.ErrInvalidSignature,
+			culprit:  "deposit 35",
+		},
+		{
+			name:     "first invalid reported",
+			verifier: crypto.GoBatchVerifier{},
+			invalid:  []int{35, 3},
+			err:      core.ErrInvalidSignature,
+			culprit:  "deposit 3",
+		},
+		{
+			name:     "batch rejected with valid signatures",
+			verifier: rejectingVerifier{},
+			err:      errBatchRejected,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deposits := newDeposits(t, 40)
+			for _, i := range tt.invalid {
+				deposits[i].Signature = deposits[i+1].Signature
+			}
+
+			err := verifyDepositSignatures(
+				&depositState{}, tt.verifier, newDepositBlock(deposits),
+			)
+			if tt.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.err)
+			require.ErrorContains(t, err, tt.culprit)
+		})
+	}
+}
+
+func TestCollectDepositSignatures(t *testing.T) {
+	deposits := newDeposits(t, 3)
+	st := &depositState{pubkeys: map[crypto.BLSPubkey]math.ValidatorIndex{
+		deposits[0].Pubkey: 0,
+	}}
+	// Only the first deposit of the new validator creates it, the others
+	// are top-ups.
+	topUp := *deposits[1]
+	topUp.Signature = crypto.BLSSignature{}
+	deposits = append(deposits, &topUp)
+
+	batch := core.NewSignatureBatch()
+	require.NoError(t, core.CollectDepositSignatures[
+		*types.Deposit, *types.ForkData, types.WithdrawalCredentials,
+	](st, slashingSpec(), batch, deposits))
+	require.Equal(t, 2, batch.Len())
+	require.NoError(t, batch.Verify(
+		crypto.GoBatchVerifier{}, testSigner{}.VerifySignature,
+	))
+}
+
+func BenchmarkVerifyDepositSignatures(b *testing.B) {
+	blk := newDepositBlock(newDeposits(b, 128))
+	st := &depositState{}
+
+	b.Run("batch", func(b *testing.B) {
+		for range b.N {
+			if err := verifyDepositSignatures(
+				st, crypto.GoBatchVerifier{}, blk,
+			); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("sequential", func(b *testing.B) {
+		cs := slashingSpec()
+		fd := types.NewForkData(
+			version.FromUint32[common.Version](
+				cs.ActiveForkVersionForEpoch(0),
+			),
+			genesisValidatorsRoot,
+		)
+		for range b.N {
+			for _, dep := range blk.GetBody().GetDeposits() {
+				if err := dep.VerifySignature(
+					fd, cs.DomainTypeDeposit(), testSigner{}.VerifySignature,
+				); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
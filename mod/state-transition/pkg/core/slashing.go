@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// SlashingHeader is a block header signed by a proposer.
+type SlashingHeader interface {
+	HashTreeRoot() ([32]byte, error)
+	GetSlot() math.Slot
+	GetProposerIndex() math.ValidatorIndex
+}
+
+// SlashingValidator is a validator of the registry that is slashed.
+type SlashingValidator interface {
+	RegistryValidator
+	GetPubkey() crypto.BLSPubkey
+	IsSlashed() bool
+	IsSlashable(epoch math.Epoch) bool
+	SetSlashed(slashed bool)
+	GetWithdrawableEpoch() math.Epoch
+}
+
+// SlashingState is the part of the beacon state validators are slashed in.
+type SlashingState[ValidatorT SlashingValidator] interface {
+	RegistryState[ValidatorT]
+	GetGenesisValidatorsRoot() (primitives.Root, error)
+	ValidatorByIndex(index math.ValidatorIndex) (ValidatorT, error)
+	GetSlashingAtIndex(index uint64) (math.Gwei, error)
+	UpdateSlashingAtIndex(index uint64, amount math.Gwei) error
+	IncreaseBalance(index math.ValidatorIndex, delta math.Gwei) error
+	DecreaseBalance(index math.ValidatorIndex, delta math.Gwei) error
+}
+
+// ProcessProposerSlashing as defined in the Ethereum 2.0 specification. The
+// proposer of two different headers signed for the same slot is slashed, and
+// the proposer of the block including the slashing is rewarded as the
+// whistleblower. The signatures of the headers are checked with
+// signatureVerificationFn.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#proposer-slashings
+//
+//nolint:lll
+func ProcessProposerSlashing[
+	BeaconBlockHeaderT SlashingHeader,
+	ForkDataT ForkData[ForkDataT],
+	ValidatorT SlashingValidator,
+](
+	st SlashingState[ValidatorT],
+	cs primitives.ChainSpec,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+	ps ProposerSlashing[BeaconBlockHeaderT],
+	proposerIndex math.ValidatorIndex,
+) error {
+	header1, header2 := ps.GetHeaders()
+	if header1.GetSlot() != header2.GetSlot() {
+		return errors.Wrapf(
+			ErrProposerSlashingSlotMismatch,
+			"%d != %d", header1.GetSlot(), header2.GetSlot(),
+		)
+	}
+	if header1.GetProposerIndex() != header2.GetProposerIndex() {
+		return errors.Wrapf(
+			ErrProposerSlashingProposerMismatch,
+			"%d != %d", header1.GetProposerIndex(), header2.GetProposerIndex(),
+		)
+	}
+
+	root1, err := header1.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	root2, err := header2.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if root1 == root2 {
+		return ErrProposerSlashingSameHeaders
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	index := header1.GetProposerIndex()
+	val, err := st.ValidatorByIndex(index)
+	if err != nil {
+		return err
+	}
+	if !val.IsSlashable(cs.SlotToEpoch(slot)) {
+		return errors.Wrapf(ErrValidatorNotSlashable, "index %d", index)
+	}
+
+	if err = VerifyProposerSlashingSignatures[BeaconBlockHeaderT, ForkDataT](
+		st, cs, signatureVerificationFn, ps,
+	); err != nil {
+		return err
+	}
+
+	return SlashValidator(st, cs, index, proposerIndex)
+}
+
+// VerifyProposerSlashingSignatures checks the signatures of the headers of a
+// proposer slashing with signatureVerificationFn. The headers are signed by
+// their proposer in the domain of the fork of their slot.
+func VerifyProposerSlashingSignatures[
+	BeaconBlockHeaderT SlashingHeader,
+	ForkDataT ForkData[ForkDataT],
+	ValidatorT SlashingValidator,
+](
+	st SlashingState[ValidatorT],
+	cs primitives.ChainSpec,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+	ps ProposerSlashing[BeaconBlockHeaderT],
+) error {
+	header1, header2 := ps.GetHeaders()
+	val, err := st.ValidatorByIndex(header1.GetProposerIndex())
+	if err != nil {
+		return err
+	}
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+	var fd ForkDataT
+	domain, err := fd.New(
+		version.FromUint32[primitives.Version](
+			cs.ActiveForkVersionForEpoch(cs.SlotToEpoch(header1.GetSlot())),
+		), genesisValidatorsRoot,
+	).ComputeDomain(cs.DomainTypeProposer())
+	if err != nil {
+		return err
+	}
+
+	sig1, sig2 := ps.GetSignatures()
+	for _, signed := range []struct {
+		header    BeaconBlockHeaderT
+		signature crypto.BLSSignature
+	}{{header1, sig1}, {header2, sig2}} {
+		var signingRoot common.Root
+		signingRoot, err = ssz.ComputeSigningRoot(signed.header, domain)
+		if err != nil {
+			return err
+		}
+		if err = signatureVerificationFn(
+			val.GetPubkey(), signingRoot[:], signed.signature,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SlashValidator as defined in the Ethereum 2.0 specification. The validator
+// is exited and penalized, and the whistleblower rewarded. The whistleblower
+// is always the proposer of the block, so it is given both the proposer and
+// the whistleblower parts of the reward.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/bellatrix/beacon-chain.md#modified-slash_validator
+//
+//nolint:lll
+func SlashValidator[ValidatorT SlashingValidator](
+	st SlashingState[ValidatorT],
+	cs primitives.ChainSpec,
+	index math.ValidatorIndex,
+	whistleblowerIndex math.ValidatorIndex,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := cs.SlotToEpoch(slot)
+
+	if err = InitiateValidatorExit(st, cs, index); err != nil {
+		return err
+	}
+	val, err := st.ValidatorByIndex(index)
+	if err != nil {
+		return err
+	}
+	val.SetSlashed(true)
+	val.SetWithdrawableEpoch(max(
+		val.GetWithdrawableEpoch(),
+		epoch+math.Epoch(cs.EpochsPerSlashingsVector()),
+	))
+	if err = st.UpdateValidatorAtIndex(index, val); err != nil {
+		return err
+	}
+
+	effectiveBalance := val.GetEffectiveBalance()
+	slashingIndex := uint64(epoch) % cs.EpochsPerSlashingsVector()
+	slashing, err := st.GetSlashingAtIndex(slashingIndex)
+	if err != nil {
+		return err
+	}
+	if slashing, err = slashing.CheckedAdd(effectiveBalance); err != nil {
+		return err
+	}
+	if err = st.UpdateSlashingAtIndex(slashingIndex, slashing); err != nil {
+		return err
+	}
+
+	if err = st.DecreaseBalance(
+		index, effectiveBalance/math.Gwei(cs.MinSlashingPenaltyQuotient()),
+	); err != nil {
+		return err
+	}
+	return st.IncreaseBalance(
+		whistleblowerIndex,
+		effectiveBalance/math.Gwei(cs.WhistleblowerRewardQuotient()),
+	)
+}
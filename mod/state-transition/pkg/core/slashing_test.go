@@ -0,0 +1,317 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/stretchr/testify/require"
+)
+
+// genesisValidatorsRoot is the genesis validators root of the slashing
+// state.
+//
+//nolint:gochecknoglobals // test fixture.
+var genesisValidatorsRoot = common.Root{0x01}
+
+// slashingState is the state validators are slashed in.
+type slashingState struct {
+	registryState
+	balances  []math.Gwei
+	slashings map[uint64]math.Gwei
+}
+
+func (s *slashingState) GetGenesisValidatorsRoot() (primitives.Root, error) {
+	return genesisValidatorsRoot, nil
+}
+
+func (s *slashingState) ValidatorByIndex(
+	index math.ValidatorIndex,
+) (*types.Validator, error) {
+	val := *s.validators[index]
+	return &val, nil
+}
+
+func (s *slashingState) GetSlashingAtIndex(index uint64) (math.Gwei, error) {
+	return s.slashings[index], nil
+}
+
+func (s *slashingState) UpdateSlashingAtIndex(
+	index uint64,
+	amount math.Gwei,
+) error {
+	s.slashings[index] = amount
+	return nil
+}
+
+func (s *slashingState) IncreaseBalance(
+	index math.ValidatorIndex,
+	delta math.Gwei,
+) error {
+	balance, err := s.balances[index].CheckedAdd(delta)
+	if err != nil {
+		return err
+	}
+	s.balances[index] = balance
+	return nil
+}
+
+func (s *slashingState) DecreaseBalance(
+	index math.ValidatorIndex,
+	delta math.Gwei,
+) error {
+	s.balances[index] -= min(s.balances[index], delta)
+	return nil
+}
+
+// testSigner signs with the secret key sk.
+type testSigner struct {
+	sk *big.Int
+}
+
+func (s testSigner) PublicKey() (crypto.BLSPubkey, error) {
+	_, _, g1, _ := bls12381.Generators()
+	var pubkey bls12381.G1Affine
+	pubkey.ScalarMultiplication(&g1, s.sk)
+	return pubkey.Bytes(), nil
+}
+
+func (s testSigner) Sign(msg []byte) (crypto.BLSSignature, error) {
+	h, err := bls12381.HashToG2(msg, []byte(crypto.BLSSignatureDST))
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	var sig bls12381.G2Affine
+	sig.ScalarMultiplication(&h, s.sk)
+	return sig.Bytes(), nil
+}
+
+func (testSigner) VerifySignature(
+	pubkey crypto.BLSPubkey,
+	msg []byte,
+	sig crypto.BLSSignature,
+) error {
+	return crypto.VerifySignature(pubkey, msg, sig)
+}
+
+func slashingSpec() chain.Spec[
+	common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+] {
+	return chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+	]{
+		MaxEffectiveBalance:              uint64(maxEffectiveBalance),
+		SlotsPerEpoch:                    32,
+		MaxSeedLookahead:                 4,
+		MinValidatorWithdrawabilityDelay: 256,
+		MinPerEpochChurnLimit:            2,
+		ChurnLimitQuotient:               1 << 16,
+		EpochsPerSlashingsVector:         8,
+		MinSlashingPenaltyQuotient:       32,
+		WhistleblowerRewardQuotient:      512,
+		DomainTypeProposer:               common.DomainType{0x00},
+		ElectraForkEpoch:                 math.Epoch(^uint64(0)),
+	})
+}
+
+// newSlashingState returns a state of validators active since genesis with
+// the maximum effective balance, the validator of index 1 having the key of
+// signer.
+func newSlashingState(t *testing.T, signer testSigner) *slashingState {
+	t.Helper()
+	pubkey, err := signer.PublicKey()
+	require.NoError(t, err)
+	st := &slashingState{
+		registryState: registryState{validators: []*types.Validator{
+			activeValidator(maxEffectiveBalance),
+			activeValidator(maxEffectiveBalance),
+			activeValidator(maxEffectiveBalance),
+		}},
+		balances: []math.Gwei{
+			maxEffectiveBalance, maxEffectiveBalance, maxEffectiveBalance,
+		},
+		slashings: make(map[uint64]math.Gwei),
+	}
+	st.validators[1].Pubkey = pubkey
+	return st
+}
+
+// signHeader returns the header of slot, proposer and body root signed by
+// signer in the proposer domain.
+func signHeader(
+	t *testing.T,
+	signer testSigner,
+	slot math.Slot,
+	proposer math.ValidatorIndex,
+	bodyRoot common.Root,
+) *types.SignedBeaconBlockHeader {
+	t.Helper()
+	cs := slashingSpec()
+	header := &types.BeaconBlockHeader{BodyRoot: bodyRoot}
+	header.Slot, header.ProposerIndex = uint64(slot), uint64(proposer)
+	sig, _, err := types.SignWithForkData(
+		signer, header,
+		types.NewForkData(
+			version.FromUint32[common.Version](
+				cs.ActiveForkVersionForEpoch(cs.SlotToEpoch(slot)),
+			),
+			genesisValidatorsRoot,
+		),
+		cs.DomainTypeProposer(),
+	)
+	require.NoError(t, err)
+	return &types.SignedBeaconBlockHeader{Header: header, Signature: sig}
+}
+
+// processBlockSlashings processes the proposer slashings of blk, as the
+// state processor does.
+func processBlockSlashings(
+	st *slashingState,
+	signer testSigner,
+	blk *types.BeaconBlock,
+) error {
+	for _, ps := range blk.GetBody().GetProposerSlashings() {
+		if err := core.ProcessProposerSlashing[
+			*types.BeaconBlockHeader, *types.ForkData, *types.Validator,
+		](
+			st, slashingSpec(), signer.VerifySignature, ps,
+			blk.GetProposerIndex(),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newSlashingBlock returns a block of the validator of index 0 including
+// slashings.
+func newSlashingBlock(slashings ...*types.ProposerSlashing) *types.BeaconBlock {
+	return &types.BeaconBlock{
+		RawBeaconBlock: &types.BeaconBlockElectra{
+			BeaconBlockHeaderBase: types.BeaconBlockHeaderBase{
+				Slot:          uint64(registryEpoch)*32 + 31,
+				ProposerIndex: 0,
+			},
+			Body: &types.BeaconBlockBodyElectra{
+				ProposerSlashings: slashings,
+			},
+		},
+	}
+}
+
+func TestProcessProposerSlashing(t *testing.T) {
+	signer := testSigner{sk: big.NewInt(42)}
+	st := newSlashingState(t, signer)
+	blk := newSlashingBlock(&types.ProposerSlashing{
+		SignedHeader1: signHeader(t, signer, 100, 1, common.Root{0x01}),
+		SignedHeader2: signHeader(t, signer, 100, 1, common.Root{0x02}),
+	})
+	require.NoError(t, processBlockSlashings(st, signer, blk))
+
+	slashed := st.validators[1]
+	require.True(t, slashed.IsSlashed())
+	require.Equal(t, activationExitEpoch, slashed.GetExitEpoch())
+	// The withdrawable epoch of the exit is after the slashings vector.
+	require.Equal(t, activationExitEpoch+256, slashed.GetWithdrawableEpoch())
+	require.Equal(t, map[uint64]math.Gwei{
+		uint64(registryEpoch) % 8: maxEffectiveBalance,
+	}, st.slashings)
+
+	// The slashed validator is penalized 1/32 of its effective balance and
+	// the proposer rewarded 1/512 of it.
+	require.Equal(t, []math.Gwei{
+		maxEffectiveBalance + maxEffectiveBalance/512,
+		maxEffectiveBalance - maxEffectiveBalance/32,
+		maxEffectiveBalance,
+	}, st.balances)
+	require.False(t, st.validators[0].IsSlashed())
+	require.False(t, st.validators[2].IsSlashed())
+
+	// A validator cannot be slashed twice.
+	require.ErrorIs(
+		t, processBlockSlashings(st, signer, blk), core.ErrValidatorNotSlashable,
+	)
+}
+
+func TestProcessProposerSlashing_Invalid(t *testing.T) {
+	signer := testSigner{sk: big.NewInt(42)}
+	other := testSigner{sk: big.NewInt(43)}
+	tests := []struct {
+		name     string
+		slashing *types.ProposerSlashing
+		err      error
+	}{
+		{
+			name: "SlotMismatch",
+			slashing: &types.ProposerSlashing{
+				SignedHeader1: signHeader(t, signer, 100, 1, common.Root{0x01}),
+				SignedHeader2: signHeader(t, signer, 101, 1, common.Root{0x02}),
+			},
+			err: core.ErrProposerSlashingSlotMismatch,
+		},
+		{
+			name: "ProposerMismatch",
+			slashing: &types.ProposerSlashing{
+				SignedHeader1: signHeader(t, signer, 100, 1, common.Root{0x01}),
+				SignedHeader2: signHeader(t, signer, 100, 2, common.Root{0x02}),
+			},
+			err: core.ErrProposerSlashingProposerMismatch,
+		},
+		{
+			name: "SameHeaders",
+			slashing: &types.ProposerSlashing{
+				SignedHeader1: signHeader(t, signer, 100, 1, common.Root{0x01}),
+				SignedHeader2: signHeader(t, signer, 100, 1, common.Root{0x01}),
+			},
+			err: core.ErrProposerSlashingSameHeaders,
+		},
+		{
+			name: "InvalidSignature",
+			slashing: &types.ProposerSlashing{
+				SignedHeader1: signHeader(t, signer, 100, 1, common.Root{0x01}),
+				SignedHeader2: signHeader(t, other, 100, 1, common.Root{0x02}),
+			},
+			err: crypto.ErrInvalidSignature,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := newSlashingState(t, signer)
+			require.ErrorIs(
+				t,
+				processBlockSlashings(st, signer, newSlashingBlock(tt.slashing)),
+				tt.err,
+			)
+			require.False(t, st.validators[1].IsSlashed())
+			require.Empty(t, st.slashings)
+		})
+	}
+}
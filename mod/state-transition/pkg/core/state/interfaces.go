@@ -64,10 +64,15 @@ type KVStore[
 	GetLatestBlockHeader() (BeaconBlockHeaderT, error)
 	SetLatestBlockHeader(header BeaconBlockHeaderT) error
 	GetBlockRootAtIndex(index uint64) (primitives.Root, error)
+	GetBlockRootAtSlot(slot, currentSlot math.Slot) (primitives.Root, error)
 	StateRootAtIndex(index uint64) (primitives.Root, error)
 	GetEth1Data() (Eth1DataT, error)
 	SetEth1Data(data Eth1DataT) error
 	GetValidators() ([]ValidatorT, error)
+	IterateValidators(
+		fn func(index math.ValidatorIndex, val ValidatorT) (bool, error),
+	) error
+	GetActiveValidatorCount(epoch math.Epoch) (uint64, error)
 	GetBalances() ([]uint64, error)
 	GetNextWithdrawalIndex() (uint64, error)
 	SetNextWithdrawalIndex(index uint64) error
@@ -76,6 +81,7 @@ type KVStore[
 	GetTotalSlashing() (math.Gwei, error)
 	SetTotalSlashing(total math.Gwei) error
 	GetRandaoMixAtIndex(index uint64) (primitives.Bytes32, error)
+	GetRandaoMixAtEpoch(epoch math.Epoch) (primitives.Bytes32, error)
 	GetSlashings() ([]uint64, error)
 	SetSlashingAtIndex(index uint64, amount math.Gwei) error
 	GetSlashingAtIndex(index uint64) (math.Gwei, error)
@@ -27,8 +27,8 @@ import (
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
 )
 
 // StateDB is the underlying struct behind the BeaconState interface.
@@ -133,7 +133,10 @@ func (s *StateDB[
 	if err != nil {
 		return err
 	}
-	return s.SetBalance(idx, balance+delta)
+	if balance, err = balance.CheckedAdd(delta); err != nil {
+		return err
+	}
+	return s.SetBalance(idx, balance)
 }
 
 // DecreaseBalance decreases the balance of a validator.
@@ -175,142 +178,68 @@ func (s *StateDB[
 	// Defensive check but total - oldValue should never underflow.
 	if oldValue > total {
 		return errors.New("count of total slashing is not up to date")
-	} else if err = s.SetTotalSlashing(
-		total - oldValue + amount,
-	); err != nil {
+	}
+	if total, err = (total - oldValue).CheckedAdd(amount); err != nil {
+		return err
+	} else if err = s.SetTotalSlashing(total); err != nil {
 		return err
 	}
 
 	return s.SetSlashingAtIndex(index, amount)
 }
 
-// ExpectedWithdrawals as defined in the Ethereum 2.0 Specification:
-// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#new-get_expected_withdrawals
-//
-//nolint:lll
+// ExpectedWithdrawals returns the withdrawals expected in the payload of
+// the next block, as computed by core.ExpectedWithdrawals.
 func (s *StateDB[
 	BeaconStateT, KVStoreT, ForkT,
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
 	ValidatorT, WithdrawalCredentialsT,
 ]) ExpectedWithdrawals() ([]*engineprimitives.Withdrawal, error) {
-	var (
-		validator         ValidatorT
-		balance           math.Gwei
-		withdrawalAddress common.ExecutionAddress
-		withdrawals       = make([]*engineprimitives.Withdrawal, 0)
+	return core.ExpectedWithdrawals[ValidatorT, WithdrawalCredentialsT](
+		s, s.cs,
 	)
-
-	slot, err := s.GetSlot()
-	if err != nil {
-		return nil, err
-	}
-
-	epoch := math.Epoch(uint64(slot) / s.cs.SlotsPerEpoch())
-
-	withdrawalIndex, err := s.GetNextWithdrawalIndex()
-	if err != nil {
-		return nil, err
-	}
-
-	validatorIndex, err := s.GetNextWithdrawalValidatorIndex()
-	if err != nil {
-		return nil, err
-	}
-
-	totalValidators, err := s.GetTotalValidators()
-	if err != nil {
-		return nil, err
-	}
-
-	// Iterate through indicies to find the next validators to withdraw.
-	for range min(
-		s.cs.MaxValidatorsPerWithdrawalsSweep(), totalValidators,
-	) {
-		validator, err = s.ValidatorByIndex(validatorIndex)
-		if err != nil {
-			return nil, err
-		}
-
-		balance, err = s.GetBalance(validatorIndex)
-		if err != nil {
-			return nil, err
-		}
-
-		withdrawalAddress, err = validator.
-			GetWithdrawalCredentials().ToExecutionAddress()
-		if err != nil {
-			return nil, err
-		}
-
-		// These fields are the same for both partial and full withdrawals.
-		withdrawal := &engineprimitives.Withdrawal{
-			Index:     math.U64(withdrawalIndex),
-			Validator: validatorIndex,
-			Address:   withdrawalAddress,
-		}
-
-		// Set the amount of the withdrawal depending on the balance of the
-		// validator.
-		if validator.IsFullyWithdrawable(balance, epoch) {
-			withdrawal.Amount = balance
-		} else if validator.IsPartiallyWithdrawable(
-			balance, math.Gwei(s.cs.MaxEffectiveBalance()),
-		) {
-			withdrawal.Amount = balance - math.Gwei(s.cs.MaxEffectiveBalance())
-		}
-		withdrawals = append(withdrawals, withdrawal)
-
-		// Increment the withdrawal index to process the next withdrawal.
-		withdrawalIndex++
-
-		// Cap the number of withdrawals to the maximum allowed per payload.
-		//#nosec:G701 // won't overflow in practice.
-		if len(withdrawals) == int(s.cs.MaxWithdrawalsPerPayload()) {
-			break
-		}
-
-		// Increment the validator index to process the next validator.
-		validatorIndex = (validatorIndex + 1) % math.ValidatorIndex(
-			totalValidators,
-		)
-	}
-
-	return withdrawals, nil
 }
 
-// Store is the interface for the beacon store.
+// beaconState gathers the fields of the state into a state of the fork of
+// its slot, for it to be merkleized.
 //
 //nolint:funlen,gocognit // todo fix somehow
 func (s *StateDB[
 	BeaconStateT, KVStoreT, ForkT,
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
 	ValidatorT, WithdrawalCredentialsT,
-]) HashTreeRoot() ([32]byte, error) {
+]) beaconState() (*state.BeaconState[
+	BeaconBlockHeaderT,
+	ExecutionPayloadHeaderT,
+	Eth1DataT,
+	ForkT,
+	ValidatorT,
+], error) {
 	slot, err := s.GetSlot()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	fork, err := s.GetFork()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	genesisValidatorsRoot, err := s.GetGenesisValidatorsRoot()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	latestBlockHeader, err := s.GetLatestBlockHeader()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	blockRoots := make([]primitives.Root, s.cs.SlotsPerHistoricalRoot())
 	for i := range s.cs.SlotsPerHistoricalRoot() {
 		blockRoots[i], err = s.GetBlockRootAtIndex(i)
 		if err != nil {
-			return [32]byte{}, err
+			return nil, err
 		}
 	}
 
@@ -318,61 +247,61 @@ func (s *StateDB[
 	for i := range s.cs.SlotsPerHistoricalRoot() {
 		stateRoots[i], err = s.StateRootAtIndex(i)
 		if err != nil {
-			return [32]byte{}, err
+			return nil, err
 		}
 	}
 
 	latestExecutionPayloadHeader, err := s.GetLatestExecutionPayloadHeader()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	eth1Data, err := s.GetEth1Data()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	eth1DepositIndex, err := s.GetEth1DepositIndex()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	validators, err := s.GetValidators()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	balances, err := s.GetBalances()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	randaoMixes := make([]primitives.Bytes32, s.cs.EpochsPerHistoricalVector())
 	for i := range s.cs.EpochsPerHistoricalVector() {
 		randaoMixes[i], err = s.GetRandaoMixAtIndex(i)
 		if err != nil {
-			return [32]byte{}, err
+			return nil, err
 		}
 	}
 
 	nextWithdrawalIndex, err := s.GetNextWithdrawalIndex()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	nextWithdrawalValidatorIndex, err := s.GetNextWithdrawalValidatorIndex()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	slashings, err := s.GetSlashings()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	totalSlashings, err := s.GetTotalSlashing()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	// TODO: Properly move BeaconState into full generics.
@@ -401,8 +330,44 @@ func (s *StateDB[
 		slashings,
 		totalSlashings,
 	)
+	if err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// HashTreeRoot returns the hash tree root of the state.
+func (s *StateDB[
+	BeaconStateT, KVStoreT, ForkT,
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ValidatorT, WithdrawalCredentialsT,
+]) HashTreeRoot() ([32]byte, error) {
+	st, err := s.beaconState()
 	if err != nil {
 		return [32]byte{}, err
 	}
 	return st.HashTreeRoot()
 }
+
+// FieldRoots returns the hash tree roots of the top-level fields of the
+// state, in the order they are merkleized in.
+func (s *StateDB[
+	BeaconStateT, KVStoreT, ForkT,
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ValidatorT, WithdrawalCredentialsT,
+]) FieldRoots() ([]core.StateFieldRoot, error) {
+	st, err := s.beaconState()
+	if err != nil {
+		return nil, err
+	}
+	roots, err := st.FieldRoots()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]core.StateFieldRoot, len(roots))
+	for i, root := range roots {
+		fields[i] = core.StateFieldRoot{Name: root.Name, Root: root.Root}
+	}
+	return fields, nil
+}
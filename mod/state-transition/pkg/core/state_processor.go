@@ -21,6 +21,8 @@
 package core
 
 import (
+	"time"
+
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -28,6 +30,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 // StateProcessor is a basic Processor, which takes care of the
@@ -35,11 +38,13 @@ import (
 type StateProcessor[
 	BeaconBlockT BeaconBlock[
 		DepositT, BeaconBlockBodyT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT,
+		ProposerSlashingT, WithdrawalT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
 		BeaconBlockBodyT, DepositT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT,
+		ProposerSlashingT, WithdrawalT,
 	],
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
@@ -63,31 +68,42 @@ type StateProcessor[
 		New(primitives.Version, primitives.Version, math.Epoch) ForkT
 	},
 	ForkDataT ForkData[ForkDataT],
+	ProposerSlashingT ProposerSlashing[BeaconBlockHeaderT],
 	ValidatorT Validator[ValidatorT, WithdrawalCredentialsT],
 	WithdrawalT Withdrawal[WithdrawalT],
-	WithdrawalCredentialsT ~[32]byte,
+	WithdrawalCredentialsT interface {
+		~[32]byte
+		WithdrawalCredentials
+	},
 ] struct {
 	// cs is the chain specification for the beacon chain.
 	cs primitives.ChainSpec
 	// signer is the BLS signer used for cryptographic operations.
 	signer crypto.BLSSigner
+	// batchVerifier verifies the signatures of a block at once.
+	batchVerifier crypto.BatchVerifier
 	// executionEngine is the engine responsible for executing transactions.
 	executionEngine ExecutionEngine[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
 	]
+	// diagnoseStateRoots reports the roots of the fields of the state on a
+	// state root mismatch.
+	diagnoseStateRoots bool
+	// metrics is the metrics for the state processor.
+	metrics *stateProcessorMetrics
 }
 
 // NewStateProcessor creates a new state processor.
 func NewStateProcessor[
 	BeaconBlockT BeaconBlock[
 		DepositT, BeaconBlockBodyT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT,
+		ProposerSlashingT, WithdrawalT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT,
-		DepositT, ExecutionPayloadT,
-		ExecutionPayloadHeaderT,
-		WithdrawalT,
+		BeaconBlockBodyT, DepositT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT,
+		ProposerSlashingT, WithdrawalT,
 	],
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
@@ -109,31 +125,42 @@ func NewStateProcessor[
 		New(primitives.Version, primitives.Version, math.Epoch) ForkT
 	},
 	ForkDataT ForkData[ForkDataT],
+	ProposerSlashingT ProposerSlashing[BeaconBlockHeaderT],
 	ValidatorT Validator[ValidatorT, WithdrawalCredentialsT],
 	WithdrawalT Withdrawal[WithdrawalT],
-	WithdrawalCredentialsT ~[32]byte,
+	WithdrawalCredentialsT interface {
+		~[32]byte
+		WithdrawalCredentials
+	},
 ](
 	cs primitives.ChainSpec,
 	executionEngine ExecutionEngine[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
 	],
 	signer crypto.BLSSigner,
+	batchVerifier crypto.BatchVerifier,
+	telemetrySink TelemetrySink,
+	diagnoseStateRoots bool,
 ) *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ] {
 	return &StateProcessor[
 		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 		BeaconStateT, BlobSidecarsT, ContextT,
 		DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-		ForkT, ForkDataT, ValidatorT, WithdrawalT,
+		ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
 		WithdrawalCredentialsT,
 	]{
-		cs:              cs,
-		executionEngine: executionEngine,
-		signer:          signer,
+		cs:                 cs,
+		executionEngine:    executionEngine,
+		signer:             signer,
+		batchVerifier:      batchVerifier,
+		diagnoseStateRoots: diagnoseStateRoots,
+		metrics:            newStateProcessorMetrics(telemetrySink),
 	}
 }
 
@@ -142,7 +169,8 @@ func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) Transition(
 	ctx ContextT,
 	st BeaconStateT,
@@ -153,10 +181,12 @@ func (sp *StateProcessor[
 	}
 
 	// Process the slots.
+	start := time.Now()
 	validatorUpdates, err := sp.ProcessSlots(st, blk.GetSlot())
 	if err != nil {
 		return nil, err
 	}
+	sp.metrics.measureProcessSlotsDuration(start)
 
 	// Process the block.
 	if err = sp.ProcessBlock(ctx, st, blk); err != nil {
@@ -169,11 +199,27 @@ func (sp *StateProcessor[
 	return validatorUpdates, nil
 }
 
+// GetBeaconProposerIndex returns the proposer of slot, as computed by
+// GetBeaconProposerIndex. The state must be at the epoch of slot.
 func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
+]) GetBeaconProposerIndex(
+	st BeaconStateT,
+	slot math.Slot,
+) (math.ValidatorIndex, error) {
+	return GetBeaconProposerIndex[ValidatorT](st, sp.cs, slot)
+}
+
+func (sp *StateProcessor[
+	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) ProcessSlots(
 	st BeaconStateT, slot math.U64,
 ) ([]*transition.ValidatorUpdate, error) {
@@ -221,7 +267,8 @@ func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) processSlot(
 	st BeaconStateT,
 ) error {
@@ -281,51 +328,68 @@ func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) ProcessBlock(
 	ctx ContextT,
 	st BeaconStateT,
 	blk BeaconBlockT,
 ) error {
+	// verify the signatures of the block at once, before any of it is
+	// applied to the state.
+	start := time.Now()
+	if !ctx.GetSkipSignatureVerification() {
+		if err := sp.verifySignatures(
+			st, blk, ctx.GetSkipValidateRandao(),
+		); err != nil {
+			return err
+		}
+		sp.metrics.measureVerifySignaturesDuration(start)
+	}
+
 	// process the freshly created header.
+	start = time.Now()
 	if err := sp.processBlockHeader(st, blk); err != nil {
 		return err
 	}
+	sp.metrics.measureProcessBlockHeaderDuration(start)
 
 	// process the execution payload.
+	start = time.Now()
 	if err := sp.processExecutionPayload(
 		ctx, st, blk,
 	); err != nil {
 		return err
 	}
+	sp.metrics.measureProcessExecutionPayloadDuration(start)
 
 	// process the withdrawals.
+	start = time.Now()
 	if err := sp.processWithdrawals(
 		st, blk.GetBody(),
 	); err != nil {
 		return err
 	}
-
-	// TODO:
-	//
-	// phase0.ProcessProposerSlashings
-	// phase0.ProcessAttesterSlashings
+	sp.metrics.measureProcessWithdrawalsDuration(start)
 
 	// process the randao reveal.
-	if err := sp.processRandaoReveal(
-		st, blk, ctx.GetSkipValidateRandao(),
-	); err != nil {
+	start = time.Now()
+	if err := sp.processRandaoReveal(st, blk); err != nil {
 		return err
 	}
+	sp.metrics.measureProcessRandaoRevealDuration(start)
 
 	// TODO:
 	//
 	// phase0.ProcessEth1Vote
 
-	// process the deposits and ensure they match the local state.
+	// process the slashings and deposits and ensure they match the local
+	// state.
+	start = time.Now()
 	if err := sp.processOperations(st, blk); err != nil {
 		return err
 	}
+	sp.metrics.measureProcessOperationsDuration(start)
 
 	// If we are skipping validate, we can skip calculating the state
 	// root to save compute.
@@ -335,15 +399,11 @@ func (sp *StateProcessor[
 
 	// Ensure the calculated state root matches the state root on
 	// the block.
-	if stateRoot, err := st.HashTreeRoot(); err != nil {
+	start = time.Now()
+	if err := sp.verifyStateRoot(st, blk); err != nil {
 		return err
-	} else if blk.GetStateRoot() != stateRoot {
-		return errors.Wrapf(
-			ErrStateRootMismatch, "expected %s, got %s",
-			primitives.Root(stateRoot), blk.GetStateRoot(),
-		)
 	}
-
+	sp.metrics.measureStateRootDuration(start)
 	return nil
 }
 
@@ -352,13 +412,35 @@ func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) processEpoch(
 	st BeaconStateT,
 ) ([]*transition.ValidatorUpdate, error) {
-	if err := sp.processRewardsAndPenalties(st); err != nil {
+	slot, err := st.GetSlot()
+	if err != nil {
 		return nil, err
-	} else if err = sp.processSlashingsReset(st); err != nil {
+	}
+	if err = sp.processRewardsAndPenalties(st); err != nil {
+		return nil, err
+	}
+	// The registry and effective balance updates change the validator set,
+	// they are only processed from the Electra fork on so that the epochs
+	// before it are processed as they were.
+	if version.IsAtLeast(
+		sp.cs.ActiveForkVersionForSlot(slot), version.Electra,
+	) {
+		if err = ProcessRegistryUpdates[ValidatorT](
+			st, sp.cs,
+		); err != nil {
+			return nil, err
+		} else if err = ProcessEffectiveBalanceUpdates[ValidatorT](
+			st, sp.cs,
+		); err != nil {
+			return nil, err
+		}
+	}
+	if err = sp.processSlashingsReset(st); err != nil {
 		return nil, err
 	} else if err = sp.processRandaoMixesReset(st); err != nil {
 		return nil, err
@@ -372,7 +454,8 @@ func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) processBlockHeader(
 	st BeaconStateT,
 	blk BeaconBlockT,
@@ -461,16 +544,17 @@ func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) getAttestationDeltas(
 	st BeaconStateT,
 ) ([]math.Gwei, []math.Gwei, error) {
 	// TODO: implement this function forreal
-	validators, err := st.GetValidators()
+	totalValidators, err := st.GetTotalValidators()
 	if err != nil {
 		return nil, nil, err
 	}
-	placeholder := make([]math.Gwei, len(validators))
+	placeholder := make([]math.Gwei, totalValidators)
 	return placeholder, placeholder, nil
 }
 
@@ -482,7 +566,8 @@ func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) processRewardsAndPenalties(
 	st BeaconStateT,
 ) error {
@@ -500,24 +585,24 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	validators, err := st.GetValidators()
+	totalValidators, err := st.GetTotalValidators()
 	if err != nil {
 		return err
 	}
 
-	if len(validators) != len(rewards) {
+	if totalValidators != uint64(len(rewards)) {
 		return errors.Wrapf(
 			ErrRewardsLengthMismatch, "expected: %d, got: %d",
-			len(validators), len(rewards),
+			totalValidators, len(rewards),
 		)
-	} else if len(validators) != len(penalties) {
+	} else if totalValidators != uint64(len(penalties)) {
 		return errors.Wrapf(
 			ErrPenaltiesLengthMismatch, "expected: %d, got: %d",
-			len(validators), len(penalties),
+			totalValidators, len(penalties),
 		)
 	}
 
-	for i := range validators {
+	for i := range totalValidators {
 		// Increase the balance of the validator.
 		if err = st.IncreaseBalance(
 			math.ValidatorIndex(i),
@@ -21,6 +21,7 @@
 package core
 
 import (
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -38,7 +39,8 @@ func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) InitializePreminedBeaconStateFromEth1(
 	st BeaconStateT,
 	deposits []DepositT,
@@ -51,6 +53,12 @@ func (sp *StateProcessor[
 		fork      ForkT
 		eth1Data  Eth1DataT
 	)
+	if !version.IsKnown(version.ToUint32(genesisVersion)) {
+		return nil, errors.Wrapf(
+			ErrUnknownGenesisVersion, "%s", genesisVersion,
+		)
+	}
+
 	fork = fork.New(
 		genesisVersion,
 		genesisVersion,
@@ -77,8 +85,6 @@ func (sp *StateProcessor[
 		return nil, err
 	}
 
-	// TODO: we need to handle primitives.Version vs
-	// uint32 better.
 	bodyRoot, err := blkBody.Empty(
 		version.ToUint32(genesisVersion)).HashTreeRoot()
 	if err != nil {
@@ -105,6 +111,20 @@ func (sp *StateProcessor[
 		return nil, err
 	}
 
+	// The signatures of the deposits are verified at once, before the
+	// validators are created.
+	batch := NewSignatureBatch()
+	if err = CollectDepositSignatures[
+		DepositT, ForkDataT, WithdrawalCredentialsT,
+	](st, sp.cs, batch, deposits); err != nil {
+		return nil, err
+	}
+	if err = batch.Verify(
+		sp.batchVerifier, sp.signer.VerifySignature,
+	); err != nil {
+		return nil, err
+	}
+
 	for _, deposit := range deposits {
 		// TODO: process deposits into eth1 data.
 		if err = sp.processDeposit(st, deposit); err != nil {
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import "time"
+
+// stateProcessorMetrics is a struct that contains metrics for the state
+// processor.
+type stateProcessorMetrics struct {
+	// sink is the sink for the metrics.
+	sink TelemetrySink
+}
+
+// newStateProcessorMetrics creates a new stateProcessorMetrics.
+func newStateProcessorMetrics(
+	sink TelemetrySink,
+) *stateProcessorMetrics {
+	return &stateProcessorMetrics{
+		sink: sink,
+	}
+}
+
+// measureProcessSlotsDuration measures the duration of processing the slots
+// up to the slot of a block.
+func (m *stateProcessorMetrics) measureProcessSlotsDuration(
+	start time.Time,
+) {
+	m.sink.MeasureSince(
+		"beacon_kit.state_processor.process_slots_duration", start,
+	)
+}
+
+// measureVerifySignaturesDuration measures the duration of verifying the
+// signatures of a block.
+func (m *stateProcessorMetrics) measureVerifySignaturesDuration(
+	start time.Time,
+) {
+	m.sink.MeasureSince(
+		"beacon_kit.state_processor.verify_signatures_duration", start,
+	)
+}
+
+// measureProcessBlockHeaderDuration measures the duration of processing the
+// header of a block.
+func (m *stateProcessorMetrics) measureProcessBlockHeaderDuration(
+	start time.Time,
+) {
+	m.sink.MeasureSince(
+		"beacon_kit.state_processor.process_block_header_duration", start,
+	)
+}
+
+// measureProcessExecutionPayloadDuration measures the duration of processing
+// the execution payload of a block, including its verification by the
+// execution client.
+func (m *stateProcessorMetrics) measureProcessExecutionPayloadDuration(
+	start time.Time,
+) {
+	m.sink.MeasureSince(
+		"beacon_kit.state_processor.process_execution_payload_duration", start,
+	)
+}
+
+// measureProcessWithdrawalsDuration measures the duration of validating and
+// processing the withdrawals of a block.
+func (m *stateProcessorMetrics) measureProcessWithdrawalsDuration(
+	start time.Time,
+) {
+	m.sink.MeasureSince(
+		"beacon_kit.state_processor.process_withdrawals_duration", start,
+	)
+}
+
+// measureProcessRandaoRevealDuration measures the duration of processing the
+// randao reveal of a block.
+func (m *stateProcessorMetrics) measureProcessRandaoRevealDuration(
+	start time.Time,
+) {
+	m.sink.MeasureSince(
+		"beacon_kit.state_processor.process_randao_reveal_duration", start,
+	)
+}
+
+// measureProcessOperationsDuration measures the duration of processing the
+// slashings and deposits of a block.
+func (m *stateProcessorMetrics) measureProcessOperationsDuration(
+	start time.Time,
+) {
+	m.sink.MeasureSince(
+		"beacon_kit.state_processor.process_operations_duration", start,
+	)
+}
+
+// measureStateRootDuration measures the duration of computing the state root
+// a block is verified against.
+func (m *stateProcessorMetrics) measureStateRootDuration(start time.Time) {
+	m.sink.MeasureSince(
+		"beacon_kit.state_processor.state_root_duration", start,
+	)
+}
+
+// incrementDepositsProcessed increments the number of deposits processed.
+func (m *stateProcessorMetrics) incrementDepositsProcessed() {
+	m.sink.IncrementCounter("beacon_kit.state_processor.deposits_processed")
+}
+
+// incrementWithdrawalsProcessed increments the number of withdrawals
+// processed.
+func (m *stateProcessorMetrics) incrementWithdrawalsProcessed() {
+	m.sink.IncrementCounter(
+		"beacon_kit.state_processor.withdrawals_processed",
+	)
+}
+
+// incrementProposerSlashingsProcessed increments the number of proposer
+// slashings processed.
+func (m *stateProcessorMetrics) incrementProposerSlashingsProcessed() {
+	m.sink.IncrementCounter(
+		"beacon_kit.state_processor.proposer_slashings_processed",
+	)
+}
@@ -34,7 +34,8 @@ func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) processExecutionPayload(
 	ctx ContextT,
 	st BeaconStateT,
@@ -78,7 +79,8 @@ func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) validateExecutionPayload(
 	ctx context.Context,
 	st BeaconStateT,
@@ -108,6 +110,14 @@ func (sp *StateProcessor[
 		)
 	}
 
+	// The timestamp of the payload is checked before the payload is sent to
+	// the execution client, which might accept it optimistically.
+	if err = engineprimitives.VerifyPayloadTimestamp(
+		sp.cs, blk.GetSlot(), uint64(payload.GetTimestamp()),
+	); err != nil {
+		return err
+	}
+
 	parentBeaconBlockRoot := blk.GetParentBlockRoot()
 	if err = sp.executionEngine.VerifyAndNotifyNewPayload(
 		ctx, engineprimitives.BuildNewPayloadRequest(
@@ -143,37 +153,22 @@ func (sp *StateProcessor[
 		)
 	}
 
-	// TODO: Verify timestamp data once Clock is done.
-	// if expectedTime, err := spec.TimeAtSlot(slot, genesisTime); err !=
-	// nil { 	return errors.Newf("slot or genesis time in state is corrupt,
-	// cannot
-	// compute time: %v", err)
-	// } else if payload.Timestamp != expectedTime {
-	// 	return errors.Newf("state at slot %d, genesis time %d, expected
-	// execution
-	// payload time %d, but got %d",
-	// 		slot, genesisTime, expectedTime, payload.Timestamp)
-	// }
-
 	// Verify the number of blobs.
+	forkVersion := sp.cs.ActiveForkVersionForSlot(slot)
 	blobKzgCommitments := body.GetBlobKzgCommitments()
-	if uint64(len(blobKzgCommitments)) > sp.cs.MaxBlobsPerBlock() {
+	if maxBlobs := sp.cs.MaxBlobsPerBlock(forkVersion); uint64(
+		len(blobKzgCommitments),
+	) > maxBlobs {
 		return errors.Wrapf(
 			ErrExceedsBlockBlobLimit,
 			"expected: %d, got: %d",
-			sp.cs.MaxBlobsPerBlock(), len(blobKzgCommitments),
+			maxBlobs, len(blobKzgCommitments),
 		)
 	}
 
-	// Verify the number of withdrawals.
+	// Verify the number of withdrawals and their indices.
 	// TODO: This is in the wrong spot I think.
-	if withdrawals := payload.GetWithdrawals(); uint64(
-		len(payload.GetWithdrawals()),
-	) > sp.cs.MaxWithdrawalsPerPayload() {
-		return errors.Newf(
-			"too many withdrawals, expected: %d, got: %d",
-			sp.cs.MaxWithdrawalsPerPayload(), len(withdrawals),
-		)
-	}
-	return nil
+	return engineprimitives.ValidateWithdrawals(
+		payload.GetWithdrawals(), sp.cs.MaxWithdrawalsPerPayload(forkVersion),
+	)
 }
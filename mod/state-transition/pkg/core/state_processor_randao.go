@@ -20,86 +20,22 @@
 
 package core
 
-import (
-	"crypto/sha256"
-
-	"github.com/berachain/beacon-kit/mod/primitives"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
-	"github.com/go-faster/xor"
-)
-
-// processRandaoReveal processes the randao reveal and
-// ensures it matches the local state.
+// processRandaoReveal mixes the randao reveal of the block into the randao
+// mix of the epoch. Its signature is verified with the other signatures of
+// the block, by verifySignatures.
 func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) processRandaoReveal(
 	st BeaconStateT,
 	blk BeaconBlockT,
-	skipVerification bool,
 ) error {
-	slot, err := st.GetSlot()
-	if err != nil {
-		return err
-	}
-
-	// Ensure the proposer index is valid.
-	proposer, err := st.ValidatorByIndex(blk.GetProposerIndex())
-	if err != nil {
-		return err
-	}
-
-	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
-	if err != nil {
-		return err
-	}
-
-	epoch := sp.cs.SlotToEpoch(slot)
-	body := blk.GetBody()
-
-	var fd ForkDataT
-	fd = fd.New(
-		version.FromUint32[primitives.Version](
-			sp.cs.ActiveForkVersionForEpoch(epoch),
-		), genesisValidatorsRoot,
-	)
-
-	if !skipVerification {
-		var signingRoot primitives.Root
-		signingRoot, err = fd.ComputeRandaoSigningRoot(
-			sp.cs.DomainTypeRandao(), epoch)
-		if err != nil {
-			return err
-		}
-
-		reveal := body.GetRandaoReveal()
-		if err = sp.signer.VerifySignature(
-			proposer.GetPubkey(),
-			signingRoot[:],
-			reveal,
-		); err != nil {
-			return err
-		}
-	}
-
-	prevMix, err := st.GetRandaoMixAtIndex(
-		uint64(epoch) % sp.cs.EpochsPerHistoricalVector(),
-	)
-	if err != nil {
-		return err
-	}
-
-	mix, err := sp.buildRandaoMix(prevMix, body.GetRandaoReveal())
-	if err != nil {
-		return err
-	}
-
-	return st.UpdateRandaoMixAtIndex(
-		uint64(epoch)%sp.cs.EpochsPerHistoricalVector(), mix,
+	return ProcessRandao[ForkDataT, ValidatorT](
+		st, sp.cs, verifiedSignature,
+		blk.GetProposerIndex(), blk.GetBody().GetRandaoReveal(),
 	)
 }
 
@@ -111,7 +47,8 @@ func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) processRandaoMixesReset(
 	st BeaconStateT,
 ) error {
@@ -132,24 +69,3 @@ func (sp *StateProcessor[
 		mix,
 	)
 }
-
-// buildRandaoMix as defined in the Ethereum 2.0 specification.
-func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
-	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
-]) buildRandaoMix(
-	mix primitives.Bytes32,
-	reveal crypto.BLSSignature,
-) (primitives.Bytes32, error) {
-	newMix := make([]byte, constants.RootLength)
-	revealHash := sha256.Sum256(reveal[:])
-	// Apparently this library giga fast? Good project? lmeow.
-	if numXor := xor.Bytes(
-		newMix, mix[:], revealHash[:],
-	); numXor != constants.RootLength {
-		return primitives.Bytes32{}, ErrXorInvalid
-	}
-	return primitives.Bytes32(newMix), nil
-}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
+
+// verifySignatures verifies the signatures of the block at once: its randao
+// reveal, unless skipValidateRandao is set, the headers of its proposer
+// slashings and the deposits creating a validator. The block itself is not
+// signed, as it is the CometBFT proposal that is.
+func (sp *StateProcessor[
+	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
+]) verifySignatures(
+	st BeaconStateT,
+	blk BeaconBlockT,
+	skipValidateRandao bool,
+) error {
+	var (
+		batch = NewSignatureBatch()
+		body  = blk.GetBody()
+	)
+	if !skipValidateRandao {
+		if err := VerifyRandaoReveal[ForkDataT](
+			st, sp.cs, batch.Add("randao reveal"),
+			blk.GetProposerIndex(), body.GetRandaoReveal(),
+		); err != nil {
+			return err
+		}
+	}
+
+	for i, ps := range body.GetProposerSlashings() {
+		if err := VerifyProposerSlashingSignatures[
+			BeaconBlockHeaderT, ForkDataT, ValidatorT,
+		](
+			st, sp.cs,
+			batch.Add(fmt.Sprintf("proposer slashing %d", i)), ps,
+		); err != nil {
+			return err
+		}
+	}
+
+	if err := CollectDepositSignatures[
+		DepositT, ForkDataT, WithdrawalCredentialsT,
+	](st, sp.cs, batch, body.GetDeposits()); err != nil {
+		return err
+	}
+
+	return batch.Verify(sp.batchVerifier, sp.signer.VerifySignature)
+}
+
+// verifiedSignature is the signature verification function of the
+// operations of a block, whose signatures are verified beforehand by
+// verifySignatures.
+func verifiedSignature(crypto.BLSPubkey, []byte, crypto.BLSSignature) error {
+	return nil
+}
@@ -21,6 +21,8 @@
 package core
 
 import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -32,7 +34,8 @@ func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) processSlashingsReset(
 	st BeaconStateT,
 ) error {
@@ -46,19 +49,36 @@ func (sp *StateProcessor[
 	return st.UpdateSlashingAtIndex(index, 0)
 }
 
-// processProposerSlashing as defined in the Ethereum 2.0 specification.
-// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#proposer-slashings
-//
-//nolint:lll,unused // will be used later
+// processProposerSlashings processes the proposer slashings of the block,
+// rewarding its proposer as the whistleblower.
 func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
-]) processProposerSlashing(
-	_ BeaconStateT,
-	// ps ProposerSlashing,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
+]) processProposerSlashings(
+	st BeaconStateT,
+	blk BeaconBlockT,
 ) error {
+	slashings := blk.GetBody().GetProposerSlashings()
+	if uint64(len(slashings)) > constants.MaxProposerSlashingsPerBlock {
+		return errors.Wrapf(
+			ErrExceedsBlockProposerSlashingLimit,
+			"%d > %d", len(slashings), constants.MaxProposerSlashingsPerBlock,
+		)
+	}
+
+	for _, ps := range slashings {
+		if err := ProcessProposerSlashing[
+			BeaconBlockHeaderT, ForkDataT, ValidatorT,
+		](
+			st, sp.cs, verifiedSignature, ps, blk.GetProposerIndex(),
+		); err != nil {
+			return err
+		}
+		sp.metrics.incrementProposerSlashingsProcessed()
+	}
 	return nil
 }
 
@@ -70,7 +90,8 @@ func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) processAttesterSlashing(
 	_ BeaconStateT,
 	// as AttesterSlashing,
@@ -89,7 +110,8 @@ func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) processSlashings(
 	st BeaconStateT,
 ) error {
@@ -108,11 +130,6 @@ func (sp *StateProcessor[
 		uint64(totalBalance),
 	)
 
-	vals, err := st.GetValidators()
-	if err != nil {
-		return err
-	}
-
 	// Get the current slot.
 	slot, err := st.GetSlot()
 	if err != nil {
@@ -123,19 +140,19 @@ func (sp *StateProcessor[
 	slashableEpoch := (uint64(sp.cs.SlotToEpoch(slot)) + sp.cs.EpochsPerSlashingsVector()) / 2
 
 	// Iterate through the validators and slash if needed.
-	for _, val := range vals {
-		if val.IsSlashed() &&
-			(slashableEpoch == uint64(val.GetWithdrawableEpoch())) {
-			if err = sp.processSlash(
-				st, val,
-				adjustedTotalSlashingBalance,
-				uint64(totalBalance),
-			); err != nil {
-				return err
+	return st.IterateValidators(
+		func(_ math.ValidatorIndex, val ValidatorT) (bool, error) {
+			if val.IsSlashed() &&
+				(slashableEpoch == uint64(val.GetWithdrawableEpoch())) {
+				return false, sp.processSlash(
+					st, val,
+					adjustedTotalSlashingBalance,
+					uint64(totalBalance),
+				)
 			}
-		}
-	}
-	return nil
+			return false, nil
+		},
+	)
 }
 
 // processSlash handles the logic for slashing a validator.
@@ -145,7 +162,8 @@ func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) processSlash(
 	st BeaconStateT,
 	val ValidatorT,
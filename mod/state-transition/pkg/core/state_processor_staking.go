@@ -21,24 +21,30 @@
 package core
 
 import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/errors"
-	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/davecgh/go-spew/spew"
 )
 
 // processOperations processes the operations and ensures they match the
-// local state.
+// local state. Attester slashings have no equivalent, as the votes are
+// CometBFT's, which handles the evidence of duplicate votes itself.
 func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) processOperations(
 	st BeaconStateT,
 	blk BeaconBlockT,
 ) error {
+	if err := sp.processProposerSlashings(st, blk); err != nil {
+		return err
+	}
+
 	// Verify that outstanding deposits are processed up to the maximum number
 	// of deposits.
 	deposits := blk.GetBody().GetDeposits()
@@ -68,7 +74,8 @@ func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) processDeposits(
 	st BeaconStateT,
 	deposits []DepositT,
@@ -78,6 +85,7 @@ func (sp *StateProcessor[
 		if err := sp.processDeposit(st, dep); err != nil {
 			return err
 		}
+		sp.metrics.incrementDepositsProcessed()
 	}
 	return nil
 }
@@ -87,7 +95,8 @@ func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) processDeposit(
 	st BeaconStateT,
 	dep DepositT,
@@ -122,7 +131,8 @@ func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) applyDeposit(
 	st BeaconStateT,
 	dep DepositT,
@@ -142,56 +152,8 @@ func (sp *StateProcessor[
 		return st.UpdateValidatorAtIndex(idx, val)
 	}
 
-	// If the validator does not exist, we add the validator.
-	// Add the validator to the registry.
-	return sp.createValidator(st, dep)
-}
-
-// createValidator creates a validator if the deposit is valid.
-func (sp *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, ContextT,
-	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
-]) createValidator(
-	st BeaconStateT,
-	dep DepositT,
-) error {
-	var (
-		genesisValidatorsRoot primitives.Root
-		epoch                 math.Epoch
-		err                   error
-	)
-
-	// Get the genesis validators root to be used to find fork data later.
-	genesisValidatorsRoot, err = st.GetGenesisValidatorsRoot()
-	if err != nil {
-		return err
-	}
-
-	// Get the current epoch.
-	// Get the current slot.
-	slot, err := st.GetSlot()
-	if err != nil {
-		return err
-	}
-	epoch = sp.cs.SlotToEpoch(slot)
-
-	// Verify that the message was signed correctly.
-	var d ForkDataT
-	if err = dep.VerifySignature(
-		d.New(
-			version.FromUint32[primitives.Version](
-				sp.cs.ActiveForkVersionForEpoch(epoch),
-			), genesisValidatorsRoot,
-		),
-		sp.cs.DomainTypeDeposit(),
-		sp.signer.VerifySignature,
-	); err != nil {
-		return err
-	}
-
-	// Add the validator to the registry.
+	// If the validator does not exist, we add the validator. The signature
+	// of the deposit is verified beforehand, with CollectDepositSignatures.
 	return sp.addValidatorToRegistry(st, dep)
 }
 
@@ -200,7 +162,8 @@ func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) addValidatorToRegistry(
 	st BeaconStateT,
 	dep DepositT,
@@ -234,7 +197,8 @@ func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) processWithdrawals(
 	st BeaconStateT,
 	body BeaconBlockBodyT,
@@ -247,7 +211,9 @@ func (sp *StateProcessor[
 	)
 
 	// Get the expected withdrawals.
-	expectedWithdrawals, err := st.ExpectedWithdrawals()
+	expectedWithdrawals, err := ExpectedWithdrawals[
+		ValidatorT, WithdrawalCredentialsT,
+	](st, sp.cs)
 	if err != nil {
 		return err
 	}
@@ -264,7 +230,7 @@ func (sp *StateProcessor[
 	// Compare and process each withdrawal.
 	for i, wd := range expectedWithdrawals {
 		// Ensure the withdrawals match the local state.
-		if !wd.Equals(payloadWithdrawals[i]) {
+		if !withdrawalEquals(wd, payloadWithdrawals[i]) {
 			return errors.Newf(
 				"withdrawals do not match expected %s, got %s",
 				spew.Sdump(wd), spew.Sdump(payloadWithdrawals[i]),
@@ -277,6 +243,7 @@ func (sp *StateProcessor[
 		); err != nil {
 			return err
 		}
+		sp.metrics.incrementWithdrawalsProcessed()
 	}
 
 	// Update the next withdrawal index if this block contained withdrawals
@@ -294,13 +261,25 @@ func (sp *StateProcessor[
 		return err
 	}
 
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
 	// Update the next validator index to start the next withdrawal sweep
+	forkVersion := sp.cs.ActiveForkVersionForSlot(slot)
 	//#nosec:G701 // won't overflow in practice.
-	if numWithdrawals == int(sp.cs.MaxWithdrawalsPerPayload()) {
-		// Next sweep starts after the latest withdrawal's validator index
-		nextValidatorIndex =
-			(expectedWithdrawals[len(expectedWithdrawals)-1].GetIndex() + 1) %
-				math.U64(totalValidators)
+	if numWithdrawals == int(sp.cs.MaxWithdrawalsPerPayload(forkVersion)) {
+		// Next sweep starts after the latest withdrawal's validator index.
+		// Before Electra, the chain has advanced it from the withdrawal
+		// index instead.
+		last := expectedWithdrawals[numWithdrawals-1]
+		nextValidatorIndex = last.GetValidatorIndex()
+		if !version.IsAtLeast(forkVersion, version.Electra) {
+			nextValidatorIndex = math.ValidatorIndex(last.GetIndex())
+		}
+		nextValidatorIndex = (nextValidatorIndex + 1) %
+			math.ValidatorIndex(totalValidators)
 	} else {
 		// Advance sweep by the max length of the sweep if there was not
 		// a full set of withdrawals
@@ -315,3 +294,15 @@ func (sp *StateProcessor[
 
 	return st.SetNextWithdrawalValidatorIndex(nextValidatorIndex)
 }
+
+// withdrawalEquals returns whether the withdrawal of a payload is the
+// expected one.
+func withdrawalEquals[WithdrawalT Withdrawal[WithdrawalT]](
+	expected *engineprimitives.Withdrawal,
+	wd WithdrawalT,
+) bool {
+	return expected.GetIndex() == wd.GetIndex() &&
+		expected.GetValidatorIndex() == wd.GetValidatorIndex() &&
+		expected.GetAddress() == wd.GetAddress() &&
+		expected.GetAmount() == wd.GetAmount()
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// StateFieldRoot is the hash tree root of a top-level field of the state.
+type StateFieldRoot struct {
+	// Name is the name of the field.
+	Name string
+	// Root is the hash tree root of the field.
+	Root common.Root
+}
+
+// StateRootMismatchError is the report of a state root mismatch, returned
+// instead of ErrStateRootMismatch when the state root diagnostics are
+// enabled. It holds the roots of the fields of the computed state, for them
+// to be compared against the ones of a reference state.
+type StateRootMismatchError struct {
+	// Computed is the state root computed by the processor.
+	Computed common.Root
+	// Block is the state root of the block.
+	Block common.Root
+	// Fields are the roots of the fields of the computed state, in the order
+	// they are merkleized in.
+	Fields []StateFieldRoot
+}
+
+// Error implements error.
+func (e *StateRootMismatchError) Error() string {
+	fields := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		fields[i] = fmt.Sprintf("%s=%s", field.Name, field.Root)
+	}
+	return fmt.Sprintf(
+		"%s: expected %s, got %s, field roots: %s",
+		ErrStateRootMismatch, e.Computed, e.Block, strings.Join(fields, " "),
+	)
+}
+
+// Unwrap returns ErrStateRootMismatch, for the report to be matched with
+// errors.Is.
+func (e *StateRootMismatchError) Unwrap() error {
+	return ErrStateRootMismatch
+}
+
+// Diff returns the names of the fields whose roots differ from the ones of
+// the reference, in the order they are merkleized in. A field missing from
+// the reference differs.
+func (e *StateRootMismatchError) Diff(reference []StateFieldRoot) []string {
+	roots := make(map[string]common.Root, len(reference))
+	for _, field := range reference {
+		roots[field.Name] = field.Root
+	}
+
+	var differing []string
+	for _, field := range e.Fields {
+		if root, ok := roots[field.Name]; !ok || root != field.Root {
+			differing = append(differing, field.Name)
+		}
+	}
+	return differing
+}
+
+// verifyStateRoot checks the state root of a block against the hash tree
+// root of the state. On a mismatch, the roots of the fields of the state are
+// reported if the state root diagnostics are enabled.
+func (sp *StateProcessor[
+	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, WithdrawalT,
+	WithdrawalCredentialsT,
+]) verifyStateRoot(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) error {
+	stateRoot, err := st.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if blk.GetStateRoot() == stateRoot {
+		return nil
+	}
+	if !sp.diagnoseStateRoots {
+		return errors.Wrapf(
+			ErrStateRootMismatch, "expected %s, got %s",
+			common.Root(stateRoot), blk.GetStateRoot(),
+		)
+	}
+
+	fields, err := st.FieldRoots()
+	if err != nil {
+		return err
+	}
+	return &StateRootMismatchError{
+		Computed: stateRoot,
+		Block:    blk.GetStateRoot(),
+		Fields:   fields,
+	}
+}
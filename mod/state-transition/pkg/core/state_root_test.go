@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateRootMismatchError(t *testing.T) {
+	report := &core.StateRootMismatchError{
+		Computed: common.Root{0x01},
+		Block:    common.Root{0x02},
+		Fields: []core.StateFieldRoot{
+			{Name: "slot", Root: common.Root{0x03}},
+			{Name: "validators", Root: common.Root{0x04}},
+			{Name: "balances", Root: common.Root{0x05}},
+		},
+	}
+	require.ErrorIs(t, report, core.ErrStateRootMismatch)
+	require.ErrorContains(t, report, "validators=0x04")
+
+	tests := []struct {
+		name      string
+		reference []core.StateFieldRoot
+		differing []string
+	}{
+		{
+			name:      "same",
+			reference: report.Fields,
+		},
+		{
+			name: "different",
+			reference: []core.StateFieldRoot{
+				{Name: "slot", Root: common.Root{0x03}},
+				{Name: "validators", Root: common.Root{0x06}},
+				{Name: "balances", Root: common.Root{0x05}},
+			},
+			differing: []string{"validators"},
+		},
+		{
+			name: "missing",
+			reference: []core.StateFieldRoot{
+				{Name: "slot", Root: common.Root{0x03}},
+			},
+			differing: []string{"validators", "balances"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.differing, report.Diff(tt.reference))
+		})
+	}
+}
@@ -23,6 +23,7 @@ package core
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives"
@@ -54,12 +55,14 @@ type BeaconBlock[
 	DepositT any,
 	BeaconBlockBodyT BeaconBlockBody[
 		BeaconBlockBodyT, DepositT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT,
+		ProposerSlashingT, WithdrawalsT,
 	],
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
+	ProposerSlashingT any,
 	WithdrawalsT any,
 ] interface {
 	IsNil() bool
@@ -84,6 +87,7 @@ type BeaconBlockBody[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
 	],
 	ExecutionPayloadHeaderT interface{ GetBlockHash() common.ExecutionHash },
+	ProposerSlashingT any,
 	WithdrawalT any,
 ] interface {
 	// Empty returns an empty beacon block body.
@@ -92,6 +96,8 @@ type BeaconBlockBody[
 	GetRandaoReveal() crypto.BLSSignature
 	// GetExecutionPayload returns the execution payload.
 	GetExecutionPayload() ExecutionPayloadT
+	// GetProposerSlashings returns the list of proposer slashings.
+	GetProposerSlashings() []ProposerSlashingT
 	// GetDeposits returns the list of deposits.
 	GetDeposits() []DepositT
 	// HashTreeRoot returns the hash tree root of the block body.
@@ -122,6 +128,9 @@ type Context interface {
 	// GetSkipValidateResult returns whether to validate the result of the state
 	// transition.
 	GetSkipValidateResult() bool
+	// GetSkipSignatureVerification returns whether to skip verifying the
+	// signatures of the block.
+	GetSkipSignatureVerification() bool
 
 	// Unwrap returns the underlying golang standard library context.
 	Unwrap() context.Context
@@ -220,6 +229,8 @@ type ExecutionEngine[
 type ForkData[ForkDataT any] interface {
 	// New creates a new fork data object.
 	New(primitives.Version, primitives.Root) ForkDataT
+	// ComputeDomain returns the domain of the given type for the fork data.
+	ComputeDomain(domainType common.DomainType) (common.Domain, error)
 	// ComputeRandaoSigningRoot returns the signing root for the fork data.
 	ComputeRandaoSigningRoot(
 		domainType common.DomainType,
@@ -231,9 +242,14 @@ type ForkData[ForkDataT any] interface {
 // ValidatorT.
 type Validator[
 	ValidatorT any,
-	WithdrawalCredentialsT ~[32]byte,
+	WithdrawalCredentialsT interface {
+		~[32]byte
+		WithdrawalCredentials
+	},
 ] interface {
 	ssz.Marshallable
+	WithdrawalValidator[WithdrawalCredentialsT]
+	SlashingValidator
 	// New creates a new validator with the given parameters.
 	New(
 		pubkey crypto.BLSPubkey,
@@ -255,6 +271,24 @@ type Validator[
 	GetWithdrawableEpoch() math.Epoch
 }
 
+// ProposerSlashing is the interface for a proposer slashing.
+type ProposerSlashing[BeaconBlockHeaderT any] interface {
+	// GetHeaders returns the two headers signed by the proposer.
+	GetHeaders() (BeaconBlockHeaderT, BeaconBlockHeaderT)
+	// GetSignatures returns the signatures of the two headers.
+	GetSignatures() (crypto.BLSSignature, crypto.BLSSignature)
+}
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
+	// MeasureSince measures the time since the provided start time,
+	// identified by the provided keys.
+	MeasureSince(key string, start time.Time, args ...string)
+}
+
 // Withdrawal is the interface for a withdrawal.
 type Withdrawal[WithdrawalT any] interface {
 	// Equals returns true if the withdrawal is equal to the other.
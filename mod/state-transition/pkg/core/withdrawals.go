@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// WithdrawalCredentials are the credentials withdrawals are paid out to.
+type WithdrawalCredentials interface {
+	ToExecutionAddress() (common.ExecutionAddress, error)
+}
+
+// WithdrawalValidator is a validator withdrawals are swept from.
+type WithdrawalValidator[
+	WithdrawalCredentialsT WithdrawalCredentials,
+] interface {
+	GetWithdrawalCredentials() WithdrawalCredentialsT
+	IsFullyWithdrawable(balance math.Gwei, epoch math.Epoch) bool
+	IsPartiallyWithdrawable(balance, maxEffectiveBalance math.Gwei) bool
+}
+
+// WithdrawalState is the part of the beacon state withdrawals are swept
+// from.
+type WithdrawalState[ValidatorT any] interface {
+	GetSlot() (math.Slot, error)
+	GetNextWithdrawalIndex() (uint64, error)
+	GetNextWithdrawalValidatorIndex() (math.ValidatorIndex, error)
+	GetTotalValidators() (uint64, error)
+	ValidatorByIndex(index math.ValidatorIndex) (ValidatorT, error)
+	GetBalance(index math.ValidatorIndex) (math.Gwei, error)
+}
+
+// ExpectedWithdrawals as defined in the Ethereum 2.0 specification. The
+// validators are swept from the next withdrawal validator index, wrapping
+// past the last one, for at most MaxValidatorsPerWithdrawalsSweep
+// validators and until MaxWithdrawalsPerPayload withdrawals are found.
+// Before Electra, every swept validator gets a withdrawal, of a zero amount
+// if it is not withdrawable, as the chain has swept them so far.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#new-get_expected_withdrawals
+//
+//nolint:lll
+func ExpectedWithdrawals[
+	ValidatorT WithdrawalValidator[WithdrawalCredentialsT],
+	WithdrawalCredentialsT WithdrawalCredentials,
+](
+	st WithdrawalState[ValidatorT],
+	cs primitives.ChainSpec,
+) ([]*engineprimitives.Withdrawal, error) {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	epoch := cs.SlotToEpoch(slot)
+
+	withdrawalIndex, err := st.GetNextWithdrawalIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	validatorIndex, err := st.GetNextWithdrawalValidatorIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	totalValidators, err := st.GetTotalValidators()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		forkVersion         = cs.ActiveForkVersionForSlot(slot)
+		skipsZeroAmounts    = version.IsAtLeast(forkVersion, version.Electra)
+		maxEffectiveBalance = math.Gwei(cs.MaxEffectiveBalance())
+		maxWithdrawals      = cs.MaxWithdrawalsPerPayload(forkVersion)
+		withdrawals         = make([]*engineprimitives.Withdrawal, 0)
+	)
+	for range min(cs.MaxValidatorsPerWithdrawalsSweep(), totalValidators) {
+		validator, err := st.ValidatorByIndex(validatorIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		balance, err := st.GetBalance(validatorIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		var amount math.Gwei
+		switch {
+		case validator.IsFullyWithdrawable(balance, epoch):
+			amount = balance
+		case validator.IsPartiallyWithdrawable(balance, maxEffectiveBalance):
+			amount = balance - maxEffectiveBalance
+		}
+
+		// From Electra on, only the withdrawable validators are paid out,
+		// the others are skipped by the sweep.
+		if amount > 0 || !skipsZeroAmounts {
+			address, err := validator.GetWithdrawalCredentials().
+				ToExecutionAddress()
+			if err != nil {
+				return nil, err
+			}
+			withdrawals = append(withdrawals, &engineprimitives.Withdrawal{
+				Index:     math.U64(withdrawalIndex),
+				Validator: validatorIndex,
+				Address:   address,
+				Amount:    amount,
+			})
+			withdrawalIndex++
+		}
+
+		if uint64(len(withdrawals)) == maxWithdrawals {
+			break
+		}
+
+		validatorIndex = (validatorIndex + 1) % math.ValidatorIndex(
+			totalValidators,
+		)
+	}
+	return withdrawals, nil
+}
@@ -0,0 +1,233 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	maxEffectiveBalance = math.Gwei(32e9)
+	farFutureEpoch      = math.Epoch(^uint64(0))
+)
+
+// withdrawalState is the state the withdrawals are swept from.
+type withdrawalState struct {
+	slot                         math.Slot
+	nextWithdrawalIndex          uint64
+	nextWithdrawalValidatorIndex math.ValidatorIndex
+	validators                   []*types.Validator
+	balances                     []math.Gwei
+}
+
+func (s *withdrawalState) GetSlot() (math.Slot, error) {
+	return s.slot, nil
+}
+
+func (s *withdrawalState) GetNextWithdrawalIndex() (uint64, error) {
+	return s.nextWithdrawalIndex, nil
+}
+
+func (s *withdrawalState) GetNextWithdrawalValidatorIndex() (
+	math.ValidatorIndex, error,
+) {
+	return s.nextWithdrawalValidatorIndex, nil
+}
+
+func (s *withdrawalState) GetTotalValidators() (uint64, error) {
+	return uint64(len(s.validators)), nil
+}
+
+func (s *withdrawalState) ValidatorByIndex(
+	index math.ValidatorIndex,
+) (*types.Validator, error) {
+	return s.validators[index], nil
+}
+
+func (s *withdrawalState) GetBalance(
+	index math.ValidatorIndex,
+) (math.Gwei, error) {
+	return s.balances[index], nil
+}
+
+// newWithdrawalState returns a state with a validator for each balance,
+// the validators at fullyWithdrawable being withdrawable.
+func newWithdrawalState(
+	balances []math.Gwei,
+	fullyWithdrawable ...int,
+) *withdrawalState {
+	st := &withdrawalState{balances: balances}
+	for i := range balances {
+		st.validators = append(st.validators, &types.Validator{
+			WithdrawalCredentials: types.NewCredentialsFromExecutionAddress(
+				address(i),
+			),
+			EffectiveBalance:  maxEffectiveBalance,
+			ExitEpoch:         farFutureEpoch,
+			WithdrawableEpoch: farFutureEpoch,
+		})
+	}
+	for _, i := range fullyWithdrawable {
+		st.validators[i].WithdrawableEpoch = 0
+	}
+	return st
+}
+
+func address(i int) common.ExecutionAddress {
+	return common.ExecutionAddress{byte(i + 1)}
+}
+
+// withdrawalsSpec returns the chain spec of the sweep, with Electra
+// activated at electraForkEpoch.
+func withdrawalsSpec(electraForkEpoch math.Epoch) chain.Spec[
+	common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+] {
+	return chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+	]{
+		MaxEffectiveBalance:              uint64(maxEffectiveBalance),
+		SlotsPerEpoch:                    32,
+		MaxWithdrawalsPerPayload:         4,
+		MaxValidatorsPerWithdrawalsSweep: 8,
+		ElectraForkEpoch:                 electraForkEpoch,
+	})
+}
+
+func TestExpectedWithdrawals(t *testing.T) {
+	const excess = maxEffectiveBalance + 1e9
+
+	tests := []struct {
+		name     string
+		st       *withdrawalState
+		expected []*engineprimitives.Withdrawal
+	}{
+		{
+			name: "NoEligibleValidators",
+			st: newWithdrawalState([]math.Gwei{
+				maxEffectiveBalance, maxEffectiveBalance, maxEffectiveBalance,
+			}),
+			expected: []*engineprimitives.Withdrawal{},
+		},
+		{
+			name:     "FullyWithdrawableWithoutBalance",
+			st:       newWithdrawalState([]math.Gwei{0, maxEffectiveBalance}, 0),
+			expected: []*engineprimitives.Withdrawal{},
+		},
+		{
+			name: "SkipsIneligibleValidators",
+			st: newWithdrawalState([]math.Gwei{
+				maxEffectiveBalance, excess, maxEffectiveBalance, 5e9,
+			}, 3),
+			expected: []*engineprimitives.Withdrawal{
+				{Index: 0, Validator: 1, Address: address(1), Amount: 1e9},
+				{Index: 1, Validator: 3, Address: address(3), Amount: 5e9},
+			},
+		},
+		{
+			name: "MaxWithdrawalsPerPayload",
+			st: newWithdrawalState([]math.Gwei{
+				excess, excess, excess, excess, excess, excess,
+			}),
+			expected: []*engineprimitives.Withdrawal{
+				{Index: 0, Validator: 0, Address: address(0), Amount: 1e9},
+				{Index: 1, Validator: 1, Address: address(1), Amount: 1e9},
+				{Index: 2, Validator: 2, Address: address(2), Amount: 1e9},
+				{Index: 3, Validator: 3, Address: address(3), Amount: 1e9},
+			},
+		},
+		{
+			name: "SweepWraps",
+			st: func() *withdrawalState {
+				st := newWithdrawalState([]math.Gwei{
+					excess, excess, maxEffectiveBalance,
+					maxEffectiveBalance, excess,
+				})
+				st.nextWithdrawalIndex = 7
+				st.nextWithdrawalValidatorIndex = 3
+				return st
+			}(),
+			expected: []*engineprimitives.Withdrawal{
+				{Index: 7, Validator: 4, Address: address(4), Amount: 1e9},
+				{Index: 8, Validator: 0, Address: address(0), Amount: 1e9},
+				{Index: 9, Validator: 1, Address: address(1), Amount: 1e9},
+			},
+		},
+		{
+			name: "SweepBound",
+			st: newWithdrawalState([]math.Gwei{
+				maxEffectiveBalance, maxEffectiveBalance, maxEffectiveBalance,
+				maxEffectiveBalance, maxEffectiveBalance, maxEffectiveBalance,
+				maxEffectiveBalance, maxEffectiveBalance, excess,
+			}),
+			expected: []*engineprimitives.Withdrawal{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withdrawals, err := core.ExpectedWithdrawals[
+				*types.Validator, types.WithdrawalCredentials,
+			](tt.st, withdrawalsSpec(0))
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, withdrawals)
+		})
+	}
+}
+
+func TestExpectedWithdrawals_BeforeElectra(t *testing.T) {
+	const excess = maxEffectiveBalance + 1e9
+	cs := withdrawalsSpec(1)
+	st := newWithdrawalState([]math.Gwei{
+		maxEffectiveBalance, excess, maxEffectiveBalance, 5e9, excess,
+	}, 3)
+
+	// Before Electra, every swept validator gets a withdrawal.
+	withdrawals, err := core.ExpectedWithdrawals[
+		*types.Validator, types.WithdrawalCredentials,
+	](st, cs)
+	require.NoError(t, err)
+	require.Equal(t, []*engineprimitives.Withdrawal{
+		{Index: 0, Validator: 0, Address: address(0), Amount: 0},
+		{Index: 1, Validator: 1, Address: address(1), Amount: 1e9},
+		{Index: 2, Validator: 2, Address: address(2), Amount: 0},
+		{Index: 3, Validator: 3, Address: address(3), Amount: 5e9},
+	}, withdrawals)
+
+	// From Electra on, the validators that are not withdrawable are skipped.
+	st.slot = math.Slot(cs.SlotsPerEpoch())
+	withdrawals, err = core.ExpectedWithdrawals[
+		*types.Validator, types.WithdrawalCredentials,
+	](st, cs)
+	require.NoError(t, err)
+	require.Equal(t, []*engineprimitives.Withdrawal{
+		{Index: 0, Validator: 1, Address: address(1), Amount: 1e9},
+		{Index: 1, Validator: 3, Address: address(3), Amount: 5e9},
+		{Index: 2, Validator: 4, Address: address(4), Amount: 1e9},
+	}, withdrawals)
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+
+// activeValidatorCount caches the number of validators active at an epoch.
+type activeValidatorCount[ValidatorT Validator] struct {
+	// valid is set once a count has been computed.
+	valid bool
+	epoch math.Epoch
+	count uint64
+}
+
+// get returns the cached count for the given epoch, if any.
+func (c *activeValidatorCount[ValidatorT]) get(
+	epoch math.Epoch,
+) (uint64, bool) {
+	if !c.valid || c.epoch != epoch {
+		return 0, false
+	}
+	return c.count, true
+}
+
+// set caches the count for the given epoch.
+func (c *activeValidatorCount[ValidatorT]) set(epoch math.Epoch, count uint64) {
+	c.valid, c.epoch, c.count = true, epoch, count
+}
+
+// update adjusts the cached count for a validator written from prev to next,
+// either of which is nil if the validator did not or does not exist.
+func (c *activeValidatorCount[ValidatorT]) update(prev, next *ValidatorT) {
+	if !c.valid {
+		return
+	}
+	if prev != nil && (*prev).IsActive(c.epoch) {
+		c.count--
+	}
+	if next != nil && (*next).IsActive(c.epoch) {
+		c.count++
+	}
+}
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// contextCache holds the values cached by a store for its context. A store
+// bound to a new context starts with an empty cache. A copy of a store starts
+// with the active validator count of the store it was copied from and hands
+// it back when it is saved, but not with its decoded values: these are
+// handed out by reference, so sharing them would let a value modified
+// through one store leak into the other.
+type contextCache[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadHeaderT, Eth1DataT any,
+	ValidatorT Validator,
+] struct {
+	// activeCount is the number of validators active at an epoch.
+	activeCount activeValidatorCount[ValidatorT]
+	// The entries below are only used if the read cache is enabled.
+	fork                         cachedItem[ForkT]
+	latestBlockHeader            cachedItem[BeaconBlockHeaderT]
+	latestExecutionPayloadHeader cachedItem[ExecutionPayloadHeaderT]
+	eth1Data                     cachedItem[Eth1DataT]
+}
+
+// invalidate drops every cached value.
+func (c *contextCache[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadHeaderT, Eth1DataT, ValidatorT,
+]) invalidate() {
+	*c = contextCache[
+		ForkT, BeaconBlockHeaderT, ExecutionPayloadHeaderT, Eth1DataT,
+		ValidatorT,
+	]{}
+}
+
+// forCopy returns the cache a copy of the store starts with, holding only
+// the active validator count.
+func (c *contextCache[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadHeaderT, Eth1DataT, ValidatorT,
+]) forCopy() contextCache[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadHeaderT, Eth1DataT, ValidatorT,
+] {
+	return contextCache[
+		ForkT, BeaconBlockHeaderT, ExecutionPayloadHeaderT, Eth1DataT,
+		ValidatorT,
+	]{activeCount: c.activeCount}
+}
+
+// cachedItem is a decoded value cached along with the version of the store
+// it was read at.
+type cachedItem[T any] struct {
+	set     bool
+	version int64
+	value   T
+}
+
+// get returns the cached value if it was read at the given version.
+func (c *cachedItem[T]) get(version int64) (T, bool) {
+	if !c.set || c.version != version {
+		var t T
+		return t, false
+	}
+	return c.value, true
+}
+
+// put caches the value read at the given version.
+func (c *cachedItem[T]) put(version int64, value T) {
+	c.set, c.version, c.value = true, version, value
+}
+
+// invalidate drops the cached value.
+func (c *cachedItem[T]) invalidate() {
+	*c = cachedItem[T]{}
+}
+
+// readThrough returns the cached value of an item if the read cache is
+// enabled and holds it for the version of the context, otherwise it reads the
+// value with get and caches it.
+func readThrough[T any](
+	ctx context.Context,
+	enabled bool,
+	entry *cachedItem[T],
+	metrics *storeMetrics,
+	name string,
+	get func() (T, error),
+) (T, error) {
+	if !enabled {
+		return get()
+	}
+
+	version := storeVersion(ctx)
+	if value, ok := entry.get(version); ok {
+		metrics.markCacheHit(name)
+		return value, nil
+	}
+	metrics.markCacheMiss(name)
+
+	value, err := get()
+	if err != nil {
+		return value, err
+	}
+	entry.put(version, value)
+	return value, nil
+}
+
+// storeVersion returns the version of the store the context reads from, that
+// is the height of the block being processed.
+func storeVersion(ctx context.Context) int64 {
+	sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context)
+	if !ok {
+		return 0
+	}
+	return sdkCtx.BlockHeight()
+}
@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb_test
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingSink is a telemetry sink that records the metrics it receives,
+// keyed by the metric key followed by its labels.
+type recordingSink struct {
+	mu       sync.Mutex
+	counters map[string]int
+	measures map[string]int
+}
+
+func newRecordingSink() *recordingSink {
+	return &recordingSink{
+		counters: make(map[string]int),
+		measures: make(map[string]int),
+	}
+}
+
+func (s *recordingSink) IncrementCounter(key string, args ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counters[strings.Join(append([]string{key}, args...), "/")]++
+}
+
+func (s *recordingSink) MeasureSince(
+	key string, _ time.Time, args ...string,
+) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.measures[strings.Join(append([]string{key}, args...), "/")]++
+}
+
+func (s *recordingSink) count(key string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.counters[key]
+}
+
+const (
+	forkHits   = "beacon_kit.beacondb.cache_hit/collection/fork"
+	forkMisses = "beacon_kit.beacondb.cache_miss/collection/fork"
+)
+
+func newCacheTestStore(
+	tb testing.TB, opts ...beacondb.Option,
+) (*testStore, sdk.Context) {
+	tb.Helper()
+	key := storetypes.NewKVStoreKey("beacon")
+	ctx := testutil.DefaultContext(
+		key, storetypes.NewTransientStoreKey("transient"),
+	).WithBlockHeight(1)
+	kv := newTestStore(key, opts...).WithContext(ctx)
+	require.NoError(tb, kv.ImportInto(ctx, newTestDump(1)))
+	return kv, ctx
+}
+
+func TestKVStore_ReadCache(t *testing.T) {
+	sink := newRecordingSink()
+	kv, ctx := newCacheTestStore(
+		t, beacondb.WithReadCache(), beacondb.WithTelemetrySink(sink),
+	)
+
+	// The second read is served by the cache.
+	fork, err := kv.GetFork()
+	require.NoError(t, err)
+	cached, err := kv.GetFork()
+	require.NoError(t, err)
+	require.Same(t, fork, cached)
+	require.Equal(t, 1, sink.count(forkMisses))
+	require.Equal(t, 1, sink.count(forkHits))
+
+	// Writes invalidate the cached value.
+	require.NoError(t, kv.SetFork(&testValue{Value: 2}))
+	fork, err = kv.GetFork()
+	require.NoError(t, err)
+	require.Equal(t, &testValue{Value: 2}, fork)
+	require.Equal(t, 2, sink.count(forkMisses))
+
+	// A copy does not share the decoded values of the store, so modifying
+	// a value read from one does not change the value read from the other.
+	cpy := kv.Copy()
+	copied, err := cpy.GetFork()
+	require.NoError(t, err)
+	require.Equal(t, fork, copied)
+	require.NotSame(t, fork, copied)
+	require.Equal(t, 3, sink.count(forkMisses))
+
+	// Writes made on a copy are visible to the store once saved.
+	require.NoError(t, cpy.SetFork(&testValue{Value: 3}))
+	fork, err = cpy.GetFork()
+	require.NoError(t, err)
+	require.Equal(t, &testValue{Value: 3}, fork)
+	cpy.Save()
+	fork, err = kv.GetFork()
+	require.NoError(t, err)
+	require.Equal(t, &testValue{Value: 3}, fork)
+	require.Equal(t, 5, sink.count(forkMisses))
+	require.Equal(t, 1, sink.count(forkHits))
+
+	// A store bound to another context starts with an empty cache, and
+	// values read at another version are not reused.
+	_, err = kv.WithContext(ctx).GetFork()
+	require.NoError(t, err)
+	require.Equal(t, 6, sink.count(forkMisses))
+	next := kv.WithContext(ctx.WithBlockHeight(2))
+	_, err = next.GetFork()
+	require.NoError(t, err)
+	_, err = next.GetFork()
+	require.NoError(t, err)
+	require.Equal(t, 7, sink.count(forkMisses))
+	require.Equal(t, 2, sink.count(forkHits))
+}
+
+func TestKVStore_ReadCacheDisabled(t *testing.T) {
+	sink := newRecordingSink()
+	kv, _ := newCacheTestStore(t, beacondb.WithTelemetrySink(sink))
+
+	for range 2 {
+		_, err := kv.GetFork()
+		require.NoError(t, err)
+	}
+	require.Zero(t, sink.count(forkMisses))
+	require.Zero(t, sink.count(forkHits))
+}
+
+func BenchmarkKVStore_GetLatestBlockHeader(b *testing.B) {
+	for _, bm := range []struct {
+		name string
+		opts []beacondb.Option
+	}{
+		{name: "uncached"},
+		{name: "cached", opts: []beacondb.Option{beacondb.WithReadCache()}},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			kv, _ := newCacheTestStore(b, bm.opts...)
+			b.ResetTimer()
+			for range b.N {
+				_, err := kv.GetLatestBlockHeader()
+				require.NoError(b, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,262 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// testValue is a fixed size SSZ value used for every container of the store.
+type testValue struct {
+	Value uint64 `json:"value"`
+}
+
+func (v *testValue) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(dst, v.Value), nil
+}
+
+func (v *testValue) MarshalSSZ() ([]byte, error) {
+	return v.MarshalSSZTo(nil)
+}
+
+func (v *testValue) UnmarshalSSZ(bz []byte) error {
+	if len(bz) != v.SizeSSZ() {
+		return errors.New("invalid size")
+	}
+	v.Value = binary.LittleEndian.Uint64(bz)
+	return nil
+}
+
+func (*testValue) SizeSSZ() int { return 8 }
+
+func (v *testValue) HashTreeRoot() ([32]byte, error) {
+	return hashTreeRoot(v)
+}
+
+func (*testValue) NewFromSSZ(bz []byte, _ uint32) (*testValue, error) {
+	v := new(testValue)
+	return v, v.UnmarshalSSZ(bz)
+}
+
+func (*testValue) Version() uint32 { return 0 }
+
+// testValidator is a validator holding a public key, a balance and the
+// epochs it is active between.
+type testValidator struct {
+	Pubkey          crypto.BLSPubkey `json:"pubkey"`
+	Balance         uint64           `json:"balance"`
+	ActivationEpoch uint64           `json:"activationEpoch"`
+	ExitEpoch       uint64           `json:"exitEpoch"`
+}
+
+func (v *testValidator) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = append(dst, v.Pubkey[:]...)
+	dst = binary.LittleEndian.AppendUint64(dst, v.Balance)
+	dst = binary.LittleEndian.AppendUint64(dst, v.ActivationEpoch)
+	return binary.LittleEndian.AppendUint64(dst, v.ExitEpoch), nil
+}
+
+func (v *testValidator) MarshalSSZ() ([]byte, error) {
+	return v.MarshalSSZTo(nil)
+}
+
+func (v *testValidator) UnmarshalSSZ(bz []byte) error {
+	if len(bz) != v.SizeSSZ() {
+		return errors.New("invalid size")
+	}
+	n := copy(v.Pubkey[:], bz)
+	v.Balance = binary.LittleEndian.Uint64(bz[n:])
+	v.ActivationEpoch = binary.LittleEndian.Uint64(bz[n+8:])
+	v.ExitEpoch = binary.LittleEndian.Uint64(bz[n+16:])
+	return nil
+}
+
+func (v *testValidator) SizeSSZ() int { return len(v.Pubkey) + 24 }
+
+func (v *testValidator) HashTreeRoot() ([32]byte, error) {
+	return hashTreeRoot(v)
+}
+
+func (v *testValidator) GetPubkey() crypto.BLSPubkey { return v.Pubkey }
+
+func (v *testValidator) GetEffectiveBalance() math.Gwei {
+	return math.Gwei(v.Balance)
+}
+
+func (v *testValidator) IsActive(epoch math.Epoch) bool {
+	return v.ActivationEpoch <= epoch.Unwrap() && epoch.Unwrap() < v.ExitEpoch
+}
+
+func (v *testValidator) Status(epoch math.Epoch) string {
+	switch {
+	case epoch.Unwrap() < v.ActivationEpoch:
+		return "pending"
+	case epoch.Unwrap() < v.ExitEpoch:
+		return "active"
+	default:
+		return "exited"
+	}
+}
+
+// hashTreeRoot stands in for the hash tree root of the test types.
+func hashTreeRoot(v interface{ MarshalSSZ() ([]byte, error) }) (
+	[32]byte, error,
+) {
+	bz, err := v.MarshalSSZ()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return sha256.Sum256(bz), nil
+}
+
+type (
+	testStore = beacondb.KVStore[
+		*testValue, *testValue, *testValue, *testValue, *testValidator,
+	]
+	testDump = beacondb.StateDump[
+		*testValue, *testValue, *testValue, *testValue, *testValidator,
+	]
+)
+
+func newTestStore(
+	key *storetypes.KVStoreKey, opts ...beacondb.Option,
+) *testStore {
+	return beacondb.New[
+		*testValue, *testValue, *testValue, *testValue, *testValidator,
+	](
+		runtime.NewKVStoreService(key),
+		&encoding.SSZInterfaceCodec[*testValue]{},
+		opts...,
+	)
+}
+
+func newTestDump(slot uint64) *testDump {
+	return &testDump{
+		Slot:                  slot,
+		GenesisValidatorsRoot: []byte{0x01, 0x02},
+		Fork:                  &testValue{Value: 1},
+		LatestBlockHeader:     &testValue{Value: slot},
+		BlockRoots: []beacondb.IndexedValue[[]byte]{
+			{Index: 0, Value: []byte{0xaa}},
+			{Index: 1, Value: []byte{0xbb}},
+		},
+		StateRoots: []beacondb.IndexedValue[[]byte]{
+			{Index: 0, Value: []byte{0xcc}},
+		},
+		Eth1Data:                     &testValue{Value: 3},
+		Eth1DepositIndex:             2,
+		LatestExecutionPayloadHeader: &testValue{Value: 4},
+		NextValidatorIndex:           2,
+		Validators: []beacondb.IndexedValue[*testValidator]{
+			{Index: 0, Value: &testValidator{Pubkey: [48]byte{1}, Balance: 32}},
+			{Index: 1, Value: &testValidator{Pubkey: [48]byte{2}, Balance: 16}},
+		},
+		Balances: []beacondb.IndexedValue[uint64]{
+			{Index: 0, Value: 32},
+			{Index: 1, Value: 16},
+		},
+		NextWithdrawalIndex:          5,
+		NextWithdrawalValidatorIndex: 1,
+		RandaoMixes: []beacondb.IndexedValue[[]byte]{
+			{Index: 0, Value: []byte{0xdd}},
+		},
+		Slashings: []beacondb.IndexedValue[uint64]{
+			{Index: 0, Value: 7},
+		},
+		TotalSlashing: 7,
+	}
+}
+
+// stateRoot returns a digest of the state held by a dump.
+func stateRoot(t *testing.T, dump *testDump) [32]byte {
+	t.Helper()
+	bz, err := json.Marshal(dump)
+	require.NoError(t, err)
+	return sha256.Sum256(bz)
+}
+
+func TestKVStore_ExportImport(t *testing.T) {
+	key := storetypes.NewKVStoreKey("beacon")
+	tctx := testutil.DefaultContextWithDB(
+		t, key, storetypes.NewTransientStoreKey("transient"),
+	)
+	kv := newTestStore(key)
+
+	// Seed the state at slot 1 and commit it as version 1.
+	seed := newTestDump(1)
+	require.NoError(t, kv.ImportInto(tctx.Ctx, seed))
+	tctx.CMS.Commit()
+
+	// Mutate the state and commit it as version 2.
+	head := kv.WithContext(tctx.Ctx)
+	require.NoError(t, head.SetSlot(2))
+	require.NoError(t, head.SetBalance(0, 64))
+	require.NoError(t, head.RemoveValidatorAtIndex(1))
+	tctx.CMS.Commit()
+
+	// The head is no longer at slot 1.
+	_, err := kv.Export(tctx.Ctx, 1)
+	require.ErrorIs(t, err, beacondb.ErrSlotMismatch)
+
+	// Exporting at version 1 returns the seeded state.
+	cms, err := tctx.CMS.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+	exported, err := kv.Export(tctx.Ctx.WithMultiStore(cms), 1)
+	require.NoError(t, err)
+	require.Equal(t, seed, exported)
+	require.Equal(t, stateRoot(t, seed), stateRoot(t, exported))
+
+	// The dump survives a JSON round trip.
+	bz, err := json.Marshal(exported)
+	require.NoError(t, err)
+	decoded := new(testDump)
+	require.NoError(t, json.Unmarshal(bz, decoded))
+	require.Equal(t, stateRoot(t, exported), stateRoot(t, decoded))
+
+	// Importing over the mutated head restores the seeded state.
+	require.NoError(t, kv.ImportInto(tctx.Ctx, decoded))
+	restored, err := kv.Export(tctx.Ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, stateRoot(t, seed), stateRoot(t, restored))
+
+	// Importing into a fresh store yields the same state.
+	freshKey := storetypes.NewKVStoreKey("fresh")
+	fresh := testutil.DefaultContext(
+		freshKey, storetypes.NewTransientStoreKey("fresh_transient"),
+	)
+	require.NoError(t, newTestStore(freshKey).ImportInto(fresh, decoded))
+	imported, err := newTestStore(freshKey).Export(fresh, 1)
+	require.NoError(t, err)
+	require.Equal(t, stateRoot(t, seed), stateRoot(t, imported))
+}
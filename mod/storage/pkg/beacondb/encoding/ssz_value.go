@@ -21,6 +21,8 @@
 package encoding
 
 import (
+	"bytes"
+	"encoding/binary"
 	"reflect"
 
 	"cosmossdk.io/collections/codec"
@@ -70,11 +72,26 @@ func (SSZValueCodec[T]) ValueType() string {
 	return "SSZMarshallable"
 }
 
+// versionedPrefix prefixes values encoded by SSZInterfaceCodec, it is
+// followed by the big endian fork version of the value. Values written before
+// the prefix was introduced do not carry it.
+//
+//nolint:gochecknoglobals // constant byte sequence.
+var versionedPrefix = []byte{0xbe, 0xac, 0x5e, 0x2f, 0x0e, 0xc0, 0xde, 0x01}
+
+// versionedHeaderLen is the length of the prefix plus the fork version.
+const versionedHeaderLen = 12
+
 // SSZInterfaceCodec provides methods to encode and decode SSZ values.
 //
 // This type exists for codecs for interfaces, which require a factory function
 // to create new instances of the underlying hard type since reflect cannot
 // infer the type of an interface.
+//
+// Values are encoded along with their fork version, so that they decode into
+// the type of the fork they were written under regardless of the active fork
+// version. Values written without a fork version are decoded with the active
+// fork version.
 type SSZInterfaceCodec[T interface {
 	ssz.Marshallable
 	NewFromSSZ([]byte, uint32) (T, error)
@@ -83,19 +100,32 @@ type SSZInterfaceCodec[T interface {
 	latestVersion uint32
 }
 
-// SetForkVersion sets the fork version for the codec.
+// SetActiveForkVersion sets the fork version used to decode values that were
+// written without one.
 func (cdc *SSZInterfaceCodec[T]) SetActiveForkVersion(version uint32) {
 	cdc.latestVersion = version
 }
 
-// Encode marshals the provided value into its SSZ encoding.
+// Encode marshals the provided value into its SSZ encoding, prefixed with its
+// fork version.
 func (cdc *SSZInterfaceCodec[T]) Encode(value T) ([]byte, error) {
-	return value.MarshalSSZ()
+	bz := make([]byte, versionedHeaderLen, versionedHeaderLen+value.SizeSSZ())
+	copy(bz, versionedPrefix)
+	binary.BigEndian.PutUint32(bz[len(versionedPrefix):], value.Version())
+	return value.MarshalSSZTo(bz)
 }
 
-// Decode unmarshals the provided bytes into a value of type T.
+// Decode unmarshals the provided bytes into a value of type T, using the
+// fork version the value was encoded with if any.
 func (cdc SSZInterfaceCodec[T]) Decode(b []byte) (T, error) {
 	var t T
+	if len(b) >= versionedHeaderLen &&
+		bytes.Equal(b[:len(versionedPrefix)], versionedPrefix) {
+		return t.NewFromSSZ(
+			b[versionedHeaderLen:],
+			binary.BigEndian.Uint32(b[len(versionedPrefix):]),
+		)
+	}
 	return t.NewFromSSZ(b, cdc.latestVersion)
 }
 
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encoding_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// denebVersion is the version of headers holding a single field.
+	denebVersion uint32 = 4
+	// electraVersion is the version of headers holding an extra field.
+	electraVersion uint32 = 5
+)
+
+// testHeader is a header whose SSZ layout depends on its fork version.
+type testHeader struct {
+	version uint32
+	data    []byte
+}
+
+func (h *testHeader) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, h.data...), nil
+}
+
+func (h *testHeader) MarshalSSZ() ([]byte, error) {
+	return h.MarshalSSZTo(nil)
+}
+
+func (h *testHeader) UnmarshalSSZ(bz []byte) error {
+	if len(bz) != h.SizeSSZ() {
+		return errors.Newf("invalid size %d for version %d", len(bz), h.version)
+	}
+	h.data = append([]byte(nil), bz...)
+	return nil
+}
+
+func (h *testHeader) SizeSSZ() int {
+	if h.version == electraVersion {
+		return 16
+	}
+	return 8
+}
+
+func (*testHeader) NewFromSSZ(bz []byte, version uint32) (*testHeader, error) {
+	h := &testHeader{version: version}
+	return h, h.UnmarshalSSZ(bz)
+}
+
+func (h *testHeader) HashTreeRoot() ([32]byte, error) {
+	return sha256.Sum256(h.data), nil
+}
+
+func (h *testHeader) Version() uint32 { return h.version }
+
+func TestSSZInterfaceCodec_ForkVersions(t *testing.T) {
+	cdc := &encoding.SSZInterfaceCodec[*testHeader]{}
+	deneb := &testHeader{version: denebVersion, data: make([]byte, 8)}
+	electra := &testHeader{version: electraVersion, data: make([]byte, 16)}
+	electra.data[15] = 0x01
+
+	// Write under Deneb, then flip the active version to Electra.
+	cdc.SetActiveForkVersion(denebVersion)
+	denebBz, err := cdc.Encode(deneb)
+	require.NoError(t, err)
+	cdc.SetActiveForkVersion(electraVersion)
+	electraBz, err := cdc.Encode(electra)
+	require.NoError(t, err)
+
+	// Both values decode into the type of the fork they were written under.
+	decoded, err := cdc.Decode(denebBz)
+	require.NoError(t, err)
+	require.Equal(t, deneb, decoded)
+	decoded, err = cdc.Decode(electraBz)
+	require.NoError(t, err)
+	require.Equal(t, electra, decoded)
+}
+
+func TestSSZInterfaceCodec_Unversioned(t *testing.T) {
+	cdc := &encoding.SSZInterfaceCodec[*testHeader]{}
+
+	// Values written before versions were encoded decode with the active
+	// fork version.
+	legacy := &testHeader{version: denebVersion, data: make([]byte, 8)}
+	bz, err := legacy.MarshalSSZ()
+	require.NoError(t, err)
+
+	cdc.SetActiveForkVersion(denebVersion)
+	decoded, err := cdc.Decode(bz)
+	require.NoError(t, err)
+	require.Equal(t, legacy, decoded)
+
+	cdc.SetActiveForkVersion(electraVersion)
+	_, err = cdc.Decode(bz)
+	require.Error(t, err)
+}
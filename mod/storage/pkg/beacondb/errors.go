@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+var (
+	// ErrSlotMismatch is returned when the state being exported is not at
+	// the requested slot.
+	ErrSlotMismatch = errors.New("state is not at the requested slot")
+	// ErrIndexOutOfRange is returned when an index of the block roots or
+	// state roots vectors is not smaller than their length.
+	ErrIndexOutOfRange = errors.New("index out of range")
+	// ErrHistoricalRootsNotConfigured is returned when a slot based lookup
+	// is made on a store created without WithSlotsPerHistoricalRoot.
+	ErrHistoricalRootsNotConfigured = errors.New(
+		"slots per historical root not configured",
+	)
+	// ErrRandaoMixesNotConfigured is returned when an epoch based lookup of
+	// the randao mixes is made on a store created without
+	// WithEpochsPerHistoricalVector.
+	ErrRandaoMixesNotConfigured = errors.New(
+		"epochs per historical vector not configured",
+	)
+)
+
+// SlotOutOfRangeError is returned when a block root is requested for a slot
+// outside of the lookback window of the current slot, i.e. a slot that is not
+// in [currentSlot - SlotsPerHistoricalRoot, currentSlot).
+type SlotOutOfRangeError struct {
+	// Slot is the requested slot.
+	Slot math.Slot
+	// CurrentSlot is the slot of the state.
+	CurrentSlot math.Slot
+	// SlotsPerHistoricalRoot is the length of the lookback window.
+	SlotsPerHistoricalRoot uint64
+}
+
+// Error implements the error interface.
+func (e *SlotOutOfRangeError) Error() string {
+	return fmt.Sprintf(
+		"slot %d is out of the lookback window of %d slots from slot %d",
+		e.Slot, e.SlotsPerHistoricalRoot, e.CurrentSlot,
+	)
+}
@@ -27,13 +27,19 @@ func (kv *KVStore[
 ]) GetLatestExecutionPayloadHeader() (
 	ExecutionPayloadHeaderT, error,
 ) {
-	forkVersion, err := kv.latestExecutionPayloadVersion.Get(kv.ctx)
-	if err != nil {
-		var t ExecutionPayloadHeaderT
-		return t, err
-	}
-	kv.latestExecutionPayloadCodec.SetActiveForkVersion(forkVersion)
-	return kv.latestExecutionPayloadHeader.Get(kv.ctx)
+	return readThrough(
+		kv.ctx, kv.opts.readCache, &kv.cache.latestExecutionPayloadHeader,
+		kv.metrics, collectionLatestExecutionPayloadHeader,
+		func() (ExecutionPayloadHeaderT, error) {
+			forkVersion, err := kv.latestExecutionPayloadVersion.Get(kv.ctx)
+			if err != nil {
+				var t ExecutionPayloadHeaderT
+				return t, err
+			}
+			kv.latestExecutionPayloadCodec.SetActiveForkVersion(forkVersion)
+			return kv.latestExecutionPayloadHeader.Get(kv.ctx)
+		},
+	)
 }
 
 // SetLatestExecutionPayloadHeader sets the latest execution payload header in
@@ -43,6 +49,7 @@ func (kv *KVStore[
 ]) SetLatestExecutionPayloadHeader(
 	payloadHeader ExecutionPayloadHeaderT,
 ) error {
+	kv.cache.latestExecutionPayloadHeader.invalidate()
 	if err := kv.latestExecutionPayloadVersion.Set(
 		kv.ctx, payloadHeader.Version(),
 	); err != nil {
@@ -72,7 +79,12 @@ func (kv *KVStore[
 func (kv *KVStore[
 	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
 ]) GetEth1Data() (Eth1DataT, error) {
-	return kv.eth1Data.Get(kv.ctx)
+	return readThrough(
+		kv.ctx, kv.opts.readCache, &kv.cache.eth1Data, kv.metrics,
+		collectionEth1Data, func() (Eth1DataT, error) {
+			return kv.eth1Data.Get(kv.ctx)
+		},
+	)
 }
 
 // SetEth1Data sets the eth1 data in the beacon state.
@@ -81,5 +93,6 @@ func (kv *KVStore[
 ]) SetEth1Data(
 	data Eth1DataT,
 ) error {
+	kv.cache.eth1Data.invalidate()
 	return kv.eth1Data.Set(kv.ctx, data)
 }
@@ -0,0 +1,330 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"context"
+
+	sdkcollections "cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// IndexedValue is an entry of an indexed keyspace of the store.
+type IndexedValue[V any] struct {
+	Index uint64 `json:"index"`
+	Value V      `json:"value"`
+}
+
+// StateDump is a snapshot of every keyspace of the store at a given slot.
+// It is meant for debugging and for seeding test states, see Export and
+// ImportInto.
+type StateDump[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadHeaderT, Eth1DataT,
+	ValidatorT any,
+] struct {
+	// Versioning.
+	Slot                  uint64 `json:"slot"`
+	GenesisValidatorsRoot []byte `json:"genesisValidatorsRoot"`
+	Fork                  ForkT  `json:"fork"`
+
+	// History.
+	LatestBlockHeader BeaconBlockHeaderT     `json:"latestBlockHeader"`
+	BlockRoots        []IndexedValue[[]byte] `json:"blockRoots"`
+	StateRoots        []IndexedValue[[]byte] `json:"stateRoots"`
+
+	// Eth1.
+	Eth1Data         Eth1DataT `json:"eth1Data"`
+	Eth1DepositIndex uint64    `json:"eth1DepositIndex"`
+
+	LatestExecutionPayloadVersion uint32 `json:"latestExecutionPayloadVersion"`
+
+	//nolint:lll // struct tag.
+	LatestExecutionPayloadHeader ExecutionPayloadHeaderT `json:"latestExecutionPayloadHeader"`
+
+	// Registry.
+	NextValidatorIndex uint64                     `json:"nextValidatorIndex"`
+	Validators         []IndexedValue[ValidatorT] `json:"validators"`
+	Balances           []IndexedValue[uint64]     `json:"balances"`
+
+	// Withdrawals.
+	NextWithdrawalIndex uint64 `json:"nextWithdrawalIndex"`
+
+	NextWithdrawalValidatorIndex uint64 `json:"nextWithdrawalValidatorIndex"`
+
+	// Randomness.
+	RandaoMixes []IndexedValue[[]byte] `json:"randaoMixes"`
+
+	// Slashing.
+	Slashings     []IndexedValue[uint64] `json:"slashings"`
+	TotalSlashing uint64                 `json:"totalSlashing"`
+}
+
+// Export walks every keyspace of the store and returns its contents.
+//
+// The store is read through the given context rather than the one of the
+// store, so that a context over a historical version of the multistore can
+// be passed to export the state as it was at that version. The slot held by
+// the state must match the requested slot, which guards against exporting
+// the head state by mistake.
+//
+//nolint:funlen // one read per keyspace.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) Export(ctx context.Context, slot math.Slot) (*StateDump[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+], error) {
+	st := kv.WithContext(ctx)
+	current, err := st.GetSlot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read slot")
+	} else if current != slot {
+		return nil, errors.Wrapf(
+			ErrSlotMismatch, "requested %d, state is at %d", slot, current,
+		)
+	}
+
+	dump := &StateDump[
+		ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT,
+		ValidatorT,
+	]{Slot: slot.Unwrap()}
+	if dump.GenesisValidatorsRoot, err = exportItem(
+		ctx, st.genesisValidatorsRoot,
+	); err != nil {
+		return nil, err
+	}
+	if dump.Fork, err = exportItem(ctx, st.fork); err != nil {
+		return nil, err
+	}
+	if dump.LatestBlockHeader, err = exportItem(
+		ctx, st.latestBlockHeader,
+	); err != nil {
+		return nil, err
+	}
+	if dump.BlockRoots, err = exportMap(ctx, st.blockRoots); err != nil {
+		return nil, err
+	}
+	if dump.StateRoots, err = exportMap(ctx, st.stateRoots); err != nil {
+		return nil, err
+	}
+	if dump.Eth1Data, err = exportItem(ctx, st.eth1Data); err != nil {
+		return nil, err
+	}
+	if dump.Eth1DepositIndex, err = exportItem(
+		ctx, st.eth1DepositIndex,
+	); err != nil {
+		return nil, err
+	}
+	if dump.LatestExecutionPayloadHeader, err = st.
+		GetLatestExecutionPayloadHeader(); err != nil {
+		return nil, errors.Wrap(err, "failed to export payload header")
+	}
+	dump.LatestExecutionPayloadVersion = dump.
+		LatestExecutionPayloadHeader.Version()
+	if dump.NextValidatorIndex, err = st.validatorIndex.Peek(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to export validator index")
+	}
+	if dump.Validators, err = exportIterator(
+		st.validators.Iterate(ctx, nil),
+	); err != nil {
+		return nil, errors.Wrap(err, "failed to export validators")
+	}
+	if dump.Balances, err = exportMap(ctx, st.balances); err != nil {
+		return nil, err
+	}
+	if dump.NextWithdrawalIndex, err = exportItem(
+		ctx, st.nextWithdrawalIndex,
+	); err != nil {
+		return nil, err
+	}
+	if dump.NextWithdrawalValidatorIndex, err = exportItem(
+		ctx, st.nextWithdrawalValidatorIndex,
+	); err != nil {
+		return nil, err
+	}
+	if dump.RandaoMixes, err = exportMap(ctx, st.randaoMix); err != nil {
+		return nil, err
+	}
+	if dump.Slashings, err = exportMap(ctx, st.slashings); err != nil {
+		return nil, err
+	}
+	if dump.TotalSlashing, err = exportItem(
+		ctx, st.totalSlashing,
+	); err != nil {
+		return nil, err
+	}
+	return dump, nil
+}
+
+// ImportInto replaces the contents of every keyspace of the store with the
+// given dump, writing through the given context. Entries of the indexed
+// keyspaces that are not part of the dump are removed.
+//
+//nolint:funlen // one write per keyspace.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) ImportInto(ctx context.Context, dump *StateDump[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) error {
+	kv.cache.invalidate()
+	st := kv.WithContext(ctx)
+	if err := st.slot.Set(ctx, dump.Slot); err != nil {
+		return err
+	}
+	if err := st.genesisValidatorsRoot.Set(
+		ctx, dump.GenesisValidatorsRoot,
+	); err != nil {
+		return err
+	}
+	if err := st.fork.Set(ctx, dump.Fork); err != nil {
+		return err
+	}
+	if err := st.latestBlockHeader.Set(
+		ctx, dump.LatestBlockHeader,
+	); err != nil {
+		return err
+	}
+	if err := importMap(ctx, st.blockRoots, dump.BlockRoots); err != nil {
+		return err
+	}
+	if err := importMap(ctx, st.stateRoots, dump.StateRoots); err != nil {
+		return err
+	}
+	if err := st.eth1Data.Set(ctx, dump.Eth1Data); err != nil {
+		return err
+	}
+	if err := st.eth1DepositIndex.Set(
+		ctx, dump.Eth1DepositIndex,
+	); err != nil {
+		return err
+	}
+	if err := st.SetLatestExecutionPayloadHeader(
+		dump.LatestExecutionPayloadHeader,
+	); err != nil {
+		return err
+	}
+	if err := st.validatorIndex.Set(ctx, dump.NextValidatorIndex); err != nil {
+		return err
+	}
+	if err := importMap(ctx, st.validators, dump.Validators); err != nil {
+		return err
+	}
+	if err := importMap(ctx, st.balances, dump.Balances); err != nil {
+		return err
+	}
+	if err := st.nextWithdrawalIndex.Set(
+		ctx, dump.NextWithdrawalIndex,
+	); err != nil {
+		return err
+	}
+	if err := st.nextWithdrawalValidatorIndex.Set(
+		ctx, dump.NextWithdrawalValidatorIndex,
+	); err != nil {
+		return err
+	}
+	if err := importMap(ctx, st.randaoMix, dump.RandaoMixes); err != nil {
+		return err
+	}
+	if err := importMap(ctx, st.slashings, dump.Slashings); err != nil {
+		return err
+	}
+	return st.totalSlashing.Set(ctx, dump.TotalSlashing)
+}
+
+// indexedKeyspace is a keyspace indexed by uint64, either a Map or an
+// IndexedMap.
+type indexedKeyspace[V any] interface {
+	Iterate(
+		ctx context.Context, ranger sdkcollections.Ranger[uint64],
+	) (sdkcollections.Iterator[uint64, V], error)
+	Set(ctx context.Context, key uint64, value V) error
+	Remove(ctx context.Context, key uint64) error
+}
+
+// exportItem returns the value of an item, naming it in the error if it can
+// not be read.
+func exportItem[V any](
+	ctx context.Context, item sdkcollections.Item[V],
+) (V, error) {
+	v, err := item.Get(ctx)
+	if err != nil {
+		return v, errors.Wrap(err, "failed to export item")
+	}
+	return v, nil
+}
+
+// exportMap returns the entries of a map in ascending order of index.
+func exportMap[V any](
+	ctx context.Context, m sdkcollections.Map[uint64, V],
+) ([]IndexedValue[V], error) {
+	entries, err := exportIterator(m.Iterate(ctx, nil))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to export %s", m.GetName())
+	}
+	return entries, nil
+}
+
+// exportIterator drains an iterator into a list of entries.
+func exportIterator[V any](
+	iter sdkcollections.Iterator[uint64, V], err error,
+) ([]IndexedValue[V], error) {
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]IndexedValue[V], 0, len(kvs))
+	for _, kv := range kvs {
+		entries = append(entries, IndexedValue[V]{Index: kv.Key, Value: kv.Value})
+	}
+	return entries, nil
+}
+
+// importMap replaces the entries of a keyspace with the given ones.
+func importMap[V any](
+	ctx context.Context, m indexedKeyspace[V], entries []IndexedValue[V],
+) error {
+	iter, err := m.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	iter.Close()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err = m.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range entries {
+		if err = m.Set(ctx, entry.Index, entry.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
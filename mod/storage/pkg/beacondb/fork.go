@@ -26,6 +26,7 @@ func (kv *KVStore[
 ]) SetFork(
 	fork ForkT,
 ) error {
+	kv.cache.fork.invalidate()
 	return kv.fork.Set(kv.ctx, fork)
 }
 
@@ -33,5 +34,10 @@ func (kv *KVStore[
 func (kv *KVStore[
 	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
 ]) GetFork() (ForkT, error) {
-	return kv.fork.Get(kv.ctx)
+	return readThrough(
+		kv.ctx, kv.opts.readCache, &kv.cache.fork, kv.metrics,
+		collectionFork, func() (ForkT, error) {
+			return kv.fork.Get(kv.ctx)
+		},
+	)
 }
@@ -21,7 +21,9 @@
 package beacondb
 
 import (
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // UpdateBlockRootAtIndex sets a block root in the BeaconStore.
@@ -31,15 +33,21 @@ func (kv *KVStore[
 	index uint64,
 	root primitives.Root,
 ) error {
+	if err := kv.checkHistoricalIndex(index); err != nil {
+		return err
+	}
 	return kv.blockRoots.Set(kv.ctx, index, root[:])
 }
 
-// GetBlockRoot retrieves the block root from the BeaconStore.
+// GetBlockRootAtIndex retrieves the block root from the BeaconStore.
 func (kv *KVStore[
 	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
 ]) GetBlockRootAtIndex(
 	index uint64,
 ) (primitives.Root, error) {
+	if err := kv.checkHistoricalIndex(index); err != nil {
+		return primitives.Root{}, err
+	}
 	bz, err := kv.blockRoots.Get(kv.ctx, index)
 	if err != nil {
 		return primitives.Root{}, err
@@ -47,12 +55,36 @@ func (kv *KVStore[
 	return primitives.Root(bz), nil
 }
 
+// GetBlockRootAtSlot returns the block root at the given slot, as seen from a
+// state at currentSlot. The slot must be within the lookback window of the
+// block roots vector, i.e. slot < currentSlot <= slot +
+// SlotsPerHistoricalRoot, otherwise a *SlotOutOfRangeError is returned.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) GetBlockRootAtSlot(
+	slot, currentSlot math.Slot,
+) (primitives.Root, error) {
+	window := kv.opts.slotsPerHistoricalRoot
+	if window == 0 {
+		return primitives.Root{}, ErrHistoricalRootsNotConfigured
+	}
+	if slot >= currentSlot || currentSlot.Unwrap()-slot.Unwrap() > window {
+		return primitives.Root{}, &SlotOutOfRangeError{
+			Slot:                   slot,
+			CurrentSlot:            currentSlot,
+			SlotsPerHistoricalRoot: window,
+		}
+	}
+	return kv.GetBlockRootAtIndex(slot.Unwrap() % window)
+}
+
 // SetLatestBlockHeader sets the latest block header in the BeaconStore.
 func (kv *KVStore[
 	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
 ]) SetLatestBlockHeader(
 	header BeaconBlockHeaderT,
 ) error {
+	kv.cache.latestBlockHeader.invalidate()
 	return kv.latestBlockHeader.Set(kv.ctx, header)
 }
 
@@ -62,7 +94,12 @@ func (kv *KVStore[
 ]) GetLatestBlockHeader() (
 	BeaconBlockHeaderT, error,
 ) {
-	return kv.latestBlockHeader.Get(kv.ctx)
+	return readThrough(
+		kv.ctx, kv.opts.readCache, &kv.cache.latestBlockHeader, kv.metrics,
+		collectionLatestBlockHeader, func() (BeaconBlockHeaderT, error) {
+			return kv.latestBlockHeader.Get(kv.ctx)
+		},
+	)
 }
 
 // UpdateStateRootAtIndex updates the state root at the given slot.
@@ -72,18 +109,49 @@ func (kv *KVStore[
 	idx uint64,
 	stateRoot primitives.Root,
 ) error {
+	if err := kv.checkHistoricalIndex(idx); err != nil {
+		return err
+	}
 	return kv.stateRoots.Set(kv.ctx, idx, stateRoot[:])
 }
 
-// StateRootAtIndex returns the state root at the given slot.
+// GetStateRootAtIndex returns the state root at the given index.
 func (kv *KVStore[
 	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
-]) StateRootAtIndex(
+]) GetStateRootAtIndex(
 	idx uint64,
 ) (primitives.Root, error) {
+	if err := kv.checkHistoricalIndex(idx); err != nil {
+		return primitives.Root{}, err
+	}
 	bz, err := kv.stateRoots.Get(kv.ctx, idx)
 	if err != nil {
 		return primitives.Root{}, err
 	}
 	return primitives.Root(bz), nil
 }
+
+// StateRootAtIndex returns the state root at the given index.
+//
+// Deprecated: use GetStateRootAtIndex.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) StateRootAtIndex(
+	idx uint64,
+) (primitives.Root, error) {
+	return kv.GetStateRootAtIndex(idx)
+}
+
+// checkHistoricalIndex returns an error if the index is out of the bounds of
+// the block roots and state roots vectors.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) checkHistoricalIndex(index uint64) error {
+	limit := kv.opts.slotsPerHistoricalRoot
+	if limit != 0 && index >= limit {
+		return errors.Wrapf(
+			ErrIndexOutOfRange, "index %d, length %d", index, limit,
+		)
+	}
+	return nil
+}
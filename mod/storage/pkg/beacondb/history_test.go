@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+const testSlotsPerHistoricalRoot = 8
+
+// rootForSlot returns a distinct root for every slot.
+func rootForSlot(slot uint64) primitives.Root {
+	return primitives.Root{byte(slot + 1)}
+}
+
+func TestKVStore_HistoricalRoots(t *testing.T) {
+	key := storetypes.NewKVStoreKey("beacon")
+	ctx := testutil.DefaultContext(
+		key, storetypes.NewTransientStoreKey("transient"),
+	)
+	kv := newTestStore(
+		key, beacondb.WithSlotsPerHistoricalRoot(testSlotsPerHistoricalRoot),
+	).WithContext(ctx)
+
+	// Write the roots of slots [0, 12), wrapping around the vectors once.
+	const currentSlot = 12
+	for slot := range uint64(currentSlot) {
+		index := slot % testSlotsPerHistoricalRoot
+		require.NoError(t, kv.UpdateBlockRootAtIndex(index, rootForSlot(slot)))
+		require.NoError(t, kv.UpdateStateRootAtIndex(index, rootForSlot(slot)))
+	}
+
+	// Index 1 was last written at slot 9, index 7 at slot 7.
+	root, err := kv.GetBlockRootAtIndex(1)
+	require.NoError(t, err)
+	require.Equal(t, rootForSlot(9), root)
+	root, err = kv.GetStateRootAtIndex(7)
+	require.NoError(t, err)
+	require.Equal(t, rootForSlot(7), root)
+
+	// Indexes past the end of the vectors are rejected.
+	require.ErrorIs(t,
+		kv.UpdateBlockRootAtIndex(testSlotsPerHistoricalRoot, primitives.Root{}),
+		beacondb.ErrIndexOutOfRange,
+	)
+	require.ErrorIs(t,
+		kv.UpdateStateRootAtIndex(testSlotsPerHistoricalRoot, primitives.Root{}),
+		beacondb.ErrIndexOutOfRange,
+	)
+	_, err = kv.GetBlockRootAtIndex(testSlotsPerHistoricalRoot)
+	require.ErrorIs(t, err, beacondb.ErrIndexOutOfRange)
+	_, err = kv.GetStateRootAtIndex(testSlotsPerHistoricalRoot)
+	require.ErrorIs(t, err, beacondb.ErrIndexOutOfRange)
+
+	tests := []struct {
+		name    string
+		slot    math.Slot
+		wantErr bool
+	}{
+		{name: "previous slot", slot: 11},
+		{name: "before the wraparound", slot: 7},
+		{name: "oldest slot in the window", slot: 4},
+		{name: "overwritten slot", slot: 3, wantErr: true},
+		{name: "current slot", slot: currentSlot, wantErr: true},
+		{name: "future slot", slot: currentSlot + 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, err := kv.GetBlockRootAtSlot(tt.slot, currentSlot)
+			if tt.wantErr {
+				var rangeErr *beacondb.SlotOutOfRangeError
+				require.ErrorAs(t, err, &rangeErr)
+				require.Equal(t, tt.slot, rangeErr.Slot)
+				require.Equal(t, math.Slot(currentSlot), rangeErr.CurrentSlot)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, rootForSlot(tt.slot.Unwrap()), root)
+		})
+	}
+}
+
+func TestKVStore_HistoricalRootsUnbounded(t *testing.T) {
+	key := storetypes.NewKVStoreKey("beacon")
+	ctx := testutil.DefaultContext(
+		key, storetypes.NewTransientStoreKey("transient"),
+	)
+	kv := newTestStore(key).WithContext(ctx)
+
+	// Without a configured length the vectors are not bounds checked, but
+	// slot based lookups are not possible.
+	require.NoError(t, kv.UpdateBlockRootAtIndex(1<<20, rootForSlot(1)))
+	root, err := kv.GetBlockRootAtIndex(1 << 20)
+	require.NoError(t, err)
+	require.Equal(t, rootForSlot(1), root)
+
+	_, err = kv.GetBlockRootAtSlot(0, 1)
+	require.ErrorIs(t, err, beacondb.ErrHistoricalRootsNotConfigured)
+}
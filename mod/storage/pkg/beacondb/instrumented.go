@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/core/store"
+)
+
+// Operations recorded by the operation metrics of the store.
+const (
+	opGet     = "get"
+	opSet     = "set"
+	opDelete  = "delete"
+	opIterate = "iterate"
+)
+
+// instrumentedService is a store.KVStoreService whose stores record the
+// count and duration of every operation, labelled with the collection the
+// key belongs to.
+type instrumentedService struct {
+	store.KVStoreService
+	metrics *storeMetrics
+}
+
+// newInstrumentedService wraps the given service.
+func newInstrumentedService(
+	kss store.KVStoreService, metrics *storeMetrics,
+) store.KVStoreService {
+	return instrumentedService{KVStoreService: kss, metrics: metrics}
+}
+
+// OpenKVStore opens the store of the given context.
+func (s instrumentedService) OpenKVStore(ctx context.Context) store.KVStore {
+	return instrumentedStore{
+		KVStore: s.KVStoreService.OpenKVStore(ctx),
+		metrics: s.metrics,
+	}
+}
+
+// instrumentedStore is a store.KVStore that records its operations.
+type instrumentedStore struct {
+	store.KVStore
+	metrics *storeMetrics
+}
+
+func (s instrumentedStore) Get(key []byte) ([]byte, error) {
+	defer s.metrics.markOperation(key, opGet, time.Now())
+	return s.KVStore.Get(key)
+}
+
+func (s instrumentedStore) Has(key []byte) (bool, error) {
+	defer s.metrics.markOperation(key, opGet, time.Now())
+	return s.KVStore.Has(key)
+}
+
+func (s instrumentedStore) Set(key, value []byte) error {
+	defer s.metrics.markOperation(key, opSet, time.Now())
+	return s.KVStore.Set(key, value)
+}
+
+func (s instrumentedStore) Delete(key []byte) error {
+	defer s.metrics.markOperation(key, opDelete, time.Now())
+	return s.KVStore.Delete(key)
+}
+
+func (s instrumentedStore) Iterator(start, end []byte) (store.Iterator, error) {
+	defer s.metrics.markOperation(start, opIterate, time.Now())
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s instrumentedStore) ReverseIterator(
+	start, end []byte,
+) (store.Iterator, error) {
+	defer s.metrics.markOperation(start, opIterate, time.Now())
+	return s.KVStore.ReverseIterator(start, end)
+}
@@ -97,6 +97,21 @@ type KVStore[
 	slashings sdkcollections.Map[uint64, uint64]
 	// totalSlashing stores the total slashing in the vector range.
 	totalSlashing sdkcollections.Item[uint64]
+	// cache holds the values cached for the context of the store.
+	cache *contextCache[
+		ForkT, BeaconBlockHeaderT, ExecutionPayloadHeaderT, Eth1DataT,
+		ValidatorT,
+	]
+	// parentCache is the cache of the store this store was copied from, it
+	// is updated when the copy is saved.
+	parentCache *contextCache[
+		ForkT, BeaconBlockHeaderT, ExecutionPayloadHeaderT, Eth1DataT,
+		ValidatorT,
+	]
+	// metrics is the metrics for the store.
+	metrics *storeMetrics
+	// opts holds the options the store was created with.
+	opts options
 }
 
 // Store creates a new instance of Store.
@@ -115,9 +130,19 @@ func New[
 ](
 	kss store.KVStoreService,
 	payloadCodec *encoding.SSZInterfaceCodec[ExecutionPayloadHeaderT],
+	opts ...Option,
 ) *KVStore[
 	ForkT, BeaconBlockHeaderT, ExecutionPayloadHeaderT, Eth1DataT, ValidatorT,
 ] {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	metrics := newStoreMetrics(o.telemetrySink)
+	if o.operationMetrics {
+		kss = newInstrumentedService(kss, metrics)
+	}
+
 	schemaBuilder := sdkcollections.NewSchemaBuilder(kss)
 	return &KVStore[
 		ForkT, BeaconBlockHeaderT,
@@ -247,6 +272,12 @@ func New[
 			keys.LatestBeaconBlockHeaderPrefixHumanReadable,
 			encoding.SSZValueCodec[BeaconBlockHeaderT]{},
 		),
+		cache: &contextCache[
+			ForkT, BeaconBlockHeaderT, ExecutionPayloadHeaderT, Eth1DataT,
+			ValidatorT,
+		]{},
+		metrics: metrics,
+		opts:    o,
 	}
 }
 
@@ -260,6 +291,10 @@ func (kv *KVStore[
 	cctx, write := sdk.UnwrapSDKContext(kv.ctx).CacheContext()
 	ss := kv.WithContext(cctx)
 	ss.write = write
+	// The copy starts from the same state, so it inherits the active
+	// validator count.
+	*ss.cache = kv.cache.forCopy()
+	ss.parentCache = kv.cache
 	return ss
 }
 
@@ -280,6 +315,10 @@ func (kv *KVStore[
 ] {
 	cpy := *kv
 	cpy.ctx = ctx
+	cpy.cache = &contextCache[
+		ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+	]{}
+	cpy.parentCache = nil
 	return &cpy
 }
 
@@ -289,5 +328,8 @@ func (kv *KVStore[
 ]) Save() {
 	if kv.write != nil {
 		kv.write()
+		if kv.parentCache != nil {
+			*kv.parentCache = kv.cache.forCopy()
+		}
 	}
 }
@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/keys"
+)
+
+// Names of the collections of the store, used as metric labels.
+const (
+	collectionFork                         = "fork"
+	collectionLatestBlockHeader            = "latest_block_header"
+	collectionLatestExecutionPayloadHeader = "latest_execution_payload_header"
+	collectionEth1Data                     = "eth1_data"
+)
+
+// Names of the logical collections of the store, used as labels of the
+// operation metrics.
+const (
+	collectionVersioning  = "versioning"
+	collectionHeaders     = "headers"
+	collectionRoots       = "roots"
+	collectionEth1        = "eth1"
+	collectionValidators  = "validators"
+	collectionBalances    = "balances"
+	collectionRandao      = "randao"
+	collectionSlashings   = "slashings"
+	collectionWithdrawals = "withdrawals"
+	collectionOther       = "other"
+)
+
+// validatorIndexesPrefix is the common prefix of the keys of the indexes of
+// the validators.
+//
+//nolint:gochecknoglobals // constant byte sequence.
+var validatorIndexesPrefix = []byte("val_")
+
+// collectionForKey returns the logical collection a key belongs to.
+//
+//nolint:gocyclo,cyclop // one case per prefix.
+func collectionForKey(key []byte) string {
+	if len(key) == 0 {
+		return collectionOther
+	} else if bytes.HasPrefix(key, validatorIndexesPrefix) {
+		return collectionValidators
+	}
+
+	switch key[0] {
+	case keys.GenesisValidatorsRootPrefix, keys.SlotPrefix, keys.ForkPrefix:
+		return collectionVersioning
+	case keys.LatestBeaconBlockHeaderPrefix,
+		keys.LatestExecutionPayloadHeaderPrefix,
+		keys.LatestExecutionPayloadVersionPrefix:
+		return collectionHeaders
+	case keys.BlockRootsPrefix, keys.StateRootsPrefix:
+		return collectionRoots
+	case keys.Eth1BlockHashPrefix, keys.Eth1DataPrefix,
+		keys.Eth1DepositIndexPrefix:
+		return collectionEth1
+	case keys.ValidatorIndexPrefix, keys.ValidatorByIndexPrefix,
+		keys.ValidatorPubkeyToIndexPrefix, keys.ValidatorConsAddrToIndexPrefix,
+		keys.ValidatorEffectiveBalanceToIndexPrefix:
+		return collectionValidators
+	case keys.BalancesPrefix:
+		return collectionBalances
+	case keys.RandaoMixPrefix:
+		return collectionRandao
+	case keys.SlashingsPrefix, keys.TotalSlashingPrefix:
+		return collectionSlashings
+	case keys.WithdrawalQueuePrefix, keys.NextWithdrawalIndexPrefix,
+		keys.NextWithdrawalValidatorIndexPrefix:
+		return collectionWithdrawals
+	default:
+		return collectionOther
+	}
+}
+
+// storeMetrics is a struct that contains metrics for the store.
+type storeMetrics struct {
+	// sink is the sink for the metrics.
+	sink TelemetrySink
+}
+
+// newStoreMetrics creates a new storeMetrics.
+func newStoreMetrics(sink TelemetrySink) *storeMetrics {
+	return &storeMetrics{
+		sink: sink,
+	}
+}
+
+// markCacheHit records a read of the given collection served by the cache.
+func (m *storeMetrics) markCacheHit(collection string) {
+	if m.sink == nil {
+		return
+	}
+	m.sink.IncrementCounter(
+		"beacon_kit.beacondb.cache_hit", "collection", collection,
+	)
+}
+
+// markCacheMiss records a read of the given collection that missed the
+// cache.
+func (m *storeMetrics) markCacheMiss(collection string) {
+	if m.sink == nil {
+		return
+	}
+	m.sink.IncrementCounter(
+		"beacon_kit.beacondb.cache_miss", "collection", collection,
+	)
+}
+
+// markOperation records an operation on the collection of the given key.
+func (m *storeMetrics) markOperation(key []byte, op string, start time.Time) {
+	if m.sink == nil {
+		return
+	}
+	collection := collectionForKey(key)
+	m.sink.IncrementCounter(
+		"beacon_kit.beacondb.operations", "collection", collection, "op", op,
+	)
+	m.sink.MeasureSince(
+		"beacon_kit.beacondb.operation_duration", start,
+		"collection", collection, "op", op,
+	)
+}
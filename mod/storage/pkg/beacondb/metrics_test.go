@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKVStore_OperationMetrics(t *testing.T) {
+	sink := newRecordingSink()
+	kv, _ := newCacheTestStore(
+		t,
+		beacondb.WithTelemetrySink(sink),
+		beacondb.WithOperationMetrics(true),
+	)
+
+	require.NoError(t, kv.SetBalance(0, 1))
+	_, err := kv.GetBalance(0)
+	require.NoError(t, err)
+	require.NoError(t, kv.RemoveValidatorAtIndex(1))
+	require.NoError(t, kv.UpdateRandaoMixAtIndex(0, primitives.Bytes32{}))
+	_, err = kv.GetLatestBlockHeader()
+	require.NoError(t, err)
+	_, err = kv.GetValidators()
+	require.NoError(t, err)
+
+	for _, key := range []string{
+		"collection/balances/op/set",
+		"collection/balances/op/get",
+		"collection/validators/op/delete",
+		"collection/randao/op/set",
+		"collection/headers/op/get",
+		"collection/validators/op/iterate",
+	} {
+		require.Positive(t,
+			sink.counters["beacon_kit.beacondb.operations/"+key], key,
+		)
+		require.Positive(t,
+			sink.measures["beacon_kit.beacondb.operation_duration/"+key], key,
+		)
+	}
+	for key := range sink.counters {
+		require.NotContains(t, key, "collection/other")
+	}
+}
+
+func TestKVStore_OperationMetricsDisabled(t *testing.T) {
+	sink := newRecordingSink()
+	kv, _ := newCacheTestStore(
+		t,
+		beacondb.WithTelemetrySink(sink),
+		beacondb.WithOperationMetrics(false),
+	)
+
+	require.NoError(t, kv.SetBalance(0, 1))
+	_, err := kv.GetBalance(0)
+	require.NoError(t, err)
+	require.Empty(t, sink.counters)
+	require.Empty(t, sink.measures)
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+// Option is a functional option for the KVStore.
+type Option func(*options)
+
+// options holds the configuration of a KVStore.
+type options struct {
+	// slotsPerHistoricalRoot is the length of the block roots and state
+	// roots vectors, zero disables bounds checking.
+	slotsPerHistoricalRoot uint64
+	// epochsPerHistoricalVector is the length of the randao mixes vector,
+	// zero disables epoch based lookups.
+	epochsPerHistoricalVector uint64
+	// readCache enables the read cache of hot items.
+	readCache bool
+	// telemetrySink is the sink for the metrics of the store.
+	telemetrySink TelemetrySink
+	// operationMetrics enables the count and duration metrics of every
+	// operation on the underlying store.
+	operationMetrics bool
+}
+
+// WithSlotsPerHistoricalRoot sets the length of the circular block roots and
+// state roots vectors. Accesses to the vectors are bounds checked against it
+// and it defines the lookback window of GetBlockRootAtSlot.
+func WithSlotsPerHistoricalRoot(slots uint64) Option {
+	return func(o *options) {
+		o.slotsPerHistoricalRoot = slots
+	}
+}
+
+// WithEpochsPerHistoricalVector sets the length of the circular randao mixes
+// vector, for the mixes to be looked up by epoch with GetRandaoMixAtEpoch.
+func WithEpochsPerHistoricalVector(epochs uint64) Option {
+	return func(o *options) {
+		o.epochsPerHistoricalVector = epochs
+	}
+}
+
+// WithReadCache enables caching of the decoded values of the items read on
+// every block: the fork, the latest block header, the latest execution
+// payload header and the eth1 data. Cached values are bound to the context
+// and version of the store and dropped when written, callers must not modify
+// the returned values without writing them back.
+func WithReadCache() Option {
+	return func(o *options) {
+		o.readCache = true
+	}
+}
+
+// WithTelemetrySink sets the sink for the metrics of the store.
+func WithTelemetrySink(sink TelemetrySink) Option {
+	return func(o *options) {
+		o.telemetrySink = sink
+	}
+}
+
+// WithOperationMetrics enables or disables the count and duration metrics of
+// the operations made on the underlying store, labelled with the collection
+// and the operation. They are reported to the sink set with
+// WithTelemetrySink.
+func WithOperationMetrics(enabled bool) Option {
+	return func(o *options) {
+		o.operationMetrics = enabled
+	}
+}
@@ -22,6 +22,7 @@ package beacondb
 
 import (
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // UpdateRandaoMixAtIndex sets the current RANDAO mix in the store.
@@ -46,3 +47,18 @@ func (kv *KVStore[
 	}
 	return primitives.Bytes32(bz), nil
 }
+
+// GetRandaoMixAtEpoch retrieves the RANDAO mix of the epoch from the store,
+// at its index in the circular randao mixes vector. It requires the store to
+// be created with WithEpochsPerHistoricalVector.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) GetRandaoMixAtEpoch(
+	epoch math.Epoch,
+) (primitives.Bytes32, error) {
+	epochs := kv.opts.epochsPerHistoricalVector
+	if epochs == 0 {
+		return primitives.Bytes32{}, ErrRandaoMixesNotConfigured
+	}
+	return kv.GetRandaoMixAtIndex(epoch.Unwrap() % epochs)
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKVStore_GetRandaoMixAtEpoch(t *testing.T) {
+	const epochsPerHistoricalVector = 4
+	key := storetypes.NewKVStoreKey("beacon")
+	ctx := testutil.DefaultContext(
+		key, storetypes.NewTransientStoreKey("transient"),
+	)
+	kv := newTestStore(
+		key, beacondb.WithEpochsPerHistoricalVector(epochsPerHistoricalVector),
+	).WithContext(ctx)
+	for index := range uint64(epochsPerHistoricalVector) {
+		require.NoError(t, kv.UpdateRandaoMixAtIndex(
+			index, primitives.Bytes32{byte(index + 1)},
+		))
+	}
+
+	// The epochs wrap around the mixes vector.
+	for epoch, expected := range map[math.Epoch]primitives.Bytes32{
+		0: {0x01},
+		3: {0x04},
+		6: {0x03},
+		9: {0x02},
+	} {
+		mix, err := kv.GetRandaoMixAtEpoch(epoch)
+		require.NoError(t, err)
+		require.Equal(t, expected, mix, "epoch %d", epoch)
+	}
+
+	// Without a configured length the mixes cannot be looked up by epoch.
+	_, err := newTestStore(key).WithContext(ctx).GetRandaoMixAtEpoch(0)
+	require.ErrorIs(t, err, beacondb.ErrRandaoMixesNotConfigured)
+}
@@ -21,7 +21,9 @@
 package beacondb
 
 import (
+	sdkcollections "cosmossdk.io/collections"
 	"cosmossdk.io/collections/indexes"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -42,6 +44,7 @@ func (kv *KVStore[
 	if err = kv.validators.Set(kv.ctx, idx, val); err != nil {
 		return err
 	}
+	kv.cache.activeCount.update(nil, &val)
 
 	// Push onto the balances list.
 	return kv.balances.Set(kv.ctx, idx, uint64(val.GetEffectiveBalance()))
@@ -54,7 +57,20 @@ func (kv *KVStore[
 	index math.ValidatorIndex,
 	val ValidatorT,
 ) error {
-	return kv.validators.Set(kv.ctx, uint64(index), val)
+	var prev *ValidatorT
+	if kv.cache.activeCount.valid {
+		old, err := kv.validators.Get(kv.ctx, uint64(index))
+		if err == nil {
+			prev = &old
+		} else if !errors.Is(err, sdkcollections.ErrNotFound) {
+			return err
+		}
+	}
+	if err := kv.validators.Set(kv.ctx, uint64(index), val); err != nil {
+		return err
+	}
+	kv.cache.activeCount.update(prev, &val)
+	return nil
 }
 
 // RemoveValidatorAtIndex removes a validator at a specified index.
@@ -63,7 +79,20 @@ func (kv *KVStore[
 ]) RemoveValidatorAtIndex(
 	idx math.ValidatorIndex,
 ) error {
-	return kv.validators.Remove(kv.ctx, uint64(idx))
+	var prev *ValidatorT
+	if kv.cache.activeCount.valid {
+		old, err := kv.validators.Get(kv.ctx, uint64(idx))
+		if err == nil {
+			prev = &old
+		} else if !errors.Is(err, sdkcollections.ErrNotFound) {
+			return err
+		}
+	}
+	if err := kv.validators.Remove(kv.ctx, uint64(idx)); err != nil {
+		return err
+	}
+	kv.cache.activeCount.update(prev, nil)
+	return nil
 }
 
 // ValidatorPubKeyByIndex returns the validator address by index.
@@ -140,15 +169,116 @@ func (kv *KVStore[
 	return vals, nil
 }
 
+// IterateValidators calls fn for every validator in ascending order of
+// index, without loading the whole registry in memory. Iteration ends when
+// fn returns true or an error.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) IterateValidators(
+	fn func(index math.ValidatorIndex, val ValidatorT) (bool, error),
+) error {
+	iter, err := kv.validators.Iterate(kv.ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	var (
+		entry sdkcollections.KeyValue[uint64, ValidatorT]
+		stop  bool
+	)
+	for ; iter.Valid(); iter.Next() {
+		if entry, err = iter.KeyValue(); err != nil {
+			return err
+		}
+		if stop, err = fn(
+			math.ValidatorIndex(entry.Key), entry.Value,
+		); err != nil || stop {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetActiveValidators returns the validators that are active at the given
+// epoch.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) GetActiveValidators(
+	epoch math.Epoch,
+) ([]ValidatorT, error) {
+	var vals []ValidatorT
+	return vals, kv.IterateValidators(
+		func(_ math.ValidatorIndex, val ValidatorT) (bool, error) {
+			if val.IsActive(epoch) {
+				vals = append(vals, val)
+			}
+			return false, nil
+		},
+	)
+}
+
+// GetValidatorsByStatus returns the validators that have the given status at
+// the given epoch.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) GetValidatorsByStatus(
+	epoch math.Epoch,
+	status string,
+) ([]ValidatorT, error) {
+	var vals []ValidatorT
+	return vals, kv.IterateValidators(
+		func(_ math.ValidatorIndex, val ValidatorT) (bool, error) {
+			if val.Status(epoch) == status {
+				vals = append(vals, val)
+			}
+			return false, nil
+		},
+	)
+}
+
+// GetActiveValidatorCount returns the number of validators that are active at
+// the given epoch. The count is cached for the last requested epoch and kept
+// up to date as validators are written through this store.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) GetActiveValidatorCount(
+	epoch math.Epoch,
+) (uint64, error) {
+	if count, ok := kv.cache.activeCount.get(epoch); ok {
+		return count, nil
+	}
+
+	var count uint64
+	if err := kv.IterateValidators(
+		func(_ math.ValidatorIndex, val ValidatorT) (bool, error) {
+			if val.IsActive(epoch) {
+				count++
+			}
+			return false, nil
+		},
+	); err != nil {
+		return 0, err
+	}
+	kv.cache.activeCount.set(epoch, count)
+	return count, nil
+}
+
 // GetTotalValidators returns the total number of validators.
 func (kv *KVStore[
 	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
 ]) GetTotalValidators() (uint64, error) {
-	validators, err := kv.GetValidators()
+	iter, err := kv.validators.Iterate(kv.ctx, nil)
 	if err != nil {
 		return 0, err
 	}
-	return uint64(len(validators)), nil
+	defer iter.Close()
+
+	var total uint64
+	for ; iter.Valid(); iter.Next() {
+		total++
+	}
+	return total, nil
 }
 
 // GetValidatorsByEffectiveBalance retrieves all validators sorted by
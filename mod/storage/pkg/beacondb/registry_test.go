@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// newRegistryTestStore returns a store holding n validators. The validator
+// at index i is active in [i%4, i%4+2).
+func newRegistryTestStore(tb testing.TB, n uint64) *testStore {
+	tb.Helper()
+	key := storetypes.NewKVStoreKey("beacon")
+	ctx := testutil.DefaultContext(
+		key, storetypes.NewTransientStoreKey("transient"),
+	)
+	kv := newTestStore(key).WithContext(ctx)
+	for i := range n {
+		require.NoError(tb, kv.AddValidator(newRegistryTestValidator(i)))
+	}
+	return kv
+}
+
+func newRegistryTestValidator(i uint64) *testValidator {
+	val := &testValidator{
+		Balance:         32,
+		ActivationEpoch: i % 4,
+		ExitEpoch:       i%4 + 2,
+	}
+	binary.BigEndian.PutUint64(val.Pubkey[:], i+1)
+	return val
+}
+
+func TestKVStore_IterateValidators(t *testing.T) {
+	kv := newRegistryTestStore(t, 10)
+
+	var visited []math.ValidatorIndex
+	require.NoError(t, kv.IterateValidators(
+		func(index math.ValidatorIndex, val *testValidator) (bool, error) {
+			require.Equal(t, newRegistryTestValidator(index.Unwrap()), val)
+			visited = append(visited, index)
+			return index == 4, nil
+		},
+	))
+	require.Equal(t, []math.ValidatorIndex{0, 1, 2, 3, 4}, visited)
+
+	errStop := errors.New("stop")
+	require.ErrorIs(t, kv.IterateValidators(
+		func(math.ValidatorIndex, *testValidator) (bool, error) {
+			return false, errStop
+		},
+	), errStop)
+
+	total, err := kv.GetTotalValidators()
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), total)
+}
+
+func TestKVStore_FilteredValidators(t *testing.T) {
+	kv := newRegistryTestStore(t, 8)
+
+	// At epoch 1 the validators activated at epochs 0 and 1 are active.
+	active, err := kv.GetActiveValidators(1)
+	require.NoError(t, err)
+	require.Len(t, active, 4)
+	for _, val := range active {
+		require.True(t, val.IsActive(1))
+	}
+
+	tests := []struct {
+		status string
+		want   int
+	}{
+		{status: "pending", want: 2},
+		{status: "active", want: 4},
+		{status: "exited", want: 2},
+		{status: "unknown", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			vals, err := kv.GetValidatorsByStatus(2, tt.status)
+			require.NoError(t, err)
+			require.Len(t, vals, tt.want)
+		})
+	}
+}
+
+func TestKVStore_ActiveValidatorCount(t *testing.T) {
+	kv := newRegistryTestStore(t, 8)
+
+	count, err := kv.GetActiveValidatorCount(1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), count)
+
+	// Writes through the store keep the cached count up to date.
+	require.NoError(t, kv.AddValidator(newRegistryTestValidator(9)))
+	require.NoError(t, kv.UpdateValidatorAtIndex(
+		0, &testValidator{Pubkey: [48]byte{0xff}, ExitEpoch: 1},
+	))
+	require.NoError(t, kv.RemoveValidatorAtIndex(1))
+	requireActiveCount(t, kv, 1)
+
+	// Writes made on a copy are reflected once the copy is saved.
+	cpy := kv.Copy()
+	require.NoError(t, cpy.RemoveValidatorAtIndex(4))
+	requireActiveCount(t, cpy, 1)
+	cpy.Save()
+	requireActiveCount(t, kv, 1)
+
+	// Other epochs are counted from the store.
+	requireActiveCount(t, kv, 3)
+}
+
+// requireActiveCount checks that the cached active count at the given epoch
+// matches the one computed from the store.
+func requireActiveCount(t *testing.T, kv *testStore, epoch math.Epoch) {
+	t.Helper()
+	active, err := kv.GetActiveValidators(epoch)
+	require.NoError(t, err)
+	count, err := kv.GetActiveValidatorCount(epoch)
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(active)), count)
+}
+
+const benchmarkValidators = 100_000
+
+func BenchmarkKVStore_GetValidators(b *testing.B) {
+	kv := newRegistryTestStore(b, benchmarkValidators)
+	b.ResetTimer()
+	for range b.N {
+		var active int
+		vals, err := kv.GetValidators()
+		require.NoError(b, err)
+		for _, val := range vals {
+			if val.IsActive(1) {
+				active++
+			}
+		}
+	}
+}
+
+func BenchmarkKVStore_IterateValidators(b *testing.B) {
+	kv := newRegistryTestStore(b, benchmarkValidators)
+	b.ResetTimer()
+	for range b.N {
+		var active int
+		require.NoError(b, kv.IterateValidators(
+			func(_ math.ValidatorIndex, val *testValidator) (bool, error) {
+				if val.IsActive(1) {
+					active++
+				}
+				return false, nil
+			},
+		))
+	}
+}
+
+func BenchmarkKVStore_GetActiveValidatorCount(b *testing.B) {
+	kv := newRegistryTestStore(b, benchmarkValidators)
+	b.ResetTimer()
+	for range b.N {
+		_, err := kv.GetActiveValidatorCount(1)
+		require.NoError(b, err)
+	}
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	"context"
+	"sync"
+
+	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
+)
+
+const (
+	// KeyBlocksPrefix is the prefix of the blocks, keyed by slot.
+	KeyBlocksPrefix = "blocks"
+	// KeyBlockRootsPrefix is the prefix of the index of the slots of the
+	// blocks by block root.
+	KeyBlockRootsPrefix = "block_roots"
+)
+
+// ErrNotFound is returned when the requested block is not in the store.
+var ErrNotFound = errors.New("block not found")
+
+// KVStore holds the finalized beacon blocks by slot, indexed by block root.
+type KVStore[BeaconBlockT BeaconBlock[BeaconBlockT]] struct {
+	blocks sdkcollections.Map[uint64, BeaconBlockT]
+	roots  sdkcollections.Map[[]byte, uint64]
+	mu     sync.RWMutex
+}
+
+// NewStore creates a new block store.
+func NewStore[BeaconBlockT BeaconBlock[BeaconBlockT]](
+	kvsp store.KVStoreService,
+) *KVStore[BeaconBlockT] {
+	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
+	return &KVStore[BeaconBlockT]{
+		blocks: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{uint8(0)}),
+			KeyBlocksPrefix,
+			sdkcollections.Uint64Key,
+			&encoding.SSZInterfaceCodec[BeaconBlockT]{},
+		),
+		roots: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{uint8(1)}),
+			KeyBlockRootsPrefix,
+			sdkcollections.BytesKey,
+			sdkcollections.Uint64Value,
+		),
+	}
+}
+
+// Set stores the block and indexes it by its root.
+func (kv *KVStore[BeaconBlockT]) Set(blk BeaconBlockT) error {
+	root, err := blk.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	slot := blk.GetSlot().Unwrap()
+	if err = kv.blocks.Set(context.TODO(), slot, blk); err != nil {
+		return err
+	}
+	return kv.roots.Set(context.TODO(), root[:], slot)
+}
+
+// GetBySlot returns the block at slot.
+func (kv *KVStore[BeaconBlockT]) GetBySlot(
+	slot math.Slot,
+) (BeaconBlockT, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	return kv.get(slot.Unwrap())
+}
+
+// GetByRoot returns the block of the given root.
+func (kv *KVStore[BeaconBlockT]) GetByRoot(
+	root common.Root,
+) (BeaconBlockT, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	slot, err := kv.roots.Get(context.TODO(), root[:])
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		var blk BeaconBlockT
+		return blk, errors.Wrapf(ErrNotFound, "root %s", root)
+	}
+	if err != nil {
+		var blk BeaconBlockT
+		return blk, err
+	}
+	return kv.get(slot)
+}
+
+// GetLatest returns the block of the highest slot.
+func (kv *KVStore[BeaconBlockT]) GetLatest() (BeaconBlockT, error) {
+	var blk BeaconBlockT
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	iter, err := kv.blocks.Iterate(
+		context.TODO(),
+		new(sdkcollections.Range[uint64]).Descending(),
+	)
+	if err != nil {
+		return blk, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return blk, errors.Wrap(ErrNotFound, "store is empty")
+	}
+	return iter.Value()
+}
+
+// get returns the block at slot.
+func (kv *KVStore[BeaconBlockT]) get(slot uint64) (BeaconBlockT, error) {
+	blk, err := kv.blocks.Get(context.TODO(), slot)
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return blk, errors.Wrapf(ErrNotFound, "slot %d", slot)
+	}
+	return blk, err
+}
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// testBlock is a block holding its slot.
+type testBlock struct {
+	Slot uint64
+}
+
+func (b *testBlock) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(dst, b.Slot), nil
+}
+
+func (b *testBlock) MarshalSSZ() ([]byte, error) {
+	return b.MarshalSSZTo(nil)
+}
+
+func (b *testBlock) UnmarshalSSZ(bz []byte) error {
+	if len(bz) != b.SizeSSZ() {
+		return errors.New("invalid size")
+	}
+	b.Slot = binary.LittleEndian.Uint64(bz)
+	return nil
+}
+
+func (*testBlock) SizeSSZ() int { return 8 }
+
+func (b *testBlock) HashTreeRoot() ([32]byte, error) {
+	bz, err := b.MarshalSSZ()
+	return sha256.Sum256(bz), err
+}
+
+func (*testBlock) NewFromSSZ(bz []byte, _ uint32) (*testBlock, error) {
+	b := new(testBlock)
+	return b, b.UnmarshalSSZ(bz)
+}
+
+func (*testBlock) Version() uint32 { return 0 }
+
+func (b *testBlock) GetSlot() math.Slot { return math.Slot(b.Slot) }
+
+// testProvider opens the in-memory store of ctx.
+type testProvider struct {
+	key *storetypes.KVStoreKey
+	ctx sdk.Context
+}
+
+func (p testProvider) OpenKVStore(context.Context) store.KVStore {
+	return runtime.NewKVStoreService(p.key).OpenKVStore(p.ctx)
+}
+
+func newTestStore() *block.KVStore[*testBlock] {
+	key := storetypes.NewKVStoreKey("blocks")
+	ctx := testutil.DefaultContext(
+		key, storetypes.NewTransientStoreKey("transient"),
+	)
+	return block.NewStore[*testBlock](testProvider{key: key, ctx: ctx})
+}
+
+func TestKVStore(t *testing.T) {
+	kv := newTestStore()
+
+	_, err := kv.GetLatest()
+	require.ErrorIs(t, err, block.ErrNotFound)
+
+	for _, slot := range []uint64{3, 1, 2} {
+		require.NoError(t, kv.Set(&testBlock{Slot: slot}))
+	}
+
+	blk, err := kv.GetBySlot(2)
+	require.NoError(t, err)
+	require.Equal(t, &testBlock{Slot: 2}, blk)
+
+	root, err := (&testBlock{Slot: 1}).HashTreeRoot()
+	require.NoError(t, err)
+	blk, err = kv.GetByRoot(root)
+	require.NoError(t, err)
+	require.Equal(t, &testBlock{Slot: 1}, blk)
+
+	blk, err = kv.GetLatest()
+	require.NoError(t, err)
+	require.Equal(t, &testBlock{Slot: 3}, blk)
+
+	_, err = kv.GetBySlot(4)
+	require.ErrorIs(t, err, block.ErrNotFound)
+	_, err = kv.GetByRoot(common.Root{0x01})
+	require.ErrorIs(t, err, block.ErrNotFound)
+}
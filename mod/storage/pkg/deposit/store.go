@@ -32,7 +32,10 @@ import (
 )
 
 // Deposit is a struct that holds the deposit information.
-var _ pruner.Prunable = (*KVStore[Deposit])(nil)
+var (
+	_ pruner.CountingPrunable = (*KVStore[Deposit])(nil)
+	_ pruner.RangeCounter     = (*KVStore[Deposit])(nil)
+)
 
 const KeyDepositPrefix = "deposit"
 
@@ -115,13 +118,64 @@ func (kv *KVStore[DepositT]) setDeposit(deposit DepositT) error {
 
 // Prune removes the [start, end) deposits from the store.
 func (kv *KVStore[DepositT]) Prune(start, end uint64) error {
+	_, err := kv.PruneCounted(start, end)
+	return err
+}
+
+// PruneCounted removes the [start, end) deposits from the store and returns
+// the number of deposits removed. Only the deposits present in the store are
+// visited, so pruning from the start of a range that was pruned before is
+// cheap.
+func (kv *KVStore[DepositT]) PruneCounted(start, end uint64) (uint64, error) {
+	if start >= end {
+		return 0, nil
+	}
+
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
-	for i := range end {
+	iter, err := kv.store.Iterate(
+		context.TODO(),
+		new(sdkcollections.Range[uint64]).
+			StartInclusive(start).
+			EndExclusive(end),
+	)
+	if err != nil {
+		return 0, err
+	}
+	indexes, err := iter.Keys()
+	if err != nil {
+		return 0, err
+	}
+	for _, index := range indexes {
 		// This only errors if the key passed in cannot be encoded.
-		if err := kv.store.Remove(context.TODO(), start+i); err != nil {
-			return err
+		if err = kv.store.Remove(context.TODO(), index); err != nil {
+			return 0, err
 		}
 	}
-	return nil
+	return uint64(len(indexes)), nil
+}
+
+// CountRange returns the number of deposits in the store with an index in
+// [start, end).
+func (kv *KVStore[DepositT]) CountRange(start, end uint64) (uint64, error) {
+	if start >= end {
+		return 0, nil
+	}
+
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	iter, err := kv.store.Iterate(
+		context.TODO(),
+		new(sdkcollections.Range[uint64]).
+			StartInclusive(start).
+			EndExclusive(end),
+	)
+	if err != nil {
+		return 0, err
+	}
+	indexes, err := iter.Keys()
+	if err != nil {
+		return 0, err
+	}
+	return uint64(len(indexes)), nil
 }
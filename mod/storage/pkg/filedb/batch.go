@@ -0,0 +1,330 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/spf13/afero"
+)
+
+const (
+	// stagingDir is the directory, relative to the root of the database, in
+	// which batches are staged before being committed.
+	stagingDir = ".staging"
+	// manifestExtension is the extension of a batch manifest. A batch is
+	// committed once its manifest has been durably written.
+	manifestExtension = ".commit"
+	// batchIDLen is the number of random bytes in a batch identifier.
+	batchIDLen = 8
+)
+
+// BatchPhase is a phase of a batch write.
+type BatchPhase uint8
+
+const (
+	// BatchPhaseStage is the phase in which values are written to temporary
+	// files.
+	BatchPhaseStage BatchPhase = iota
+	// BatchPhaseCommit is the phase in which staged files are renamed into
+	// place, after the batch has been committed.
+	BatchPhaseCommit
+)
+
+// BatchFailpoint is invoked by a batch before the n-th operation of the given
+// phase. Returning an error aborts the batch at that point, which allows
+// tests to simulate a crash.
+type BatchFailpoint func(phase BatchPhase, n int) error
+
+// stagedWrite is a value that has been written to a temporary file and is
+// waiting to be renamed into place.
+type stagedWrite struct {
+	Tmp  string `json:"tmp"`
+	Path string `json:"path"`
+	// raw is the size of the value before encoding, it is only known to
+	// the process that staged the write.
+	raw int
+}
+
+// Batch is a set of writes that become visible atomically. Values are staged
+// to temporary files as they are added and only become visible once Write
+// succeeds. If the process crashes before the batch is committed, none of
+// the values are visible after the database is reopened; if it crashes after,
+// all of them are.
+type Batch struct {
+	db     *DB
+	id     string
+	writes []stagedWrite
+	// committed is set once the manifest of the batch has been written.
+	committed bool
+}
+
+// Batch returns a new batch for the database.
+func (db *DB) Batch() *Batch {
+	return &Batch{db: db}
+}
+
+// Len returns the number of writes in the batch.
+func (b *Batch) Len() int {
+	return len(b.writes)
+}
+
+// Set stages the value for a key. The value is not visible until the batch
+// is written.
+func (b *Batch) Set(key []byte, value []byte) error {
+	if b.id == "" {
+		id, err := newBatchID()
+		if err != nil {
+			return err
+		}
+		b.id = id
+		if err = b.db.fs.MkdirAll(b.dir(), b.db.dirPerms); err != nil {
+			return err
+		}
+	}
+
+	if err := b.db.failpoint(BatchPhaseStage, len(b.writes)); err != nil {
+		return err
+	}
+
+	tmp := filepath.Join(b.dir(), strconv.Itoa(len(b.writes)))
+	if _, err := b.db.writeValue(
+		tmp, value, b.db.syncsBatches(),
+	); err != nil {
+		return err
+	}
+
+	b.writes = append(b.writes, stagedWrite{
+		Tmp:  tmp,
+		Path: b.db.pathForKey(key),
+		raw:  len(value),
+	})
+	return nil
+}
+
+// Write commits the batch. The batch is committed once its manifest has
+// been synced to disk, after which the staged files are renamed into place.
+// An error returned after the commit point leaves the batch to be completed
+// when the database is next opened.
+func (b *Batch) Write() error {
+	if len(b.writes) == 0 {
+		return nil
+	}
+
+	manifest, err := json.Marshal(b.writes)
+	if err != nil {
+		return err
+	}
+
+	// Barrier: every staged file has been synced by Set, syncing the manifest
+	// commits the batch.
+	durable := b.db.syncsBatches()
+	if err = b.db.writeRaw(b.manifest(), manifest, durable); err != nil {
+		return errors.Wrap(err, "failed to commit batch")
+	}
+	if durable {
+		if err = b.db.syncPath(stagingDir); err != nil {
+			return errors.Wrap(err, "failed to commit batch")
+		}
+	}
+	b.committed = true
+
+	if err = b.db.applyBatch(b.writes, b.db.failpoint); err != nil {
+		return errors.Wrap(err, "failed to apply committed batch")
+	}
+	if err = b.db.syncApplied(b.writes); err != nil {
+		return errors.Wrap(err, "failed to sync committed batch")
+	}
+
+	if err = b.db.fs.RemoveAll(b.dir()); err != nil {
+		return err
+	}
+	return b.db.fs.Remove(b.manifest())
+}
+
+// Discard removes the staged files of a batch that has not been committed.
+// A committed batch that failed to apply is left in place so that it is
+// completed when the database is next opened.
+func (b *Batch) Discard() error {
+	if b.id == "" || b.committed {
+		return nil
+	}
+	if err := b.db.fs.RemoveAll(b.dir()); err != nil {
+		return err
+	}
+	if err := b.db.fs.Remove(b.manifest()); err != nil &&
+		!os.IsNotExist(err) {
+		return err
+	}
+	b.writes = nil
+	b.id = ""
+	return nil
+}
+
+// dir returns the staging directory of the batch.
+func (b *Batch) dir() string {
+	return filepath.Join(stagingDir, b.id)
+}
+
+// manifest returns the path of the manifest of the batch.
+func (b *Batch) manifest() string {
+	return b.dir() + manifestExtension
+}
+
+// applyBatch renames the staged writes of a committed batch into place.
+// Writes whose staged file no longer exists have already been applied.
+func (db *DB) applyBatch(writes []stagedWrite, fp BatchFailpoint) error {
+	for i, w := range writes {
+		if err := fp(BatchPhaseCommit, i); err != nil {
+			return err
+		}
+		if err := db.applyWrite(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyWrite renames a staged write into place, holding the lock of its key.
+func (db *DB) applyWrite(w stagedWrite) error {
+	mu := db.locks.forKey(db.keyForPath(w.Path))
+	mu.Lock()
+	defer mu.Unlock()
+
+	start := time.Now()
+	size, err := db.sizeOfFile(w.Tmp)
+	if err != nil {
+		return err
+	} else if size == 0 {
+		return nil
+	}
+	replaced, err := db.sizeOfFile(w.Path)
+	if err != nil {
+		return err
+	}
+
+	db.locks.dirs.RLock()
+	defer db.locks.dirs.RUnlock()
+	if err = db.fs.MkdirAll(filepath.Dir(w.Path), db.dirPerms); err != nil {
+		return err
+	}
+	if err = db.fs.Rename(w.Tmp, w.Path); err != nil {
+		return err
+	}
+	db.metrics.markWrite(start, w.raw, size, replaced)
+	return nil
+}
+
+// recoverBatches completes batches that were committed but not fully applied
+// and discards batches that were never committed. It is called when the
+// database is opened.
+func (db *DB) recoverBatches() error {
+	entries, err := afero.ReadDir(db.fs, stagingDir)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if !strings.HasSuffix(entry.Name(), manifestExtension) {
+			continue
+		}
+
+		var (
+			bz     []byte
+			writes []stagedWrite
+		)
+		bz, err = afero.ReadFile(
+			db.fs, filepath.Join(stagingDir, entry.Name()),
+		)
+		if err != nil {
+			return err
+		}
+		// A manifest that cannot be decoded was never fully synced, so the
+		// batch was not committed.
+		if err = json.Unmarshal(bz, &writes); err != nil {
+			db.logger.Warn(
+				"discarding uncommitted batch", "manifest", entry.Name(),
+			)
+			continue
+		}
+		if err = db.applyBatch(writes, noopFailpoint); err != nil {
+			return err
+		}
+		if err = db.syncApplied(writes); err != nil {
+			return err
+		}
+		db.logger.Info(
+			"recovered committed batch", "manifest", entry.Name(),
+			"writes", len(writes),
+		)
+	}
+
+	return db.fs.RemoveAll(stagingDir)
+}
+
+// failpoint invokes the configured failpoint, if any.
+func (db *DB) failpoint(phase BatchPhase, n int) error {
+	if db.batchFailpoint == nil {
+		return nil
+	}
+	return db.batchFailpoint(phase, n)
+}
+
+// syncApplied makes the renames of an applied batch durable by syncing the
+// directories they were made in. Under SyncPerBatch, the writes made outside
+// of a batch since the last sync are flushed along with it.
+func (db *DB) syncApplied(writes []stagedWrite) error {
+	if !db.syncsBatches() {
+		return nil
+	}
+	paths := make([]string, 0, len(writes))
+	for _, w := range writes {
+		paths = append(paths, w.Path)
+	}
+	if err := db.syncDirs(paths); err != nil {
+		return err
+	}
+	return db.Sync()
+}
+
+// noopFailpoint is a failpoint that never fails.
+func noopFailpoint(BatchPhase, int) error {
+	return nil
+}
+
+// newBatchID returns a new random batch identifier.
+func newBatchID() (string, error) {
+	bz := make([]byte, batchIDLen)
+	if _, err := rand.Read(bz); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bz), nil
+}
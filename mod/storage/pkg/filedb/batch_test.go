@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	file "github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/stretchr/testify/require"
+)
+
+var errCrash = errors.New("simulated crash")
+
+func TestBatch_Write(t *testing.T) {
+	dir := t.TempDir()
+	rdb := file.NewRangeDB(newBatchTestDB(dir, nil))
+
+	batch := rdb.Batch()
+	for i := range 3 {
+		require.NoError(t, batch.Set(7, batchKey(i), batchValue(i)))
+	}
+
+	// Staged values are not visible before the batch is written.
+	requireBatchVisible(t, rdb, 3, false)
+	require.NoError(t, batch.Write())
+	requireBatchVisible(t, rdb, 3, true)
+
+	// The staging area is cleaned up after a successful write.
+	entries, err := os.ReadDir(filepath.Join(dir, ".staging"))
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestBatch_Discard(t *testing.T) {
+	rdb := file.NewRangeDB(newBatchTestDB(t.TempDir(), nil))
+
+	batch := rdb.Batch()
+	for i := range 3 {
+		require.NoError(t, batch.Set(7, batchKey(i), batchValue(i)))
+	}
+	require.NoError(t, batch.Discard())
+	require.NoError(t, batch.Write())
+	requireBatchVisible(t, rdb, 3, false)
+}
+
+func TestRangeDB_SetBatch(t *testing.T) {
+	rdb := file.NewRangeDB(newBatchTestDB(t.TempDir(), nil))
+
+	keys := [][]byte{batchKey(0), batchKey(1), batchKey(2)}
+	values := [][]byte{batchValue(0), batchValue(1), batchValue(2)}
+	require.NoError(t, rdb.SetBatch(7, keys, values))
+	requireBatchVisible(t, rdb, 3, true)
+
+	require.Error(t, rdb.SetBatch(8, keys, values[:1]))
+}
+
+// TestBatch_CrashRecovery simulates a crash at every step of a batch write
+// and asserts that after restarting either none or all of the entries are
+// visible.
+func TestBatch_CrashRecovery(t *testing.T) {
+	const numWrites = 4
+
+	for _, tc := range []struct {
+		phase       file.BatchPhase
+		expectAll   bool
+		description string
+	}{
+		{file.BatchPhaseStage, false, "stage"},
+		{file.BatchPhaseCommit, true, "commit"},
+	} {
+		for crashAt := range numWrites {
+			name := fmt.Sprintf("%s-%d", tc.description, crashAt)
+			t.Run(name, func(t *testing.T) {
+				dir := t.TempDir()
+				rdb := file.NewRangeDB(newBatchTestDB(
+					dir,
+					func(phase file.BatchPhase, n int) error {
+						if phase == tc.phase && n == crashAt {
+							return errCrash
+						}
+						return nil
+					},
+				))
+
+				// The process dies as soon as the failpoint triggers, so
+				// the batch is neither discarded nor retried.
+				batch := rdb.Batch()
+				var err error
+				for i := 0; i < numWrites && err == nil; i++ {
+					err = batch.Set(7, batchKey(i), batchValue(i))
+				}
+				if err == nil {
+					err = batch.Write()
+				}
+				require.ErrorIs(t, err, errCrash)
+
+				// Restart the database on the same directory.
+				restarted := file.NewRangeDB(newBatchTestDB(dir, nil))
+				requireBatchVisible(
+					t, restarted, numWrites, tc.expectAll,
+				)
+				_, err = os.Stat(filepath.Join(dir, ".staging"))
+				require.True(t, os.IsNotExist(err))
+			})
+		}
+	}
+}
+
+// newBatchTestDB returns a new file DB rooted at dir with the given
+// failpoint.
+func newBatchTestDB(dir string, fp file.BatchFailpoint) *file.DB {
+	return file.NewDB(
+		file.WithRootDirectory(dir),
+		file.WithFileExtension("ssz"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+		file.WithBatchFailpoint(fp),
+	)
+}
+
+func batchKey(i int) []byte {
+	return []byte(fmt.Sprintf("key-%d", i))
+}
+
+func batchValue(i int) []byte {
+	return []byte(fmt.Sprintf("value-%d", i))
+}
+
+// requireBatchVisible requires the first n entries at index 7 to be visible
+// or not.
+func requireBatchVisible(
+	t *testing.T, rdb *file.RangeDB, n int, visible bool,
+) {
+	t.Helper()
+	for i := range n {
+		exists, err := rdb.Has(7, batchKey(i))
+		require.NoError(t, err)
+		require.Equal(t, visible, exists, "entry %d", i)
+		if visible {
+			value, err := rdb.Get(7, batchKey(i))
+			require.NoError(t, err)
+			require.Equal(t, batchValue(i), value)
+		}
+	}
+}
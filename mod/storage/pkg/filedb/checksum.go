@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+const (
+	// checksumLen is the length of the CRC32 checksum in the trailer.
+	checksumLen = 4
+	// checksumTrailerLen is the length of the checksum plus the trailer
+	// magic.
+	checksumTrailerLen = checksumLen + 4
+)
+
+//nolint:gochecknoglobals // constant lookup table and byte sequence.
+var (
+	// checksumTable is the CRC32 table used to checksum entries.
+	checksumTable = crc32.MakeTable(crc32.Castagnoli)
+	// checksumMagic terminates every entry written with a checksum so that
+	// entries written before checksums were introduced remain readable.
+	checksumMagic = []byte{0xc4, 0xec, 0x5a, 0x11}
+)
+
+// appendChecksum appends the checksum trailer to the stored bytes.
+func appendChecksum(bz []byte) []byte {
+	out := make([]byte, len(bz), len(bz)+checksumTrailerLen)
+	copy(out, bz)
+	out = binary.LittleEndian.AppendUint32(
+		out, crc32.Checksum(bz, checksumTable),
+	)
+	return append(out, checksumMagic...)
+}
+
+// splitChecksum splits the bytes read from disk into the payload and the
+// checksum trailer. The returned bool is false if the entry has no trailer.
+func splitChecksum(bz []byte) ([]byte, uint32, bool) {
+	if len(bz) < checksumTrailerLen ||
+		!bytes.Equal(bz[len(bz)-len(checksumMagic):], checksumMagic) {
+		return bz, 0, false
+	}
+	payload := bz[:len(bz)-checksumTrailerLen]
+	return payload, binary.LittleEndian.Uint32(bz[len(payload):]), true
+}
+
+// verifyEntry verifies the checksum of an entry read from disk and returns
+// its payload. Entries without a checksum are returned as is.
+func (db *DB) verifyEntry(key []byte, bz []byte) ([]byte, error) {
+	payload, checksum, ok := splitChecksum(bz)
+	if !ok {
+		db.logLegacyEntry(key)
+		return bz, nil
+	}
+	if crc32.Checksum(payload, checksumTable) != checksum {
+		return nil, ErrCorruptedEntry{Key: key}
+	}
+	return payload, nil
+}
+
+// logLegacyEntry logs the read of an entry written without a checksum. Only
+// the first such read is logged as a warning, the following ones are logged
+// at debug level.
+func (db *DB) logLegacyEntry(key []byte) {
+	warned := false
+	db.legacyWarning.Do(func() {
+		warned = true
+		db.logger.Warn(
+			"reading entries without checksum, written before checksums "+
+				"were introduced",
+			"key", string(key),
+		)
+	})
+	if !warned {
+		db.logger.Debug("reading entry without checksum", "key", string(key))
+	}
+}
+
+// Verify scans every entry in the database and returns the keys of the
+// entries that are corrupted. Entries written without a checksum are only
+// reported as corrupted if they cannot be decoded.
+func (db *DB) Verify(ctx context.Context) ([][]byte, error) {
+	var (
+		corrupted [][]byte
+		legacy    int
+		suffix    = "." + db.extension
+	)
+	err := afero.Walk(db.fs, ".", func(
+		path string, info fs.FileInfo, err error,
+	) error {
+		// Entries removed while the database is scanned are skipped.
+		if os.IsNotExist(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if info.IsDir() {
+			if path == stagingDir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, suffix) {
+			return nil
+		}
+
+		key := db.keyForPath(path)
+		bz, err := db.readLocked(key, path)
+		if os.IsNotExist(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		payload, checksum, ok := splitChecksum(bz)
+		switch {
+		case !ok:
+			legacy++
+		case crc32.Checksum(payload, checksumTable) != checksum:
+			corrupted = append(corrupted, key)
+			return nil
+		}
+		if _, err = decompressFrame(key, payload); err != nil {
+			corrupted = append(corrupted, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if legacy > 0 {
+		db.logger.Warn("found entries without checksum", "count", legacy)
+	}
+	for _, key := range corrupted {
+		db.logger.Error("found corrupted entry", "key", string(key))
+	}
+	return corrupted, nil
+}
+
+// readLocked reads the file at path, holding the lock of its key.
+func (db *DB) readLocked(key []byte, path string) ([]byte, error) {
+	mu := db.locks.forKey(key)
+	mu.RLock()
+	defer mu.RUnlock()
+	return afero.ReadFile(db.fs, path)
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	file "github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_Checksum_DetectsFlippedByte(t *testing.T) {
+	for _, codec := range []string{
+		file.CompressionNone,
+		file.CompressionSnappy,
+		file.CompressionZstd,
+	} {
+		t.Run(codec, func(t *testing.T) {
+			dir := t.TempDir()
+			db := newCompressedDB(dir, codec)
+			require.NoError(t, db.Set([]byte("key"), []byte("some-value")))
+
+			flipByte(t, filepath.Join(dir, "key.ssz"), 0)
+
+			_, err := db.Get([]byte("key"))
+			var corrupted file.ErrCorruptedEntry
+			require.True(t, errors.As(err, &corrupted))
+			require.Equal(t, []byte("key"), corrupted.Key)
+		})
+	}
+}
+
+func TestDB_Checksum_LegacyEntry(t *testing.T) {
+	dir := t.TempDir()
+	db := newCompressedDB(dir, file.CompressionNone)
+
+	// Entries written before checksums were introduced have no trailer.
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "legacy.ssz"), []byte("legacy-value"), 0600,
+	))
+
+	value, err := db.Get([]byte("legacy"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("legacy-value"), value)
+
+	corrupted, err := db.Verify(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, corrupted)
+}
+
+// logLines records the lines of a log.TestLogger.
+type logLines []string
+
+func (*logLines) Helper() {}
+
+func (l *logLines) Logf(format string, args ...any) {
+	*l = append(*l, fmt.Sprintf(format, args...))
+}
+
+func TestDB_Checksum_LegacyEntryWarnsOnce(t *testing.T) {
+	dir := t.TempDir()
+	var lines logLines
+	db := file.NewDB(
+		file.WithRootDirectory(dir),
+		file.WithFileExtension("ssz"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewTestLogger(&lines).WithLevel(log.LevelWarn)),
+	)
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "legacy.ssz"), []byte("legacy-value"), 0600,
+	))
+
+	// Every read of the entry, whole or streamed, would otherwise warn.
+	for range 3 {
+		_, err := db.Get([]byte("legacy"))
+		require.NoError(t, err)
+		r, _, err := db.GetReader([]byte("legacy"))
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+	}
+	require.Len(t, lines, 1)
+	require.Contains(t, lines[0], "reading entries without checksum")
+}
+
+func TestDB_Verify(t *testing.T) {
+	dir := t.TempDir()
+	rdb := file.NewRangeDB(newCompressedDB(dir, file.CompressionSnappy))
+	require.NoError(t, populateTestDB(rdb, 1, 5))
+
+	corrupted, err := rdb.Verify(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, corrupted)
+
+	// Corrupt the entry at index 3.
+	matches, err := filepath.Glob(filepath.Join(dir, "shard-0", "3", "*.ssz"))
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	flipByte(t, matches[0], 6)
+
+	corrupted, err = rdb.Verify(context.Background())
+	require.NoError(t, err)
+	require.Len(t, corrupted, 1)
+	index, err := file.ExtractIndex(corrupted[0])
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), index)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = rdb.Verify(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+// flipByte flips every bit of the byte at the given offset of a file.
+func flipByte(t *testing.T, path string, offset int) {
+	t.Helper()
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	bz[offset] ^= 0xff
+	require.NoError(t, os.WriteFile(path, bz, 0600))
+}
@@ -0,0 +1,200 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb
+
+import (
+	"bytes"
+	"sync"
+	"sync/atomic"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// CompressionNone disables compression of stored values.
+	CompressionNone = "none"
+	// CompressionSnappy compresses stored values with snappy.
+	CompressionSnappy = "snappy"
+	// CompressionZstd compresses stored values with zstd.
+	CompressionZstd = "zstd"
+)
+
+// Codec identifiers written after the frame magic.
+const (
+	snappyCodecID byte = iota + 1
+	zstdCodecID
+)
+
+// frameMagic prefixes every compressed value so that uncompressed values
+// written before compression was enabled remain readable.
+//
+//nolint:gochecknoglobals // constant byte sequence.
+var frameMagic = []byte{0xbe, 0xac, 0xc0, 0xde}
+
+// frameHeaderLen is the length of the magic plus the codec identifier.
+const frameHeaderLen = 5
+
+// codec compresses and decompresses values stored in the database.
+type codec interface {
+	// id returns the identifier written into the frame header.
+	id() byte
+	// encode compresses src.
+	encode(src []byte) []byte
+	// decode decompresses src.
+	decode(src []byte) ([]byte, error)
+}
+
+// newCodec returns the codec for the given name, or nil if compression is
+// disabled.
+func newCodec(name string) (codec, error) {
+	switch name {
+	case "", CompressionNone:
+		return nil, nil
+	case CompressionSnappy:
+		return snappyCodec{}, nil
+	case CompressionZstd:
+		return sharedZstdCodec()
+	default:
+		return nil, errors.Newf("unsupported compression codec: %s", name)
+	}
+}
+
+// snappyCodec is a codec backed by snappy block compression.
+type snappyCodec struct{}
+
+func (snappyCodec) id() byte { return snappyCodecID }
+
+func (snappyCodec) encode(src []byte) []byte {
+	return snappy.Encode(nil, src)
+}
+
+func (snappyCodec) decode(src []byte) ([]byte, error) {
+	return snappy.Decode(nil, src)
+}
+
+// sharedZstdCodec lazily builds a single zstdCodec shared by all databases.
+//
+//nolint:gochecknoglobals // zstd encoders are expensive to construct.
+var sharedZstdCodec = sync.OnceValues(newZstdCodec)
+
+// zstdCodec is a codec backed by zstd. The encoder and decoder are safe for
+// concurrent use through EncodeAll and DecodeAll.
+type zstdCodec struct {
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+// newZstdCodec creates a new zstdCodec.
+func newZstdCodec() (codec, error) {
+	encoder, err := zstd.NewWriter(nil)
+	if err != nil {
+		return nil, err
+	}
+	decoder, err := zstd.NewReader(nil)
+	if err != nil {
+		return nil, err
+	}
+	return &zstdCodec{encoder: encoder, decoder: decoder}, nil
+}
+
+func (*zstdCodec) id() byte { return zstdCodecID }
+
+func (c *zstdCodec) encode(src []byte) []byte {
+	return c.encoder.EncodeAll(src, nil)
+}
+
+func (c *zstdCodec) decode(src []byte) ([]byte, error) {
+	return c.decoder.DecodeAll(src, nil)
+}
+
+// compressFrame compresses value with the given codec and prepends the frame
+// header.
+func compressFrame(c codec, value []byte) []byte {
+	compressed := c.encode(value)
+	frame := make([]byte, 0, frameHeaderLen+len(compressed))
+	frame = append(frame, frameMagic...)
+	frame = append(frame, c.id())
+	return append(frame, compressed...)
+}
+
+// decompressFrame decompresses a value read from disk. Values without a
+// frame header are returned as is, since they were written without
+// compression. The codec is taken from the frame header, so values written
+// with a different codec than the one currently configured remain readable.
+func decompressFrame(key []byte, bz []byte) ([]byte, error) {
+	if len(bz) < frameHeaderLen ||
+		!bytes.Equal(bz[:len(frameMagic)], frameMagic) {
+		return bz, nil
+	}
+
+	var (
+		c   codec
+		err error
+	)
+	switch id := bz[len(frameMagic)]; id {
+	case snappyCodecID:
+		c = snappyCodec{}
+	case zstdCodecID:
+		if c, err = sharedZstdCodec(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.Wrapf(
+			ErrCorruptedFrame, "unknown codec %d for key %s", id, key,
+		)
+	}
+
+	value, err := c.decode(bz[frameHeaderLen:])
+	if err != nil {
+		return nil, errors.Wrapf(
+			ErrCorruptedFrame, "failed to decompress key %s: %v", key, err,
+		)
+	}
+	return value, nil
+}
+
+// compressionStats tracks the number of bytes handed to the database and the
+// number of bytes actually written to disk.
+type compressionStats struct {
+	rawBytes    atomic.Uint64
+	storedBytes atomic.Uint64
+}
+
+// record records a single write.
+func (s *compressionStats) record(raw, stored int) {
+	//#nosec:G115 // lengths are never negative.
+	s.rawBytes.Add(uint64(raw))
+	//#nosec:G115 // lengths are never negative.
+	s.storedBytes.Add(uint64(stored))
+}
+
+// CompressionRatio returns the ratio of bytes written by callers to bytes
+// stored on disk since the database was opened. A ratio of 1 means no
+// space was saved.
+func (db *DB) CompressionRatio() float64 {
+	stored := db.stats.storedBytes.Load()
+	if stored == 0 {
+		return 1
+	}
+	return float64(db.stats.rawBytes.Load()) / float64(stored)
+}
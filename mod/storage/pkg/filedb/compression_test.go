@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	file "github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_Compression(t *testing.T) {
+	value := bytes.Repeat([]byte("beacon-kit"), 1024)
+
+	for _, codec := range []string{
+		file.CompressionNone,
+		file.CompressionSnappy,
+		file.CompressionZstd,
+	} {
+		t.Run(codec, func(t *testing.T) {
+			dir := t.TempDir()
+			db := newCompressedDB(dir, codec)
+
+			require.NoError(t, db.Set([]byte("key"), value))
+			got, err := db.Get([]byte("key"))
+			require.NoError(t, err)
+			require.Equal(t, value, got)
+
+			info, err := os.Stat(filepath.Join(dir, "key.ssz"))
+			require.NoError(t, err)
+			if codec == file.CompressionNone {
+				require.GreaterOrEqual(t, info.Size(), int64(len(value)))
+				require.InDelta(t, 1.0, db.CompressionRatio(), 0.01)
+			} else {
+				require.Less(t, info.Size(), int64(len(value)))
+				require.Greater(t, db.CompressionRatio(), 1.0)
+			}
+		})
+	}
+}
+
+func TestDB_Compression_MixedFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	legacy := newCompressedDB(dir, file.CompressionNone)
+	require.NoError(t, legacy.Set([]byte("legacy"), []byte("legacy-value")))
+
+	snappyDB := newCompressedDB(dir, file.CompressionSnappy)
+	require.NoError(t, snappyDB.Set([]byte("snappy"), []byte("snappy-value")))
+
+	zstdDB := newCompressedDB(dir, file.CompressionZstd)
+	require.NoError(t, zstdDB.Set([]byte("zstd"), []byte("zstd-value")))
+
+	// Every file must be readable regardless of the configured codec.
+	for _, db := range []*file.DB{legacy, snappyDB, zstdDB} {
+		for _, key := range []string{"legacy", "snappy", "zstd"} {
+			got, err := db.Get([]byte(key))
+			require.NoError(t, err)
+			require.Equal(t, []byte(key+"-value"), got)
+		}
+	}
+}
+
+func TestDB_Compression_CorruptedFrame(t *testing.T) {
+	for _, codec := range []string{
+		file.CompressionSnappy,
+		file.CompressionZstd,
+	} {
+		t.Run(codec, func(t *testing.T) {
+			dir := t.TempDir()
+			db := newCompressedDB(dir, codec)
+			require.NoError(t, db.Set(
+				[]byte("key"), bytes.Repeat([]byte("blob"), 256),
+			))
+
+			path := filepath.Join(dir, "key.ssz")
+			bz, err := os.ReadFile(path)
+			require.NoError(t, err)
+			// Truncate the compressed payload, keeping the frame header.
+			require.NoError(t, os.WriteFile(path, bz[:len(bz)/2], 0600))
+
+			_, err = db.Get([]byte("key"))
+			require.ErrorIs(t, err, file.ErrCorruptedFrame)
+			require.ErrorContains(t, err, "key")
+		})
+	}
+}
+
+func TestDB_Compression_UnsupportedCodec(t *testing.T) {
+	require.Panics(t, func() {
+		newCompressedDB(t.TempDir(), "lz4")
+	})
+}
+
+// newCompressedDB returns a new file DB rooted at dir using the given codec.
+func newCompressedDB(dir string, codec string) *file.DB {
+	return file.NewDB(
+		file.WithRootDirectory(dir),
+		file.WithFileExtension("ssz"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+		file.WithCompression(codec),
+	)
+}
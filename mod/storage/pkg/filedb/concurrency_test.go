@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb_test
+
+import (
+	"bytes"
+	"context"
+	"io/fs"
+	"sync"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	file "github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/stretchr/testify/require"
+)
+
+// concurrentValueLen is the length of the values written by the concurrency
+// tests, large enough for a read racing a write to see a partial file.
+const concurrentValueLen = 64 << 10
+
+// concurrentValue returns the value written by the writer w.
+func concurrentValue(w int) []byte {
+	return bytes.Repeat([]byte{byte(w + 1)}, concurrentValueLen)
+}
+
+// checkConcurrentRead returns an error if a read raced with writes and
+// deletes returned anything but a whole value or a not exist error.
+func checkConcurrentRead(value []byte, err error) error {
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return nil
+	case err != nil:
+		return err
+	case len(value) != concurrentValueLen ||
+		!bytes.Equal(value, concurrentValue(int(value[0])-1)):
+		return errors.Newf("read a partial value of %d bytes", len(value))
+	}
+	return nil
+}
+
+// hammer runs each of fns n times in its own goroutine and returns the
+// errors they returned.
+func hammer(n int, fns ...func(i int) error) []error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	for _, fn := range fns {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range n {
+				if err := fn(i); err != nil {
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return errs
+}
+
+func TestDB_ConcurrentSameKeys(t *testing.T) {
+	db := newBatchTestDB(t.TempDir(), nil)
+	keys := [][]byte{[]byte("a"), []byte("b"), []byte("7/c")}
+	key := func(i int) []byte { return keys[i%len(keys)] }
+
+	set := func(w int) func(int) error {
+		return func(i int) error { return db.Set(key(i), concurrentValue(w)) }
+	}
+	get := func(i int) error {
+		return checkConcurrentRead(db.Get(key(i)))
+	}
+	has := func(i int) error {
+		_, err := db.Has(key(i))
+		return err
+	}
+	del := func(i int) error { return db.Delete(key(i)) }
+	batch := func(i int) error {
+		b := db.Batch()
+		if err := b.Set(key(i), concurrentValue(3)); err != nil {
+			return err
+		}
+		return b.Write()
+	}
+
+	require.Empty(t, hammer(
+		100, set(0), set(1), set(2), batch, get, get, get, has, del,
+	))
+	for _, k := range keys {
+		require.NoError(t, checkConcurrentRead(db.Get(k)))
+	}
+}
+
+func TestRangeDB_ConcurrentPrune(t *testing.T) {
+	const indexes = 200
+	rdb := file.NewRangeDB(newBatchTestDB(t.TempDir(), nil))
+	key := []byte("key")
+
+	set := func(i int) error {
+		//#nosec:G115 // i is never negative.
+		return rdb.Set(uint64(i), key, concurrentValue(i%4))
+	}
+	setBatch := func(i int) error {
+		return rdb.SetBatch(
+			//#nosec:G115 // i is never negative.
+			uint64(i), [][]byte{[]byte("other")}, [][]byte{concurrentValue(0)},
+		)
+	}
+	get := func(i int) error {
+		//#nosec:G115 // i is never negative.
+		return checkConcurrentRead(rdb.Get(uint64(i), key))
+	}
+	prune := func(i int) error {
+		//#nosec:G115 // i is never negative.
+		return rdb.Prune(0, uint64(i))
+	}
+	verify := func(i int) error {
+		if i%50 != 0 {
+			return nil
+		}
+		corrupted, err := rdb.Verify(context.Background())
+		if err == nil && len(corrupted) > 0 {
+			err = errors.Newf("found %d corrupted entries", len(corrupted))
+		}
+		return err
+	}
+
+	require.Empty(t, hammer(indexes, set, setBatch, get, get, prune, verify))
+}
@@ -21,8 +21,11 @@
 package filedb
 
 import (
+	"io/fs"
 	"os"
 	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
@@ -32,17 +35,75 @@ import (
 // DB represents a filesystem backed key-value store.
 // It is useful for storing amounts of data that exceed what is
 // performant to store in a traditional key-value database.
+//
+// DB is safe for concurrent use. The reads, writes and deletes of a key,
+// including the writes of a batch being applied, are serialized with each
+// other, so a read returns either the value before a concurrent write or
+// delete, or the value after it, never a partially written or removed one.
+// The removal of an index by a RangeDB is serialized with the operations on
+// the keys of the index in the same way. Operations on different keys are
+// not ordered with each other.
 type DB struct {
 	fs        afero.Fs
 	logger    log.Logger[any]
 	rootDir   string
 	extension string
 	dirPerms  os.FileMode
+	// codec compresses values before they are written, nil if compression
+	// is disabled.
+	codec codec
+	stats compressionStats
+	// batchFailpoint is invoked between the operations of a batch write.
+	batchFailpoint BatchFailpoint
+	// metrics is the metrics for the database.
+	metrics *dbMetrics
+	// syncPolicy controls when writes are synced to disk.
+	syncPolicy SyncPolicy
+	// syncInterval is the interval at which writes made outside of a batch
+	// are synced under SyncPerBatch.
+	syncInterval time.Duration
+	// pending is the writes made outside of a batch that have not been
+	// synced yet.
+	pending pendingSyncs
+	// format is the version of the on-disk layout of the database.
+	format int
+	// wrapFS wraps the filesystem of the database, nil if unset.
+	wrapFS func(afero.Fs) afero.Fs
+	// locks serializes the operations on the same key.
+	locks keyLocks
+	// legacyWarning warns once about the entries read without a checksum.
+	legacyWarning sync.Once
 }
 
-// NewDB creates a new instance of the DB.
+// NewDB creates a new instance of the DB. It panics if an option is
+// invalid, and only logs the failure to read or migrate the layout of the
+// database; Open should be used to handle it.
 func NewDB(opts ...Option) *DB {
-	db := &DB{}
+	db, err := newDB(opts...)
+	if err != nil {
+		db.logger.Error("failed to open database layout", "error", err)
+	}
+	return db
+}
+
+// Open opens the database, migrating it to the current layout if it was
+// written in an older one. It returns an error if the layout cannot be read
+// or migrated, in which case the database must not be used.
+func Open(opts ...Option) (*DB, error) {
+	db, err := newDB(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// newDB creates a new instance of the DB, returning it along with the error
+// opening its layout, if any.
+func newDB(opts ...Option) (*DB, error) {
+	db := &DB{
+		metrics:      newDBMetrics(nil),
+		syncInterval: defaultSyncInterval,
+	}
 	for _, opt := range opts {
 		if err := opt(db); err != nil {
 			panic(errors.Wrap(err, "failed to apply option"))
@@ -50,16 +111,50 @@ func NewDB(opts ...Option) *DB {
 	}
 
 	db.fs = afero.NewBasePathFs(afero.NewOsFs(), db.rootDir)
-	return db
+	if db.wrapFS != nil {
+		db.fs = db.wrapFS(db.fs)
+	}
+	if err := db.recoverBatches(); err != nil {
+		db.logger.Error("failed to recover batches", "error", err)
+	}
+	layoutErr := db.openLayout()
+	if layoutErr != nil {
+		layoutErr = errors.Wrap(layoutErr, "failed to open database layout")
+	}
+	if db.metrics.enabled() {
+		size, err := db.sizeOf(".")
+		if err != nil {
+			db.logger.Error("failed to compute size on disk", "error", err)
+		}
+		db.metrics.setSize(size)
+	}
+	return db, layoutErr
 }
 
 // Get retrieves the value for a key.
 func (db *DB) Get(key []byte) ([]byte, error) {
-	return afero.ReadFile(db.fs, db.pathForKey(key))
+	mu := db.locks.forKey(key)
+	mu.RLock()
+	defer mu.RUnlock()
+
+	start := time.Now()
+	bz, err := afero.ReadFile(db.fs, db.pathForKey(key))
+	if err != nil {
+		return nil, err
+	}
+	db.metrics.markRead(start, len(bz))
+	if bz, err = db.verifyEntry(key, bz); err != nil {
+		return nil, err
+	}
+	return decompressFrame(key, bz)
 }
 
 // Has returns true if the key exists in the database.
 func (db *DB) Has(key []byte) (bool, error) {
+	mu := db.locks.forKey(key)
+	mu.RLock()
+	defer mu.RUnlock()
+
 	exists, err := afero.Exists(db.fs, db.pathForKey(key))
 	if err != nil {
 		return false, err
@@ -69,40 +164,196 @@ func (db *DB) Has(key []byte) (bool, error) {
 
 // Set stores the value for a key.
 func (db *DB) Set(key []byte, value []byte) error {
-	if exists, err := afero.Exists(db.fs, db.pathForKey(key)); err != nil {
+	mu := db.locks.forKey(key)
+	mu.Lock()
+	defer mu.Unlock()
+
+	start := time.Now()
+	replaced, err := db.sizeOfFile(db.pathForKey(key))
+	if err != nil {
 		return err
-	} else if exists {
+	} else if replaced > 0 {
 		db.logger.Warn("overriding existing key", "key", key)
 	}
 
-	if err := db.fs.MkdirAll(
-		filepath.Dir(db.pathForKey(key)), db.dirPerms,
-	); err != nil {
+	n, err := db.createValue(
+		db.pathForKey(key), value, db.syncPolicy == SyncPerWrite,
+	)
+	if err != nil {
 		return err
 	}
+	db.metrics.markWrite(start, len(value), n, replaced)
+	return db.afterWrite(db.pathForKey(key))
+}
 
-	file, err := db.fs.Create(db.pathForKey(key))
+// createValue creates the directory of the given path and writes the value
+// to it, as writeValue does.
+func (db *DB) createValue(
+	path string, value []byte, sync bool,
+) (int64, error) {
+	db.locks.dirs.RLock()
+	defer db.locks.dirs.RUnlock()
+	if err := db.fs.MkdirAll(filepath.Dir(path), db.dirPerms); err != nil {
+		return 0, err
+	}
+	return db.writeValue(path, value, sync)
+}
+
+// Delete removes the value for a key.
+func (db *DB) Delete(key []byte) error {
+	mu := db.locks.forKey(key)
+	mu.Lock()
+	defer mu.Unlock()
+
+	start := time.Now()
+	size, err := db.sizeOfFile(db.pathForKey(key))
+	if err != nil {
+		return err
+	}
+	if err = db.fs.RemoveAll(db.pathForKey(key)); err != nil {
+		return err
+	}
+	db.metrics.markDelete(start, size)
+	return nil
+}
+
+// removeDir removes a directory and all the entries within it, returning the
+// number of entries removed. Entries are unlinked one by one from a single
+// directory listing so that the count and size come for free; nested
+// directories are removed recursively.
+func (db *DB) removeDir(dir string) (uint64, error) {
+	start := time.Now()
+	infos, err := afero.ReadDir(db.fs, dir)
+	if os.IsNotExist(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+
+	var (
+		removed uint64
+		size    int64
+	)
+	for _, info := range infos {
+		path := filepath.Join(dir, info.Name())
+		if info.IsDir() {
+			var n uint64
+			n, err = db.removeDir(path)
+			removed += n
+			if err != nil {
+				break
+			}
+			continue
+		}
+		if err = db.fs.Remove(path); err != nil {
+			break
+		}
+		removed++
+		size += info.Size()
+	}
+	if err == nil {
+		err = db.fs.Remove(dir)
+	}
+	db.metrics.markDelete(start, size)
+	return removed, err
+}
+
+// countDir returns the number of files in a directory and its
+// subdirectories, which is the number of entries removeDir would remove.
+func (db *DB) countDir(dir string) (uint64, error) {
+	infos, err := afero.ReadDir(db.fs, dir)
+	if os.IsNotExist(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+	for _, info := range infos {
+		if !info.IsDir() {
+			count++
+			continue
+		}
+		n, err := db.countDir(filepath.Join(dir, info.Name()))
+		if err != nil {
+			return 0, err
+		}
+		count += n
+	}
+	return count, nil
+}
+
+// writeValue encodes the value, appends its checksum and writes it to the
+// given path, syncing the file to disk if sync is set. It returns the number
+// of bytes written.
+func (db *DB) writeValue(
+	path string, value []byte, sync bool,
+) (int64, error) {
+	stored := value
+	if db.codec != nil {
+		stored = compressFrame(db.codec, value)
+	}
+	stored = appendChecksum(stored)
+
+	if err := db.writeRaw(path, stored, sync); err != nil {
+		return 0, err
+	}
+	db.stats.record(len(value), len(stored))
+	db.logger.Debug("wrote %d bytes to %s", len(stored), path)
+
+	return int64(len(stored)), nil
+}
+
+// writeRaw writes the bytes to the given path as is, syncing the file to
+// disk if sync is set.
+func (db *DB) writeRaw(path string, bz []byte, sync bool) error {
+	file, err := db.fs.Create(path)
 	if err != nil {
 		return errors.Wrap(err, "failed to create file")
 	}
 	defer file.Close()
 
-	n, err := file.Write(value)
-	if err != nil {
+	if _, err = file.Write(bz); err != nil {
 		return errors.Wrap(err, "failed to write to file")
 	}
-	db.logger.Debug("wrote %d bytes to %s", n, db.pathForKey(key))
-
+	if sync {
+		if err = db.syncFile(file); err != nil {
+			return errors.Wrap(err, "failed to sync file")
+		}
+	}
 	return nil
 }
 
-// Delete removes the value for a key.
-func (db *DB) Delete(key []byte) error {
-	return db.fs.RemoveAll(db.pathForKey(key))
+// sizeOfFile returns the size of the file at the given path, or zero if it
+// does not exist.
+func (db *DB) sizeOfFile(path string) (int64, error) {
+	info, err := db.fs.Stat(path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
 }
 
-// pathForKey returns the path for a key.
-// TODO: for efficient storage we should expand this path
-func (db *DB) pathForKey(key []byte) string {
-	return string(key) + "." + db.extension
+// sizeOf returns the total size of the entries under the given directory,
+// ignoring batches that are being staged and the format marker.
+func (db *DB) sizeOf(dir string) (int64, error) {
+	var size int64
+	err := afero.Walk(db.fs, dir, func(
+		path string, info fs.FileInfo, err error,
+	) error {
+		switch {
+		case os.IsNotExist(err):
+			return nil
+		case err != nil:
+			return err
+		case info.IsDir() && path == stagingDir:
+			return filepath.SkipDir
+		case !info.IsDir() && path != formatFile:
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
 }
@@ -22,7 +22,9 @@ package filedb
 
 import (
 	"os"
+	"time"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/spf13/afero"
 )
@@ -38,6 +40,40 @@ func WithAferoFS(fs afero.Fs) Option {
 	}
 }
 
+// WithFSWrapper wraps the filesystem of the database once it has been
+// opened, e.g. to observe or fail filesystem operations.
+// NOTE: Should only be used for testing.
+func WithFSWrapper(wrap func(afero.Fs) afero.Fs) Option {
+	return func(db *DB) error {
+		db.wrapFS = wrap
+		return nil
+	}
+}
+
+// WithBatchFailpoint sets a failpoint invoked between the operations of a
+// batch write.
+// NOTE: Should only be used for testing.
+func WithBatchFailpoint(fp BatchFailpoint) Option {
+	return func(db *DB) error {
+		db.batchFailpoint = fp
+		return nil
+	}
+}
+
+// WithCompression sets the codec used to compress values on disk. Supported
+// codecs are "none", "snappy" and "zstd". Values written without compression
+// remain readable after compression is enabled.
+func WithCompression(codec string) Option {
+	return func(db *DB) error {
+		c, err := newCodec(codec)
+		if err != nil {
+			return err
+		}
+		db.codec = c
+		return nil
+	}
+}
+
 // WithDirectoryPermissions sets the permissions for the directory.
 func WithDirectoryPermissions(permissions os.FileMode) Option {
 	return func(db *DB) error {
@@ -62,6 +98,35 @@ func WithLogger(logger log.Logger[any]) Option {
 	}
 }
 
+// WithSyncPolicy sets when writes are synced to disk, see SyncPolicy for the
+// durability each policy provides. The interval is only used by SyncPerBatch,
+// as the minimum time between two syncs of the writes made outside of a
+// batch; a zero interval keeps the default of one second.
+//
+// Every sync blocks the writer until the disk acknowledges it, so
+// SyncPerWrite can be an order of magnitude slower than SyncPerBatch on
+// spinning disks and network volumes.
+func WithSyncPolicy(policy SyncPolicy, interval time.Duration) Option {
+	return func(db *DB) error {
+		if policy > SyncNever {
+			return errors.Newf("unsupported sync policy: %d", policy)
+		}
+		db.syncPolicy = policy
+		if interval > 0 {
+			db.syncInterval = interval
+		}
+		return nil
+	}
+}
+
+// WithTelemetrySink sets the sink the database reports metrics to.
+func WithTelemetrySink(sink TelemetrySink) Option {
+	return func(db *DB) error {
+		db.metrics = newDBMetrics(sink)
+		return nil
+	}
+}
+
 // WithRootDirectory sets the root directory for the database.
 func WithRootDirectory(rootDir string) Option {
 	return func(db *DB) error {
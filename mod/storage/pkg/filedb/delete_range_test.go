@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/log"
+	file "github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// syntheticIndexes is the number of indexes in the synthetic database.
+	syntheticIndexes = 1000
+	// syntheticKeysPerIndex is the number of entries stored at each index.
+	syntheticKeysPerIndex = 10
+)
+
+// TestRangeDB_DeleteRange_Synthetic runs a sequence of deletes against a
+// single database of syntheticIndexes * syntheticKeysPerIndex entries.
+func TestRangeDB_DeleteRange_Synthetic(t *testing.T) {
+	rdb := newSyntheticRangeDB(t, t.TempDir())
+	deleted := make(map[uint64]bool)
+
+	steps := []struct {
+		name     string
+		from, to uint64
+		removed  uint64
+	}{
+		{
+			name:    "dense range",
+			from:    100,
+			to:      300,
+			removed: 200 * syntheticKeysPerIndex,
+		},
+		{
+			name:    "empty range",
+			from:    syntheticIndexes,
+			to:      syntheticIndexes + 10,
+			removed: 0,
+		},
+		{
+			// An interrupted delete leaves a prefix of the range removed,
+			// running it again only removes what is left.
+			name:    "resume partially deleted range",
+			from:    0,
+			to:      500,
+			removed: 300 * syntheticKeysPerIndex,
+		},
+		{
+			name:    "sparse range",
+			from:    0,
+			to:      1 << 32,
+			removed: 500 * syntheticKeysPerIndex,
+		},
+		{
+			name:    "already deleted range",
+			from:    0,
+			to:      1 << 32,
+			removed: 0,
+		},
+	}
+
+	for _, step := range steps {
+		removed, err := rdb.DeleteRange(step.from, step.to)
+		require.NoError(t, err, step.name)
+		require.Equal(t, step.removed, removed, step.name)
+
+		for index := step.from; index < min(step.to, syntheticIndexes); index++ {
+			deleted[index] = true
+		}
+		for index := uint64(0); index < syntheticIndexes; index++ {
+			exists, err := rdb.Has(index, syntheticKey(0))
+			require.NoError(t, err)
+			require.Equal(t, !deleted[index], exists, step.name)
+		}
+	}
+}
+
+func BenchmarkRangeDB_DeleteRange(b *testing.B) {
+	for _, bm := range []struct {
+		name string
+		to   uint64
+	}{
+		{"dense", syntheticIndexes},
+		{"sparse", 1 << 32},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			for range b.N {
+				b.StopTimer()
+				rdb := newSyntheticRangeDB(b, b.TempDir())
+				b.StartTimer()
+
+				removed, err := rdb.DeleteRange(0, bm.to)
+				require.NoError(b, err)
+				require.Equal(b,
+					uint64(syntheticIndexes*syntheticKeysPerIndex), removed,
+				)
+			}
+		})
+	}
+}
+
+// newSyntheticRangeDB returns a range DB rooted at dir populated with
+// syntheticIndexes * syntheticKeysPerIndex entries.
+func newSyntheticRangeDB(tb testing.TB, dir string) *file.RangeDB {
+	tb.Helper()
+	rdb := file.NewRangeDB(file.NewDB(
+		file.WithRootDirectory(dir),
+		file.WithFileExtension("ssz"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+	))
+	for index := uint64(0); index < syntheticIndexes; index++ {
+		for i := range syntheticKeysPerIndex {
+			require.NoError(tb, rdb.Set(
+				index, syntheticKey(i), []byte("synthetic-value"),
+			))
+		}
+	}
+	return rdb
+}
+
+func syntheticKey(i int) []byte {
+	return []byte(fmt.Sprintf("synthetic-%d", i))
+}
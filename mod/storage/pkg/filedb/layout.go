@@ -0,0 +1,336 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/spf13/afero"
+)
+
+// The on-disk layout of the database is versioned by a marker file in its
+// root directory, so that the layout can change without stranding the
+// entries written by older versions.
+//
+// In format v1, an entry keyed "<index>/<name>" lives at
+// "<index>/<name>.<ext>", so the root directory holds one directory per
+// index and becomes slow to list and prune once it grows to hundreds of
+// thousands of entries. Format v2 groups index directories into shards of
+// shardSize consecutive indexes:
+// "shard-<index/shardSize>/<index>/<name>.<ext>".
+// Keys that do not start with an index are stored as is in both formats.
+const (
+	// formatV1 is the layout of databases written before the marker file
+	// was introduced.
+	formatV1 = 1
+	// formatV2 is the sharded layout.
+	formatV2 = 2
+	// currentFormat is the format new databases are created with and older
+	// databases are migrated to.
+	currentFormat = formatV2
+	// formatFile is the name of the marker file holding the format version.
+	formatFile = ".format"
+	// shardSize is the number of consecutive indexes grouped in a shard.
+	shardSize = 1000
+	// shardPrefix prefixes the name of shard directories.
+	shardPrefix = "shard-"
+	// migrationLogInterval is the number of directories migrated between
+	// two progress logs.
+	migrationLogInterval = 1000
+)
+
+// openLayout reads the format of the database, creating the marker file
+// for a new database and migrating a database written in an older format.
+//
+// Migration moves every index directory into its shard with a single
+// rename and writes the marker file last. Each rename is atomic, so a
+// migration that is interrupted leaves every index directory either in its
+// old or its new place and is resumed the next time the database is opened.
+func (db *DB) openLayout() error {
+	format, err := db.readFormat()
+	if err != nil {
+		return err
+	}
+	if format > currentFormat {
+		return errors.Newf(
+			"database format %d is newer than supported format %d",
+			format, currentFormat,
+		)
+	}
+
+	db.format = format
+	if format == currentFormat {
+		return nil
+	}
+	if err = db.migrateLayout(); err != nil {
+		return errors.Wrapf(err, "failed to migrate from format %d", format)
+	}
+	db.format = currentFormat
+	return nil
+}
+
+// readFormat returns the format of the database. A database without marker
+// file is in format v1 if it holds any entry, and a new database otherwise.
+func (db *DB) readFormat() (int, error) {
+	bz, err := afero.ReadFile(db.fs, formatFile)
+	if err == nil {
+		return strconv.Atoi(string(bytes.TrimSpace(bz)))
+	} else if !os.IsNotExist(err) {
+		return 0, err
+	}
+
+	infos, err := afero.ReadDir(db.fs, ".")
+	if err != nil && !os.IsNotExist(err) {
+		return 0, err
+	}
+	for _, info := range infos {
+		if info.Name() != stagingDir {
+			return formatV1, nil
+		}
+	}
+
+	// A new database is created directly in the current format.
+	if err = db.fs.MkdirAll(".", db.dirPerms); err != nil {
+		return 0, err
+	}
+	if err = db.writeFormat(currentFormat); err != nil {
+		return 0, err
+	}
+	return currentFormat, nil
+}
+
+// writeFormat atomically replaces the marker file.
+func (db *DB) writeFormat(format int) error {
+	tmp := formatFile + ".tmp"
+	if err := db.writeRaw(
+		tmp, []byte(strconv.Itoa(format)), db.syncsBatches(),
+	); err != nil {
+		return err
+	}
+	if err := db.fs.Rename(tmp, formatFile); err != nil {
+		return err
+	}
+	if !db.syncsBatches() {
+		return nil
+	}
+	return db.syncPath(".")
+}
+
+// migrateLayout moves the index directories of a v1 database into their
+// shards and marks the database as migrated.
+func (db *DB) migrateLayout() error {
+	infos, err := afero.ReadDir(db.fs, ".")
+	if err != nil {
+		return err
+	}
+
+	var indexes []uint64
+	for _, info := range infos {
+		if index, ok := parseIndexDir(info); ok {
+			indexes = append(indexes, index)
+		}
+	}
+	db.logger.Info(
+		"migrating database layout",
+		"from", formatV1, "to", currentFormat, "directories", len(indexes),
+	)
+
+	shards := make(map[string]struct{})
+	for i, index := range indexes {
+		shard := shardDir(index)
+		if _, ok := shards[shard]; !ok {
+			if err = db.fs.MkdirAll(shard, db.dirPerms); err != nil {
+				return err
+			}
+			shards[shard] = struct{}{}
+		}
+		if err = db.fs.Rename(
+			strconv.FormatUint(index, 10), indexDir(currentFormat, index),
+		); err != nil {
+			return err
+		}
+		if (i+1)%migrationLogInterval == 0 {
+			db.logger.Info(
+				"migrating database layout",
+				"migrated", i+1, "directories", len(indexes),
+			)
+		}
+	}
+
+	// Make the renames durable before marking the migration as done.
+	if db.syncsBatches() {
+		for shard := range shards {
+			if err = db.syncPath(shard); err != nil {
+				return err
+			}
+		}
+		if err = db.syncPath("."); err != nil {
+			return err
+		}
+	}
+	if err = db.writeFormat(currentFormat); err != nil {
+		return err
+	}
+	db.logger.Info(
+		"migrated database layout", "directories", len(indexes),
+	)
+	return nil
+}
+
+// listIndexes returns the indexes in [from, to) that have a directory in
+// the database, in ascending order.
+func (db *DB) listIndexes(from, to uint64) ([]uint64, error) {
+	if from >= to {
+		return nil, nil
+	} else if db.format < formatV2 {
+		return db.listIndexDirs(".", from, to)
+	}
+
+	infos, err := afero.ReadDir(db.fs, ".")
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var indexes []uint64
+	for _, info := range infos {
+		name, ok := strings.CutPrefix(info.Name(), shardPrefix)
+		if !ok || !info.IsDir() {
+			continue
+		}
+		shard, parseErr := strconv.ParseUint(name, 10, 64)
+		if parseErr != nil ||
+			shard < from/shardSize || shard > (to-1)/shardSize {
+			continue
+		}
+		var inShard []uint64
+		if inShard, err = db.listIndexDirs(
+			info.Name(), from, to,
+		); err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, inShard...)
+	}
+	slices.Sort(indexes)
+	return indexes, nil
+}
+
+// listIndexDirs returns the indexes in [from, to) of the index directories
+// directly under dir. Entries that are not index directories are ignored.
+func (db *DB) listIndexDirs(dir string, from, to uint64) ([]uint64, error) {
+	infos, err := afero.ReadDir(db.fs, dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	indexes := make([]uint64, 0, len(infos))
+	for _, info := range infos {
+		index, ok := parseIndexDir(info)
+		if ok && index >= from && index < to {
+			indexes = append(indexes, index)
+		}
+	}
+	slices.Sort(indexes)
+	return indexes, nil
+}
+
+// removeIndex removes the directory of an index, and its shard once it is
+// empty, returning the number of entries removed. It holds the lock of the
+// keys of the index while removing them.
+func (db *DB) removeIndex(index uint64) (uint64, error) {
+	mu := db.locks.forIndex(index)
+	mu.Lock()
+	removed, err := db.removeDir(db.indexDir(index))
+	mu.Unlock()
+	if err != nil || db.format < formatV2 {
+		return removed, err
+	}
+	// Removing a shard that still holds other indexes fails, which is fine.
+	db.locks.dirs.Lock()
+	defer db.locks.dirs.Unlock()
+	_ = db.fs.Remove(shardDir(index))
+	return removed, nil
+}
+
+// indexDir returns the directory of an index.
+func (db *DB) indexDir(index uint64) string {
+	return indexDir(db.format, index)
+}
+
+// pathForKey returns the path for a key.
+func (db *DB) pathForKey(key []byte) string {
+	path := string(key) + "." + db.extension
+	if db.format < formatV2 {
+		return path
+	}
+	prefix, _, ok := strings.Cut(path, "/")
+	if !ok {
+		return path
+	}
+	index, err := strconv.ParseUint(prefix, 10, 64)
+	if err != nil {
+		return path
+	}
+	return filepath.Join(shardDir(index), path)
+}
+
+// keyForPath returns the key stored at a path, it is the inverse of
+// pathForKey.
+func (db *DB) keyForPath(path string) []byte {
+	path = strings.TrimSuffix(path, "."+db.extension)
+	if db.format >= formatV2 && strings.HasPrefix(path, shardPrefix) {
+		if _, rest, ok := strings.Cut(path, "/"); ok {
+			path = rest
+		}
+	}
+	return []byte(path)
+}
+
+// indexDir returns the directory of an index in the given format.
+func indexDir(format int, index uint64) string {
+	if format < formatV2 {
+		return strconv.FormatUint(index, 10)
+	}
+	return filepath.Join(shardDir(index), strconv.FormatUint(index, 10))
+}
+
+// shardDir returns the directory of the shard holding an index.
+func shardDir(index uint64) string {
+	return shardPrefix + strconv.FormatUint(index/shardSize, 10)
+}
+
+// parseIndexDir returns the index of an index directory.
+func parseIndexDir(info os.FileInfo) (uint64, bool) {
+	if !info.IsDir() {
+		return 0, false
+	}
+	index, err := strconv.ParseUint(info.Name(), 10, 64)
+	return index, err == nil
+}
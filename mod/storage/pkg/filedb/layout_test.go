@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb_test
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"cosmossdk.io/log"
+	file "github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/stretchr/testify/require"
+)
+
+// v1Indexes are the indexes of the v1 fixture, spread over several shards.
+//
+//nolint:gochecknoglobals // test fixture.
+var v1Indexes = []uint64{0, 1, 999, 1000, 1500, 2999, 123456}
+
+// writeV1Fixture writes a tree in the v1 layout, as written before the
+// format marker was introduced, with two entries per index and one entry
+// that is not keyed by an index.
+func writeV1Fixture(t *testing.T, dir string) {
+	t.Helper()
+	for _, index := range v1Indexes {
+		indexDir := filepath.Join(dir, strconv.FormatUint(index, 10))
+		require.NoError(t, os.MkdirAll(indexDir, 0700))
+		for _, key := range []string{"a", "b"} {
+			require.NoError(t, os.WriteFile(
+				filepath.Join(indexDir, fmt.Sprintf("0x%x.ssz", key)),
+				[]byte(v1Value(index, key)), 0600,
+			))
+		}
+	}
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "meta.ssz"), []byte("meta"), 0600,
+	))
+}
+
+// v1Value returns the value of an entry of the v1 fixture.
+func v1Value(index uint64, key string) string {
+	return fmt.Sprintf("%d-%s", index, key)
+}
+
+// requireV1FixtureReadable requires every entry of the v1 fixture to be
+// readable from a database opened on dir.
+func requireV1FixtureReadable(t *testing.T, dir string) {
+	t.Helper()
+	db := newCompressedDB(dir, file.CompressionNone)
+	rdb := file.NewRangeDB(db)
+
+	for _, index := range v1Indexes {
+		for _, key := range []string{"a", "b"} {
+			value, err := rdb.Get(index, []byte(key))
+			require.NoError(t, err)
+			require.Equal(t, v1Value(index, key), string(value))
+		}
+	}
+	value, err := db.Get([]byte("meta"))
+	require.NoError(t, err)
+	require.Equal(t, "meta", string(value))
+
+	var visited int
+	require.NoError(t, rdb.Iterate(0, math.MaxUint64, func(
+		uint64, []byte, []byte,
+	) error {
+		visited++
+		return nil
+	}))
+	require.Equal(t, 2*len(v1Indexes), visited)
+}
+
+// requireFormat requires the format marker of the database in dir to hold
+// the given version.
+func requireFormat(t *testing.T, dir string, format string) {
+	t.Helper()
+	bz, err := os.ReadFile(filepath.Join(dir, ".format"))
+	require.NoError(t, err)
+	require.Equal(t, format, string(bz))
+}
+
+func TestDB_Layout_NewDatabase(t *testing.T) {
+	dir := t.TempDir()
+	rdb := file.NewRangeDB(newCompressedDB(dir, file.CompressionNone))
+	require.NoError(t, rdb.Set(1234, []byte("key"), []byte("value")))
+
+	requireFormat(t, dir, "2")
+	require.FileExists(t, filepath.Join(
+		dir, "shard-1", "1234", fmt.Sprintf("0x%x.ssz", "key"),
+	))
+}
+
+func TestDB_Layout_MigratesV1(t *testing.T) {
+	dir := t.TempDir()
+	writeV1Fixture(t, dir)
+
+	requireV1FixtureReadable(t, dir)
+	requireFormat(t, dir, "2")
+	for _, index := range v1Indexes {
+		require.NoDirExists(t, filepath.Join(dir, strconv.FormatUint(index, 10)))
+	}
+	require.DirExists(t, filepath.Join(dir, "shard-123", "123456"))
+
+	// Reopening a migrated database is a no-op.
+	requireV1FixtureReadable(t, dir)
+}
+
+func TestDB_Layout_ResumesInterruptedMigration(t *testing.T) {
+	dir := t.TempDir()
+	writeV1Fixture(t, dir)
+
+	// Simulate a migration that was interrupted after moving some of the
+	// index directories, before the marker was written.
+	for _, index := range v1Indexes[:3] {
+		shard := filepath.Join(dir, "shard-"+strconv.FormatUint(index/1000, 10))
+		require.NoError(t, os.MkdirAll(shard, 0700))
+		require.NoError(t, os.Rename(
+			filepath.Join(dir, strconv.FormatUint(index, 10)),
+			filepath.Join(shard, strconv.FormatUint(index, 10)),
+		))
+	}
+
+	requireV1FixtureReadable(t, dir)
+	requireFormat(t, dir, "2")
+}
+
+func TestDB_Layout_MigrationFailure(t *testing.T) {
+	dir := t.TempDir()
+	writeV1Fixture(t, dir)
+	// A file in place of the first shard makes the migration fail.
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "shard-0"), []byte("x"), 0600,
+	))
+	opts := []file.Option{
+		file.WithRootDirectory(dir),
+		file.WithFileExtension("ssz"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+	}
+
+	db, err := file.Open(opts...)
+	require.ErrorContains(t, err, "failed to migrate from format 1")
+	require.Nil(t, db)
+}
+
+func TestDB_Layout_NewerFormat(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, ".format"), []byte("3"), 0600,
+	))
+
+	_, err := file.Open(
+		file.WithRootDirectory(dir),
+		file.WithLogger(log.NewNopLogger()),
+	)
+	require.ErrorContains(t, err, "newer than supported format")
+}
+
+func TestDB_Layout_PruneRemovesEmptyShards(t *testing.T) {
+	dir := t.TempDir()
+	writeV1Fixture(t, dir)
+	rdb := file.NewRangeDB(newCompressedDB(dir, file.CompressionNone))
+
+	removed, err := rdb.DeleteRange(0, 2000)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), removed)
+	require.NoDirExists(t, filepath.Join(dir, "shard-0"))
+	require.NoDirExists(t, filepath.Join(dir, "shard-1"))
+	require.DirExists(t, filepath.Join(dir, "shard-2"))
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb
+
+import (
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// lockStripes is the number of locks the keys of a database are spread
+// over.
+const lockStripes = 64
+
+// keyLocks serializes the operations on the same key of a database. The
+// keys are spread over a fixed set of read-write locks, the stripes: the
+// keys of an index all share the stripe of the index, so that an index can
+// be removed as a whole, and other keys are spread by hash.
+//
+// A read holds the stripe of its key for reading, a write or a delete holds
+// it for writing, so a read never observes a value being written or
+// removed. An operation holds a single stripe at a time, which rules out
+// deadlocks between them.
+type keyLocks struct {
+	stripes [lockStripes]sync.RWMutex
+	// dirs is held for reading while a directory is created and a file is
+	// put in it, and for writing while an empty shard is removed, so that
+	// a write never loses the shard it is created in.
+	dirs sync.RWMutex
+}
+
+// forKey returns the stripe of a key.
+func (l *keyLocks) forKey(key []byte) *sync.RWMutex {
+	prefix, _, ok := strings.Cut(string(key), "/")
+	if ok {
+		if index, err := strconv.ParseUint(prefix, 10, 64); err == nil {
+			return l.forIndex(index)
+		}
+	}
+	h := fnv.New32a()
+	_, _ = h.Write(key)
+	return &l.stripes[h.Sum32()%lockStripes]
+}
+
+// forIndex returns the stripe of the keys of an index.
+func (l *keyLocks) forIndex(index uint64) *sync.RWMutex {
+	return &l.stripes[index%lockStripes]
+}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// dbMetrics is a struct that contains metrics for the database.
+type dbMetrics struct {
+	// sink is the sink for the metrics.
+	sink TelemetrySink
+	// sizeOnDisk is the total size of the entries on disk. It is computed
+	// once when the database is opened and tracked incrementally afterwards.
+	sizeOnDisk atomic.Int64
+}
+
+// newDBMetrics creates a new dbMetrics.
+func newDBMetrics(sink TelemetrySink) *dbMetrics {
+	return &dbMetrics{
+		sink: sink,
+	}
+}
+
+// enabled returns true if a sink has been configured.
+func (m *dbMetrics) enabled() bool {
+	return m.sink != nil
+}
+
+// markRead records a read of the given number of bytes.
+func (m *dbMetrics) markRead(start time.Time, n int) {
+	if !m.enabled() {
+		return
+	}
+	m.sink.IncrementCounter("beacon_kit.filedb.reads")
+	m.sink.IncrementCounterBy("beacon_kit.filedb.bytes_read", int64(n))
+	m.sink.MeasureSince("beacon_kit.filedb.get_duration", start)
+}
+
+// markWrite records a write of raw bytes that took n bytes on disk,
+// replacing an entry of the given size.
+func (m *dbMetrics) markWrite(
+	start time.Time, raw int, n int64, replaced int64,
+) {
+	if !m.enabled() {
+		return
+	}
+	m.sink.IncrementCounter("beacon_kit.filedb.writes")
+	// The bytes handed to the database, before compression.
+	m.sink.IncrementCounterBy(
+		"beacon_kit.filedb.raw_bytes_written", int64(raw),
+	)
+	m.sink.IncrementCounterBy("beacon_kit.filedb.bytes_written", n)
+	m.addSize(n - replaced)
+	m.sink.MeasureSince("beacon_kit.filedb.set_duration", start)
+}
+
+// markDelete records the deletion of entries totalling the given size.
+func (m *dbMetrics) markDelete(start time.Time, size int64) {
+	if !m.enabled() {
+		return
+	}
+	m.sink.IncrementCounter("beacon_kit.filedb.deletes")
+	m.addSize(-size)
+	m.sink.MeasureSince("beacon_kit.filedb.delete_duration", start)
+}
+
+// markSync records a call to fsync.
+func (m *dbMetrics) markSync(start time.Time) {
+	if !m.enabled() {
+		return
+	}
+	m.sink.IncrementCounter("beacon_kit.filedb.syncs")
+	m.sink.MeasureSince("beacon_kit.filedb.sync_duration", start)
+}
+
+// markPrune records a prune of the given range.
+func (m *dbMetrics) markPrune(start time.Time) {
+	if !m.enabled() {
+		return
+	}
+	m.sink.IncrementCounter("beacon_kit.filedb.prunes")
+	m.sink.MeasureSince("beacon_kit.filedb.prune_duration", start)
+}
+
+// markPruned records the number of entries removed by the last prune.
+func (m *dbMetrics) markPruned(removed uint64) {
+	if !m.enabled() {
+		return
+	}
+	//#nosec:G115 // entry counts fit in an int64.
+	m.sink.SetGauge("beacon_kit.filedb.pruned_entries", int64(removed))
+}
+
+// setSize sets the size of the entries on disk.
+func (m *dbMetrics) setSize(size int64) {
+	if !m.enabled() {
+		return
+	}
+	m.sizeOnDisk.Store(size)
+	m.sink.SetGauge("beacon_kit.filedb.size_on_disk", size)
+}
+
+// addSize adjusts the size of the entries on disk by the given delta.
+func (m *dbMetrics) addSize(delta int64) {
+	if !m.enabled() || delta == 0 {
+		return
+	}
+	m.sink.SetGauge(
+		"beacon_kit.filedb.size_on_disk", m.sizeOnDisk.Add(delta),
+	)
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb_test
+
+import (
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	metricstesting "github.com/berachain/beacon-kit/mod/primitives/pkg/metrics/testing"
+	file "github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/stretchr/testify/require"
+)
+
+// gauge returns the last value of the gauge of the given key.
+func gauge(sink *metricstesting.RecordingSink, key string) int64 {
+	value, _ := sink.LastGauge(key)
+	return value
+}
+
+func TestDB_Metrics(t *testing.T) {
+	dir := t.TempDir()
+	sink := metricstesting.NewRecordingSink()
+	rdb := file.NewRangeDB(newMetricsTestDB(dir, sink))
+
+	require.NoError(t, populateTestDB(rdb, 1, 4))
+	require.Equal(t, 4, sink.CountFor("beacon_kit.filedb.writes"))
+	require.Equal(t, 4, len(sink.Durations("beacon_kit.filedb.set_duration")))
+	requireSizeOnDisk(t, sink, dir)
+	require.Equal(t,
+		gauge(sink, "beacon_kit.filedb.size_on_disk"),
+		sink.CounterTotal("beacon_kit.filedb.bytes_written"),
+	)
+	require.Equal(t,
+		int64(4*len("value")),
+		sink.CounterTotal("beacon_kit.filedb.raw_bytes_written"),
+	)
+
+	// Overwriting an entry does not change the size on disk.
+	require.NoError(t, rdb.Set(1, []byte("key"), []byte("value")))
+	requireSizeOnDisk(t, sink, dir)
+
+	value, err := rdb.Get(2, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+	require.Equal(t, 1, sink.CountFor("beacon_kit.filedb.reads"))
+	require.Equal(t, 1, len(sink.Durations("beacon_kit.filedb.get_duration")))
+	require.Positive(t, sink.CounterTotal("beacon_kit.filedb.bytes_read"))
+
+	require.NoError(t, rdb.Delete(2, []byte("key")))
+	require.Equal(t, 1, sink.CountFor("beacon_kit.filedb.deletes"))
+	requireSizeOnDisk(t, sink, dir)
+
+	require.NoError(t, rdb.Prune(0, 4))
+	require.Equal(t, 1, sink.CountFor("beacon_kit.filedb.prunes"))
+	require.Equal(t, 1, len(sink.Durations("beacon_kit.filedb.prune_duration")))
+	requireSizeOnDisk(t, sink, dir)
+
+	// The size on disk is computed when the database is reopened.
+	reopened := metricstesting.NewRecordingSink()
+	newMetricsTestDB(dir, reopened)
+	requireSizeOnDisk(t, reopened, dir)
+}
+
+func TestDB_Metrics_Batch(t *testing.T) {
+	dir := t.TempDir()
+	sink := metricstesting.NewRecordingSink()
+	rdb := file.NewRangeDB(newMetricsTestDB(dir, sink))
+
+	batch := rdb.Batch()
+	for i := range 3 {
+		require.NoError(t, batch.Set(7, batchKey(i), batchValue(i)))
+	}
+	require.Zero(t, sink.CountFor("beacon_kit.filedb.writes"))
+	require.NoError(t, batch.Write())
+	require.Equal(t, 3, sink.CountFor("beacon_kit.filedb.writes"))
+	requireSizeOnDisk(t, sink, dir)
+}
+
+// newMetricsTestDB returns a new file DB rooted at dir reporting to sink.
+func newMetricsTestDB(dir string, sink file.TelemetrySink) *file.DB {
+	return file.NewDB(
+		file.WithRootDirectory(dir),
+		file.WithFileExtension("ssz"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+		file.WithTelemetrySink(sink),
+	)
+}
+
+// requireSizeOnDisk requires the size on disk reported to the sink to match
+// the actual size of dir.
+func requireSizeOnDisk(
+	t *testing.T, sink *metricstesting.RecordingSink, dir string,
+) {
+	t.Helper()
+	require.Equal(t,
+		dirSize(t, dir), gauge(sink, "beacon_kit.filedb.size_on_disk"),
+	)
+}
+
+// dirSize returns the total size of the entries under dir, excluding the
+// format marker.
+func dirSize(t *testing.T, dir string) int64 {
+	t.Helper()
+	var size int64
+	require.NoError(t, filepath.WalkDir(dir, func(
+		_ string, d fs.DirEntry, err error,
+	) error {
+		if err != nil || d.IsDir() || d.Name() == ".format" {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	}))
+	return size
+}
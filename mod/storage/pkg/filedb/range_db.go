@@ -22,26 +22,51 @@ package filedb
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"io"
+	"math"
+	"os"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/hex"
 	db "github.com/berachain/beacon-kit/mod/storage/pkg/interfaces"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/rangedb"
+	"github.com/spf13/afero"
 )
 
-// two is a constant for the number 2.
-const two = 2
+const (
+	// two is a constant for the number 2.
+	two = 2
+	// denseRangeLimit is the size up to which DeleteRange visits every index
+	// of the range instead of listing the indexes present on disk.
+	denseRangeLimit = 1 << 12
+)
 
-// Compile-time assertion of prunable interface.
-var _ pruner.Prunable = (*RangeDB)(nil)
+// Compile-time assertions of the backend and prunable interfaces.
+var (
+	_ rangedb.Backend         = (*RangeDB)(nil)
+	_ pruner.CountingPrunable = (*RangeDB)(nil)
+	_ pruner.RangeCounter     = (*RangeDB)(nil)
+)
 
 // RangeDB is a database that stores versioned data.
 // It prefixes keys with an index.
 // Invariant: No index below firstNonNilIndex should be populated.
+//
+// RangeDB is safe for concurrent use if its underlying database is. Over a
+// DB, the prunes remove one index at a time, holding the lock of its keys,
+// so a concurrent read of a pruned key returns either its value or a not
+// exist error.
 type RangeDB struct {
 	db.DB
+	// mu guards firstNonNilIndex.
+	mu               sync.Mutex
 	firstNonNilIndex uint64
 }
 
@@ -60,6 +85,33 @@ func (db *RangeDB) Get(index uint64, key []byte) ([]byte, error) {
 	return db.DB.Get(db.prefix(index, key))
 }
 
+// GetReader returns a reader of the value associated with the given index
+// and key, with its length, see DB.GetReader. Over another database, the
+// value is read whole. The reader must be closed.
+func (db *RangeDB) GetReader(
+	index uint64, key []byte,
+) (io.ReadCloser, int64, error) {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		value, err := db.Get(index, key)
+		if err != nil {
+			return nil, 0, err
+		}
+		return io.NopCloser(bytes.NewReader(value)), int64(len(value)), nil
+	}
+	return f.GetReader(db.prefix(index, key))
+}
+
+// Keys returns the keys of the entries with the given index, in the order of
+// their file names.
+func (db *RangeDB) Keys(index uint64) ([][]byte, error) {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return nil, errors.New("rangedb: keys not supported for this db")
+	}
+	return db.listKeys(f, f.indexDir(index))
+}
+
 // Has checks if the given index and key exist in the database.
 // It prefixes the key with the index and a slash before querying the underlying
 // database.
@@ -71,13 +123,36 @@ func (db *RangeDB) Has(index uint64, key []byte) (bool, error) {
 // It prefixes the key with the index and a slash before storing it in the
 // underlying database.
 func (db *RangeDB) Set(index uint64, key []byte, value []byte) error {
-	// enforce invariant
-	if index < db.firstNonNilIndex {
-		db.firstNonNilIndex = index
-	}
+	db.lowerFirstIndex(index)
 	return db.DB.Set(db.prefix(index, key), value)
 }
 
+// SetBatch stores the values for the given keys at the given index as a
+// single batch, so either all of them are visible or none of them is.
+func (db *RangeDB) SetBatch(index uint64, keys, values [][]byte) error {
+	if len(keys) != len(values) {
+		return errors.New("rangedb: mismatched keys and values")
+	}
+
+	batch := db.Batch()
+	for i := range keys {
+		if err := batch.Set(index, keys[i], values[i]); err != nil {
+			return errors.Join(err, batch.Discard())
+		}
+	}
+	return batch.Write()
+}
+
+// Batch returns a new batch for the database.
+func (db *RangeDB) Batch() *RangeBatch {
+	f, _ := db.DB.(*DB)
+	return &RangeBatch{
+		rdb:      db,
+		db:       f,
+		minIndex: math.MaxUint64,
+	}
+}
+
 // Delete removes the value associated with the given index and key from the
 // database. It prefixes the key with the index and a slash before deleting it
 // from the underlying database.
@@ -85,37 +160,284 @@ func (db *RangeDB) Delete(index uint64, key []byte) error {
 	return db.DB.Delete(db.prefix(index, key))
 }
 
-// DeleteRange removes all values associated with the given index from the
-// filesystem. It is INCLUSIVE of the `from` index and EXCLUSIVE of
-// the `to“ index.
-func (db *RangeDB) DeleteRange(from, to uint64) error {
+// Iterate calls fn for every entry with an index in [from, to), in ascending
+// order of index. Keys within an index are visited in the order of their
+// file names.
+func (db *RangeDB) Iterate(
+	from, to uint64, fn func(index uint64, key, value []byte) error,
+) error {
 	f, ok := db.DB.(*DB)
 	if !ok {
-		return errors.New("rangedb: delete range not supported for this db")
+		return errors.New("rangedb: iterate not supported for this db")
+	}
+
+	indexes, err := f.listIndexes(from, to)
+	if err != nil {
+		return err
 	}
-	for ; from < to; from++ {
-		if err := f.fs.RemoveAll(fmt.Sprintf("%d/", from)); err != nil {
+	for _, index := range indexes {
+		var keys [][]byte
+		keys, err = db.listKeys(f, f.indexDir(index))
+		if err != nil {
 			return err
 		}
+		for _, key := range keys {
+			var value []byte
+			if value, err = db.Get(index, key); err != nil {
+				return err
+			}
+			if err = fn(index, key, value); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
-// Prune removes all values in the given range [start, end) from the db.
-func (db *RangeDB) Prune(start, end uint64) error {
-	start = max(start, db.firstNonNilIndex)
-	if err := db.DeleteRange(start, end); err != nil {
-		// Resets last pruned index in case Delete somehow populates indices on
-		// err. This will cause the next prune operation is O(n), but next
-		// successful prune will set it to the correct value, so runtime is
-		// ammortized
-		db.firstNonNilIndex = 0
+// IterateReaders calls fn with a reader of every entry with an index in
+// [from, to) and its length, in the order of Iterate, streaming the values
+// instead of reading them whole. A reader is closed once fn returns.
+func (db *RangeDB) IterateReaders(
+	from, to uint64,
+	fn func(index uint64, key []byte, r io.Reader, size int64) error,
+) error {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return errors.New("rangedb: iterate not supported for this db")
+	}
+
+	indexes, err := f.listIndexes(from, to)
+	if err != nil {
 		return err
 	}
-	db.firstNonNilIndex = end
+	for _, index := range indexes {
+		var keys [][]byte
+		keys, err = db.listKeys(f, f.indexDir(index))
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if err = db.readEntry(index, key, fn); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
+// readEntry calls fn with a reader of the entry of the given index and key,
+// which is closed once fn returns.
+func (db *RangeDB) readEntry(
+	index uint64,
+	key []byte,
+	fn func(index uint64, key []byte, r io.Reader, size int64) error,
+) error {
+	r, size, err := db.GetReader(index, key)
+	if err != nil {
+		return err
+	}
+	return errors.Join(fn(index, key, r, size), r.Close())
+}
+
+// DeleteRange removes all values associated with the given index from the
+// filesystem. It is INCLUSIVE of the `from` index and EXCLUSIVE of
+// the `to“ index. It returns the number of entries removed.
+//
+// Indexes are removed in ascending order, one directory at a time, so an
+// interrupted call leaves a contiguous prefix of the range removed and can
+// simply be retried.
+func (db *RangeDB) DeleteRange(from, to uint64) (uint64, error) {
+	removed, _, err := db.deleteRange(from, to)
+	return removed, err
+}
+
+// deleteRange removes the indexes in [from, to) and returns the number of
+// entries removed and the first index that has not been removed.
+func (db *RangeDB) deleteRange(from, to uint64) (uint64, uint64, error) {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return 0, from, errors.New(
+			"rangedb: delete range not supported for this db",
+		)
+	}
+	if from >= to {
+		return 0, from, nil
+	}
+
+	// For small ranges it is cheaper to visit each index directly, for large
+	// ones a single listing of the root avoids touching every empty index.
+	var indexes []uint64
+	if to-from <= denseRangeLimit {
+		indexes = make([]uint64, 0, to-from)
+		for index := from; index < to; index++ {
+			indexes = append(indexes, index)
+		}
+	} else {
+		var err error
+		if indexes, err = f.listIndexes(from, to); err != nil {
+			return 0, from, err
+		}
+	}
+
+	var removed uint64
+	for _, index := range indexes {
+		n, err := f.removeIndex(index)
+		removed += n
+		if err != nil {
+			return removed, index, err
+		}
+	}
+	return removed, to, nil
+}
+
+// Verify scans the database for corrupted entries and returns their keys.
+func (db *RangeDB) Verify(ctx context.Context) ([][]byte, error) {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return nil, errors.New("rangedb: verify not supported for this db")
+	}
+	return f.Verify(ctx)
+}
+
+// Prune removes all values in the given range [start, end) from the db.
+func (db *RangeDB) Prune(start, end uint64) error {
+	_, err := db.PruneCounted(start, end)
+	return err
+}
+
+// PruneCounted removes all values in the given range [start, end) from the
+// db and returns the number of entries removed.
+func (db *RangeDB) PruneCounted(start, end uint64) (uint64, error) {
+	f, ok := db.DB.(*DB)
+	if ok {
+		defer f.metrics.markPrune(time.Now())
+	}
+	start = max(start, db.firstIndex())
+	removed, next, err := db.deleteRange(start, end)
+	if ok {
+		f.metrics.markPruned(removed)
+	}
+	if err != nil {
+		// Everything below next has been removed, so the next prune resumes
+		// from where this one was interrupted.
+		db.raiseFirstIndex(next)
+		return removed, err
+	}
+	db.raiseFirstIndex(end)
+	return removed, nil
+}
+
+// CountRange returns the number of entries with an index in [start, end),
+// which is the number of entries PruneCounted would remove.
+func (db *RangeDB) CountRange(start, end uint64) (uint64, error) {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return 0, errors.New("rangedb: count range not supported for this db")
+	}
+	indexes, err := f.listIndexes(max(start, db.firstIndex()), end)
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+	for _, index := range indexes {
+		n, countErr := f.countDir(f.indexDir(index))
+		if countErr != nil {
+			return 0, countErr
+		}
+		count += n
+	}
+	return count, nil
+}
+
+// RangeBatch is a set of writes to a RangeDB that become visible atomically.
+type RangeBatch struct {
+	rdb   *RangeDB
+	db    *DB
+	batch *Batch
+	// minIndex is the lowest index written to in the batch.
+	minIndex uint64
+}
+
+// Set stages the value with the given index and key. The value is not
+// visible until the batch is written.
+func (b *RangeBatch) Set(index uint64, key []byte, value []byte) error {
+	if b.db == nil {
+		return errors.New("rangedb: batch not supported for this db")
+	}
+	if b.batch == nil {
+		b.batch = b.db.Batch()
+	}
+	b.minIndex = min(b.minIndex, index)
+	return b.batch.Set(b.rdb.prefix(index, key), value)
+}
+
+// Write commits the batch.
+func (b *RangeBatch) Write() error {
+	if b.batch == nil {
+		return nil
+	}
+	b.rdb.lowerFirstIndex(b.minIndex)
+	return b.batch.Write()
+}
+
+// Discard removes the staged writes of the batch.
+func (b *RangeBatch) Discard() error {
+	if b.batch == nil {
+		return nil
+	}
+	return b.batch.Discard()
+}
+
+// firstIndex returns the first index that may be populated.
+func (db *RangeDB) firstIndex() uint64 {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.firstNonNilIndex
+}
+
+// lowerFirstIndex lowers the first index that may be populated to index, if
+// it is above it, to enforce the invariant before index is written to.
+func (db *RangeDB) lowerFirstIndex(index uint64) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.firstNonNilIndex = min(db.firstNonNilIndex, index)
+}
+
+// raiseFirstIndex raises the first index that may be populated to index,
+// once the indexes below it have been removed.
+func (db *RangeDB) raiseFirstIndex(index uint64) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.firstNonNilIndex = max(db.firstNonNilIndex, index)
+}
+
+// listKeys returns the keys of the entries in the given index directory of
+// the underlying database, in the order of their file names. Files that do
+// not hold an entry are ignored.
+func (db *RangeDB) listKeys(f *DB, dir string) ([][]byte, error) {
+	infos, err := afero.ReadDir(f.fs, dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	keys := make([][]byte, 0, len(infos))
+	for _, info := range infos {
+		name, ok := strings.CutSuffix(info.Name(), "."+f.extension)
+		if info.IsDir() || !ok || !strings.HasPrefix(name, "0x") {
+			continue
+		}
+		key, decodeErr := hex.String(name).ToBytes()
+		if decodeErr != nil {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
 // prefix prefixes the given key with the index and a slash.
 func (db *RangeDB) prefix(index uint64, key []byte) []byte {
 	return []byte(fmt.Sprintf("%d/%s", index, hex.FromBytes(key).Unwrap()))
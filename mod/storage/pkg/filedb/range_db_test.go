@@ -110,8 +110,9 @@ func TestRangeDB(t *testing.T) {
 			},
 			testFunc: func(t *testing.T, rdb *file.RangeDB) {
 				t.Helper()
-				err := rdb.DeleteRange(1, 4)
+				removed, err := rdb.DeleteRange(1, 4)
 				require.NoError(t, err)
+				require.Equal(t, uint64(3), removed)
 
 				for index := uint64(1); index <= 3; index++ {
 					var exists bool
@@ -213,7 +214,7 @@ func TestRangeDB_DeleteRange_NotSupported(t *testing.T) {
 
 			rdb := file.NewRangeDB(tt.db)
 
-			err := rdb.DeleteRange(1, 4)
+			_, err := rdb.DeleteRange(1, 4)
 			require.Error(t, err)
 			require.Equal(t,
 				"rangedb: delete range not supported for this db",
@@ -277,6 +278,41 @@ func TestRangeDB_Prune(t *testing.T) {
 	}
 }
 
+func TestRangeDB_CountRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    uint64
+		end      uint64
+		expected uint64
+	}{
+		{name: "CountsEveryKey", start: 2, end: 7, expected: 7},
+		{name: "SkipsMissingIndexes", start: 48, end: 60, expected: 3},
+		{name: "EmptyRange", start: 7, end: 7, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rdb := file.NewRangeDB(newTestFDB("/tmp/testdb-count"))
+			require.NoError(t, populateTestDB(rdb, 0, 50))
+			require.NoError(t, rdb.Set(3, []byte("other"), []byte("value")))
+			require.NoError(t, rdb.Set(6, []byte("other"), []byte("value")))
+
+			count, err := rdb.CountRange(tt.start, tt.end)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, count)
+
+			// The count is accurate, a prune removes as many entries.
+			removed, err := rdb.PruneCounted(tt.start, tt.end)
+			require.NoError(t, err)
+			require.Equal(t, count, removed)
+
+			count, err = rdb.CountRange(tt.start, tt.end)
+			require.NoError(t, err)
+			require.Zero(t, count)
+		})
+	}
+}
+
 // =========================== INVARIANTS ================================.
 
 // invariant: all indexes up to the firstNonNilIndex should be nil.
@@ -327,7 +363,7 @@ func TestRangeDB_Invariants(t *testing.T) {
 			},
 			testFunc: func(t *testing.T, rdb *file.RangeDB) {
 				t.Helper()
-				_ = rdb.DeleteRange(1, 5) // ignore error
+				_, _ = rdb.DeleteRange(1, 5) // ignore error
 				requireNotExist(t, rdb, 0, lastConsequetiveNilIndex(rdb))
 			},
 		},
@@ -0,0 +1,279 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb
+
+import (
+	"bufio"
+	"bytes"
+	"hash"
+	"hash/crc32"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/klauspost/compress/zstd"
+	"github.com/spf13/afero"
+)
+
+// zstdDecoders pools the streaming zstd decoders, which allocate their
+// buffers once.
+//
+//nolint:gochecknoglobals // pool shared by all databases.
+var zstdDecoders sync.Pool
+
+// GetReader returns a reader of the value for a key, with its length. The
+// value is streamed from its file and decompressed on the fly if it was
+// written with zstd. Values written with snappy, whose blocks cannot be
+// decoded incrementally, are decompressed whole. The checksum of the entry
+// is verified once it has been read: the read returning the last bytes of
+// the value fails with ErrCorruptedEntry if it does not match. The reader
+// must be closed.
+//
+// The lock of the key is only held while its file is opened, so a slow
+// reader does not hold back the writes of the other keys of its index. On
+// POSIX filesystems, an entry removed while it is read remains readable
+// through the reader, and an entry overwritten meanwhile fails its
+// checksum.
+func (db *DB) GetReader(key []byte) (io.ReadCloser, int64, error) {
+	start := time.Now()
+	file, size, err := db.openLocked(key)
+	if err != nil {
+		return nil, 0, err
+	}
+	db.metrics.markRead(start, int(size))
+
+	r, err := db.newEntryReader(key, file, size)
+	if err != nil {
+		return nil, 0, errors.Join(err, file.Close())
+	}
+	// An empty value has no last bytes to verify the checksum with.
+	if r.remaining == 0 {
+		if err = r.verify(); err != nil {
+			return nil, 0, errors.Join(err, r.Close())
+		}
+	}
+	return r, r.remaining, nil
+}
+
+// openLocked opens the file of a key, holding the lock of the key, and
+// returns it with its size.
+func (db *DB) openLocked(key []byte) (afero.File, int64, error) {
+	mu := db.locks.forKey(key)
+	mu.RLock()
+	defer mu.RUnlock()
+
+	file, err := db.fs.Open(db.pathForKey(key))
+	if err != nil {
+		return nil, 0, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return nil, 0, errors.Join(err, file.Close())
+	}
+	return file, info.Size(), nil
+}
+
+// entryReader streams the value of an entry from its file.
+type entryReader struct {
+	key  []byte
+	file afero.File
+	// payload reads the payload of the entry, the bytes before its checksum
+	// trailer, hashing them into crc.
+	payload *bufio.Reader
+	crc     hash.Hash32
+	// checksum is the checksum of the payload, if hasChecksum is set.
+	checksum    uint32
+	hasChecksum bool
+	// value reads the value, decompressed from the payload.
+	value io.Reader
+	// decoder is the zstd decoder of the value, nil if it is not
+	// compressed with zstd.
+	decoder *zstd.Decoder
+	// remaining is the number of bytes of the value left to read.
+	remaining int64
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// newEntryReader returns a reader of the value of the entry stored in file,
+// of the given size.
+func (db *DB) newEntryReader(
+	key []byte, file afero.File, size int64,
+) (*entryReader, error) {
+	r := &entryReader{
+		key:  key,
+		file: file,
+		crc:  crc32.New(checksumTable),
+	}
+
+	// The trailer is read first, to know where the payload ends.
+	payloadLen := size
+	if size >= checksumTrailerLen {
+		trailer := make([]byte, checksumTrailerLen)
+		if _, err := file.ReadAt(trailer, size-checksumTrailerLen); err != nil {
+			return nil, err
+		}
+		var ok bool
+		if _, r.checksum, ok = splitChecksum(trailer); ok {
+			r.hasChecksum = true
+			payloadLen -= checksumTrailerLen
+		}
+	}
+	if !r.hasChecksum {
+		db.logLegacyEntry(key)
+	}
+	r.payload = bufio.NewReader(io.TeeReader(
+		io.NewSectionReader(file, 0, payloadLen), r.crc,
+	))
+
+	// Values without a frame header were written without compression.
+	header, _ := r.payload.Peek(frameHeaderLen)
+	if len(header) < frameHeaderLen ||
+		!bytes.Equal(header[:len(frameMagic)], frameMagic) {
+		r.value, r.remaining = r.payload, payloadLen
+		return r, nil
+	}
+	if _, err := r.payload.Discard(frameHeaderLen); err != nil {
+		return nil, err
+	}
+
+	switch id := header[len(frameMagic)]; id {
+	case snappyCodecID:
+		return r, r.decodeWhole(snappyCodec{})
+	case zstdCodecID:
+		return r, r.decodeZstd()
+	default:
+		return nil, r.corrupted(errors.Wrapf(
+			ErrCorruptedFrame, "unknown codec %d for key %s", id, key,
+		))
+	}
+}
+
+// decodeZstd streams the value out of a zstd frame. Frames that do not
+// record the length of their content are decompressed whole.
+func (r *entryReader) decodeZstd() error {
+	bz, _ := r.payload.Peek(zstd.HeaderMaxSize)
+	var header zstd.Header
+	if err := header.Decode(bz); err != nil || !header.HasFCS {
+		c, codecErr := sharedZstdCodec()
+		if codecErr != nil {
+			return codecErr
+		}
+		return r.decodeWhole(c)
+	}
+
+	decoder, _ := zstdDecoders.Get().(*zstd.Decoder)
+	if decoder == nil {
+		var err error
+		if decoder, err = zstd.NewReader(
+			nil, zstd.WithDecoderConcurrency(1),
+		); err != nil {
+			return err
+		}
+	}
+	if err := decoder.Reset(r.payload); err != nil {
+		zstdDecoders.Put(decoder)
+		return r.corrupted(errors.Wrapf(
+			ErrCorruptedFrame, "failed to decompress key %s: %v", r.key, err,
+		))
+	}
+	r.value, r.decoder = decoder, decoder
+	//#nosec:G115 // a value is far smaller than 2^63 bytes.
+	r.remaining = int64(header.FrameContentSize)
+	return nil
+}
+
+// decodeWhole decompresses the whole payload with c.
+func (r *entryReader) decodeWhole(c codec) error {
+	compressed, err := io.ReadAll(r.payload)
+	if err != nil {
+		return err
+	}
+	value, err := c.decode(compressed)
+	if err != nil {
+		return r.corrupted(errors.Wrapf(
+			ErrCorruptedFrame, "failed to decompress key %s: %v", r.key, err,
+		))
+	}
+	r.value, r.remaining = bytes.NewReader(value), int64(len(value))
+	return nil
+}
+
+// Read reads the value. The read returning its last bytes verifies the
+// checksum of the entry.
+func (r *entryReader) Read(p []byte) (int, error) {
+	if r.remaining == 0 {
+		return 0, io.EOF
+	}
+	if int64(len(p)) > r.remaining {
+		p = p[:r.remaining]
+	}
+	n, err := r.value.Read(p)
+	r.remaining -= int64(n)
+	switch {
+	case r.remaining == 0:
+		return n, r.verify()
+	case errors.Is(err, io.EOF):
+		return n, r.corrupted(errors.Wrapf(
+			ErrCorruptedFrame, "value of key %s is truncated", r.key,
+		))
+	case err != nil && r.decoder != nil:
+		return n, r.corrupted(errors.Wrapf(
+			ErrCorruptedFrame, "failed to decompress key %s: %v", r.key, err,
+		))
+	}
+	return n, err
+}
+
+// verify reads the rest of the payload and verifies its checksum.
+func (r *entryReader) verify() error {
+	if _, err := io.Copy(io.Discard, r.payload); err != nil {
+		return err
+	}
+	if r.hasChecksum && r.crc.Sum32() != r.checksum {
+		return ErrCorruptedEntry{Key: r.key}
+	}
+	return nil
+}
+
+// corrupted returns err for a payload that cannot be decoded, unless its
+// checksum does not match, in which case the entry is corrupted.
+func (r *entryReader) corrupted(err error) error {
+	if verifyErr := r.verify(); verifyErr != nil {
+		return verifyErr
+	}
+	return err
+}
+
+// Close closes the file of the entry. It is safe to call more than once.
+func (r *entryReader) Close() error {
+	r.closeOnce.Do(func() {
+		if r.decoder != nil {
+			// The decoder no longer references the file once reset.
+			_ = r.decoder.Reset(nil)
+			zstdDecoders.Put(r.decoder)
+			r.decoder = nil
+		}
+		r.closeErr = r.file.Close()
+	})
+	return r.closeErr
+}
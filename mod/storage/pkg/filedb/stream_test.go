@@ -0,0 +1,228 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb_test
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"testing/iotest"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/errors"
+	file "github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+// openCountingFs is a filesystem that counts the files left open.
+type openCountingFs struct {
+	afero.Fs
+	open atomic.Int64
+}
+
+func (fs *openCountingFs) Open(name string) (afero.File, error) {
+	f, err := fs.Fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	fs.open.Add(1)
+	return &openCountingFile{File: f, fs: fs}, nil
+}
+
+// openCountingFile is a file of an openCountingFs.
+type openCountingFile struct {
+	afero.File
+	fs *openCountingFs
+}
+
+func (f *openCountingFile) Close() error {
+	f.fs.open.Add(-1)
+	return f.File.Close()
+}
+
+// newStreamedValue returns a value the size of a blob sidecar, half of it
+// compressible.
+func newStreamedValue() []byte {
+	value := bytes.Repeat([]byte("beacon-kit"), 131544/10)
+	//#nosec:G404 // deterministic test data.
+	_, _ = rand.New(rand.NewSource(1)).Read(value[:len(value)/2])
+	return value
+}
+
+// readStreamed reads the value of key through a reader, one byte at a time.
+func readStreamed(t *testing.T, db *file.DB, key string) ([]byte, error) {
+	t.Helper()
+	r, size, err := db.GetReader([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { require.NoError(t, r.Close()) }()
+	value, err := io.ReadAll(iotest.OneByteReader(r))
+	if err == nil {
+		require.Equal(t, int64(len(value)), size)
+	}
+	return value, err
+}
+
+func TestDB_GetReader(t *testing.T) {
+	value := newStreamedValue()
+	for _, codec := range []string{
+		file.CompressionNone,
+		file.CompressionSnappy,
+		file.CompressionZstd,
+	} {
+		t.Run(codec, func(t *testing.T) {
+			db := newCompressedDB(t.TempDir(), codec)
+			require.NoError(t, db.Set([]byte("key"), value))
+
+			want, err := db.Get([]byte("key"))
+			require.NoError(t, err)
+			got, err := readStreamed(t, db, "key")
+			require.NoError(t, err)
+			require.Equal(t, want, got)
+
+			_, _, err = db.GetReader([]byte("missing"))
+			require.ErrorIs(t, err, os.ErrNotExist)
+		})
+	}
+}
+
+func TestDB_GetReader_LegacyEntry(t *testing.T) {
+	dir := t.TempDir()
+	db := newCompressedDB(dir, file.CompressionZstd)
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "legacy.ssz"), []byte("legacy-value"), 0600,
+	))
+
+	value, err := readStreamed(t, db, "legacy")
+	require.NoError(t, err)
+	require.Equal(t, []byte("legacy-value"), value)
+}
+
+func TestDB_GetReader_Corrupted(t *testing.T) {
+	value := newStreamedValue()
+	for _, codec := range []string{
+		file.CompressionNone,
+		file.CompressionSnappy,
+		file.CompressionZstd,
+	} {
+		t.Run(codec, func(t *testing.T) {
+			dir := t.TempDir()
+			db := newCompressedDB(dir, codec)
+			require.NoError(t, db.Set([]byte("key"), value))
+			path := filepath.Join(dir, "key.ssz")
+			info, err := os.Stat(path)
+			require.NoError(t, err)
+
+			flipByte(t, path, int(info.Size()/2))
+
+			_, err = readStreamed(t, db, "key")
+			var corrupted file.ErrCorruptedEntry
+			require.True(t, errors.As(err, &corrupted), "%v", err)
+			require.Equal(t, []byte("key"), corrupted.Key)
+		})
+	}
+
+	t.Run("TruncatedFrame", func(t *testing.T) {
+		dir := t.TempDir()
+		db := newCompressedDB(dir, file.CompressionZstd)
+		require.NoError(t, db.Set([]byte("key"), value))
+		path := filepath.Join(dir, "key.ssz")
+		bz, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, bz[:len(bz)/2], 0600))
+
+		_, err = readStreamed(t, db, "key")
+		require.ErrorIs(t, err, file.ErrCorruptedFrame)
+	})
+}
+
+func TestDB_GetReader_Close(t *testing.T) {
+	fs := new(openCountingFs)
+	rdb := file.NewRangeDB(file.NewDB(
+		file.WithRootDirectory(t.TempDir()),
+		file.WithFileExtension("ssz"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+		file.WithCompression(file.CompressionZstd),
+		file.WithFSWrapper(func(base afero.Fs) afero.Fs {
+			fs.Fs = base
+			return fs
+		}),
+	))
+	value := newStreamedValue()
+	require.NoError(t, rdb.Set(1, []byte("key"), value))
+
+	// A reader abandoned part way holds its file until it is closed, but not
+	// the lock of its index: the index can still be written and pruned.
+	r, _, err := rdb.GetReader(1, []byte("key"))
+	require.NoError(t, err)
+	_, err = io.ReadFull(r, make([]byte, 1024))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), fs.open.Load())
+	require.NoError(t, rdb.Set(1, []byte("other"), value))
+	require.NoError(t, rdb.Prune(0, 2))
+
+	require.NoError(t, r.Close())
+	require.NoError(t, r.Close())
+	require.Equal(t, int64(0), fs.open.Load())
+}
+
+func TestRangeDB_IterateReaders(t *testing.T) {
+	rdb := file.NewRangeDB(
+		newCompressedDB(t.TempDir(), file.CompressionSnappy),
+	)
+	require.NoError(t, populateTestDB(rdb, 1, 5))
+	require.NoError(t, rdb.Set(3, []byte("other"), newStreamedValue()))
+
+	type entry struct {
+		index uint64
+		key   string
+		value string
+	}
+	var want, got []entry
+	require.NoError(t, rdb.Iterate(1, 5, func(
+		index uint64, key, value []byte,
+	) error {
+		want = append(want, entry{index, string(key), string(value)})
+		return nil
+	}))
+	require.NoError(t, rdb.IterateReaders(1, 5, func(
+		index uint64, key []byte, r io.Reader, size int64,
+	) error {
+		value, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, int64(len(value)), size)
+		got = append(got, entry{index, string(key), string(value)})
+		return nil
+	}))
+	require.Len(t, got, 5)
+	require.Equal(t, want, got)
+
+	keys, err := rdb.Keys(3)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("key"), []byte("other")}, keys)
+}
@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// defaultSyncInterval is the default interval at which writes made outside
+// of a batch are synced under SyncPerBatch.
+const defaultSyncInterval = time.Second
+
+// SyncPolicy controls when the database calls fsync.
+//
+// Syncing trades write latency for durability: a value that has not been
+// synced lives only in the OS page cache and is lost on power loss, even
+// though the write returned successfully. A process crash alone never loses
+// a write, since the page cache outlives the process.
+type SyncPolicy uint8
+
+const (
+	// SyncPerBatch syncs every batch before it is committed and the
+	// directories it renamed into once it has been applied, so a batch that
+	// returned successfully survives power loss. Writes made outside of a
+	// batch are synced together by the first such write made once the sync
+	// interval has elapsed since the last sync, by the next batch, or by a
+	// call to Sync, avoiding an fsync on every write. There is no timer
+	// behind the interval: the last writes before the database goes idle
+	// stay unsynced until one of these happens. This is the default.
+	SyncPerBatch SyncPolicy = iota
+	// SyncPerWrite syncs every write and its parent directory before
+	// returning. This is the most durable policy and the slowest one.
+	SyncPerWrite
+	// SyncNever never syncs and relies on the OS to flush the page cache.
+	// Batches remain atomic with respect to process crashes, but any write,
+	// including a committed batch, may be lost or only partially visible
+	// after power loss. Only suitable for data that can be refetched.
+	SyncNever
+)
+
+// Names of the sync policies, as accepted by ParseSyncPolicy.
+const (
+	// SyncPolicyPerBatch is the name of SyncPerBatch.
+	SyncPolicyPerBatch = "per-batch"
+	// SyncPolicyPerWrite is the name of SyncPerWrite.
+	SyncPolicyPerWrite = "per-write"
+	// SyncPolicyNever is the name of SyncNever.
+	SyncPolicyNever = "never"
+)
+
+// ParseSyncPolicy returns the sync policy of the given name. An empty name
+// selects SyncPerBatch.
+func ParseSyncPolicy(name string) (SyncPolicy, error) {
+	switch name {
+	case "", SyncPolicyPerBatch:
+		return SyncPerBatch, nil
+	case SyncPolicyPerWrite:
+		return SyncPerWrite, nil
+	case SyncPolicyNever:
+		return SyncNever, nil
+	default:
+		return 0, errors.Newf("unsupported sync policy: %s", name)
+	}
+}
+
+// pendingSyncs tracks the writes made outside of a batch that have not been
+// synced yet.
+type pendingSyncs struct {
+	mu       sync.Mutex
+	paths    map[string]struct{}
+	lastSync time.Time
+}
+
+// add records a write to the given path and returns true if the sync
+// interval has elapsed since the last sync.
+func (p *pendingSyncs) add(path string, interval time.Duration) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.paths == nil {
+		p.paths = make(map[string]struct{})
+		p.lastSync = time.Now()
+	}
+	p.paths[path] = struct{}{}
+	return time.Since(p.lastSync) >= interval
+}
+
+// take returns the pending paths and resets the set.
+func (p *pendingSyncs) take() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	paths := make([]string, 0, len(p.paths))
+	for path := range p.paths {
+		paths = append(paths, path)
+	}
+	clear(p.paths)
+	p.lastSync = time.Now()
+	return paths
+}
+
+// Sync flushes the writes made outside of a batch that have not been synced
+// yet. It is a no-op unless the sync policy is SyncPerBatch.
+func (db *DB) Sync() error {
+	if db.syncPolicy != SyncPerBatch {
+		return nil
+	}
+
+	var (
+		errs []error
+		dirs = make(map[string]struct{})
+	)
+	for _, path := range db.pending.take() {
+		if err := db.syncPath(path); err != nil && !os.IsNotExist(err) {
+			errs = append(errs, err)
+		}
+		dirs[filepath.Dir(path)] = struct{}{}
+	}
+	for dir := range dirs {
+		if err := db.syncPath(dir); err != nil && !os.IsNotExist(err) {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// syncsBatches returns true if batches are synced before being committed.
+func (db *DB) syncsBatches() bool {
+	return db.syncPolicy != SyncNever
+}
+
+// afterWrite syncs a write made outside of a batch according to the sync
+// policy.
+func (db *DB) afterWrite(path string) error {
+	switch db.syncPolicy {
+	case SyncPerWrite:
+		return db.syncPath(filepath.Dir(path))
+	case SyncPerBatch:
+		if db.pending.add(path, db.syncInterval) {
+			return db.Sync()
+		}
+	case SyncNever:
+	}
+	return nil
+}
+
+// syncDirs syncs the parent directories of the given paths once each.
+func (db *DB) syncDirs(paths []string) error {
+	seen := make(map[string]struct{}, len(paths))
+	for _, path := range paths {
+		dir := filepath.Dir(path)
+		if _, ok := seen[dir]; ok {
+			continue
+		}
+		seen[dir] = struct{}{}
+		if err := db.syncPath(dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncPath syncs a file or a directory, so that its contents or the renames
+// and newly created entries within it are durable.
+func (db *DB) syncPath(path string) error {
+	f, err := db.fs.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return db.syncFile(f)
+}
+
+// syncFile syncs an open file and records the latency of the sync.
+func (db *DB) syncFile(f interface{ Sync() error }) error {
+	start := time.Now()
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	db.metrics.markSync(start)
+	return nil
+}
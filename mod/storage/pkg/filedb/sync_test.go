@@ -0,0 +1,272 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	metricstesting "github.com/berachain/beacon-kit/mod/primitives/pkg/metrics/testing"
+	file "github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+// syncCountingFs is a filesystem that counts the calls to Sync per path.
+type syncCountingFs struct {
+	afero.Fs
+	mu    sync.Mutex
+	syncs map[string]int
+}
+
+func (fs *syncCountingFs) Create(name string) (afero.File, error) {
+	f, err := fs.Fs.Create(name)
+	return fs.wrap(name, f, err)
+}
+
+func (fs *syncCountingFs) Open(name string) (afero.File, error) {
+	f, err := fs.Fs.Open(name)
+	return fs.wrap(name, f, err)
+}
+
+func (fs *syncCountingFs) OpenFile(
+	name string, flag int, perm os.FileMode,
+) (afero.File, error) {
+	f, err := fs.Fs.OpenFile(name, flag, perm)
+	return fs.wrap(name, f, err)
+}
+
+func (fs *syncCountingFs) wrap(
+	name string, f afero.File, err error,
+) (afero.File, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &syncCountingFile{File: f, fs: fs, name: filepath.Clean(name)}, nil
+}
+
+// count returns the number of syncs of the given path.
+func (fs *syncCountingFs) count(name string) int {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return fs.syncs[name]
+}
+
+// total returns the number of syncs of every path whose name contains the
+// given substring.
+func (fs *syncCountingFs) total(substr string) int {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	var n int
+	for name, count := range fs.syncs {
+		if strings.Contains(name, substr) {
+			n += count
+		}
+	}
+	return n
+}
+
+// syncCountingFile is a file that reports its syncs to a syncCountingFs.
+type syncCountingFile struct {
+	afero.File
+	fs   *syncCountingFs
+	name string
+}
+
+func (f *syncCountingFile) Sync() error {
+	f.fs.mu.Lock()
+	f.fs.syncs[f.name]++
+	f.fs.mu.Unlock()
+	return f.File.Sync()
+}
+
+// newSyncTestDB returns a database with the given sync policy and the
+// filesystem that counts its syncs.
+func newSyncTestDB(
+	t *testing.T, policy file.SyncPolicy, interval time.Duration,
+) (*file.DB, *syncCountingFs) {
+	t.Helper()
+	counting := &syncCountingFs{syncs: make(map[string]int)}
+	db := file.NewDB(
+		file.WithRootDirectory(t.TempDir()),
+		file.WithFileExtension("ssz"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+		file.WithSyncPolicy(policy, interval),
+		file.WithFSWrapper(func(fs afero.Fs) afero.Fs {
+			counting.Fs = fs
+			return counting
+		}),
+	)
+	// Only count the syncs made after the database has been opened.
+	clear(counting.syncs)
+	return db, counting
+}
+
+// writeBatch writes a batch of two values to index 1.
+func writeBatch(t *testing.T, db *file.DB) {
+	t.Helper()
+	batch := db.Batch()
+	require.NoError(t, batch.Set([]byte("1/x"), []byte("x")))
+	require.NoError(t, batch.Set([]byte("1/y"), []byte("y")))
+	require.NoError(t, batch.Write())
+}
+
+func TestDB_SyncPolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   file.SyncPolicy
+		interval time.Duration
+		testFunc func(t *testing.T, db *file.DB, fs *syncCountingFs)
+	}{
+		{
+			name:   "NeverSyncsWrites",
+			policy: file.SyncNever,
+			testFunc: func(t *testing.T, db *file.DB, fs *syncCountingFs) {
+				t.Helper()
+				require.NoError(t, db.Set([]byte("0/a"), []byte("a")))
+				require.NoError(t, db.Sync())
+				require.Zero(t, fs.total(""))
+			},
+		},
+		{
+			name:   "NeverSyncsBatches",
+			policy: file.SyncNever,
+			testFunc: func(t *testing.T, db *file.DB, fs *syncCountingFs) {
+				t.Helper()
+				writeBatch(t, db)
+				require.Zero(t, fs.total(""))
+			},
+		},
+		{
+			name:   "PerWriteSyncsFileAndDirectory",
+			policy: file.SyncPerWrite,
+			testFunc: func(t *testing.T, db *file.DB, fs *syncCountingFs) {
+				t.Helper()
+				require.NoError(t, db.Set([]byte("0/a"), []byte("a")))
+				require.Equal(t, 1, fs.count("shard-0/0/a.ssz"))
+				require.Equal(t, 1, fs.count("shard-0/0"))
+			},
+		},
+		{
+			name:     "PerBatchDefersWrites",
+			policy:   file.SyncPerBatch,
+			interval: time.Hour,
+			testFunc: func(t *testing.T, db *file.DB, fs *syncCountingFs) {
+				t.Helper()
+				require.NoError(t, db.Set([]byte("0/a"), []byte("a")))
+				require.NoError(t, db.Set([]byte("0/b"), []byte("b")))
+				require.Zero(t, fs.total(""))
+
+				require.NoError(t, db.Sync())
+				require.Equal(t, 1, fs.count("shard-0/0/a.ssz"))
+				require.Equal(t, 1, fs.count("shard-0/0/b.ssz"))
+				require.Equal(t, 1, fs.count("shard-0/0"))
+
+				// Nothing is left to sync.
+				require.NoError(t, db.Sync())
+				require.Equal(t, 3, fs.total(""))
+			},
+		},
+		{
+			name:     "PerBatchSyncsWritesAfterInterval",
+			policy:   file.SyncPerBatch,
+			interval: time.Nanosecond,
+			testFunc: func(t *testing.T, db *file.DB, fs *syncCountingFs) {
+				t.Helper()
+				require.NoError(t, db.Set([]byte("0/a"), []byte("a")))
+				require.NoError(t, db.Set([]byte("0/b"), []byte("b")))
+				require.Equal(t, 1, fs.count("shard-0/0/b.ssz"))
+			},
+		},
+		{
+			name:     "PerBatchSyncsBatches",
+			policy:   file.SyncPerBatch,
+			interval: time.Hour,
+			testFunc: func(t *testing.T, db *file.DB, fs *syncCountingFs) {
+				t.Helper()
+				require.NoError(t, db.Set([]byte("0/a"), []byte("a")))
+				writeBatch(t, db)
+
+				// Both staged files, the manifest and the staging directory
+				// before the commit, the directory renamed into after it.
+				require.Equal(t, 4, fs.total(".staging"))
+				require.Equal(t, 1, fs.count("shard-0/1"))
+				// Writes made outside of the batch are flushed with it.
+				require.Equal(t, 1, fs.count("shard-0/0/a.ssz"))
+				require.Equal(t, 1, fs.count("shard-0/0"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, fs := newSyncTestDB(t, tt.policy, tt.interval)
+			tt.testFunc(t, db, fs)
+		})
+	}
+
+	t.Run("UnsupportedPolicy", func(t *testing.T) {
+		require.Panics(t, func() {
+			file.NewDB(file.WithSyncPolicy(file.SyncNever+1, 0))
+		})
+	})
+}
+
+func TestParseSyncPolicy(t *testing.T) {
+	for name, expected := range map[string]file.SyncPolicy{
+		"":                      file.SyncPerBatch,
+		file.SyncPolicyPerBatch: file.SyncPerBatch,
+		file.SyncPolicyPerWrite: file.SyncPerWrite,
+		file.SyncPolicyNever:    file.SyncNever,
+	} {
+		policy, err := file.ParseSyncPolicy(name)
+		require.NoError(t, err)
+		require.Equal(t, expected, policy)
+	}
+	_, err := file.ParseSyncPolicy("sometimes")
+	require.ErrorContains(t, err, "unsupported sync policy: sometimes")
+}
+
+func TestDB_SyncMetrics(t *testing.T) {
+	sink := metricstesting.NewRecordingSink()
+	db := file.NewDB(
+		file.WithRootDirectory(t.TempDir()),
+		file.WithFileExtension("ssz"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+		file.WithSyncPolicy(file.SyncPerWrite, 0),
+		file.WithTelemetrySink(sink),
+	)
+
+	syncs := sink.CountFor("beacon_kit.filedb.syncs")
+	durations := len(sink.Durations("beacon_kit.filedb.sync_duration"))
+	require.NoError(t, db.Set([]byte("0/a"), []byte("a")))
+	require.Equal(t, syncs+2, sink.CountFor("beacon_kit.filedb.syncs"))
+	require.Equal(t,
+		durations+2, len(sink.Durations("beacon_kit.filedb.sync_duration")),
+	)
+}
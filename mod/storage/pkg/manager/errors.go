@@ -31,4 +31,28 @@ var (
 	// ErrDuplicatePruner is returned when a pruner with the same name is added
 	// to the manager.
 	ErrDuplicatePruner = errors.New("pruner with the same name already exists")
+
+	// ErrDuplicateVerifier is returned when a verifier with the same name is
+	// registered with the manager.
+	ErrDuplicateVerifier = errors.New(
+		"verifier with the same name already exists",
+	)
+
+	// ErrDuplicateCloser is returned when a store with the same name is
+	// registered to be closed by the manager.
+	ErrDuplicateCloser = errors.New(
+		"closer with the same name already exists",
+	)
+
+	// ErrStopTimeout is returned when services of the manager have not
+	// stopped before the deadline of Stop.
+	ErrStopTimeout = errors.New("services did not stop in time")
+
+	// ErrUnhealthyPruner is returned by Status when a pruner has failed since
+	// its last successful prune.
+	ErrUnhealthyPruner = errors.New("pruner is unhealthy")
+
+	// ErrPrunerNotFound is returned when no pruner of the manager has the
+	// requested name.
+	ErrPrunerNotFound = errors.New("pruner not found")
 )
@@ -27,19 +27,42 @@ package manager
 
 import (
 	"context"
+	"io"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 )
 
+// DefaultStopTimeout is the time Stop waits for the pruners to exit when the
+// given context has no deadline.
+const DefaultStopTimeout = 10 * time.Second
+
 // DBManager is a manager for all pruners.
 type DBManager[
 	BeaconBlockT BeaconBlock,
 	BlockEventT BlockEvent[BeaconBlockT],
 	SubscriptionT Subscription,
 ] struct {
-	pruners []pruner.Pruner[pruner.Prunable]
+	pruners   []pruner.Pruner[pruner.Prunable]
+	verifiers map[string]Verifier
+	// closers are the stores closed by Stop, in registration order.
+	closers []namedCloser
 	logger  log.Logger[any]
+
+	mu sync.Mutex
+	// cancel cancels the context the pruners were started with, it is nil
+	// when the manager is not running.
+	cancel context.CancelFunc
+}
+
+// namedCloser is a store closed by the manager when it stops.
+type namedCloser struct {
+	name   string
+	closer io.Closer
 }
 
 func NewDBManager[
@@ -53,8 +76,9 @@ func NewDBManager[
 	return &DBManager[
 		BeaconBlockT, BlockEventT, SubscriptionT,
 	]{
-		logger:  logger,
-		pruners: pruners,
+		logger:    logger,
+		pruners:   pruners,
+		verifiers: make(map[string]Verifier),
 	}, nil
 }
 
@@ -65,25 +89,196 @@ func (m *DBManager[
 	return "db-manager"
 }
 
-// TODO: fr implementation
+// Status returns an error if any pruner has failed since its last
+// successful prune.
 func (m *DBManager[
 	BeaconBlockT, BlockEventT, SubscriptionT,
 ]) Status() error {
+	var unhealthy []string
+	for _, p := range m.pruners {
+		if !p.Health().Healthy() {
+			unhealthy = append(unhealthy, p.Name())
+		}
+	}
+	if len(unhealthy) > 0 {
+		return errors.Wrapf(
+			ErrUnhealthyPruner, "%s", strings.Join(unhealthy, ", "),
+		)
+	}
 	return nil
 }
 
+// Health returns the outcome of the latest prunes of every pruner, by
+// pruner name.
+func (m *DBManager[
+	BeaconBlockT, BlockEventT, SubscriptionT,
+]) Health() map[string]pruner.Health {
+	health := make(map[string]pruner.Health, len(m.pruners))
+	for _, p := range m.pruners {
+		health[p.Name()] = p.Health()
+	}
+	return health
+}
+
+// PruneNow prunes the range [start, end) of the named pruner right away, or
+// only counts the entries in it if dryRun is set. It returns the number of
+// entries that were, or would be, removed.
+func (m *DBManager[
+	BeaconBlockT, BlockEventT, SubscriptionT,
+]) PruneNow(
+	ctx context.Context, name string, start, end uint64, dryRun bool,
+) (uint64, error) {
+	for _, p := range m.pruners {
+		if p.Name() != name {
+			continue
+		}
+		res, err := p.PruneNow(ctx, start, end, dryRun)
+		if err != nil {
+			return 0, err
+		}
+		m.logger.Info(
+			"manual prune", "pruner", name, "start", start, "end", end,
+			"dry_run", dryRun, "deleted", res.Deleted,
+		)
+		return res.Deleted, nil
+	}
+	return 0, errors.Wrapf(ErrPrunerNotFound, "%s", name)
+}
+
 // TODO: fr implementation
 func (m *DBManager[
 	BeaconBlockT, BlockEventT, SubscriptionT,
 ]) WaitForHealthy(_ context.Context) {
 }
 
-// Start starts all pruners.
+// Start starts all pruners. The pruners run until ctx is cancelled or Stop
+// is called.
 func (m *DBManager[
 	BeaconBlockT, BlockEventT, SubscriptionT,
 ]) Start(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ctx, m.cancel = context.WithCancel(ctx)
 	for _, pruner := range m.pruners {
 		pruner.Start(ctx)
 	}
 	return nil
 }
+
+// Stop cancels the pruners and waits for them to exit, so that no prune is
+// interrupted half way, then closes the registered stores. It waits until
+// ctx is done, or DefaultStopTimeout if ctx has no deadline, and returns
+// ErrStopTimeout with the names of the pruners that are still running, in
+// which case the stores are left open.
+func (m *DBManager[
+	BeaconBlockT, BlockEventT, SubscriptionT,
+]) Stop(ctx context.Context) error {
+	m.mu.Lock()
+	cancel := m.cancel
+	m.cancel = nil
+	m.mu.Unlock()
+	if cancel == nil {
+		return m.close()
+	}
+	cancel()
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeout(ctx, DefaultStopTimeout)
+		defer cancelTimeout()
+	}
+
+	var running []string
+	for _, p := range m.pruners {
+		// Check for an exited pruner first, since once ctx is done both
+		// cases of the select below may be ready.
+		select {
+		case <-p.Done():
+			continue
+		default:
+		}
+		select {
+		case <-p.Done():
+		case <-ctx.Done():
+			running = append(running, p.Name())
+		}
+	}
+	if len(running) > 0 {
+		m.logger.Error("pruners did not stop in time", "pruners", running)
+		return errors.Wrapf(
+			ErrStopTimeout, "%s", strings.Join(running, ", "),
+		)
+	}
+	return m.close()
+}
+
+// close closes the registered stores, once. It returns the errors of all the
+// stores that failed to close.
+func (m *DBManager[
+	BeaconBlockT, BlockEventT, SubscriptionT,
+]) close() error {
+	m.mu.Lock()
+	closers := m.closers
+	m.closers = nil
+	m.mu.Unlock()
+
+	var errs []error
+	for _, c := range closers {
+		if err := c.closer.Close(); err != nil {
+			m.logger.Error(
+				"failed to close store", "store", c.name, "error", err,
+			)
+			errs = append(errs, errors.Wrapf(err, "%s", c.name))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// RegisterCloser registers a store to be closed when the manager stops,
+// after the pruners using it have exited.
+func (m *DBManager[
+	BeaconBlockT, BlockEventT, SubscriptionT,
+]) RegisterCloser(name string, closer io.Closer) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, c := range m.closers {
+		if c.name == name {
+			return ErrDuplicateCloser
+		}
+	}
+	m.closers = append(m.closers, namedCloser{name: name, closer: closer})
+	return nil
+}
+
+// RegisterVerifier registers a store that can be scanned for corrupted
+// entries with Verify.
+func (m *DBManager[
+	BeaconBlockT, BlockEventT, SubscriptionT,
+]) RegisterVerifier(name string, verifier Verifier) error {
+	if _, ok := m.verifiers[name]; ok {
+		return ErrDuplicateVerifier
+	}
+	m.verifiers[name] = verifier
+	return nil
+}
+
+// Verify scans every registered store for corrupted entries and returns
+// their keys by store name.
+func (m *DBManager[
+	BeaconBlockT, BlockEventT, SubscriptionT,
+]) Verify(ctx context.Context) (map[string][][]byte, error) {
+	corrupted := make(map[string][][]byte)
+	for name, verifier := range m.verifiers {
+		keys, err := verifier.Verify(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(keys) > 0 {
+			m.logger.Error(
+				"found corrupted entries", "store", name, "count", len(keys),
+			)
+			corrupted[name] = keys
+		}
+	}
+	return corrupted, nil
+}
@@ -27,6 +27,8 @@ package manager_test
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"testing"
 	"time"
 
@@ -86,3 +88,242 @@ func TestDBManager_Start(t *testing.T) {
 	feed.AssertNumberOfCalls(t, "Subscribe", 2)
 	mockPrunable.AssertNotCalled(t, "PruneFromInclusive")
 }
+
+type stubVerifier struct {
+	corrupted [][]byte
+}
+
+func (v stubVerifier) Verify(context.Context) ([][]byte, error) {
+	return v.corrupted, nil
+}
+
+func TestDBManager_Verify(t *testing.T) {
+	m, err := manager.NewDBManager[
+		manager.BeaconBlock,
+		manager.BlockEvent[manager.BeaconBlock],
+		manager.Subscription,
+	](log.NewNopLogger())
+	require.NoError(t, err)
+
+	require.NoError(t, m.RegisterVerifier("healthy", stubVerifier{}))
+	require.NoError(t, m.RegisterVerifier("corrupted", stubVerifier{
+		corrupted: [][]byte{[]byte("1/0xabcd")},
+	}))
+	require.ErrorIs(t,
+		m.RegisterVerifier("healthy", stubVerifier{}),
+		manager.ErrDuplicateVerifier,
+	)
+
+	corrupted, err := m.Verify(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, map[string][][]byte{
+		"corrupted": {[]byte("1/0xabcd")},
+	}, corrupted)
+}
+
+// fakePruner is a pruner that keeps running for the given delay after its
+// context is cancelled.
+type fakePruner struct {
+	name   string
+	delay  time.Duration
+	health pruner.Health
+	done   chan struct{}
+}
+
+func newFakePruner(name string, delay time.Duration) *fakePruner {
+	return &fakePruner{name: name, delay: delay, done: make(chan struct{})}
+}
+
+func (p *fakePruner) Name() string { return p.name }
+
+func (p *fakePruner) Start(ctx context.Context) {
+	go func() {
+		defer close(p.done)
+		<-ctx.Done()
+		time.Sleep(p.delay)
+	}()
+}
+
+func (p *fakePruner) Done() <-chan struct{} { return p.done }
+
+func (p *fakePruner) Health() pruner.Health { return p.health }
+
+func (p *fakePruner) PruneNow(
+	_ context.Context, start, end uint64, _ bool,
+) (pruner.Result, error) {
+	return pruner.Result{Start: start, End: end, Deleted: end - start}, nil
+}
+
+func newTestManager(
+	t *testing.T, pruners ...pruner.Pruner[pruner.Prunable],
+) *manager.DBManager[
+	manager.BeaconBlock,
+	manager.BlockEvent[manager.BeaconBlock],
+	manager.Subscription,
+] {
+	t.Helper()
+	m, err := manager.NewDBManager[
+		manager.BeaconBlock,
+		manager.BlockEvent[manager.BeaconBlock],
+		manager.Subscription,
+	](log.NewNopLogger(), pruners...)
+	require.NoError(t, err)
+	return m
+}
+
+func TestDBManager_Stop(t *testing.T) {
+	tests := []struct {
+		name    string
+		delays  map[string]time.Duration
+		timeout time.Duration
+		running []string
+	}{
+		{
+			name: "AllStop",
+			delays: map[string]time.Duration{
+				"fast1": 0,
+				"fast2": 10 * time.Millisecond,
+			},
+			timeout: time.Second,
+		},
+		{
+			name: "SlowPrunerTimesOut",
+			delays: map[string]time.Duration{
+				"fast": 0,
+				"slow": time.Second,
+			},
+			timeout: 50 * time.Millisecond,
+			running: []string{"slow"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pruners := make([]pruner.Pruner[pruner.Prunable], 0)
+			for name, delay := range tt.delays {
+				pruners = append(pruners, newFakePruner(name, delay))
+			}
+			m := newTestManager(t, pruners...)
+			require.NoError(t, m.Start(context.Background()))
+
+			ctx, cancel := context.WithTimeout(
+				context.Background(), tt.timeout,
+			)
+			defer cancel()
+			err := m.Stop(ctx)
+			if len(tt.running) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, manager.ErrStopTimeout)
+			for _, name := range tt.running {
+				require.ErrorContains(t, err, name)
+			}
+			for name := range tt.delays {
+				if !slices.Contains(tt.running, name) {
+					require.NotContains(t, err.Error(), name)
+				}
+			}
+		})
+	}
+}
+
+func TestDBManager_StopNotStarted(t *testing.T) {
+	m := newTestManager(t, newFakePruner("pruner", time.Hour))
+	require.NoError(t, m.Stop(context.Background()))
+}
+
+// fakeCloser counts the calls to Close.
+type fakeCloser struct {
+	closed int
+	err    error
+}
+
+func (c *fakeCloser) Close() error {
+	c.closed++
+	return c.err
+}
+
+func TestDBManager_StopClosesStores(t *testing.T) {
+	errClose := errors.New("close failed")
+	for _, started := range []bool{true, false} {
+		m := newTestManager(t, newFakePruner("pruner", 0))
+		blobs, deposits := &fakeCloser{}, &fakeCloser{err: errClose}
+		require.NoError(t, m.RegisterCloser("blobs", blobs))
+		require.NoError(t, m.RegisterCloser("deposits", deposits))
+		require.ErrorIs(t,
+			m.RegisterCloser("blobs", &fakeCloser{}),
+			manager.ErrDuplicateCloser,
+		)
+		if started {
+			require.NoError(t, m.Start(context.Background()))
+		}
+
+		// Every store is closed, even after one fails to close.
+		require.ErrorIs(t, m.Stop(context.Background()), errClose)
+		require.Equal(t, 1, blobs.closed)
+		require.Equal(t, 1, deposits.closed)
+
+		// The stores are closed once.
+		require.NoError(t, m.Stop(context.Background()))
+		require.Equal(t, 1, blobs.closed)
+	}
+}
+
+func TestDBManager_StopTimeoutLeavesStoresOpen(t *testing.T) {
+	m := newTestManager(t, newFakePruner("slow", time.Second))
+	closer := &fakeCloser{}
+	require.NoError(t, m.RegisterCloser("blobs", closer))
+	require.NoError(t, m.Start(context.Background()))
+
+	// The store is still in use by the pruner.
+	ctx, cancel := context.WithTimeout(
+		context.Background(), 50*time.Millisecond,
+	)
+	defer cancel()
+	require.ErrorIs(t, m.Stop(ctx), manager.ErrStopTimeout)
+	require.Zero(t, closer.closed)
+}
+
+func TestDBManager_Health(t *testing.T) {
+	now := time.Now()
+	healthy := newFakePruner("healthy", 0)
+	healthy.health = pruner.Health{LastSuccess: now}
+	recovered := newFakePruner("recovered", 0)
+	recovered.health = pruner.Health{
+		LastSuccess:   now,
+		LastError:     errors.New("prune failed"),
+		LastErrorTime: now.Add(-time.Minute),
+	}
+	failing := newFakePruner("failing", 0)
+	failing.health = pruner.Health{
+		LastSuccess:   now.Add(-time.Minute),
+		LastError:     errors.New("prune failed"),
+		LastErrorTime: now,
+	}
+	m := newTestManager(t, healthy, recovered, failing)
+
+	health := m.Health()
+	require.Len(t, health, 3)
+	require.Equal(t, healthy.health, health["healthy"])
+	require.Equal(t, failing.health, health["failing"])
+
+	err := m.Status()
+	require.ErrorIs(t, err, manager.ErrUnhealthyPruner)
+	require.ErrorContains(t, err, "failing")
+	require.NotContains(t, err.Error(), "recovered")
+}
+
+func TestDBManager_PruneNow(t *testing.T) {
+	m := newTestManager(
+		t, newFakePruner("first", 0), newFakePruner("second", 0),
+	)
+
+	deleted, err := m.PruneNow(context.Background(), "second", 2, 5, true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), deleted)
+
+	_, err = m.PruneNow(context.Background(), "missing", 2, 5, true)
+	require.ErrorIs(t, err, manager.ErrPrunerNotFound)
+	require.ErrorContains(t, err, "missing")
+}
@@ -26,6 +26,8 @@
 package manager
 
 import (
+	"context"
+
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -52,3 +54,9 @@ type BlockFeed[
 ] interface {
 	Subscribe(chan<- (BlockEventT)) SubscriptionT
 }
+
+// Verifier is a store that can scan itself for corrupted entries.
+type Verifier interface {
+	// Verify returns the keys of the corrupted entries in the store.
+	Verify(ctx context.Context) ([][]byte, error)
+}
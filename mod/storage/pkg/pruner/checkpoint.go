@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+
+package pruner
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// checkpointPrefix prefixes the keys of the checkpoints of the pruners.
+const checkpointPrefix = "pruner/checkpoint/"
+
+// checkpointLen is the length of an encoded checkpoint.
+const checkpointLen = 8
+
+// Compile-time assertion of the checkpoint store interface.
+var _ CheckpointStore = (*KVCheckpointStore)(nil)
+
+// KVCheckpointStore is a CheckpointStore on top of a key-value store.
+type KVCheckpointStore struct {
+	db store.KVStore
+}
+
+// NewKVCheckpointStore creates a new KVCheckpointStore.
+func NewKVCheckpointStore(db store.KVStore) *KVCheckpointStore {
+	return &KVCheckpointStore{db: db}
+}
+
+// Checkpoint returns the checkpoint of the named pruner, or zero if it has
+// none.
+func (s *KVCheckpointStore) Checkpoint(name string) (uint64, error) {
+	bz, err := s.db.Get(checkpointKey(name))
+	if err != nil || bz == nil {
+		return 0, err
+	} else if len(bz) != checkpointLen {
+		return 0, errors.Newf("invalid checkpoint of pruner %s", name)
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+// SetCheckpoint stores the checkpoint of the named pruner.
+func (s *KVCheckpointStore) SetCheckpoint(name string, index uint64) error {
+	return s.db.Set(
+		checkpointKey(name),
+		binary.BigEndian.AppendUint64(make([]byte, 0, checkpointLen), index),
+	)
+}
+
+// checkpointKey returns the key of the checkpoint of the named pruner.
+func checkpointKey(name string) []byte {
+	return []byte(checkpointPrefix + name)
+}
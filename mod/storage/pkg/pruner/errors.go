@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+
+package pruner
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInvalidRange is returned when the start of a range is after its
+	// end.
+	ErrInvalidRange = errors.New("invalid prune range")
+	// ErrUnsafeRange is returned when a manual prune reaches past the data
+	// that the pruner itself would have pruned on finalization.
+	ErrUnsafeRange = errors.New("prune range overlaps un-finalized data")
+	// ErrDryRunNotSupported is returned for a dry-run prune of a store that
+	// cannot count its entries.
+	ErrDryRunNotSupported = errors.New("dry run not supported by store")
+)
@@ -25,15 +25,94 @@
 
 package pruner
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type Prunable interface {
 	// Prune prunes the store from [start, end).
 	Prune(start, end uint64) error
 }
 
+// CountingPrunable is a Prunable that reports the number of entries it
+// removed, so that it can be exposed in the metrics of the pruner.
+type CountingPrunable interface {
+	Prunable
+	// PruneCounted prunes the store from [start, end) and returns the
+	// number of entries removed.
+	PruneCounted(start, end uint64) (uint64, error)
+}
+
+// RangeCounter is implemented by stores that can count the entries in a
+// range without removing them, which is required for dry-run prunes.
+type RangeCounter interface {
+	// CountRange returns the number of entries in [start, end).
+	CountRange(start, end uint64) (uint64, error)
+}
+
+// CheckpointStore persists the index up to which each pruner has pruned, so
+// that a restarted pruner does not walk the ranges it already emptied.
+type CheckpointStore interface {
+	// Checkpoint returns the index below which the named pruner has pruned
+	// everything, or zero if it has no checkpoint.
+	Checkpoint(name string) (uint64, error)
+	// SetCheckpoint stores the checkpoint of the named pruner.
+	SetCheckpoint(name string, index uint64) error
+}
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
+	// MeasureSince measures the time since the provided start time,
+	// identified by the provided keys.
+	MeasureSince(key string, start time.Time, args ...string)
+}
+
+// Result is the outcome of a single prune.
+type Result struct {
+	// Start is the first index of the pruned range.
+	Start uint64
+	// End is the index after the last index of the pruned range.
+	End uint64
+	// Deleted is the number of entries removed, it is only known for a
+	// CountingPrunable and zero otherwise.
+	Deleted uint64
+}
+
 // Pruner is an interface for pruning the store.
 type Pruner[PrunableT Prunable] interface {
 	Name() string
 	Start(ctx context.Context)
+	// Done returns a channel that is closed once the pruner has stopped
+	// after its context was cancelled.
+	Done() <-chan struct{}
+	// Health returns the outcome of the latest prunes.
+	Health() Health
+	// PruneNow prunes [start, end) right away, or only counts the entries
+	// that would be removed if dryRun is set.
+	PruneNow(
+		ctx context.Context, start, end uint64, dryRun bool,
+	) (Result, error)
+}
+
+// Health is the outcome of the latest prunes of a pruner.
+type Health struct {
+	// LastSuccess is the time of the last successful prune.
+	LastSuccess time.Time
+	// LastError is the error of the last failed prune, if any.
+	LastError error
+	// LastErrorTime is the time of the last failed prune.
+	LastErrorTime time.Time
+}
+
+// Healthy returns true if the pruner has not failed since its last
+// successful prune.
+func (h Health) Healthy() bool {
+	return h.LastError == nil || h.LastSuccess.After(h.LastErrorTime)
 }
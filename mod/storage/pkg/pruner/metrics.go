@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+
+package pruner
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// prunerMetrics is a struct that contains metrics for a pruner.
+type prunerMetrics struct {
+	// sink is the sink for the metrics.
+	sink TelemetrySink
+	// name is the name of the pruner, used as a label of every metric.
+	name string
+	// deleted is the total number of entries deleted by the pruner.
+	deleted atomic.Int64
+}
+
+// newPrunerMetrics creates a new prunerMetrics.
+func newPrunerMetrics(sink TelemetrySink, name string) *prunerMetrics {
+	return &prunerMetrics{
+		sink: sink,
+		name: name,
+	}
+}
+
+// markDropped records a prune request dropped because the queue was full.
+func (m *prunerMetrics) markDropped() {
+	if m.sink == nil {
+		return
+	}
+	m.sink.IncrementCounter("beacon_kit.pruner.dropped", "pruner", m.name)
+}
+
+// markPrune records the outcome of a prune that started at the given time.
+func (m *prunerMetrics) markPrune(start time.Time, res Result, err error) {
+	if m.sink == nil {
+		return
+	}
+	m.sink.MeasureSince(
+		"beacon_kit.pruner.prune_duration", start, "pruner", m.name,
+	)
+	if err != nil {
+		m.sink.IncrementCounter("beacon_kit.pruner.errors", "pruner", m.name)
+		return
+	}
+	m.sink.IncrementCounter(
+		"beacon_kit.pruner.ranges_pruned", "pruner", m.name,
+	)
+	//#nosec:G115 // entry counts fit in an int64.
+	m.sink.SetGauge(
+		"beacon_kit.pruner.entries_deleted",
+		m.deleted.Add(int64(res.Deleted)), "pruner", m.name,
+	)
+}
@@ -22,6 +22,98 @@ func (_m *Pruner[PrunableT]) EXPECT() *Pruner_Expecter[PrunableT] {
 	return &Pruner_Expecter[PrunableT]{mock: &_m.Mock}
 }
 
+// Done provides a mock function with given fields:
+func (_m *Pruner[PrunableT]) Done() <-chan struct{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Done")
+	}
+
+	var r0 <-chan struct{}
+	if rf, ok := ret.Get(0).(func() <-chan struct{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+
+	return r0
+}
+
+// Pruner_Done_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Done'
+type Pruner_Done_Call[PrunableT pruner.Prunable] struct {
+	*mock.Call
+}
+
+// Done is a helper method to define mock.On call
+func (_e *Pruner_Expecter[PrunableT]) Done() *Pruner_Done_Call[PrunableT] {
+	return &Pruner_Done_Call[PrunableT]{Call: _e.mock.On("Done")}
+}
+
+func (_c *Pruner_Done_Call[PrunableT]) Run(run func()) *Pruner_Done_Call[PrunableT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Pruner_Done_Call[PrunableT]) Return(_a0 <-chan struct{}) *Pruner_Done_Call[PrunableT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Pruner_Done_Call[PrunableT]) RunAndReturn(run func() <-chan struct{}) *Pruner_Done_Call[PrunableT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Health provides a mock function with given fields:
+func (_m *Pruner[PrunableT]) Health() pruner.Health {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Health")
+	}
+
+	var r0 pruner.Health
+	if rf, ok := ret.Get(0).(func() pruner.Health); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(pruner.Health)
+	}
+
+	return r0
+}
+
+// Pruner_Health_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Health'
+type Pruner_Health_Call[PrunableT pruner.Prunable] struct {
+	*mock.Call
+}
+
+// Health is a helper method to define mock.On call
+func (_e *Pruner_Expecter[PrunableT]) Health() *Pruner_Health_Call[PrunableT] {
+	return &Pruner_Health_Call[PrunableT]{Call: _e.mock.On("Health")}
+}
+
+func (_c *Pruner_Health_Call[PrunableT]) Run(run func()) *Pruner_Health_Call[PrunableT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Pruner_Health_Call[PrunableT]) Return(_a0 pruner.Health) *Pruner_Health_Call[PrunableT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Pruner_Health_Call[PrunableT]) RunAndReturn(run func() pruner.Health) *Pruner_Health_Call[PrunableT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Name provides a mock function with given fields:
 func (_m *Pruner[PrunableT]) Name() string {
 	ret := _m.Called()
@@ -67,6 +159,65 @@ func (_c *Pruner_Name_Call[PrunableT]) RunAndReturn(run func() string) *Pruner_N
 	return _c
 }
 
+// PruneNow provides a mock function with given fields: ctx, start, end, dryRun
+func (_m *Pruner[PrunableT]) PruneNow(ctx context.Context, start uint64, end uint64, dryRun bool) (pruner.Result, error) {
+	ret := _m.Called(ctx, start, end, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PruneNow")
+	}
+
+	var r0 pruner.Result
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, bool) (pruner.Result, error)); ok {
+		return rf(ctx, start, end, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, bool) pruner.Result); ok {
+		r0 = rf(ctx, start, end, dryRun)
+	} else {
+		r0 = ret.Get(0).(pruner.Result)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, bool) error); ok {
+		r1 = rf(ctx, start, end, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Pruner_PruneNow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PruneNow'
+type Pruner_PruneNow_Call[PrunableT pruner.Prunable] struct {
+	*mock.Call
+}
+
+// PruneNow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - start uint64
+//   - end uint64
+//   - dryRun bool
+func (_e *Pruner_Expecter[PrunableT]) PruneNow(ctx interface{}, start interface{}, end interface{}, dryRun interface{}) *Pruner_PruneNow_Call[PrunableT] {
+	return &Pruner_PruneNow_Call[PrunableT]{Call: _e.mock.On("PruneNow", ctx, start, end, dryRun)}
+}
+
+func (_c *Pruner_PruneNow_Call[PrunableT]) Run(run func(ctx context.Context, start uint64, end uint64, dryRun bool)) *Pruner_PruneNow_Call[PrunableT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *Pruner_PruneNow_Call[PrunableT]) Return(_a0 pruner.Result, _a1 error) *Pruner_PruneNow_Call[PrunableT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Pruner_PruneNow_Call[PrunableT]) RunAndReturn(run func(context.Context, uint64, uint64, bool) (pruner.Result, error)) *Pruner_PruneNow_Call[PrunableT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Pruner[PrunableT]) Start(ctx context.Context) {
 	_m.Called(ctx)
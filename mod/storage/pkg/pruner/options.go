@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+
+package pruner
+
+// Option is a functional option for the DBPruner.
+type Option func(*options)
+
+// options holds the configuration of a DBPruner.
+type options struct {
+	// telemetrySink is the sink for the metrics of the pruner.
+	telemetrySink TelemetrySink
+	// queueSize is the number of prune requests that can wait for the
+	// worker before new ones are dropped.
+	queueSize int
+	// checkpoints persists the progress of the pruner across restarts.
+	checkpoints CheckpointStore
+}
+
+// WithTelemetrySink sets the sink for the metrics of the pruner.
+func WithTelemetrySink(sink TelemetrySink) Option {
+	return func(o *options) {
+		o.telemetrySink = sink
+	}
+}
+
+// WithQueueSize sets the number of prune requests that can wait for the
+// worker of the pruner. Requests arriving while the queue is full are
+// dropped, and the span of their ranges is pruned along with the next
+// requests taken by the worker.
+func WithQueueSize(size int) Option {
+	return func(o *options) {
+		o.queueSize = size
+	}
+}
+
+// WithCheckpointStore sets the store in which the pruner persists the index
+// up to which it has pruned, so that it resumes from there after a restart.
+func WithCheckpointStore(checkpoints CheckpointStore) Option {
+	return func(o *options) {
+		o.checkpoints = checkpoints
+	}
+}
@@ -28,13 +28,18 @@ package pruner
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 )
 
 // DBPruner is a struct that holds the prunable interface and a notifier
-// channel.
+// channel. Block events only enqueue prune requests, which are serviced by a
+// separate worker so that slow prunes do not hold up the block feed.
 type DBPruner[
 	BeaconBlockT BeaconBlock,
 	BlockEventT BlockEvent[BeaconBlockT],
@@ -46,6 +51,29 @@ type DBPruner[
 	name         string
 	feed         BlockFeed[BeaconBlockT, BlockEventT, SubscriptionT]
 	pruneRangeFn func(BlockEventT) (uint64, uint64)
+	metrics      *prunerMetrics
+	// queue holds the prune requests waiting for the worker.
+	queue chan pruneRequest
+	// droppedMu guards dropped.
+	droppedMu sync.Mutex
+	// dropped spans the requests dropped while the queue was full, nil if
+	// there are none. It is serviced along with the next requests taken by
+	// the worker.
+	dropped *pruneRequest
+	// done is closed once the pruning goroutines have exited.
+	done chan struct{}
+	// safeEnd is the end of the latest range computed from a finalized
+	// block. Manual prunes may not reach past it.
+	safeEnd atomic.Uint64
+	// pruneMu serializes the prunes of the worker with manual prunes.
+	pruneMu sync.Mutex
+	// checkpoint is the index below which everything has been pruned, it is
+	// guarded by pruneMu.
+	checkpoint  uint64
+	checkpoints CheckpointStore
+
+	mu     sync.RWMutex
+	health Health
 }
 
 func NewPruner[
@@ -59,23 +87,38 @@ func NewPruner[
 	name string,
 	feed BlockFeed[BeaconBlockT, BlockEventT, SubscriptionT],
 	pruneRangeFn func(BlockEventT) (uint64, uint64),
+	opts ...Option,
 ) *DBPruner[BeaconBlockT, BlockEventT, PrunableT, SubscriptionT] {
+	o := options{queueSize: defaultQueueSize}
+	for _, opt := range opts {
+		opt(&o)
+	}
 	return &DBPruner[BeaconBlockT, BlockEventT, PrunableT, SubscriptionT]{
 		logger:       logger,
 		prunable:     prunable,
 		name:         name,
 		feed:         feed,
 		pruneRangeFn: pruneRangeFn,
+		metrics:      newPrunerMetrics(o.telemetrySink, name),
+		queue:        make(chan pruneRequest, max(o.queueSize, 1)),
+		done:         make(chan struct{}),
+		checkpoints:  o.checkpoints,
 	}
 }
 
-// Start starts the Pruner by listening for new indexes to prune.
+// Start starts the Pruner by listening for new indexes to prune. The pruner
+// stops once ctx is cancelled, after the prune in progress has completed.
 func (p *DBPruner[
 	BeaconBlockT, BlockEventT, PrunableT, SubscriptionT,
 ]) Start(ctx context.Context) {
+	p.loadCheckpoint()
 	ch := make(chan BlockEventT)
 	sub := p.feed.Subscribe(ch)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
 	go func() {
+		defer wg.Done()
 		defer sub.Unsubscribe()
 		for {
 			select {
@@ -84,16 +127,217 @@ func (p *DBPruner[
 			case event := <-ch:
 				if event.Is(events.BeaconBlockFinalized) {
 					start, end := p.pruneRangeFn(event)
-					if err := p.prunable.Prune(start, end); err != nil {
-						p.logger.Error(
-							"‼️ error pruning index ‼️",
-							"error", err,
-						)
+					if end > p.safeEnd.Load() {
+						p.safeEnd.Store(end)
 					}
+					p.enqueue(start, end)
 				}
 			}
 		}
 	}()
+	go func() {
+		defer wg.Done()
+		p.work(ctx)
+	}()
+	go func() {
+		wg.Wait()
+		close(p.done)
+	}()
+}
+
+// enqueue queues a request to prune [start, end) without blocking. The
+// request is dropped if the queue is full, its range is then pruned along
+// with the next requests taken by the worker.
+func (p *DBPruner[
+	BeaconBlockT, BlockEventT, PrunableT, SubscriptionT,
+]) enqueue(start, end uint64) {
+	select {
+	case p.queue <- pruneRequest{start: start, end: end}:
+	default:
+		p.metrics.markDropped()
+		p.logger.Warn(
+			"prune queue is full, dropping request",
+			"start", start, "end", end,
+		)
+		p.droppedMu.Lock()
+		defer p.droppedMu.Unlock()
+		if p.dropped == nil {
+			p.dropped = &pruneRequest{start: start, end: end}
+			return
+		}
+		p.dropped.start = min(p.dropped.start, start)
+		p.dropped.end = max(p.dropped.end, end)
+	}
+}
+
+// work services the prune requests until ctx is cancelled. The requests
+// waiting in the queue are coalesced before being pruned.
+func (p *DBPruner[
+	BeaconBlockT, BlockEventT, PrunableT, SubscriptionT,
+]) work(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case req := <-p.queue:
+			for _, req = range coalesce(p.drain(req)) {
+				if ctx.Err() != nil {
+					return
+				}
+				//nolint:errcheck // the outcome is logged and recorded.
+				p.prune(req.start, req.end)
+			}
+		}
+	}
+}
+
+// drain returns the given request followed by the requests waiting in the
+// queue and the span of the requests dropped meanwhile, if any.
+func (p *DBPruner[
+	BeaconBlockT, BlockEventT, PrunableT, SubscriptionT,
+]) drain(req pruneRequest) []pruneRequest {
+	reqs := []pruneRequest{req}
+	for {
+		select {
+		case req = <-p.queue:
+			reqs = append(reqs, req)
+		default:
+			p.droppedMu.Lock()
+			defer p.droppedMu.Unlock()
+			if p.dropped != nil {
+				reqs = append(reqs, *p.dropped)
+				p.dropped = nil
+			}
+			return reqs
+		}
+	}
+}
+
+// PruneNow prunes the range [start, end) right away, or only counts the
+// entries in it if dryRun is set. Ranges reaching past the end of the latest
+// range computed from a finalized block are refused, since they may hold
+// data that is still needed.
+func (p *DBPruner[
+	BeaconBlockT, BlockEventT, PrunableT, SubscriptionT,
+]) PruneNow(
+	ctx context.Context, start, end uint64, dryRun bool,
+) (Result, error) {
+	if start > end {
+		return Result{}, errors.Wrapf(
+			ErrInvalidRange, "start %d is after end %d", start, end,
+		)
+	}
+	if safeEnd := p.safeEnd.Load(); end > safeEnd {
+		return Result{}, errors.Wrapf(
+			ErrUnsafeRange, "end %d is after %d", end, safeEnd,
+		)
+	}
+	if err := ctx.Err(); err != nil {
+		return Result{}, err
+	}
+	if !dryRun {
+		return p.prune(start, end)
+	}
+
+	counter, ok := p.prunable.(RangeCounter)
+	if !ok {
+		return Result{}, ErrDryRunNotSupported
+	}
+	p.pruneMu.Lock()
+	defer p.pruneMu.Unlock()
+	count, err := counter.CountRange(start, end)
+	return Result{Start: start, End: end, Deleted: count}, err
+}
+
+// prune prunes the range [start, end) and records its outcome. The part of
+// the range below the checkpoint has already been pruned and is skipped.
+func (p *DBPruner[
+	BeaconBlockT, BlockEventT, PrunableT, SubscriptionT,
+]) prune(start, end uint64) (Result, error) {
+	p.pruneMu.Lock()
+	defer p.pruneMu.Unlock()
+
+	if start < p.checkpoint {
+		if end <= p.checkpoint {
+			return Result{Start: start, End: end}, nil
+		}
+		start = p.checkpoint
+	}
+
+	now := time.Now()
+	res, err := p.pruneRange(start, end)
+	p.metrics.markPrune(now, res, err)
+	p.recordPrune(err)
+	if err != nil {
+		p.logger.Error(
+			"‼️ error pruning index ‼️",
+			"start", start, "end", end, "error", err,
+		)
+		return res, err
+	}
+	p.advanceCheckpoint(start, end)
+	p.logger.Info(
+		"pruned range",
+		"start", res.Start, "end", res.End, "deleted", res.Deleted,
+		"duration", time.Since(now),
+	)
+	return res, nil
+}
+
+// loadCheckpoint restores the checkpoint persisted by a previous run of the
+// pruner.
+func (p *DBPruner[
+	BeaconBlockT, BlockEventT, PrunableT, SubscriptionT,
+]) loadCheckpoint() {
+	if p.checkpoints == nil {
+		return
+	}
+	checkpoint, err := p.checkpoints.Checkpoint(p.name)
+	if err != nil {
+		p.logger.Error("failed to load prune checkpoint", "error", err)
+		return
+	}
+
+	p.pruneMu.Lock()
+	defer p.pruneMu.Unlock()
+	p.checkpoint = max(p.checkpoint, checkpoint)
+	if p.checkpoint > 0 {
+		p.logger.Info("resuming pruning", "checkpoint", p.checkpoint)
+	}
+}
+
+// advanceCheckpoint moves the checkpoint to end after [start, end) has been
+// pruned, if the range continues from the current checkpoint. It must be
+// called with pruneMu held.
+func (p *DBPruner[
+	BeaconBlockT, BlockEventT, PrunableT, SubscriptionT,
+]) advanceCheckpoint(start, end uint64) {
+	if start > p.checkpoint || end <= p.checkpoint {
+		return
+	}
+	p.checkpoint = end
+	if p.checkpoints == nil {
+		return
+	}
+	if err := p.checkpoints.SetCheckpoint(p.name, end); err != nil {
+		p.logger.Error(
+			"failed to persist prune checkpoint",
+			"checkpoint", end, "error", err,
+		)
+	}
+}
+
+// pruneRange prunes the range [start, end) of the prunable.
+func (p *DBPruner[
+	BeaconBlockT, BlockEventT, PrunableT, SubscriptionT,
+]) pruneRange(start, end uint64) (Result, error) {
+	res := Result{Start: start, End: end}
+	if counting, ok := p.prunable.(CountingPrunable); ok {
+		deleted, err := counting.PruneCounted(start, end)
+		res.Deleted = deleted
+		return res, err
+	}
+	return res, p.prunable.Prune(start, end)
 }
 
 // Name returns the name of the Pruner.
@@ -102,3 +346,33 @@ func (p *DBPruner[
 ]) Name() string {
 	return p.name
 }
+
+// Done returns a channel that is closed once the pruner has stopped.
+func (p *DBPruner[
+	BeaconBlockT, BlockEventT, PrunableT, SubscriptionT,
+]) Done() <-chan struct{} {
+	return p.done
+}
+
+// Health returns the outcome of the latest prunes.
+func (p *DBPruner[
+	BeaconBlockT, BlockEventT, PrunableT, SubscriptionT,
+]) Health() Health {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.health
+}
+
+// recordPrune records the outcome of a prune.
+func (p *DBPruner[
+	BeaconBlockT, BlockEventT, PrunableT, SubscriptionT,
+]) recordPrune(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err != nil {
+		p.health.LastError = err
+		p.health.LastErrorTime = time.Now()
+		return
+	}
+	p.health.LastSuccess = time.Now()
+}
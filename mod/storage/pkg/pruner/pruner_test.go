@@ -27,6 +27,11 @@ package pruner_test
 
 import (
 	"context"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -36,6 +41,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner/mocks"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func pruneRangeFn[EventT pruner.BlockEvent[pruner.BeaconBlock]](
@@ -64,6 +70,19 @@ func (ef *eventFeed[BlockEventT]) Subscribe(
 	return subscription
 }
 
+// sendBlock notifies the subscriber of the feed of a finalized block at the
+// given slot.
+func sendBlock(
+	feed *eventFeed[pruner.BlockEvent[pruner.BeaconBlock]], slot uint64,
+) {
+	block := mocks.BeaconBlock{}
+	block.On("GetSlot").Return(math.U64(slot))
+	event := mocks.BlockEvent[pruner.BeaconBlock]{}
+	event.On("Data").Return(&block)
+	event.On("Is", mock.Anything).Return(true)
+	feed.Send(&event)
+}
+
 func TestPruner(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -142,3 +161,553 @@ func TestPruner(t *testing.T) {
 		})
 	}
 }
+
+func TestPruner_HealthAndDone(t *testing.T) {
+	feed := eventFeed[pruner.BlockEvent[pruner.BeaconBlock]]{}
+	errPrune := errors.New("prune failed")
+	mockPrunable := new(interfacemocks.Prunable)
+	mockPrunable.On("Prune", uint64(1), mock.Anything).Return(nil)
+	mockPrunable.On("Prune", uint64(2), mock.Anything).Return(errPrune)
+
+	testPruner := pruner.NewPruner[
+		pruner.BeaconBlock,
+		pruner.BlockEvent[pruner.BeaconBlock],
+		pruner.Prunable,
+		pruner.Subscription,
+	](log.NewNopLogger(), mockPrunable, "TestPruner", &feed, pruneRangeFn)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	testPruner.Start(ctx)
+	require.True(t, testPruner.Health().Healthy())
+
+	for _, index := range []uint64{1, 2} {
+		sendBlock(&feed, index)
+	}
+	require.Eventually(t, func() bool {
+		health := testPruner.Health()
+		return health.LastError != nil && !health.LastSuccess.IsZero()
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	select {
+	case <-testPruner.Done():
+	case <-time.After(time.Second):
+		t.Fatal("pruner did not stop")
+	}
+
+	health := testPruner.Health()
+	require.False(t, health.Healthy())
+	require.ErrorIs(t, health.LastError, errPrune)
+	require.False(t, health.LastSuccess.IsZero())
+}
+
+// countingPrunable is a prunable that reports a fixed number of deleted
+// entries per prune, and fails the prunes of the given starts.
+type countingPrunable struct {
+	deleted uint64
+	fail    map[uint64]bool
+}
+
+func (p countingPrunable) Prune(start, end uint64) error {
+	_, err := p.PruneCounted(start, end)
+	return err
+}
+
+func (p countingPrunable) PruneCounted(start, _ uint64) (uint64, error) {
+	if p.fail[start] {
+		return 0, errors.New("prune failed")
+	}
+	return p.deleted, nil
+}
+
+// recordingSink is a telemetry sink that records the metrics it receives,
+// keyed by the metric key followed by its labels.
+type recordingSink struct {
+	mu       sync.Mutex
+	counters map[string]int
+	gauges   map[string]int64
+	measures map[string]int
+}
+
+func newRecordingSink() *recordingSink {
+	return &recordingSink{
+		counters: make(map[string]int),
+		gauges:   make(map[string]int64),
+		measures: make(map[string]int),
+	}
+}
+
+func (s *recordingSink) IncrementCounter(key string, args ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counters[strings.Join(append([]string{key}, args...), "/")]++
+}
+
+func (s *recordingSink) SetGauge(key string, value int64, args ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gauges[strings.Join(append([]string{key}, args...), "/")] = value
+}
+
+func (s *recordingSink) MeasureSince(
+	key string, _ time.Time, args ...string,
+) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.measures[strings.Join(append([]string{key}, args...), "/")]++
+}
+
+func TestPruner_Metrics(t *testing.T) {
+	feed := eventFeed[pruner.BlockEvent[pruner.BeaconBlock]]{}
+	sink := newRecordingSink()
+	testPruner := pruner.NewPruner[
+		pruner.BeaconBlock,
+		pruner.BlockEvent[pruner.BeaconBlock],
+		pruner.Prunable,
+		pruner.Subscription,
+	](
+		log.NewNopLogger(),
+		countingPrunable{deleted: 3, fail: map[uint64]bool{2: true}},
+		"TestPruner",
+		&feed,
+		pruneRangeFn,
+		pruner.WithTelemetrySink(sink),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	testPruner.Start(ctx)
+	for _, index := range []uint64{1, 2, 3} {
+		sendBlock(&feed, index)
+	}
+	require.Eventually(t, func() bool {
+		sink.mu.Lock()
+		defer sink.mu.Unlock()
+		return len(sink.measures) == 1 &&
+			sink.measures["beacon_kit.pruner.prune_duration/pruner/TestPruner"] == 3
+	}, time.Second, 10*time.Millisecond)
+	cancel()
+	<-testPruner.Done()
+
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+	require.Equal(t, map[string]int{
+		"beacon_kit.pruner.ranges_pruned/pruner/TestPruner": 2,
+		"beacon_kit.pruner.errors/pruner/TestPruner":        1,
+	}, sink.counters)
+	require.Equal(t, map[string]int64{
+		"beacon_kit.pruner.entries_deleted/pruner/TestPruner": 6,
+	}, sink.gauges)
+	require.Equal(t, map[string]int{
+		"beacon_kit.pruner.prune_duration/pruner/TestPruner": 3,
+	}, sink.measures)
+}
+
+// blockingPrunable is a prunable whose first prune blocks until released.
+type blockingPrunable struct {
+	mu      sync.Mutex
+	calls   [][2]uint64
+	started chan struct{}
+	release chan struct{}
+}
+
+func (p *blockingPrunable) Prune(start, end uint64) error {
+	p.mu.Lock()
+	p.calls = append(p.calls, [2]uint64{start, end})
+	first := len(p.calls) == 1
+	p.mu.Unlock()
+	if first {
+		close(p.started)
+		<-p.release
+	}
+	return nil
+}
+
+func (p *blockingPrunable) pruned() [][2]uint64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return slices.Clone(p.calls)
+}
+
+func TestPruner_Queue(t *testing.T) {
+	tests := []struct {
+		name      string
+		queueSize int
+		slots     []uint64
+		expected  [][2]uint64
+		dropped   int
+	}{
+		{
+			name:      "CoalescesAdjacentRanges",
+			queueSize: 8,
+			slots:     []uint64{2, 3, 4},
+			expected:  [][2]uint64{{1, 2}, {2, 5}},
+		},
+		{
+			name:      "CoalescesOverlappingRanges",
+			queueSize: 8,
+			slots:     []uint64{4, 3, 3},
+			expected:  [][2]uint64{{1, 2}, {3, 5}},
+		},
+		{
+			name:      "KeepsDisjointRanges",
+			queueSize: 8,
+			slots:     []uint64{5, 8},
+			expected:  [][2]uint64{{1, 2}, {5, 6}, {8, 9}},
+		},
+		{
+			name:      "PrunesDroppedRanges",
+			queueSize: 1,
+			slots:     []uint64{2, 4, 3},
+			expected:  [][2]uint64{{1, 2}, {2, 5}},
+			dropped:   2,
+		},
+		{
+			name:      "PrunesDisjointDroppedRanges",
+			queueSize: 1,
+			slots:     []uint64{2, 6, 7},
+			expected:  [][2]uint64{{1, 2}, {2, 3}, {6, 8}},
+			dropped:   2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed := eventFeed[pruner.BlockEvent[pruner.BeaconBlock]]{}
+			sink := newRecordingSink()
+			prunable := &blockingPrunable{
+				started: make(chan struct{}),
+				release: make(chan struct{}),
+			}
+			testPruner := pruner.NewPruner[
+				pruner.BeaconBlock,
+				pruner.BlockEvent[pruner.BeaconBlock],
+				pruner.Prunable,
+				pruner.Subscription,
+			](
+				log.NewNopLogger(),
+				prunable,
+				"TestPruner",
+				&feed,
+				func(event pruner.BlockEvent[pruner.BeaconBlock]) (
+					uint64, uint64,
+				) {
+					slot := event.Data().GetSlot().Unwrap()
+					return slot, slot + 1
+				},
+				pruner.WithTelemetrySink(sink),
+				pruner.WithQueueSize(tt.queueSize),
+			)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			testPruner.Start(ctx)
+
+			// The first prune blocks the worker, the following events must
+			// still be delivered without waiting for it.
+			sendBlock(&feed, 1)
+			<-prunable.started
+			delivered := make(chan struct{})
+			go func() {
+				defer close(delivered)
+				for _, slot := range tt.slots {
+					sendBlock(&feed, slot)
+				}
+				// Events that are not finalizations are not queued, once
+				// one is delivered every previous event has been queued.
+				flush := mocks.BlockEvent[pruner.BeaconBlock]{}
+				flush.On("Is", mock.Anything).Return(false)
+				feed.Send(&flush)
+			}()
+			select {
+			case <-delivered:
+			case <-time.After(time.Second):
+				t.Fatal("slow prune blocked event delivery")
+			}
+
+			close(prunable.release)
+			require.Eventually(t, func() bool {
+				return len(prunable.pruned()) == len(tt.expected)
+			}, time.Second, 10*time.Millisecond)
+			require.Equal(t, tt.expected, prunable.pruned())
+
+			sink.mu.Lock()
+			defer sink.mu.Unlock()
+			require.Equal(t, tt.dropped,
+				sink.counters["beacon_kit.pruner.dropped/pruner/TestPruner"],
+			)
+		})
+	}
+}
+
+// entriesPrunable is a store holding the given number of entries per index.
+type entriesPrunable struct {
+	mu      sync.Mutex
+	entries map[uint64]uint64
+}
+
+func (p *entriesPrunable) Prune(start, end uint64) error {
+	_, err := p.PruneCounted(start, end)
+	return err
+}
+
+func (p *entriesPrunable) PruneCounted(start, end uint64) (uint64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var deleted uint64
+	for index, n := range p.entries {
+		if index >= start && index < end {
+			deleted += n
+			delete(p.entries, index)
+		}
+	}
+	return deleted, nil
+}
+
+func (p *entriesPrunable) CountRange(start, end uint64) (uint64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var count uint64
+	for index, n := range p.entries {
+		if index >= start && index < end {
+			count += n
+		}
+	}
+	return count, nil
+}
+
+// finalize notifies the subscriber of the feed of a finalized block at the
+// given slot and waits for the event to be handled.
+func finalize(
+	feed *eventFeed[pruner.BlockEvent[pruner.BeaconBlock]], slot uint64,
+) {
+	sendBlock(feed, slot)
+	flush := mocks.BlockEvent[pruner.BeaconBlock]{}
+	flush.On("Is", mock.Anything).Return(false)
+	feed.Send(&flush)
+}
+
+func TestPruner_PruneNow(t *testing.T) {
+	entries := map[uint64]uint64{1: 2, 2: 1, 3: 4, 5: 3, 9: 1, 12: 5}
+	tests := []struct {
+		name      string
+		finalized uint64
+		start     uint64
+		end       uint64
+		dryRun    bool
+		deleted   uint64
+		removed   []uint64
+		err       error
+	}{
+		{
+			name:      "DryRunCountsEntries",
+			finalized: 10,
+			start:     2,
+			end:       6,
+			dryRun:    true,
+			deleted:   8,
+		},
+		{
+			name:      "PrunesCountedEntries",
+			finalized: 10,
+			start:     2,
+			end:       6,
+			deleted:   8,
+			removed:   []uint64{2, 3, 5},
+		},
+		{
+			name:      "DryRunEmptyRange",
+			finalized: 10,
+			start:     6,
+			end:       9,
+			dryRun:    true,
+		},
+		{
+			name:      "RefusesUnfinalizedData",
+			finalized: 10,
+			start:     2,
+			end:       13,
+			err:       pruner.ErrUnsafeRange,
+		},
+		{
+			name:  "RefusesBeforeFinalization",
+			start: 0,
+			end:   2,
+			err:   pruner.ErrUnsafeRange,
+		},
+		{
+			name:      "RefusesInvertedRange",
+			finalized: 10,
+			start:     6,
+			end:       2,
+			dryRun:    true,
+			err:       pruner.ErrInvalidRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed := eventFeed[pruner.BlockEvent[pruner.BeaconBlock]]{}
+			prunable := &entriesPrunable{entries: maps.Clone(entries)}
+			testPruner := pruner.NewPruner[
+				pruner.BeaconBlock,
+				pruner.BlockEvent[pruner.BeaconBlock],
+				pruner.Prunable,
+				pruner.Subscription,
+			](
+				log.NewNopLogger(),
+				prunable,
+				"TestPruner",
+				&feed,
+				pruneRangeFn,
+			)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			testPruner.Start(ctx)
+			if tt.finalized > 0 {
+				finalize(&feed, tt.finalized)
+			}
+
+			res, err := testPruner.PruneNow(ctx, tt.start, tt.end, tt.dryRun)
+			expected := maps.Clone(entries)
+			for _, index := range tt.removed {
+				delete(expected, index)
+			}
+			prunable.mu.Lock()
+			defer prunable.mu.Unlock()
+			require.Equal(t, expected, prunable.entries)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, pruner.Result{
+				Start: tt.start, End: tt.end, Deleted: tt.deleted,
+			}, res)
+		})
+	}
+}
+
+func TestPruner_PruneNowDryRunNotSupported(t *testing.T) {
+	feed := eventFeed[pruner.BlockEvent[pruner.BeaconBlock]]{}
+	testPruner := pruner.NewPruner[
+		pruner.BeaconBlock,
+		pruner.BlockEvent[pruner.BeaconBlock],
+		pruner.Prunable,
+		pruner.Subscription,
+	](
+		log.NewNopLogger(),
+		countingPrunable{deleted: 1},
+		"TestPruner",
+		&feed,
+		pruneRangeFn,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	testPruner.Start(ctx)
+	finalize(&feed, 10)
+
+	_, err := testPruner.PruneNow(ctx, 2, 6, true)
+	require.ErrorIs(t, err, pruner.ErrDryRunNotSupported)
+}
+
+// memCheckpoints is an in-memory checkpoint store.
+type memCheckpoints struct {
+	mu          sync.Mutex
+	checkpoints map[string]uint64
+}
+
+func (s *memCheckpoints) Checkpoint(name string) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.checkpoints[name], nil
+}
+
+func (s *memCheckpoints) SetCheckpoint(name string, index uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoints[name] = index
+	return nil
+}
+
+// recordingPrunable records the ranges it prunes and fails if err is set.
+type recordingPrunable struct {
+	mu    sync.Mutex
+	calls [][2]uint64
+	err   error
+}
+
+func (p *recordingPrunable) Prune(start, end uint64) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.calls = append(p.calls, [2]uint64{start, end})
+	return p.err
+}
+
+func (p *recordingPrunable) pruned() [][2]uint64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return slices.Clone(p.calls)
+}
+
+func TestPruner_Checkpoint(t *testing.T) {
+	checkpoints := &memCheckpoints{checkpoints: make(map[string]uint64)}
+	// run starts a pruner that prunes everything below the finalized slot,
+	// finalizes the given slots and waits for the resulting prunes.
+	run := func(
+		prunable *recordingPrunable, slots []uint64, calls int,
+	) {
+		t.Helper()
+		feed := eventFeed[pruner.BlockEvent[pruner.BeaconBlock]]{}
+		testPruner := pruner.NewPruner[
+			pruner.BeaconBlock,
+			pruner.BlockEvent[pruner.BeaconBlock],
+			pruner.Prunable,
+			pruner.Subscription,
+		](
+			log.NewNopLogger(),
+			prunable,
+			"TestPruner",
+			&feed,
+			func(event pruner.BlockEvent[pruner.BeaconBlock]) (
+				uint64, uint64,
+			) {
+				return 0, event.Data().GetSlot().Unwrap()
+			},
+			pruner.WithCheckpointStore(checkpoints),
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		testPruner.Start(ctx)
+		for _, slot := range slots {
+			finalize(&feed, slot)
+		}
+		require.Eventually(t, func() bool {
+			return len(prunable.pruned()) == calls
+		}, time.Second, 10*time.Millisecond)
+		// The worker completes the prune in progress before it exits.
+		cancel()
+		<-testPruner.Done()
+	}
+
+	// A failed prune does not move the checkpoint.
+	run(&recordingPrunable{err: errors.New("prune failed")}, []uint64{5}, 1)
+	checkpoint, err := checkpoints.Checkpoint("TestPruner")
+	require.NoError(t, err)
+	require.Zero(t, checkpoint)
+
+	run(&recordingPrunable{}, []uint64{10}, 1)
+	checkpoint, err = checkpoints.Checkpoint("TestPruner")
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), checkpoint)
+
+	// After a restart, ranges below the checkpoint are not pruned again and
+	// the next prune resumes from it.
+	restarted := &recordingPrunable{}
+	run(restarted, []uint64{8, 25}, 1)
+	require.Equal(t, [][2]uint64{{10, 25}}, restarted.pruned())
+	checkpoint, err = checkpoints.Checkpoint("TestPruner")
+	require.NoError(t, err)
+	require.Equal(t, uint64(25), checkpoint)
+}
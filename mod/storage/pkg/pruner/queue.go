@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+
+package pruner
+
+import "slices"
+
+// defaultQueueSize is the default number of prune requests that can wait for
+// the worker of a pruner.
+const defaultQueueSize = 16
+
+// pruneRequest is a request to prune the range [start, end).
+type pruneRequest struct {
+	start uint64
+	end   uint64
+}
+
+// coalesce merges the requests whose ranges overlap or are adjacent and
+// returns the merged requests in ascending order of start.
+func coalesce(reqs []pruneRequest) []pruneRequest {
+	if len(reqs) < 2 {
+		return reqs
+	}
+	slices.SortFunc(reqs, func(a, b pruneRequest) int {
+		switch {
+		case a.start < b.start:
+			return -1
+		case a.start > b.start:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	merged := reqs[:1]
+	for _, req := range reqs[1:] {
+		last := &merged[len(merged)-1]
+		if req.start <= last.end {
+			last.end = max(last.end, req.end)
+			continue
+		}
+		merged = append(merged, req)
+	}
+	return merged
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rangedb
+
+const (
+	// BackendFileDB stores every entry in its own file on the filesystem.
+	BackendFileDB = "filedb"
+	// BackendPebble stores entries in a pebble key-value store.
+	BackendPebble = "pebble"
+)
+
+// Backend is a database that stores values under a key and an index, and
+// allows whole ranges of indexes to be removed at once.
+type Backend interface {
+	// Get returns the value for the given index and key, or an error if it
+	// does not exist.
+	Get(index uint64, key []byte) ([]byte, error)
+	// Has returns true if a value exists for the given index and key.
+	Has(index uint64, key []byte) (bool, error)
+	// Set stores the value for the given index and key.
+	Set(index uint64, key []byte, value []byte) error
+	// SetBatch stores the values for the given keys at the given index
+	// atomically, either all of them are stored or none of them is.
+	SetBatch(index uint64, keys, values [][]byte) error
+	// Delete removes the value for the given index and key.
+	Delete(index uint64, key []byte) error
+	// Iterate calls fn for every entry with an index in [from, to), in
+	// ascending order of index. The order of the keys within an index is
+	// unspecified. Iteration stops at the first error returned by fn.
+	Iterate(
+		from, to uint64, fn func(index uint64, key, value []byte) error,
+	) error
+	// DeleteRange removes every entry with an index in [from, to) and
+	// returns the number of entries removed.
+	DeleteRange(from, to uint64) (uint64, error)
+	// Prune removes every entry with an index in [start, end).
+	Prune(start, end uint64) error
+}
@@ -0,0 +1,209 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rangedb_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"testing"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/rangedb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/rangedb/rangedbtest"
+	"github.com/stretchr/testify/require"
+)
+
+// backends returns a constructor for the Backend implementations of the
+// package, the kv one over an in memory store. The pebble store is run
+// through the conformance tests by the node, which opens it.
+func backends() map[string]func(t *testing.T) rangedb.Backend {
+	return map[string]func(t *testing.T) rangedb.Backend{
+		rangedb.BackendFileDB: func(t *testing.T) rangedb.Backend {
+			t.Helper()
+			return filedb.NewRangeDB(filedb.NewDB(
+				filedb.WithRootDirectory(t.TempDir()),
+				filedb.WithFileExtension("ssz"),
+				filedb.WithLogger(log.NewNopLogger()),
+			))
+		},
+		"kv": func(*testing.T) rangedb.Backend {
+			return rangedb.NewKVBackend(newMemKV())
+		},
+	}
+}
+
+func TestBackend_Conformance(t *testing.T) {
+	for name, newBackend := range backends() {
+		t.Run(name, func(t *testing.T) {
+			rangedbtest.RunConformance(t, newBackend)
+		})
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	for srcName, newSrc := range backends() {
+		for dstName, newDst := range backends() {
+			if srcName == dstName {
+				continue
+			}
+			t.Run(srcName+"->"+dstName, func(t *testing.T) {
+				src, dst := newSrc(t), newDst(t)
+				fixture := rangedbtest.Fixture()
+				rangedbtest.Populate(t, src, fixture)
+
+				copied, err := rangedb.Migrate(context.Background(), src, dst)
+				require.NoError(t, err)
+				require.Equal(t, uint64(len(fixture)), copied)
+				require.Equal(t,
+					fixture, rangedbtest.Collect(t, dst, 0, math.MaxUint64),
+				)
+
+				// Rerunning the migration is harmless.
+				_, err = rangedb.Migrate(context.Background(), src, dst)
+				require.NoError(t, err)
+				require.Equal(t,
+					fixture, rangedbtest.Collect(t, dst, 0, math.MaxUint64),
+				)
+			})
+		}
+	}
+}
+
+// memKV is an in memory store.KVStoreWithBatch.
+type memKV struct {
+	entries map[string][]byte
+}
+
+func newMemKV() *memKV {
+	return &memKV{entries: make(map[string][]byte)}
+}
+
+func (m *memKV) Get(key []byte) ([]byte, error) {
+	return m.entries[string(key)], nil
+}
+
+func (m *memKV) Has(key []byte) (bool, error) {
+	_, ok := m.entries[string(key)]
+	return ok, nil
+}
+
+func (m *memKV) Set(key, value []byte) error {
+	m.entries[string(key)] = append([]byte(nil), value...)
+	return nil
+}
+
+func (m *memKV) Delete(key []byte) error {
+	delete(m.entries, string(key))
+	return nil
+}
+
+func (m *memKV) Iterator(start, end []byte) (store.Iterator, error) {
+	it := &memIterator{start: start, end: end}
+	for key, value := range m.entries {
+		if bytes.Compare([]byte(key), start) >= 0 &&
+			(end == nil || bytes.Compare([]byte(key), end) < 0) {
+			it.keys = append(it.keys, key)
+			it.values = append(it.values, value)
+		}
+	}
+	sort.Sort(it)
+	return it, nil
+}
+
+func (m *memKV) ReverseIterator([]byte, []byte) (store.Iterator, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *memKV) NewBatch() store.Batch {
+	return &memBatch{kv: m}
+}
+
+func (m *memKV) Close() error { return nil }
+
+func (m *memKV) NewBatchWithSize(int) store.Batch {
+	return m.NewBatch()
+}
+
+// memIterator iterates over a snapshot of the keys of a memKV.
+type memIterator struct {
+	start, end []byte
+	keys       []string
+	values     [][]byte
+	pos        int
+}
+
+func (it *memIterator) Len() int { return len(it.keys) }
+
+func (it *memIterator) Less(i, j int) bool { return it.keys[i] < it.keys[j] }
+
+func (it *memIterator) Swap(i, j int) {
+	it.keys[i], it.keys[j] = it.keys[j], it.keys[i]
+	it.values[i], it.values[j] = it.values[j], it.values[i]
+}
+
+func (it *memIterator) Domain() ([]byte, []byte) { return it.start, it.end }
+
+func (it *memIterator) Valid() bool { return it.pos < len(it.keys) }
+
+func (it *memIterator) Next() { it.pos++ }
+
+func (it *memIterator) Key() []byte { return []byte(it.keys[it.pos]) }
+
+func (it *memIterator) Value() []byte { return it.values[it.pos] }
+
+func (it *memIterator) Error() error { return nil }
+
+func (it *memIterator) Close() error { return nil }
+
+// memBatch buffers writes to a memKV until it is written.
+type memBatch struct {
+	kv  *memKV
+	ops []func()
+}
+
+func (b *memBatch) Set(key, value []byte) error {
+	b.ops = append(b.ops, func() { _ = b.kv.Set(key, value) })
+	return nil
+}
+
+func (b *memBatch) Delete(key []byte) error {
+	b.ops = append(b.ops, func() { _ = b.kv.Delete(key) })
+	return nil
+}
+
+func (b *memBatch) Write() error {
+	for _, op := range b.ops {
+		op()
+	}
+	b.ops = nil
+	return nil
+}
+
+func (b *memBatch) WriteSync() error { return b.Write() }
+
+func (b *memBatch) Close() error { return nil }
+
+func (b *memBatch) GetByteSize() (int, error) { return 0, nil }
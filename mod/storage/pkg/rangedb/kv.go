@@ -0,0 +1,209 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rangedb
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+)
+
+// indexLen is the length of the big endian index prefixed to every key.
+const indexLen = 8
+
+// Compile-time assertions of the backend and prunable interfaces.
+var (
+	_ Backend                 = (*KVBackend)(nil)
+	_ pruner.CountingPrunable = (*KVBackend)(nil)
+	_ pruner.RangeCounter     = (*KVBackend)(nil)
+)
+
+// ErrNotFound is returned when a value does not exist.
+var ErrNotFound = errors.New("rangedb: not found")
+
+// KVBackend is a Backend on top of an ordered key-value store, such as
+// pebble. Keys are prefixed with their big endian index, so the entries of
+// an index are contiguous and ranges of indexes map to ranges of keys.
+type KVBackend struct {
+	db store.KVStoreWithBatch
+}
+
+// NewKVBackend creates a new KVBackend.
+func NewKVBackend(db store.KVStoreWithBatch) *KVBackend {
+	return &KVBackend{db: db}
+}
+
+// Close closes the underlying store.
+func (b *KVBackend) Close() error {
+	return b.db.Close()
+}
+
+// Get returns the value for the given index and key.
+func (b *KVBackend) Get(index uint64, key []byte) ([]byte, error) {
+	value, err := b.db.Get(encodeKey(index, key))
+	if err != nil {
+		return nil, err
+	} else if value == nil {
+		return nil, errors.Wrapf(ErrNotFound, "index %d key %x", index, key)
+	}
+	return value, nil
+}
+
+// Has returns true if a value exists for the given index and key.
+func (b *KVBackend) Has(index uint64, key []byte) (bool, error) {
+	return b.db.Has(encodeKey(index, key))
+}
+
+// Set stores the value for the given index and key.
+func (b *KVBackend) Set(index uint64, key []byte, value []byte) error {
+	return b.db.Set(encodeKey(index, key), value)
+}
+
+// SetBatch stores the values for the given keys at the given index in a
+// single write batch.
+func (b *KVBackend) SetBatch(index uint64, keys, values [][]byte) error {
+	if len(keys) != len(values) {
+		return errors.New("rangedb: mismatched keys and values")
+	}
+
+	batch := b.db.NewBatch()
+	defer batch.Close()
+	for i := range keys {
+		if err := batch.Set(encodeKey(index, keys[i]), values[i]); err != nil {
+			return err
+		}
+	}
+	return batch.WriteSync()
+}
+
+// Delete removes the value for the given index and key.
+func (b *KVBackend) Delete(index uint64, key []byte) error {
+	return b.db.Delete(encodeKey(index, key))
+}
+
+// Iterate calls fn for every entry with an index in [from, to), in
+// ascending order of index and key.
+func (b *KVBackend) Iterate(
+	from, to uint64, fn func(index uint64, key, value []byte) error,
+) error {
+	if from >= to {
+		return nil
+	}
+
+	it, err := b.db.Iterator(encodeIndex(from), encodeIndex(to))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		index, key := decodeKey(it.Key())
+		value := append([]byte(nil), it.Value()...)
+		if err = fn(index, key, value); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// DeleteRange removes every entry with an index in [from, to) in a single
+// write batch and returns the number of entries removed.
+func (b *KVBackend) DeleteRange(from, to uint64) (uint64, error) {
+	if from >= to {
+		return 0, nil
+	}
+
+	// Collect the keys before deleting them, so the iterator is not
+	// invalidated by the writes.
+	var keys [][]byte
+	it, err := b.db.Iterator(encodeIndex(from), encodeIndex(to))
+	if err != nil {
+		return 0, err
+	}
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, append([]byte(nil), it.Key()...))
+	}
+	if err = errors.Join(it.Error(), it.Close()); err != nil {
+		return 0, err
+	}
+	if len(keys) == 0 {
+		return 0, nil
+	}
+
+	batch := b.db.NewBatch()
+	defer batch.Close()
+	for _, key := range keys {
+		if err = batch.Delete(key); err != nil {
+			return 0, err
+		}
+	}
+	if err = batch.WriteSync(); err != nil {
+		return 0, err
+	}
+	return uint64(len(keys)), nil
+}
+
+// Prune removes every entry with an index in [start, end).
+func (b *KVBackend) Prune(start, end uint64) error {
+	_, err := b.DeleteRange(start, end)
+	return err
+}
+
+// PruneCounted removes every entry with an index in [start, end) and returns
+// the number of entries removed.
+func (b *KVBackend) PruneCounted(start, end uint64) (uint64, error) {
+	return b.DeleteRange(start, end)
+}
+
+// CountRange returns the number of entries with an index in [start, end).
+func (b *KVBackend) CountRange(start, end uint64) (uint64, error) {
+	if start >= end {
+		return 0, nil
+	}
+
+	it, err := b.db.Iterator(encodeIndex(start), encodeIndex(end))
+	if err != nil {
+		return 0, err
+	}
+	var count uint64
+	for ; it.Valid(); it.Next() {
+		count++
+	}
+	return count, errors.Join(it.Error(), it.Close())
+}
+
+// encodeIndex returns the big endian encoding of the index.
+func encodeIndex(index uint64) []byte {
+	return binary.BigEndian.AppendUint64(make([]byte, 0, indexLen), index)
+}
+
+// encodeKey prefixes the key with the big endian index.
+func encodeKey(index uint64, key []byte) []byte {
+	return append(encodeIndex(index), key...)
+}
+
+// decodeKey splits a prefixed key into its index and key.
+func decodeKey(bz []byte) (uint64, []byte) {
+	return binary.BigEndian.Uint64(bz[:indexLen]),
+		append([]byte(nil), bz[indexLen:]...)
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rangedb
+
+import (
+	"context"
+	"math"
+)
+
+// Migrate copies every entry of src into dst and returns the number of
+// entries copied. Entries are written to dst one index at a time with
+// SetBatch, so an index is either fully copied or not at all.
+//
+// Migrate is meant to be run while the node is stopped, e.g. to move the
+// availability store from filedb to pebble:
+//
+//	src := filedb.NewRangeDB(filedb.NewDB(
+//		filedb.WithRootDirectory(home+"/data/blobs"),
+//		filedb.WithFileExtension("ssz"),
+//	))
+//	kv, err := storev2.NewDB(storev2.DBTypePebbleDB, "blobs", home+"/data", nil)
+//	...
+//	n, err := rangedb.Migrate(ctx, src, rangedb.NewKVBackend(kv))
+//
+// and then setting `storage-backend = "pebble"` in the availability store
+// config. Migrating an index that already exists in dst overwrites the
+// entries with the same keys, so an interrupted migration can be rerun.
+func Migrate(ctx context.Context, src, dst Backend) (uint64, error) {
+	var (
+		copied  uint64
+		current uint64
+		keys    [][]byte
+		values  [][]byte
+	)
+
+	flush := func() error {
+		if len(keys) == 0 {
+			return nil
+		}
+		if err := dst.SetBatch(current, keys, values); err != nil {
+			return err
+		}
+		copied += uint64(len(keys))
+		keys, values = nil, nil
+		return nil
+	}
+
+	if err := src.Iterate(0, math.MaxUint64, func(
+		index uint64, key, value []byte,
+	) error {
+		if index != current {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := flush(); err != nil {
+				return err
+			}
+			current = index
+		}
+		keys = append(keys, key)
+		values = append(values, value)
+		return nil
+	}); err != nil {
+		return copied, err
+	}
+	return copied, flush()
+}
@@ -0,0 +1,193 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package rangedbtest provides the conformance tests of the implementations
+// of rangedb.Backend.
+package rangedbtest
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/rangedb"
+	"github.com/stretchr/testify/require"
+)
+
+// Entry is a single value of a backend.
+type Entry struct {
+	Index uint64
+	Key   string
+	Value string
+}
+
+// Fixture returns the entries the conformance tests populate backends with,
+// sorted by index and key.
+func Fixture() []Entry {
+	return []Entry{
+		{1, "a", "1a"},
+		{1, "b", "1b"},
+		{2, "a", "2a"},
+		{5, "c", "5c"},
+		{300, "a", "300a"},
+	}
+}
+
+// Populate writes the entries to the backend.
+func Populate(t *testing.T, b rangedb.Backend, entries []Entry) {
+	t.Helper()
+	for _, e := range entries {
+		require.NoError(t, b.Set(e.Index, []byte(e.Key), []byte(e.Value)))
+	}
+}
+
+// Collect returns the entries of the backend in [from, to), sorted by index
+// and key.
+func Collect(t *testing.T, b rangedb.Backend, from, to uint64) []Entry {
+	t.Helper()
+	entries := []Entry{}
+	require.NoError(t, b.Iterate(from, to, func(
+		index uint64, key, value []byte,
+	) error {
+		entries = append(entries, Entry{index, string(key), string(value)})
+		return nil
+	}))
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Index != entries[j].Index {
+			return entries[i].Index < entries[j].Index
+		}
+		return entries[i].Key < entries[j].Key
+	})
+	return entries
+}
+
+// RunConformance runs the conformance tests on empty backends returned by
+// newBackend.
+//
+//nolint:funlen // one subtest per method.
+func RunConformance(
+	t *testing.T, newBackend func(t *testing.T) rangedb.Backend,
+) {
+	t.Helper()
+	fixture := Fixture()
+
+	t.Run("GetHasSetDelete", func(t *testing.T) {
+		b := newBackend(t)
+		Populate(t, b, fixture)
+
+		value, err := b.Get(1, []byte("b"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("1b"), value)
+
+		ok, err := b.Has(2, []byte("a"))
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		_, err = b.Get(2, []byte("b"))
+		require.Error(t, err)
+
+		require.NoError(t, b.Delete(2, []byte("a")))
+		ok, err = b.Has(2, []byte("a"))
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+
+	t.Run("SetBatch", func(t *testing.T) {
+		b := newBackend(t)
+		require.NoError(t, b.SetBatch(
+			7,
+			[][]byte{[]byte("x"), []byte("y")},
+			[][]byte{[]byte("7x"), []byte("7y")},
+		))
+		require.Equal(t, []Entry{
+			{7, "x", "7x"},
+			{7, "y", "7y"},
+		}, Collect(t, b, 0, math.MaxUint64))
+
+		require.Error(t, b.SetBatch(
+			8, [][]byte{[]byte("x")}, nil,
+		))
+	})
+
+	t.Run("Iterate", func(t *testing.T) {
+		b := newBackend(t)
+		Populate(t, b, fixture)
+
+		require.Equal(t, fixture, Collect(t, b, 0, math.MaxUint64))
+		require.Equal(t, fixture[2:4], Collect(t, b, 2, 300))
+		require.Empty(t, Collect(t, b, 6, 6))
+
+		var indexes []uint64
+		require.NoError(t, b.Iterate(0, math.MaxUint64, func(
+			index uint64, _, _ []byte,
+		) error {
+			indexes = append(indexes, index)
+			return nil
+		}))
+		require.IsNonDecreasing(t, indexes)
+
+		errStop := errors.New("stop")
+		require.ErrorIs(t, b.Iterate(0, math.MaxUint64, func(
+			uint64, []byte, []byte,
+		) error {
+			return errStop
+		}), errStop)
+	})
+
+	t.Run("DeleteRange", func(t *testing.T) {
+		b := newBackend(t)
+		Populate(t, b, fixture)
+
+		removed, err := b.DeleteRange(1, 5)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), removed)
+		require.Equal(t, fixture[3:], Collect(t, b, 0, math.MaxUint64))
+
+		removed, err = b.DeleteRange(1, 5)
+		require.NoError(t, err)
+		require.Zero(t, removed)
+	})
+
+	t.Run("CountRange", func(t *testing.T) {
+		b := newBackend(t)
+		Populate(t, b, fixture)
+		counter, ok := b.(pruner.RangeCounter)
+		require.True(t, ok)
+
+		count, err := counter.CountRange(1, 5)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), count)
+		require.Equal(t, fixture, Collect(t, b, 0, math.MaxUint64))
+
+		removed, err := b.DeleteRange(1, 5)
+		require.NoError(t, err)
+		require.Equal(t, count, removed)
+	})
+
+	t.Run("Prune", func(t *testing.T) {
+		b := newBackend(t)
+		Populate(t, b, fixture)
+
+		require.NoError(t, b.Prune(0, 301))
+		require.Empty(t, Collect(t, b, 0, math.MaxUint64))
+	})
+}
@@ -41,6 +41,7 @@ MONIKER="localtestnet"
 LOGLEVEL="info"
 CONSENSUS_KEY_ALGO="bls12_381"
 HOMEDIR="./.tmp/beacond"
+FEE_RECIPIENT="0x0000000000000000000000000000000000000001"
 
 # Path variables
 GENESIS=$HOMEDIR/config/genesis.json
@@ -73,6 +74,9 @@ if [[ $overwrite == "y" || $overwrite == "Y" ]]; then
 	./build/bin/beacond genesis add-premined-deposit --home $HOMEDIR
 	./build/bin/beacond genesis collect-premined-deposits --home $HOMEDIR 
 	./build/bin/beacond genesis execution-payload "$ETH_GENESIS" --home $HOMEDIR
+	# The node refuses to start without a fee recipient.
+	sed -i.bak "s/^suggested-fee-recipient = .*/suggested-fee-recipient = \"$FEE_RECIPIENT\"/" \
+		$HOMEDIR/config/app.toml && rm $HOMEDIR/config/app.toml.bak
 fi
 
 export CHAIN_SPEC="devnet"